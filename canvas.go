@@ -2,30 +2,75 @@ package canvasapi
 
 import (
 	"bytes"
-	"encoding/json"
+	"context"
 	"fmt"
-	"io/ioutil"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
-	"strconv"
+	"strings"
 )
 
+const defaultScheme = "https"
+
 type Canvas struct {
 	AccessToken string
 	CanvasURL   string
 	UserAgent   string
+
+	// Scheme is the url scheme used to reach CanvasURL. Defaults to https.
+	Scheme string
+	// BasePath is the path Canvas is served under, such as /canvas. Empty when Canvas is
+	// served from the root of CanvasURL.
+	BasePath string
+	// HTTPClient sends the requests. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+	// Header holds additional headers sent with every request.
+	Header http.Header
+	// Throttle delays requests when the Canvas rate limit bucket runs low. Disabled when nil.
+	Throttle *Throttle
+	// RetryPolicy retries failed requests. Requests are not retried when nil.
+	RetryPolicy *RetryPolicy
+	// JSONBodies sends every request body as JSON. Otherwise only requests that implement
+	// JSONRequest are sent as JSON and the rest are form encoded.
+	JSONBodies bool
+	// StringIDs asks Canvas to send ids as JSON strings, which keeps ids above 2^53 exact for
+	// clients that decode numbers as floats. The models decode ids in either form.
+	StringIDs bool
+	// StrictModels validates decoded responses with the HasErrors method of their models and
+	// returns the errors, so values that are not documented by Canvas are noticed early.
+	StrictModels bool
+	// AsUserID makes every request act as this user, see
+	// https://canvas.instructure.com/doc/api/file.masquerading.html
+	AsUserID ID
+	// TokenSource supplies the access token when set, otherwise AccessToken is sent.
+	TokenSource TokenSource
+
+	rateLimit *rateLimitState
 }
 
-func New(accessToken string, canvasURL string) Canvas {
-	return Canvas{
+func New(accessToken string, canvasURL string, options ...Option) Canvas {
+	canvas := Canvas{
 		AccessToken: accessToken,
 		CanvasURL:   canvasURL,
 		UserAgent:   "AtomicJolt Go Agent v1.0",
+		Scheme:      defaultScheme,
+		rateLimit:   &rateLimitState{},
+	}
+	for _, option := range options {
+		option(&canvas)
 	}
+	return canvas
 }
 
+// SendRequest builds the url and body for a CanvasRequest and sends it to Canvas.
 func (c *Canvas) SendRequest(canvasRequest CanvasRequest) (*http.Response, error) {
+	return c.SendRequestContext(context.Background(), canvasRequest)
+}
+
+// SendRequestContext is like SendRequest but the request is bound to ctx. Cancelling ctx
+// aborts the request, including any read of the response body.
+func (c *Canvas) SendRequestContext(ctx context.Context, canvasRequest CanvasRequest) (*http.Response, error) {
 	err := canvasRequest.HasErrors()
 	if err != nil {
 		return nil, err
@@ -41,57 +86,239 @@ func (c *Canvas) SendRequest(canvasRequest CanvasRequest) (*http.Response, error
 		return nil, err
 	}
 
+	urlPath := path.Join("/", c.BasePath, apiPath(canvasRequest.GetURLPath()))
 	canvasUrl := &url.URL{
 		Host:     c.CanvasURL,
-		Scheme:   "https",
-		Path:     path.Join("/api/v1", canvasRequest.GetURLPath()),
+		Scheme:   c.scheme(),
+		Path:     urlPath,
 		RawQuery: query,
 	}
+	// Paths hold escaped ids, such as SIS ids with a slash or a period. Keep the escaped form
+	// so the ids reach Canvas as single path segments.
+	if unescaped, err := url.PathUnescape(urlPath); err == nil && unescaped != urlPath {
+		canvasUrl.Path = unescaped
+		canvasUrl.RawPath = urlPath
+	}
+
+	method := canvasRequest.GetMethod()
+	jsonType, accept := "application/json", ""
+	if mediaTypeRequest, ok := canvasRequest.(MediaTypeRequest); ok {
+		contentType, acceptType := mediaTypeRequest.MediaTypes()
+		if contentType != "" {
+			jsonType = contentType
+		}
+		accept = acceptType
+	}
+
+	if multipartRequest, ok := canvasRequest.(MultipartRequest); ok {
+		payload, contentType, err := multipartRequest.GetMultipart()
+		if err != nil {
+			return nil, err
+		}
+		if payload != nil {
+			return c.send(ctx, canvasUrl, method, payload, contentType, accept)
+		}
+	}
+
+	if c.sendsJSON(canvasRequest) {
+		payload, err := canvasRequest.GetJSON()
+		if err != nil {
+			return nil, err
+		}
+		if payload != nil {
+			return c.send(ctx, canvasUrl, method, payload, jsonType, accept)
+		}
+	}
+
+	return c.send(ctx, canvasUrl, method, []byte(body.Encode()), "application/x-www-form-urlencoded", accept)
+}
 
-	return c.Send(canvasUrl, canvasRequest.GetMethod(), &body)
+// apiPath returns the absolute path of a request path. The LTI Advantage services live under
+// /api/lti rather than the versioned REST api.
+func apiPath(requestPath string) string {
+	if strings.HasPrefix(requestPath, "/lti/") {
+		return path.Join("/api", requestPath)
+	}
+	return path.Join("/api/v1", requestPath)
 }
 
+// Send sends a request to the given url. It is used directly to follow pagination links.
 func (c *Canvas) Send(canvasUrl *url.URL, method string, body *url.Values) (*http.Response, error) {
+	return c.SendContext(context.Background(), canvasUrl, method, body)
+}
 
-	request := http.Request{
-		Method: method,
-		Proto:  "HTTP/1.1",
-		URL:    canvasUrl,
-		Host:   canvasUrl.Host,
-		Header: http.Header{},
+// SendContext is like Send but the request is bound to ctx.
+func (c *Canvas) SendContext(ctx context.Context, canvasUrl *url.URL, method string, body *url.Values) (*http.Response, error) {
+	var payload []byte
+	contentType := ""
+	if body != nil {
+		payload = []byte(body.Encode())
+		contentType = "application/x-www-form-urlencoded"
 	}
+	return c.send(ctx, canvasUrl, method, payload, contentType, "")
+}
 
-	if body != nil {
-		encodedBody := body.Encode()
-		reqBody := bytes.NewBuffer([]byte(encodedBody))
-		request.Body = ioutil.NopCloser(reqBody)
-		request.Header.Add("Content-Type", "application/x-www-form-urlencoded")
-		request.Header.Add("Content-Length", strconv.Itoa(len(encodedBody)))
+// SendBodyContext sends payload as the request body with the given content type. It is used
+// for bodies that are not form encoded, such as multipart file uploads.
+func (c *Canvas) SendBodyContext(ctx context.Context, canvasUrl *url.URL, method string, payload []byte, contentType string) (*http.Response, error) {
+	return c.send(ctx, canvasUrl, method, payload, contentType, "")
+}
+
+// SendAcceptContext is like SendBodyContext but asks for the accept media type. It is used to
+// follow the pagination links of requests that implement MediaTypeRequest.
+func (c *Canvas) SendAcceptContext(ctx context.Context, canvasUrl *url.URL, method string, payload []byte, contentType string, accept string) (*http.Response, error) {
+	return c.send(ctx, canvasUrl, method, payload, contentType, accept)
+}
+
+// send sends the payload, retrying according to the RetryPolicy. The request body is
+// rebuilt from payload for every attempt.
+func (c *Canvas) send(ctx context.Context, canvasUrl *url.URL, method string, payload []byte, contentType string, accept string) (*http.Response, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	canvasUrl = c.masquerade(canvasUrl)
+	refreshed := false
+	for attempt := 1; ; attempt++ {
+		token, err := c.token(ctx)
+		if err != nil {
+			return nil, err
+		}
+		response, err := c.sendOnce(ctx, canvasUrl, method, payload, contentType, accept, token)
+		if err == nil {
+			return response, nil
+		}
+		if refresher, ok := c.refresher(err); ok && !refreshed {
+			// An expired token is refreshed once and the request sent again straight away,
+			// without counting as a retry.
+			refreshed = true
+			if _, err := refresher.Refresh(ctx, token); err != nil {
+				return nil, err
+			}
+			attempt--
+			continue
+		}
+		delay, retry := c.RetryPolicy.retry(ctx, attempt, method, err)
+		if !retry {
+			return nil, err
+		}
+		if c.RetryPolicy.OnRetry != nil {
+			c.RetryPolicy.OnRetry(RetryEvent{
+				Attempt: attempt,
+				Method:  method,
+				URL:     canvasUrl.String(),
+				Err:     err,
+				Delay:   delay,
+			})
+		}
+		if serr := sleep(ctx, delay); serr != nil {
+			return nil, serr
+		}
+	}
+}
+
+func (c *Canvas) sendOnce(ctx context.Context, canvasUrl *url.URL, method string, payload []byte, contentType string, accept string, token string) (*http.Response, error) {
+	if err := c.throttle(ctx); err != nil {
+		return nil, err
+	}
+
+	var reqBody io.Reader
+	if payload != nil {
+		reqBody = bytes.NewReader(payload)
+	}
+	request, err := http.NewRequestWithContext(ctx, method, canvasUrl.String(), reqBody)
+	if err != nil {
+		return nil, err
+	}
+	request.URL = canvasUrl
+	request.Host = canvasUrl.Host
+	if contentType != "" {
+		request.Header.Add("Content-Type", contentType)
 	}
 
-	request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", c.AccessToken))
+	if accept != "" {
+		request.Header.Set("Accept", accept)
+	} else if c.StringIDs {
+		request.Header.Set("Accept", "application/json+canvas-string-ids")
+	}
+	request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
 	request.Header.Add("User-Agent", c.UserAgent)
+	for key, values := range c.Header {
+		request.Header[key] = append([]string(nil), values...)
+	}
 
-	response, err := http.DefaultClient.Do(&request)
+	response, err := c.Client().Do(request)
 	if err != nil {
 		return nil, err
 	}
 
-	var e error
-	switch response.StatusCode {
-	case http.StatusOK, http.StatusCreated, http.StatusAccepted:
+	c.recordRateLimit(response.Header)
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
 		return response, nil
-	case http.StatusForbidden:
-		response.Body.Close()
-		return nil, ErrRateLimitExceeded
-	case http.StatusUnprocessableEntity:
-		return nil, fmt.Errorf("HTTP status: %v. %w", response.Status, response.Body.Close())
-	case http.StatusNotFound, http.StatusUnauthorized:
-		e = fmt.Errorf("HTTP status: %v. %w", response.Status, response.Body.Close())
-	case http.StatusBadRequest, http.StatusInternalServerError:
-		e = fmt.Errorf("HTTP status: %v. %w", response.Status, response.Body.Close())
-	default:
-		e = fmt.Errorf("HTTP status: %v. %w", response.Status, response.Body.Close())
-	}
-	return nil, fmt.Errorf("%v %v %w", json.NewDecoder(response.Body).Decode(&e), response.Body.Close(), e)
+	}
+	return nil, newAPIError(response)
+}
+
+// WithAsUser returns a copy of c whose requests act as the user with the given id. The copy
+// shares the rate limit state of c.
+//
+//	submission, err := submit.Do(canvas.WithAsUser(canvasapi.SISUserID("s123")))
+func (c *Canvas) WithAsUser(id ID) *Canvas {
+	c.rateLimits()
+	masqueraded := *c
+	masqueraded.AsUserID = id
+	return &masqueraded
+}
+
+// masquerade adds as_user_id to the query. Canvas reads it from the query string for every
+// method, and pagination links may already carry it.
+func (c *Canvas) masquerade(canvasUrl *url.URL) *url.URL {
+	if c.AsUserID == "" {
+		return canvasUrl
+	}
+	if canvasUrl.Query().Get("as_user_id") != "" {
+		return canvasUrl
+	}
+	masqueraded := *canvasUrl
+	if masqueraded.RawQuery != "" {
+		masqueraded.RawQuery += "&"
+	}
+	masqueraded.RawQuery += url.Values{"as_user_id": {string(c.AsUserID)}}.Encode()
+	return &masqueraded
+}
+
+func (c *Canvas) sendsJSON(canvasRequest CanvasRequest) bool {
+	if c.JSONBodies {
+		return true
+	}
+	jsonRequest, ok := canvasRequest.(JSONRequest)
+	return ok && jsonRequest.PrefersJSON()
+}
+
+// ResolveURL resolves a url Canvas returned, such as a progress or download url, against
+// the Canvas instance. Absolute urls are returned as they are.
+func (c *Canvas) ResolveURL(location string) (*url.URL, error) {
+	u, err := url.Parse(location)
+	if err != nil {
+		return nil, err
+	}
+	if u.IsAbs() {
+		return u, nil
+	}
+	base := &url.URL{Scheme: c.scheme(), Host: c.CanvasURL, Path: strings.TrimSuffix(path.Join("/", c.BasePath), "/") + "/"}
+	return base.ResolveReference(u), nil
+}
+
+func (c *Canvas) scheme() string {
+	if c.Scheme == "" {
+		return defaultScheme
+	}
+	return c.Scheme
+}
+
+// Client returns the http.Client used to send requests.
+func (c *Canvas) Client() *http.Client {
+	if c.HTTPClient == nil {
+		return http.DefaultClient
+	}
+	return c.HTTPClient
 }
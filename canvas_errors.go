@@ -1,13 +1,222 @@
 package canvasapi
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
 )
 
-// ErrRateLimitExceeded is returned when the api rate limit has been reached.
+// ErrRateLimitExceeded matches, using errors.Is, the APIError returned when the api rate limit has been reached.
 var ErrRateLimitExceeded = errors.New("403 Forbidden (Rate Limit Exceeded)")
 
 // IsRateLimit returns true if the error given is a rate limit error.
 func IsRateLimit(e error) bool {
-	return e == ErrRateLimitExceeded
+	return errors.Is(e, ErrRateLimitExceeded)
+}
+
+// ErrorMessage is a single error reported by Canvas in the body of a failed response.
+// Field is only set for validation errors, which Canvas groups by attribute.
+type ErrorMessage struct {
+	Field   string `json:"attribute,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Message string `json:"message"`
+}
+
+// APIError is returned when Canvas responds with a non 2xx status code.
+type APIError struct {
+	StatusCode int
+	Status     string
+	Method     string
+	URL        string
+	RequestID  string
+	Header     http.Header
+	Body       []byte
+	Errors     []ErrorMessage
+	RateLimit  RateLimit
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("%s %s: HTTP status: %s", e.Method, e.URL, e.Status)
+	messages := []string{}
+	for _, m := range e.Errors {
+		if m.Field != "" {
+			messages = append(messages, fmt.Sprintf("%s %s", m.Field, m.Message))
+		} else if m.Message != "" {
+			messages = append(messages, m.Message)
+		}
+	}
+	if len(messages) > 0 {
+		msg = fmt.Sprintf("%s. %s", msg, strings.Join(messages, ", "))
+	}
+	return msg
+}
+
+// Is reports whether the error is a rate limit error when target is ErrRateLimitExceeded.
+// Canvas throttles with a 403 whose body reads "403 Forbidden (Rate Limit Exceeded)". A 429 is
+// treated the same way. Other 403 responses are permission failures.
+func (e *APIError) Is(target error) bool {
+	return target == ErrRateLimitExceeded && e.isRateLimit()
+}
+
+func (e *APIError) isRateLimit() bool {
+	switch e.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusForbidden:
+		return bytes.Contains(bytes.ToLower(e.Body), []byte("rate limit exceeded"))
+	}
+	return false
+}
+
+// IsNotFound returns true if the error is an APIError with status 404.
+func IsNotFound(e error) bool {
+	return hasStatus(e, http.StatusNotFound)
+}
+
+// IsUnauthorized returns true if the error is an APIError with status 401.
+func IsUnauthorized(e error) bool {
+	return hasStatus(e, http.StatusUnauthorized)
+}
+
+// IsInvalidToken returns true if Canvas rejected the access token because it is invalid or
+// expired. Canvas also answers 401 when the user lacks permission for an action, which is not
+// an invalid token.
+func IsInvalidToken(e error) bool {
+	var apiError *APIError
+	return errors.As(e, &apiError) && apiError.isInvalidToken()
+}
+
+// isInvalidToken reads the Bearer challenge Canvas sends in WWW-Authenticate when it rejects a
+// token, and the error messages of the body.
+func (e *APIError) isInvalidToken() bool {
+	if e.StatusCode != http.StatusUnauthorized {
+		return false
+	}
+	challenge := strings.ToLower(e.Header.Get("WWW-Authenticate"))
+	if strings.HasPrefix(challenge, "bearer") {
+		return true
+	}
+	for _, m := range e.Errors {
+		message := strings.ToLower(m.Message)
+		if strings.Contains(message, "invalid access token") || strings.Contains(message, "expired") {
+			return true
+		}
+	}
+	return false
+}
+
+// IsForbidden returns true if the error is an APIError with status 403.
+func IsForbidden(e error) bool {
+	return hasStatus(e, http.StatusForbidden)
+}
+
+// IsValidation returns true if Canvas rejected the parameters sent with the request.
+// Canvas uses 400 and 422 for validation failures.
+func IsValidation(e error) bool {
+	return hasStatus(e, http.StatusBadRequest, http.StatusUnprocessableEntity)
+}
+
+// IsConflict returns true if the error is an APIError with status 409.
+func IsConflict(e error) bool {
+	return hasStatus(e, http.StatusConflict)
+}
+
+func hasStatus(e error, statusCodes ...int) bool {
+	var apiError *APIError
+	if !errors.As(e, &apiError) {
+		return false
+	}
+	for _, code := range statusCodes {
+		if apiError.StatusCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckResponse returns nil for a 2xx response. Otherwise it reads and closes the response
+// body and returns an *APIError. It is used for responses from requests sent outside of
+// SendRequest, such as the file data posted to an upload url.
+func CheckResponse(response *http.Response) error {
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return nil
+	}
+	return newAPIError(response)
+}
+
+// newAPIError reads and closes the response body and builds an APIError from it.
+func newAPIError(response *http.Response) error {
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return err
+	}
+
+	apiError := &APIError{
+		StatusCode: response.StatusCode,
+		Status:     response.Status,
+		Header:     response.Header,
+		RequestID:  response.Header.Get("X-Request-Context-Id"),
+		Body:       body,
+		Errors:     parseErrorMessages(body),
+		RateLimit:  ParseRateLimit(response.Header),
+	}
+	if response.Request != nil {
+		apiError.Method = response.Request.Method
+		if response.Request.URL != nil {
+			apiError.URL = response.Request.URL.String()
+		}
+	}
+	return apiError
+}
+
+// parseErrorMessages understands the error payloads Canvas returns:
+//
+//	{"errors":[{"message":"..."}]}
+//	{"errors":{"name":[{"attribute":"name","type":"blank","message":"..."}]}}
+//	{"errors":"..."} and {"message":"..."}
+func parseErrorMessages(body []byte) []ErrorMessage {
+	payload := struct {
+		Errors  json.RawMessage `json:"errors"`
+		Message string          `json:"message"`
+	}{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil
+	}
+
+	messages := []ErrorMessage{}
+	list := []ErrorMessage{}
+	fields := map[string][]ErrorMessage{}
+	text := ""
+	if json.Unmarshal(payload.Errors, &list) == nil {
+		messages = append(messages, list...)
+	} else if json.Unmarshal(payload.Errors, &fields) == nil {
+		keys := make([]string, 0, len(fields))
+		for key := range fields {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			for _, m := range fields[key] {
+				if m.Field == "" {
+					m.Field = key
+				}
+				messages = append(messages, m)
+			}
+		}
+	} else if json.Unmarshal(payload.Errors, &text) == nil && text != "" {
+		messages = append(messages, ErrorMessage{Message: text})
+	}
+	if payload.Message != "" {
+		messages = append(messages, ErrorMessage{Message: payload.Message})
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return messages
 }
@@ -11,6 +11,28 @@ type CanvasRequest interface {
 	HasErrors() error
 }
 
+// MultipartRequest is implemented by requests that can post a file as multipart/form-data.
+// GetMultipart returns a nil body when the request has no file to send, in which case the
+// form body is used.
+type MultipartRequest interface {
+	GetMultipart() (body []byte, contentType string, err error)
+}
+
+// JSONRequest is implemented by requests whose body Canvas expects as JSON rather than as a
+// form, such as rubric assessments and LTI scores.
+type JSONRequest interface {
+	PrefersJSON() bool
+}
+
+// MediaTypeRequest is implemented by requests that use their own media types rather than
+// JSON, such as the IMS LTI Advantage services. Either media type may be empty to keep the
+// default.
+type MediaTypeRequest interface {
+	MediaTypes() (contentType string, accept string)
+}
+
+// CanvasModel is implemented by every model. HasErrors reports fields whose values are not
+// among the values Canvas documents for them.
 type CanvasModel interface {
-	HasError() error
+	HasErrors() error
 }
@@ -1,7 +1,13 @@
 package canvasapi
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
+	"time"
 )
 
 func TestNew(t *testing.T) {
@@ -30,3 +36,58 @@ func TestNew(t *testing.T) {
 		})
 	}
 }
+
+func TestSendContextCanceled(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	canvas := New("test", u.Host)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = canvas.SendContext(ctx, u, http.MethodGet, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected SendContext to return context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestSendReturnsAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Context-Id", "abc-123")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"errors":[{"message":"The specified resource does not exist."}]}`))
+	}))
+	defer server.Close()
+
+	canvas := New("test", "", WithBaseURL(server.URL))
+	_, err := canvas.SendRequest(&testRequest{method: http.MethodGet, path: "courses/1"})
+
+	var apiError *APIError
+	if !errors.As(err, &apiError) {
+		t.Fatalf("expected an APIError, got %v", err)
+	}
+	if apiError.StatusCode != http.StatusNotFound || apiError.RequestID != "abc-123" || apiError.Method != http.MethodGet {
+		t.Errorf("unexpected APIError %+v", apiError)
+	}
+	if len(apiError.Errors) != 1 || apiError.Errors[0].Message != "The specified resource does not exist." {
+		t.Errorf("expected the error body to be decoded, got %+v", apiError.Errors)
+	}
+	if !IsNotFound(err) || IsUnauthorized(err) || IsValidation(err) {
+		t.Errorf("expected only IsNotFound to match %v", err)
+	}
+}
+
+func TestParseValidationErrors(t *testing.T) {
+	messages := parseErrorMessages([]byte(`{"errors":{"name":[{"attribute":"name","type":"blank","message":"can't be blank"}]}}`))
+	if len(messages) != 1 || messages[0].Field != "name" || messages[0].Type != "blank" {
+		t.Errorf("expected a field error for name, got %+v", messages)
+	}
+}
@@ -0,0 +1,267 @@
+package canvasapi
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Encoder is implemented by types that encode themselves into form or query values. key is
+// the full parameter name, such as "course[name]".
+type Encoder interface {
+	EncodeValues(key string, v *url.Values) error
+}
+
+var (
+	encoderType = reflect.TypeOf((*Encoder)(nil)).Elem()
+	timeType    = reflect.TypeOf(time.Time{})
+)
+
+// EncodeValues encodes the Query or Form struct of a request the way Rails, and so Canvas,
+// parses parameters. Field names come from the url tag and fields tagged omitempty are
+// skipped when they hold their zero value.
+//   - nested structs and maps become hashes: course[name]=x, grade_data[12][posted_grade]=A
+//   - slices of values become arrays: include[]=a&include[]=b
+//   - slices of structs and maps become indexed hashes: assignment_overrides[0][title]=x
+//   - times are sent as RFC3339
+func EncodeValues(v interface{}) (url.Values, error) {
+	values := url.Values{}
+	if v == nil {
+		return values, nil
+	}
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return values, nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("EncodeValues expects a struct, got %v", rv.Type())
+	}
+	if !rv.CanAddr() {
+		// Copy the struct so that Encoders with pointer receivers are found on its fields.
+		addressable := reflect.New(rv.Type()).Elem()
+		addressable.Set(rv)
+		rv = addressable
+	}
+	if err := encodeStruct(values, "", rv); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+func encodeStruct(values url.Values, scope string, rv reflect.Value) error {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if field.PkgPath != "" && !field.Anonymous {
+			continue
+		}
+		fv := rv.Field(i)
+
+		tag := field.Tag.Get("url")
+		if tag == "-" {
+			continue
+		}
+		name, opts := parseTag(tag)
+		if field.Anonymous && name == "" && indirectType(field.Type).Kind() == reflect.Struct {
+			fv = indirect(fv)
+			if fv.IsValid() {
+				if err := encodeStruct(values, scope, fv); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if opts.contains("omitempty") && isEmptyValue(fv) {
+			continue
+		}
+		if err := encodeValue(values, scopedKey(scope, name), fv); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func encodeValue(values url.Values, key string, rv reflect.Value) error {
+	if rv.Type().Implements(encoderType) {
+		if rv.Kind() == reflect.Ptr && rv.IsNil() {
+			return nil
+		}
+		return rv.Interface().(Encoder).EncodeValues(key, &values)
+	}
+	if rv.CanAddr() && rv.Addr().Type().Implements(encoderType) {
+		return rv.Addr().Interface().(Encoder).EncodeValues(key, &values)
+	}
+
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if rv.IsNil() {
+			return nil
+		}
+		return encodeValue(values, key, rv.Elem())
+	case reflect.Struct:
+		if rv.Type() == timeType {
+			values.Add(key, rv.Interface().(time.Time).Format(time.RFC3339))
+			return nil
+		}
+		return encodeStruct(values, key, rv)
+	case reflect.Map:
+		return encodeMap(values, key, rv)
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			return nil
+		}
+		if rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Uint8 {
+			values.Add(key, string(rv.Bytes()))
+			return nil
+		}
+		return encodeSlice(values, key, rv)
+	}
+
+	s, err := scalarString(rv)
+	if err != nil {
+		return fmt.Errorf("%s: %w", key, err)
+	}
+	values.Add(key, s)
+	return nil
+}
+
+func encodeMap(values url.Values, key string, rv reflect.Value) error {
+	keys := make([]string, 0, rv.Len())
+	byName := make(map[string]reflect.Value, rv.Len())
+	for _, k := range rv.MapKeys() {
+		name := fmt.Sprint(k.Interface())
+		keys = append(keys, name)
+		byName[name] = rv.MapIndex(k)
+	}
+	sort.Strings(keys)
+	for _, name := range keys {
+		if err := encodeValue(values, scopedKey(key, name), byName[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func encodeSlice(values url.Values, key string, rv reflect.Value) error {
+	for i := 0; i < rv.Len(); i++ {
+		elem := rv.Index(i)
+		if isHash(elem) {
+			if err := encodeValue(values, fmt.Sprintf("%s[%d]", key, i), elem); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := encodeValue(values, key+"[]", elem); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isHash reports whether v encodes as a Rails hash rather than a single value.
+func isHash(v reflect.Value) bool {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return false
+		}
+		v = v.Elem()
+	}
+	if v.Type().Implements(encoderType) {
+		return false
+	}
+	return v.Kind() == reflect.Map || (v.Kind() == reflect.Struct && v.Type() != timeType)
+}
+
+func scalarString(rv reflect.Value) (string, error) {
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	case reflect.Float32:
+		return strconv.FormatFloat(rv.Float(), 'f', -1, 32), nil
+	case reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'f', -1, 64), nil
+	}
+	return "", fmt.Errorf("unsupported type %v", rv.Type())
+}
+
+func scopedKey(scope, name string) string {
+	if scope == "" {
+		return name
+	}
+	return scope + "[" + name + "]"
+}
+
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	case reflect.Struct:
+		if v.Type() == timeType {
+			return v.Interface().(time.Time).IsZero()
+		}
+		if z, ok := v.Interface().(interface{ IsZero() bool }); ok {
+			return z.IsZero()
+		}
+		return v.IsZero()
+	}
+	return false
+}
+
+func indirect(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
+
+type tagOptions []string
+
+func parseTag(tag string) (string, tagOptions) {
+	parts := strings.Split(tag, ",")
+	return parts[0], parts[1:]
+}
+
+func (o tagOptions) contains(option string) bool {
+	for _, s := range o {
+		if s == option {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,116 @@
+package canvasapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+var jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// EncodeJSON encodes the Query or Form struct of a request as a JSON body. It follows the
+// same rules as EncodeValues: names come from the json tag, and fields tagged omitempty in
+// either their json or url tag are left out when empty, as are unset Opt values.
+func EncodeJSON(v interface{}) ([]byte, error) {
+	tree, err := jsonTree(reflect.ValueOf(v))
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(tree)
+}
+
+func jsonTree(rv reflect.Value) (interface{}, error) {
+	if !rv.IsValid() {
+		return nil, nil
+	}
+	if rv.Type().Implements(jsonMarshalerType) {
+		if rv.Kind() == reflect.Ptr && rv.IsNil() {
+			return nil, nil
+		}
+		return rv.Interface(), nil
+	}
+
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if rv.IsNil() {
+			return nil, nil
+		}
+		return jsonTree(rv.Elem())
+	case reflect.Struct:
+		object := map[string]interface{}{}
+		if err := jsonStruct(object, rv); err != nil {
+			return nil, err
+		}
+		return object, nil
+	case reflect.Map:
+		if rv.IsNil() {
+			return nil, nil
+		}
+		object := make(map[string]interface{}, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			value, err := jsonTree(iter.Value())
+			if err != nil {
+				return nil, err
+			}
+			object[fmt.Sprint(iter.Key().Interface())] = value
+		}
+		return object, nil
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			return nil, nil
+		}
+		if rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Uint8 {
+			return rv.Interface(), nil
+		}
+		array := make([]interface{}, rv.Len())
+		for i := range array {
+			value, err := jsonTree(rv.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			array[i] = value
+		}
+		return array, nil
+	}
+	return rv.Interface(), nil
+}
+
+func jsonStruct(object map[string]interface{}, rv reflect.Value) error {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if field.PkgPath != "" && !field.Anonymous {
+			continue
+		}
+		fv := rv.Field(i)
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts := parseTag(tag)
+		if field.Anonymous && name == "" && indirectType(field.Type).Kind() == reflect.Struct {
+			fv = indirect(fv)
+			if fv.IsValid() {
+				if err := jsonStruct(object, fv); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		_, urlOpts := parseTag(field.Tag.Get("url"))
+		if (opts.contains("omitempty") || urlOpts.contains("omitempty")) && isEmptyValue(fv) {
+			continue
+		}
+		value, err := jsonTree(fv)
+		if err != nil {
+			return err
+		}
+		object[name] = value
+	}
+	return nil
+}
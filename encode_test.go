@@ -0,0 +1,86 @@
+package canvasapi
+
+import (
+	"net/url"
+	"testing"
+	"time"
+)
+
+type testGradeData struct {
+	PostedGrade string   `url:"posted_grade,omitempty"`
+	FileIDs     []string `url:"file_ids,omitempty"`
+}
+
+type testOverride struct {
+	Title      string  `url:"title,omitempty"`
+	StudentIDs []int64 `url:"student_ids,omitempty"`
+}
+
+type testPrefixEncoder string
+
+func (t testPrefixEncoder) EncodeValues(key string, v *url.Values) error {
+	v.Set(key, "encoded:"+string(t))
+	return nil
+}
+
+func TestEncodeValues(t *testing.T) {
+	form := struct {
+		Include []string `url:"include,omitempty"`
+		Course  struct {
+			Name      string    `url:"name,omitempty"`
+			IsPublic  bool      `url:"is_public"`
+			StartAt   time.Time `url:"start_at,omitempty"`
+			EndAt     time.Time `url:"end_at,omitempty"`
+			Weight    float64   `url:"weight,omitempty"`
+			Ignored   string    `url:"-"`
+			Untouched string    `url:"untouched,omitempty"`
+		} `url:"course,omitempty"`
+		GradeData map[string]testGradeData `url:"grade_data,omitempty"`
+		Overrides []*testOverride          `url:"assignment_overrides,omitempty"`
+		Metadata  map[string]interface{}   `url:"transport_metadata,omitempty"`
+		Custom    testPrefixEncoder        `url:"custom,omitempty"`
+		Empty     []string                 `url:"empty,omitempty"`
+	}{}
+	form.Include = []string{"submission", "can_edit"}
+	form.Course.Name = "Biology"
+	form.Course.StartAt = time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+	form.Course.Weight = 2.5
+	form.Course.Ignored = "x"
+	form.GradeData = map[string]testGradeData{
+		"12": {PostedGrade: "A", FileIDs: []string{"1", "2"}},
+		"7":  {PostedGrade: "B"},
+	}
+	form.Overrides = []*testOverride{{Title: "Group 1", StudentIDs: []int64{3, 4}}, {Title: "Group 2"}}
+	form.Metadata = map[string]interface{}{"url": "https://example.com", "headers": map[string]interface{}{"a": 1}}
+	form.Custom = "x"
+
+	values, err := EncodeValues(form)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := url.Values{
+		"include[]":                              {"submission", "can_edit"},
+		"course[name]":                           {"Biology"},
+		"course[is_public]":                      {"false"},
+		"course[start_at]":                       {"2021-01-02T03:04:05Z"},
+		"course[weight]":                         {"2.5"},
+		"grade_data[12][posted_grade]":           {"A"},
+		"grade_data[12][file_ids][]":             {"1", "2"},
+		"grade_data[7][posted_grade]":            {"B"},
+		"assignment_overrides[0][title]":         {"Group 1"},
+		"assignment_overrides[0][student_ids][]": {"3", "4"},
+		"assignment_overrides[1][title]":         {"Group 2"},
+		"transport_metadata[url]":                {"https://example.com"},
+		"transport_metadata[headers][a]":         {"1"},
+		"custom":                                 {"encoded:x"},
+	}
+	if values.Encode() != expected.Encode() {
+		t.Errorf("EncodeValues\n got: %v\nwant: %v", values.Encode(), expected.Encode())
+	}
+}
+
+func TestEncodeValuesRejectsNonStruct(t *testing.T) {
+	if _, err := EncodeValues("course"); err == nil {
+		t.Errorf("expected an error encoding a string")
+	}
+}
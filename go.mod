@@ -1,8 +1,5 @@
 module github.com/atomicjolt/canvasapi
 
-go 1.15
+go 1.18
 
-require (
-	github.com/atomicjolt/string_utils v0.0.0-20210507200519-0d5ef93b94f1
-	github.com/google/go-querystring v1.1.0
-)
+require github.com/atomicjolt/string_utils v0.0.0-20210507200519-0d5ef93b94f1
@@ -0,0 +1,83 @@
+package canvasapi
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ID identifies a Canvas object in a request path. Besides numeric ids Canvas accepts SIS
+// ids, integration ids and LTI context ids with a prefix, and "self" for the current user or
+// the current course context, see
+// https://canvas.instructure.com/doc/api/file.object_ids.html
+type ID string
+
+// Self refers to the current user, or to the object of the current context.
+const Self ID = "self"
+
+// IDFromInt returns the ID of a Canvas object from its numeric id, as found in models.
+func IDFromInt(id int64) ID {
+	return ID(strconv.FormatInt(id, 10))
+}
+
+// SISCourseID refers to a course by its SIS id.
+func SISCourseID(sisID string) ID {
+	return ID("sis_course_id:" + sisID)
+}
+
+// SISUserID refers to a user by their SIS id.
+func SISUserID(sisID string) ID {
+	return ID("sis_user_id:" + sisID)
+}
+
+// SISLoginID refers to a user by the unique id of one of their logins.
+func SISLoginID(loginID string) ID {
+	return ID("sis_login_id:" + loginID)
+}
+
+// SISAccountID refers to an account by its SIS id.
+func SISAccountID(sisID string) ID {
+	return ID("sis_account_id:" + sisID)
+}
+
+// SISSectionID refers to a section by its SIS id.
+func SISSectionID(sisID string) ID {
+	return ID("sis_section_id:" + sisID)
+}
+
+// SISTermID refers to an enrollment term by its SIS id.
+func SISTermID(sisID string) ID {
+	return ID("sis_term_id:" + sisID)
+}
+
+// SISGroupID refers to a group by its SIS id.
+func SISGroupID(sisID string) ID {
+	return ID("sis_group_id:" + sisID)
+}
+
+// SISIntegrationID refers to a user, course, section or account by its SIS integration id.
+func SISIntegrationID(integrationID string) ID {
+	return ID("sis_integration_id:" + integrationID)
+}
+
+// LTIContextID refers to a course, account or user by its LTI context id.
+func LTIContextID(contextID string) ID {
+	return ID("lti_context_id:" + contextID)
+}
+
+func (id ID) String() string {
+	return string(id)
+}
+
+// Int64 returns the numeric id. ok is false for SIS ids, "self" and other non numeric ids.
+func (id ID) Int64() (value int64, ok bool) {
+	value, err := strconv.ParseInt(string(id), 10, 64)
+	return value, err == nil
+}
+
+// Escaped returns the id escaped for use as a segment of a url path. Slashes and other
+// reserved characters in SIS ids are percent encoded, and so are periods because Canvas would
+// otherwise read what follows a period as the response format.
+func (id ID) Escaped() string {
+	return strings.ReplaceAll(url.PathEscape(string(id)), ".", "%2E")
+}
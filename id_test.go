@@ -0,0 +1,51 @@
+package canvasapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIDEscaped(t *testing.T) {
+	tests := []struct {
+		id   ID
+		want string
+	}{
+		{IDFromInt(101), "101"},
+		{Self, "self"},
+		{SISCourseID("BIO 101/2021.fall"), "sis_course_id:BIO%20101%2F2021%2Efall"},
+		{SISLoginID("jane@example.com"), "sis_login_id:jane@example%2Ecom"},
+		{LTIContextID("abc123"), "lti_context_id:abc123"},
+	}
+	for _, tt := range tests {
+		if got := tt.id.Escaped(); got != tt.want {
+			t.Errorf("%v.Escaped() = %v, want %v", tt.id, got, tt.want)
+		}
+	}
+
+	if id, ok := IDFromInt(42).Int64(); !ok || id != 42 {
+		t.Errorf("expected Int64 to return 42, got %v %v", id, ok)
+	}
+	if _, ok := SISUserID("42").Int64(); ok {
+		t.Errorf("expected a SIS id not to be numeric")
+	}
+}
+
+func TestSendRequestEscapedID(t *testing.T) {
+	var rawPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rawPath = r.URL.EscapedPath()
+	}))
+	defer server.Close()
+
+	canvas := New("token", "", WithBaseURL(server.URL))
+	path := "courses/" + SISCourseID("BIO/101.a").Escaped() + "/assignments"
+	response, err := canvas.SendRequest(&testRequest{method: http.MethodGet, path: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+	if rawPath != "/api/v1/courses/sis_course_id:BIO%2F101%2Ea/assignments" {
+		t.Errorf("unexpected path %v", rawPath)
+	}
+}
@@ -0,0 +1,145 @@
+package canvasapi
+
+import (
+	"context"
+	"errors"
+	"net/url"
+)
+
+// ErrStopIteration can be returned from the callback given to Iterate to stop walking
+// the pages without returning an error.
+var ErrStopIteration = errors.New("stop iteration")
+
+// PagedRequest is implemented by every request whose DoContext returns a page of results.
+type PagedRequest[T any] interface {
+	DoContext(ctx context.Context, c *Canvas, next *url.URL) ([]T, *PagedResource, error)
+}
+
+// IterateOption limits how much of a collection Iterate and All fetch.
+type IterateOption func(*iterateOptions)
+
+type iterateOptions struct {
+	maxPages int
+	maxItems int
+}
+
+// WithMaxPages stops iterating after n pages have been fetched.
+func WithMaxPages(n int) IterateOption {
+	return func(o *iterateOptions) {
+		o.maxPages = n
+	}
+}
+
+// WithMaxItems stops iterating after n items have been passed to the callback.
+func WithMaxItems(n int) IterateOption {
+	return func(o *iterateOptions) {
+		o.maxItems = n
+	}
+}
+
+// Iterate calls fn for every item of a paged request, following the next links of the
+// Link header. A page is only fetched once fn has seen every item of the previous page.
+// Iteration stops at the first error returned by fn, which Iterate returns unless it is
+// ErrStopIteration.
+//
+//	err := canvasapi.Iterate[*models.Assignment](ctx, &canvas, &listAssignments, func(a *models.Assignment) error {
+//		fmt.Println(a.Name)
+//		return nil
+//	})
+func Iterate[T any](ctx context.Context, c *Canvas, request PagedRequest[T], fn func(T) error, options ...IterateOption) error {
+	o := iterateOptions{}
+	for _, option := range options {
+		option(&o)
+	}
+
+	var next *url.URL
+	seen := map[string]bool{}
+	items := 0
+	for pages := 0; o.maxPages <= 0 || pages < o.maxPages; pages++ {
+		page, pagedResource, err := request.DoContext(ctx, c, next)
+		if err != nil {
+			return err
+		}
+		for _, item := range page {
+			items++
+			if err := fn(item); err != nil {
+				if errors.Is(err, ErrStopIteration) {
+					return nil
+				}
+				return err
+			}
+			if o.maxItems > 0 && items >= o.maxItems {
+				return nil
+			}
+		}
+		if pagedResource == nil || pagedResource.Next == nil || pagedResource.Next.URL == nil {
+			return nil
+		}
+		next = pagedResource.Next.URL
+		if seen[next.String()] {
+			return nil
+		}
+		seen[next.String()] = true
+	}
+	return nil
+}
+
+// All collects every item of a paged request into a slice. See Iterate.
+func All[T any](ctx context.Context, c *Canvas, request PagedRequest[T], options ...IterateOption) ([]T, error) {
+	all := []T{}
+	err := Iterate(ctx, c, request, func(item T) error {
+		all = append(all, item)
+		return nil
+	}, options...)
+	if err != nil {
+		return nil, err
+	}
+	return all, nil
+}
+
+// PagedEnvelope is a page that holds its items together with related resources, such as the
+// events and linked resources of the audit logs. Append adds the content of the next page.
+type PagedEnvelope[T any] interface {
+	Append(page T)
+}
+
+// PagedEnvelopeRequest is implemented by every request whose DoContext returns an envelope.
+type PagedEnvelopeRequest[T PagedEnvelope[T]] interface {
+	DoContext(ctx context.Context, c *Canvas, next *url.URL) (T, *PagedResource, error)
+}
+
+// AllPages fetches every page of an envelope request, following the next links of the Link
+// header, and appends them to the first page. WithMaxPages limits the pages fetched.
+//
+//	events, err := canvasapi.AllPages[*models.GradeChangeEventsResponse](ctx, &canvas, &queryByCourse)
+func AllPages[T PagedEnvelope[T]](ctx context.Context, c *Canvas, request PagedEnvelopeRequest[T], options ...IterateOption) (T, error) {
+	o := iterateOptions{}
+	for _, option := range options {
+		option(&o)
+	}
+
+	var all T
+	var next *url.URL
+	seen := map[string]bool{}
+	for pages := 0; o.maxPages <= 0 || pages < o.maxPages; pages++ {
+		page, pagedResource, err := request.DoContext(ctx, c, next)
+		if err != nil {
+			var zero T
+			return zero, err
+		}
+		if pages == 0 {
+			all = page
+		} else {
+			all.Append(page)
+		}
+		if pagedResource == nil || pagedResource.Next == nil || pagedResource.Next.URL == nil {
+			break
+		}
+		next = pagedResource.Next.URL
+		if seen[next.String()] {
+			break
+		}
+		seen[next.String()] = true
+	}
+	return all, nil
+}
@@ -0,0 +1,161 @@
+package canvasapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+)
+
+type testPagedRequest struct {
+	testRequest
+}
+
+func (t *testPagedRequest) DoContext(ctx context.Context, c *Canvas, next *url.URL) ([]int, *PagedResource, error) {
+	var err error
+	var response *http.Response
+	if next != nil {
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
+	} else {
+		response, err = c.SendRequestContext(ctx, t)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	ret := []int{}
+	if err := json.Unmarshal(body, &ret); err != nil {
+		return nil, nil, err
+	}
+	pagedResource, err := ExtractPagedResource(response.Header)
+	return ret, pagedResource, err
+}
+
+// newPagedServer serves pages of 2 items, linking only to the next page like Canvas does
+// for large collections.
+func newPagedServer(pages int, requested *int) *httptest.Server {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page == 0 {
+			page = 1
+		}
+		*requested++
+		if page < pages {
+			w.Header().Set("Link", fmt.Sprintf(`<%s/api/v1/items?page=%d>; rel="next"`, server.URL, page+1))
+		}
+		json.NewEncoder(w).Encode([]int{page*2 - 1, page * 2})
+	}))
+	return server
+}
+
+func TestIterate(t *testing.T) {
+	tests := []struct {
+		name      string
+		options   []IterateOption
+		stopAfter int
+		items     []int
+		requested int
+	}{
+		{name: "walks every page", items: []int{1, 2, 3, 4, 5, 6}, requested: 3},
+		{name: "max pages", options: []IterateOption{WithMaxPages(2)}, items: []int{1, 2, 3, 4}, requested: 2},
+		{name: "max items", options: []IterateOption{WithMaxItems(3)}, items: []int{1, 2, 3}, requested: 2},
+		{name: "max items filled by a page", options: []IterateOption{WithMaxItems(2)}, items: []int{1, 2}, requested: 1},
+		{name: "stops early", stopAfter: 1, items: []int{1}, requested: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requested := 0
+			server := newPagedServer(3, &requested)
+			defer server.Close()
+
+			canvas := New("test", "", WithBaseURL(server.URL))
+			request := &testPagedRequest{testRequest{method: http.MethodGet, path: "items"}}
+			items := []int{}
+			err := Iterate[int](context.Background(), &canvas, request, func(item int) error {
+				items = append(items, item)
+				if tt.stopAfter > 0 && len(items) >= tt.stopAfter {
+					return ErrStopIteration
+				}
+				return nil
+			}, tt.options...)
+			if err != nil {
+				t.Fatalf("Iterate failed: %v", err)
+			}
+			if fmt.Sprint(items) != fmt.Sprint(tt.items) {
+				t.Errorf("expected items %v, got %v", tt.items, items)
+			}
+			if requested != tt.requested {
+				t.Errorf("expected %v pages to be requested, got %v", tt.requested, requested)
+			}
+		})
+	}
+}
+
+func TestExtractPagedResourceWithoutLast(t *testing.T) {
+	header := http.Header{}
+	header.Set("Link", `<https://canvas.example.com/api/v1/users?page=bookmark:WzEwXQ>; rel="next", <https://canvas.example.com/api/v1/users?page=1>; rel="first"`)
+	pagedResource, err := ExtractPagedResource(header)
+	if err != nil {
+		t.Fatalf("ExtractPagedResource failed: %v", err)
+	}
+	if pagedResource.Last != nil || pagedResource.Current != nil || pagedResource.Next == nil || pagedResource.First.Page != 1 {
+		t.Errorf("unexpected paged resource %+v", pagedResource)
+	}
+}
+
+type testEnvelope struct {
+	Items []int
+	Pages int
+}
+
+func (t *testEnvelope) Append(page *testEnvelope) {
+	t.Items = append(t.Items, page.Items...)
+	t.Pages += page.Pages
+}
+
+type testEnvelopeRequest struct {
+	testPagedRequest
+}
+
+func (t *testEnvelopeRequest) DoContext(ctx context.Context, c *Canvas, next *url.URL) (*testEnvelope, *PagedResource, error) {
+	items, pagedResource, err := t.testPagedRequest.DoContext(ctx, c, next)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &testEnvelope{Items: items, Pages: 1}, pagedResource, nil
+}
+
+func TestAllPages(t *testing.T) {
+	requested := 0
+	server := newPagedServer(3, &requested)
+	defer server.Close()
+
+	canvas := New("token", "", WithBaseURL(server.URL))
+	request := &testEnvelopeRequest{testPagedRequest{testRequest{method: http.MethodGet, path: "items"}}}
+	all, err := AllPages[*testEnvelope](context.Background(), &canvas, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(all.Items) != "[1 2 3 4 5 6]" || all.Pages != 3 {
+		t.Errorf("unexpected envelope %+v", all)
+	}
+
+	requested = 0
+	first, err := AllPages[*testEnvelope](context.Background(), &canvas, request, WithMaxPages(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(first.Items) != "[1 2]" || requested != 1 {
+		t.Errorf("unexpected envelope %+v after %d requests", first, requested)
+	}
+}
@@ -0,0 +1,220 @@
+// Package lti is a client for the LTI Advantage services of Canvas, the Assignment and Grade
+// Services (line items, scores and results) and the Names and Role Provisioning Services.
+// https://canvas.instructure.com/doc/api/file.tools_intro.html
+//
+// The services are authorized with tokens from the client_credentials grant of an LTI
+// developer key rather than with user tokens.
+package lti
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
+	"github.com/atomicjolt/canvasapi/oauth"
+	"github.com/atomicjolt/canvasapi/requests"
+)
+
+// Activity and grading progress values of a Score.
+const (
+	ActivityInitialized = "Initialized"
+	ActivityStarted     = "Started"
+	ActivityInProgress  = "InProgress"
+	ActivitySubmitted   = "Submitted"
+	ActivityCompleted   = "Completed"
+
+	GradingNotReady      = "NotReady"
+	GradingFailed        = "Failed"
+	GradingPending       = "Pending"
+	GradingPendingManual = "PendingManual"
+	GradingFullyGraded   = "FullyGraded"
+)
+
+// Client sends requests to the LTI Advantage services.
+type Client struct {
+	Canvas *canvasapi.Canvas
+}
+
+// NewClient returns a client for the Canvas instance at canvasURL whose requests are
+// authorized with tokens from credentials. The credentials should request the scopes of the
+// services used, such as https://purl.imsglobal.org/spec/lti-ags/scope/lineitem
+func NewClient(canvasURL string, credentials *oauth.ClientCredentials, options ...canvasapi.Option) *Client {
+	options = append([]canvasapi.Option{canvasapi.WithTokenSource(credentials.TokenSource())}, options...)
+	canvas := canvasapi.New("", canvasURL, options...)
+	return &Client{Canvas: &canvas}
+}
+
+// LineItemFilter limits the line items returned by LineItems. Empty fields are ignored.
+type LineItemFilter struct {
+	Tag            string
+	ResourceID     string
+	ResourceLinkID string
+}
+
+// LineItems returns every line item of a course, following the rel=next links.
+func (c *Client) LineItems(ctx context.Context, courseID canvasapi.ID, filter LineItemFilter) ([]*models.LineItem, error) {
+	request := requests.ListLineItems{}
+	request.Path.CourseID = courseID
+	request.Query.Tag = filter.Tag
+	request.Query.ResourceID = filter.ResourceID
+	request.Query.ResourceLinkID = filter.ResourceLinkID
+	return canvasapi.All[*models.LineItem](ctx, c.Canvas, &request)
+}
+
+// LineItem returns a single line item.
+func (c *Client) LineItem(ctx context.Context, courseID canvasapi.ID, id canvasapi.ID) (*models.LineItem, error) {
+	request := requests.ShowLineItem{}
+	request.Path.CourseID = courseID
+	request.Path.ID = id
+	return request.DoContext(ctx, c.Canvas)
+}
+
+// CreateLineItem creates a line item. Canvas creates a placeholder assignment for it unless
+// item.ResourceLinkID attaches it to an existing assignment.
+func (c *Client) CreateLineItem(ctx context.Context, courseID canvasapi.ID, item *models.LineItem) (*models.LineItem, error) {
+	request := requests.CreateLineItem{}
+	request.Path.CourseID = courseID
+	request.Form.ScoreMaximum = canvasapi.Some(item.ScoreMaximum)
+	request.Form.Label = item.Label
+	request.Form.ResourceID = item.ResourceID
+	request.Form.Tag = item.Tag
+	request.Form.ResourceLinkID = item.ResourceLinkID
+	request.Form.CanvasLTISubmissionType = item.SubmissionType
+	return request.DoContext(ctx, c.Canvas)
+}
+
+// UpdateLineItem updates the tag and resource id of a line item, clearing them when they are
+// empty, and its label and maximum score when they are set.
+func (c *Client) UpdateLineItem(ctx context.Context, courseID canvasapi.ID, id canvasapi.ID, item *models.LineItem) (*models.LineItem, error) {
+	request := requests.UpdateLineItem{}
+	request.Path.CourseID = courseID
+	request.Path.ID = id
+	if item.ScoreMaximum != 0 {
+		request.Form.ScoreMaximum = canvasapi.Some(item.ScoreMaximum)
+	}
+	if item.Label != "" {
+		request.Form.Label = canvasapi.Some(item.Label)
+	}
+	request.Form.ResourceID = canvasapi.Some(item.ResourceID)
+	request.Form.Tag = canvasapi.Some(item.Tag)
+	return request.DoContext(ctx, c.Canvas)
+}
+
+// DeleteLineItem deletes a line item.
+func (c *Client) DeleteLineItem(ctx context.Context, courseID canvasapi.ID, id canvasapi.ID) error {
+	request := requests.DeleteLineItem{}
+	request.Path.CourseID = courseID
+	request.Path.ID = id
+	return request.DoContext(ctx, c.Canvas)
+}
+
+// Results returns every result of a line item, following the rel=next links.
+func (c *Client) Results(ctx context.Context, courseID canvasapi.ID, lineItemID canvasapi.ID) ([]*models.Result, error) {
+	request := requests.ShowCollectionOfResults{}
+	request.Path.CourseID = courseID
+	request.Path.LineItemID = lineItemID
+	return canvasapi.All[*models.Result](ctx, c.Canvas, &request)
+}
+
+// Result returns a single result of a line item.
+func (c *Client) Result(ctx context.Context, courseID canvasapi.ID, lineItemID canvasapi.ID, id canvasapi.ID) (*models.Result, error) {
+	request := requests.ShowResult{}
+	request.Path.CourseID = courseID
+	request.Path.LineItemID = lineItemID
+	request.Path.ID = id
+	return request.DoContext(ctx, c.Canvas)
+}
+
+// Score is the score of a user for a line item.
+type Score struct {
+	// UserID is the LTI user id or the Canvas user id.
+	UserID           string
+	ActivityProgress string
+	GradingProgress  string
+	// Timestamp is when the score was modified in the tool. Defaults to now.
+	Timestamp    time.Time
+	ScoreGiven   canvasapi.Opt[float64]
+	ScoreMaximum canvasapi.Opt[float64]
+	Comment      string
+	// Submission is the Canvas submission extension, see requests.CreateScore.
+	Submission map[string]interface{}
+}
+
+// PostScore creates or updates the result of a user for a line item.
+func (c *Client) PostScore(ctx context.Context, courseID canvasapi.ID, lineItemID canvasapi.ID, score Score) (*models.ScoreResult, error) {
+	timestamp := score.Timestamp
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+	request := requests.CreateScore{}
+	request.Path.CourseID = courseID
+	request.Path.LineItemID = lineItemID
+	request.Form.UserID = score.UserID
+	request.Form.ActivityProgress = score.ActivityProgress
+	request.Form.GradingProgress = score.GradingProgress
+	request.Form.Timestamp = timestamp.UTC().Format(time.RFC3339Nano)
+	request.Form.ScoreGiven = score.ScoreGiven
+	request.Form.ScoreMaximum = score.ScoreMaximum
+	request.Form.Comment = score.Comment
+	request.Form.CanvasLTISubmission = score.Submission
+	return request.DoContext(ctx, c.Canvas)
+}
+
+// MembershipFilter limits the members returned by CourseMembers and GroupMembers. Empty fields
+// are ignored.
+type MembershipFilter struct {
+	// Role is a fully qualified LIS role, such as
+	// http://purl.imsglobal.org/vocab/lis/v2/membership#Learner
+	Role string
+	// ResourceLinkID only returns members with access to the resource link, and includes the
+	// launch message of each member.
+	ResourceLinkID string
+}
+
+// CourseMembers returns the memberships of a course with the members of every page.
+// WithMaxPages limits the pages fetched.
+func (c *Client) CourseMembers(ctx context.Context, courseID canvasapi.ID, filter MembershipFilter, options ...canvasapi.IterateOption) (*models.NamesAndRoleMemberships, error) {
+	request := requests.ListCourseMemberships{}
+	request.Path.CourseID = courseID
+	request.Query.Role = filter.Role
+	request.Query.Rlid = filter.ResourceLinkID
+	return canvasapi.AllPages[*models.NamesAndRoleMemberships](ctx, c.Canvas, &request, options...)
+}
+
+// GroupMembers returns the memberships of a group with the members of every page.
+// WithMaxPages limits the pages fetched.
+func (c *Client) GroupMembers(ctx context.Context, groupID canvasapi.ID, filter MembershipFilter, options ...canvasapi.IterateOption) (*models.NamesAndRoleMemberships, error) {
+	request := requests.NamesAndRoleListGroupMemberships{}
+	request.Path.GroupID = groupID
+	request.Query.Role = filter.Role
+	request.Query.RLID = filter.ResourceLinkID
+	return canvasapi.AllPages[*models.NamesAndRoleMemberships](ctx, c.Canvas, &request, options...)
+}
+
+// LineItemID returns the id to use in requests for a line item, which Canvas identifies by
+// its url.
+func LineItemID(item *models.LineItem) (canvasapi.ID, error) {
+	return urlID(item.ID)
+}
+
+// ResultID returns the id to use in requests for a result, which Canvas identifies by its
+// url.
+func ResultID(result *models.Result) (canvasapi.ID, error) {
+	return urlID(result.ID)
+}
+
+func urlID(rawURL string) (canvasapi.ID, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+	id := path.Base(u.Path)
+	if id == "." || id == "/" {
+		return "", fmt.Errorf("lti: %q does not end with an id", rawURL)
+	}
+	return canvasapi.ID(id), nil
+}
@@ -0,0 +1,177 @@
+package lti
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	canvas := canvasapi.New("token", "", canvasapi.WithBaseURL(server.URL))
+	return &Client{Canvas: &canvas}
+}
+
+func TestLineItemsFollowsNextLinks(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/lti/courses/5/line_items" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if r.Header.Get("Accept") != "application/vnd.ims.lis.v2.lineitemcontainer+json" {
+			t.Errorf("unexpected accept %q", r.Header.Get("Accept"))
+		}
+		if r.URL.Query().Get("tag") != "quiz" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		if r.URL.Query().Get("page") == "" {
+			w.Header().Set("Link", fmt.Sprintf(`<http://%s/api/lti/courses/5/line_items?tag=quiz&page=2>; rel="next"`, r.Host))
+			fmt.Fprint(w, `[{"id":"http://canvas.test/api/lti/courses/5/line_items/1","scoreMaximum":10,"label":"Quiz 1","resourceLinkId":"abc"}]`)
+			return
+		}
+		fmt.Fprint(w, `[{"id":"http://canvas.test/api/lti/courses/5/line_items/2","scoreMaximum":20,"label":"Quiz 2"}]`)
+	})
+
+	items, err := client.LineItems(context.Background(), "5", LineItemFilter{Tag: "quiz"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 2 || items[0].ScoreMaximum != 10 || items[0].ResourceLinkID != "abc" || items[1].Label != "Quiz 2" {
+		t.Fatalf("unexpected line items %+v", items)
+	}
+	id, err := LineItemID(items[1])
+	if err != nil || id != "2" {
+		t.Errorf("unexpected line item id %q %v", id, err)
+	}
+}
+
+func TestCreateLineItem(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/lti/courses/5/line_items" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		if r.Header.Get("Content-Type") != "application/vnd.ims.lis.v2.lineitem+json" {
+			t.Errorf("unexpected content type %q", r.Header.Get("Content-Type"))
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		form := map[string]interface{}{}
+		json.Unmarshal(body, &form)
+		if form["scoreMaximum"] != 50.0 || form["label"] != "Essay" || form["resourceId"] != "essay-1" {
+			t.Errorf("unexpected body %s", body)
+		}
+		fmt.Fprint(w, `{"id":"http://canvas.test/api/lti/courses/5/line_items/9","scoreMaximum":50,"label":"Essay","resourceId":"essay-1"}`)
+	})
+
+	item, err := client.CreateLineItem(context.Background(), "5", &models.LineItem{ScoreMaximum: 50, Label: "Essay", ResourceID: "essay-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.ID != "http://canvas.test/api/lti/courses/5/line_items/9" {
+		t.Errorf("unexpected line item %+v", item)
+	}
+}
+
+func TestPostScore(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/lti/courses/5/line_items/9/scores" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if r.Header.Get("Content-Type") != "application/vnd.ims.lis.v1.score+json" {
+			t.Errorf("unexpected content type %q", r.Header.Get("Content-Type"))
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		form := map[string]interface{}{}
+		json.Unmarshal(body, &form)
+		if form["userId"] != "42" || form["scoreGiven"] != 7.5 || form["gradingProgress"] != GradingFullyGraded || form["timestamp"] == "" {
+			t.Errorf("unexpected body %s", body)
+		}
+		if _, ok := form["scoreMaximum"]; !ok {
+			t.Errorf("expected scoreMaximum in %s", body)
+		}
+		fmt.Fprint(w, `{"resultUrl":"http://canvas.test/api/lti/courses/5/line_items/9/results/3"}`)
+	})
+
+	result, err := client.PostScore(context.Background(), "5", "9", Score{
+		UserID:           "42",
+		ActivityProgress: ActivityCompleted,
+		GradingProgress:  GradingFullyGraded,
+		ScoreGiven:       canvasapi.Some(7.5),
+		ScoreMaximum:     canvasapi.Some(10.0),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.ResultUrl != "http://canvas.test/api/lti/courses/5/line_items/9/results/3" {
+		t.Errorf("unexpected result %+v", result)
+	}
+}
+
+func TestCourseMembers(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/lti/courses/5/names_and_roles" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if r.Header.Get("Accept") != "application/vnd.ims.lti-nrps.v2.membershipcontainer+json" {
+			t.Errorf("unexpected accept %q", r.Header.Get("Accept"))
+		}
+		if r.URL.Query().Get("page") == "" {
+			w.Header().Set("Link", fmt.Sprintf(`<http://%s/api/lti/courses/5/names_and_roles?page=2>; rel="next"`, r.Host))
+			fmt.Fprint(w, `{"id":"nrps","context":{"id":"ctx","title":"Biology"},"members":[{"user_id":"a","roles":["http://purl.imsglobal.org/vocab/lis/v2/membership#Learner"]}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"id":"nrps","context":{"id":"ctx","title":"Biology"},"members":[{"user_id":"b"}]}`)
+	})
+
+	memberships, err := client.CourseMembers(context.Background(), "5", MembershipFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if memberships.Context.Title != "Biology" || len(memberships.Members) != 2 || memberships.Members[1].UserID != "b" {
+		t.Errorf("unexpected memberships %+v", memberships)
+	}
+
+	first, err := client.CourseMembers(context.Background(), "5", MembershipFilter{}, canvasapi.WithMaxPages(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first.Members) != 1 || first.Members[0].UserID != "a" {
+		t.Errorf("unexpected first page %+v", first)
+	}
+}
+
+func TestGroupMembersStopsAtRepeatedLink(t *testing.T) {
+	requests := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Link", fmt.Sprintf(`<http://%s/api/lti/groups/3/names_and_roles?page=2>; rel="next"`, r.Host))
+		fmt.Fprintf(w, `{"id":"nrps","members":[{"user_id":"%d"}]}`, requests)
+	})
+
+	memberships, err := client.GroupMembers(context.Background(), "3", MembershipFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if requests != 2 || len(memberships.Members) != 2 {
+		t.Errorf("expected the repeated next link to stop paging, got %d requests and %+v", requests, memberships)
+	}
+}
+
+func TestDeleteLineItem(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/api/lti/courses/5/line_items/9" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if err := client.DeleteLineItem(context.Background(), "5", "9"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type Account struct {
 	ID              int64  `json:"id" url:"id,omitempty"`                               // the ID of the Account object.Example: 2
 	Name            string `json:"name" url:"name,omitempty"`                           // The display name of the account.Example: Canvas Account
@@ -12,3 +16,20 @@ type Account struct {
 func (t *Account) HasErrors() error {
 	return nil
 }
+
+func (t *Account) UnmarshalJSON(data []byte) error {
+	type model Account
+	ids := struct {
+		*model
+		ID              ID `json:"id"`
+		ParentAccountID ID `json:"parent_account_id"`
+		RootAccountID   ID `json:"root_account_id"`
+	}{model: (*model)(t), ID: ID(t.ID), ParentAccountID: ID(t.ParentAccountID), RootAccountID: ID(t.RootAccountID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.ParentAccountID = int64(ids.ParentAccountID)
+	t.RootAccountID = int64(ids.RootAccountID)
+	return nil
+}
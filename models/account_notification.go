@@ -1,9 +1,10 @@
 package models
 
 import (
-	"fmt"
+	"encoding/json"
 	"time"
 
+	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -14,15 +15,31 @@ type AccountNotification struct {
 	EndAt   time.Time `json:"end_at" url:"end_at,omitempty"`     // When to expire the notification..Example: 2013-08-29T23:59:00-06:00
 	Icon    string    `json:"icon" url:"icon,omitempty"`         // The icon to display with the message.  Defaults to warning..Example: information
 	Roles   []string  `json:"roles" url:"roles,omitempty"`       // (Deprecated) The roles to send the notification to.  If roles is not passed it defaults to all roles.Example: StudentEnrollment
-	RoleIDs []string  `json:"role_ids" url:"role_ids,omitempty"` // The roles to send the notification to.  If roles is not passed it defaults to all roles.Example: 1
+	RoleIDs []int64   `json:"role_ids" url:"role_ids,omitempty"` // The roles to send the notification to.  If roles is not passed it defaults to all roles.Example: 1
 }
 
 func (t *AccountNotification) HasErrors() error {
 	var s []string
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	s = []string{"warning", "information", "question", "error", "calendar"}
 	if t.Icon != "" && !string_utils.Include(s, t.Icon) {
-		errs = append(errs, fmt.Sprintf("expected 'Icon' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "Icon", Rule: canvasapi.RuleOneOf, Allowed: s})
 	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func (t *AccountNotification) UnmarshalJSON(data []byte) error {
+	type model AccountNotification
+	ids := struct {
+		*model
+		RoleIDs []ID `json:"role_ids"`
+	}{model: (*model)(t), RoleIDs: idSlice(t.RoleIDs)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.RoleIDs = int64Slice(ids.RoleIDs)
 	return nil
 }
@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type Admin struct {
 	ID            int64  `json:"id" url:"id,omitempty"`                         // The unique identifier for the account role/user assignment..Example: 1023
 	Role          string `json:"role" url:"role,omitempty"`                     // The account role assigned. This can be 'AccountAdmin' or a user-defined role created by the Roles API..Example: AccountAdmin
@@ -10,3 +14,16 @@ type Admin struct {
 func (t *Admin) HasErrors() error {
 	return nil
 }
+
+func (t *Admin) UnmarshalJSON(data []byte) error {
+	type model Admin
+	ids := struct {
+		*model
+		ID ID `json:"id"`
+	}{model: (*model)(t), ID: ID(t.ID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	return nil
+}
@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type Answer struct {
 	ID                             int64  `json:"id" url:"id,omitempty"`                                                               // The unique identifier for the answer.  Do not supply if this answer is part of a new question.Example: 6656
 	AnswerText                     string `json:"answer_text" url:"answer_text,omitempty"`                                             // The text of the answer..Example: Constantinople
@@ -23,3 +27,18 @@ type Answer struct {
 func (t *Answer) HasErrors() error {
 	return nil
 }
+
+func (t *Answer) UnmarshalJSON(data []byte) error {
+	type model Answer
+	ids := struct {
+		*model
+		ID      ID `json:"id"`
+		BlankID ID `json:"blank_id"`
+	}{model: (*model)(t), ID: ID(t.ID), BlankID: ID(t.BlankID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.BlankID = int64(ids.BlankID)
+	return nil
+}
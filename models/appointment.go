@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -13,3 +14,16 @@ type Appointment struct {
 func (t *Appointment) HasErrors() error {
 	return nil
 }
+
+func (t *Appointment) UnmarshalJSON(data []byte) error {
+	type model Appointment
+	ids := struct {
+		*model
+		ID ID `json:"id"`
+	}{model: (*model)(t), ID: ID(t.ID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	return nil
+}
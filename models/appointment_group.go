@@ -1,9 +1,10 @@
 package models
 
 import (
-	"fmt"
+	"encoding/json"
 	"time"
 
+	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -37,18 +38,34 @@ type AppointmentGroup struct {
 
 func (t *AppointmentGroup) HasErrors() error {
 	var s []string
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	s = []string{"pending", "active", "deleted"}
 	if t.WorkflowState != "" && !string_utils.Include(s, t.WorkflowState) {
-		errs = append(errs, fmt.Sprintf("expected 'WorkflowState' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "WorkflowState", Rule: canvasapi.RuleOneOf, Allowed: s})
 	}
 	s = []string{"private", "protected"}
 	if t.ParticipantVisibility != "" && !string_utils.Include(s, t.ParticipantVisibility) {
-		errs = append(errs, fmt.Sprintf("expected 'ParticipantVisibility' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "ParticipantVisibility", Rule: canvasapi.RuleOneOf, Allowed: s})
 	}
 	s = []string{"User", "Group"}
 	if t.ParticipantType != "" && !string_utils.Include(s, t.ParticipantType) {
-		errs = append(errs, fmt.Sprintf("expected 'ParticipantType' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "ParticipantType", Rule: canvasapi.RuleOneOf, Allowed: s})
 	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func (t *AppointmentGroup) UnmarshalJSON(data []byte) error {
+	type model AppointmentGroup
+	ids := struct {
+		*model
+		ID ID `json:"id"`
+	}{model: (*model)(t), ID: ID(t.ID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
 	return nil
 }
@@ -1,9 +1,10 @@
 package models
 
 import (
-	"fmt"
+	"encoding/json"
 	"time"
 
+	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -81,17 +82,45 @@ type Assignment struct {
 
 func (t *Assignment) HasErrors() error {
 	var s []string
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	s = []string{"discussion_topic", "online_quiz", "on_paper", "not_graded", "none", "external_tool", "online_text_entry", "online_url", "online_upload", "media_recording", "student_annotation"}
 
 	for _, v := range t.SubmissionTypes {
 		if v != "" && !string_utils.Include(s, v) {
-			errs = append(errs, fmt.Sprintf("expected 'SubmissionTypes' to be one of %v", s))
+			errs = append(errs, canvasapi.FieldError{Field: "SubmissionTypes", Rule: canvasapi.RuleOneOf, Allowed: s})
 		}
 	}
 	s = []string{"pass_fail", "percent", "letter_grade", "gpa_scale", "points"}
 	if t.GradingType != "" && !string_utils.Include(s, t.GradingType) {
-		errs = append(errs, fmt.Sprintf("expected 'GradingType' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "GradingType", Rule: canvasapi.RuleOneOf, Allowed: s})
 	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func (t *Assignment) UnmarshalJSON(data []byte) error {
+	type model Assignment
+	ids := struct {
+		*model
+		ID                ID `json:"id"`
+		CourseID          ID `json:"course_id"`
+		AssignmentGroupID ID `json:"assignment_group_id"`
+		GroupCategoryID   ID `json:"group_category_id"`
+		GradingStandardID ID `json:"grading_standard_id"`
+		QuizID            ID `json:"quiz_id"`
+		FinalGraderID     ID `json:"final_grader_id"`
+	}{model: (*model)(t), ID: ID(t.ID), CourseID: ID(t.CourseID), AssignmentGroupID: ID(t.AssignmentGroupID), GroupCategoryID: ID(t.GroupCategoryID), GradingStandardID: ID(t.GradingStandardID), QuizID: ID(t.QuizID), FinalGraderID: ID(t.FinalGraderID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.CourseID = int64(ids.CourseID)
+	t.AssignmentGroupID = int64(ids.AssignmentGroupID)
+	t.GroupCategoryID = int64(ids.GroupCategoryID)
+	t.GradingStandardID = int64(ids.GradingStandardID)
+	t.QuizID = int64(ids.QuizID)
+	t.FinalGraderID = int64(ids.FinalGraderID)
 	return nil
 }
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -16,3 +17,16 @@ type AssignmentDate struct {
 func (t *AssignmentDate) HasErrors() error {
 	return nil
 }
+
+func (t *AssignmentDate) UnmarshalJSON(data []byte) error {
+	type model AssignmentDate
+	ids := struct {
+		*model
+		ID ID `json:"id"`
+	}{model: (*model)(t), ID: ID(t.ID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	return nil
+}
@@ -1,9 +1,9 @@
 package models
 
 import (
-	"fmt"
 	"time"
 
+	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -28,10 +28,13 @@ type AssignmentEvent struct {
 
 func (t *AssignmentEvent) HasErrors() error {
 	var s []string
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	s = []string{"published", "deleted"}
 	if t.WorkflowState != "" && !string_utils.Include(s, t.WorkflowState) {
-		errs = append(errs, fmt.Sprintf("expected 'WorkflowState' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "WorkflowState", Rule: canvasapi.RuleOneOf, Allowed: s})
+	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
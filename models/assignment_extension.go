@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type AssignmentExtension struct {
 	AssignmentID  int64 `json:"assignment_id" url:"assignment_id,omitempty"`   // The ID of the Assignment the extension belongs to..Example: 2
 	UserID        int64 `json:"user_id" url:"user_id,omitempty"`               // The ID of the Student that needs the assignment extension..Example: 3
@@ -9,3 +13,18 @@ type AssignmentExtension struct {
 func (t *AssignmentExtension) HasErrors() error {
 	return nil
 }
+
+func (t *AssignmentExtension) UnmarshalJSON(data []byte) error {
+	type model AssignmentExtension
+	ids := struct {
+		*model
+		AssignmentID ID `json:"assignment_id"`
+		UserID       ID `json:"user_id"`
+	}{model: (*model)(t), AssignmentID: ID(t.AssignmentID), UserID: ID(t.UserID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.AssignmentID = int64(ids.AssignmentID)
+	t.UserID = int64(ids.UserID)
+	return nil
+}
@@ -0,0 +1,9 @@
+package models
+
+type AssignmentExtensionsResponse struct {
+	AssignmentExtensions []*AssignmentExtension `json:"assignment_extensions" url:"assignment_extensions,omitempty"` // The assignment extensions..
+}
+
+func (t *AssignmentExtensionsResponse) HasErrors() error {
+	return nil
+}
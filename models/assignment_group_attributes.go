@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type AssignmentGroupAttributes struct {
 	ID              int64                    `json:"id" url:"id,omitempty"`                             // the id of the Assignment Group.Example: 1
 	Name            string                   `json:"name" url:"name,omitempty"`                         // the name of the Assignment Group.Example: group2
@@ -11,3 +15,16 @@ type AssignmentGroupAttributes struct {
 func (t *AssignmentGroupAttributes) HasErrors() error {
 	return nil
 }
+
+func (t *AssignmentGroupAttributes) UnmarshalJSON(data []byte) error {
+	type model AssignmentGroupAttributes
+	ids := struct {
+		*model
+		ID ID `json:"id"`
+	}{model: (*model)(t), ID: ID(t.ID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	return nil
+}
@@ -1,13 +1,14 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 )
 
 type AssignmentOverride struct {
 	ID              int64     `json:"id" url:"id,omitempty"`                               // the ID of the assignment override.Example: 4
 	AssignmentID    int64     `json:"assignment_id" url:"assignment_id,omitempty"`         // the ID of the assignment the override applies to.Example: 123
-	StudentIDs      []string  `json:"student_ids" url:"student_ids,omitempty"`             // the IDs of the override's target students (present if the override targets an ad-hoc set of students).Example: 1, 2, 3
+	StudentIDs      []int64   `json:"student_ids" url:"student_ids,omitempty"`             // the IDs of the override's target students (present if the override targets an ad-hoc set of students).Example: 1, 2, 3
 	GroupID         int64     `json:"group_id" url:"group_id,omitempty"`                   // the ID of the override's target group (present if the override targets a group and the assignment is a group assignment).Example: 2
 	CourseSectionID int64     `json:"course_section_id" url:"course_section_id,omitempty"` // the ID of the overrides's target section (present if the override targets a section).Example: 1
 	Title           string    `json:"title" url:"title,omitempty"`                         // the title of the override.Example: an assignment override
@@ -21,3 +22,24 @@ type AssignmentOverride struct {
 func (t *AssignmentOverride) HasErrors() error {
 	return nil
 }
+
+func (t *AssignmentOverride) UnmarshalJSON(data []byte) error {
+	type model AssignmentOverride
+	ids := struct {
+		*model
+		ID              ID   `json:"id"`
+		AssignmentID    ID   `json:"assignment_id"`
+		StudentIDs      []ID `json:"student_ids"`
+		GroupID         ID   `json:"group_id"`
+		CourseSectionID ID   `json:"course_section_id"`
+	}{model: (*model)(t), ID: ID(t.ID), AssignmentID: ID(t.AssignmentID), StudentIDs: idSlice(t.StudentIDs), GroupID: ID(t.GroupID), CourseSectionID: ID(t.CourseSectionID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.AssignmentID = int64(ids.AssignmentID)
+	t.StudentIDs = int64Slice(ids.StudentIDs)
+	t.GroupID = int64(ids.GroupID)
+	t.CourseSectionID = int64(ids.CourseSectionID)
+	return nil
+}
@@ -0,0 +1,304 @@
+package models
+
+import "sync"
+
+// The audit log endpoints return their events in a compound document. The events hold the ids
+// of the resources they refer to in their links, and the resources themselves are returned
+// once per page in linked.
+// https://canvas.instructure.com/doc/api/file.compound_documents.html
+
+// AuditLinked holds the resources linked from the events of an audit log page.
+type AuditLinked struct {
+	Assignments []*Assignment `json:"assignments" url:"assignments,omitempty"`
+	Courses     []*Course     `json:"courses" url:"courses,omitempty"`
+	Users       []*User       `json:"users" url:"users,omitempty"`
+	PageViews   []*PageView   `json:"page_views" url:"page_views,omitempty"`
+	Logins      []*Login      `json:"logins" url:"logins,omitempty"`
+	Accounts    []*Account    `json:"accounts" url:"accounts,omitempty"`
+
+	assignments linkedIndex[ID, *Assignment]
+	courses     linkedIndex[ID, *Course]
+	users       linkedIndex[ID, *User]
+	pageViews   linkedIndex[string, *PageView]
+	logins      linkedIndex[ID, *Login]
+	accounts    linkedIndex[ID, *Account]
+}
+
+func (t *AuditLinked) HasErrors() error {
+	return nil
+}
+
+// linkedIndex maps the ids of a slice of linked resources to the resources. Resources are
+// indexed on the first lookup after they were decoded or appended, so each is indexed once.
+// The lock lets the read-only accessors be called concurrently even though they index.
+type linkedIndex[K comparable, T any] struct {
+	mu      sync.Mutex
+	byID    map[K]T
+	indexed int
+}
+
+// find returns the resource with the given id, indexing the resources appended to items since
+// the last lookup. The first resource with an id wins.
+func (x *linkedIndex[K, T]) find(items []T, id K, key func(T) K) (T, bool) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if x.byID == nil || x.indexed > len(items) {
+		x.byID = make(map[K]T, len(items))
+		x.indexed = 0
+	}
+	for ; x.indexed < len(items); x.indexed++ {
+		item := items[x.indexed]
+		if _, ok := x.byID[key(item)]; !ok {
+			x.byID[key(item)] = item
+		}
+	}
+	item, ok := x.byID[id]
+	return item, ok
+}
+
+// Assignment returns the linked assignment with the given id, or nil.
+func (t *AuditLinked) Assignment(id ID) *Assignment {
+	if t == nil || id == 0 {
+		return nil
+	}
+	assignment, _ := t.assignments.find(t.Assignments, id, func(a *Assignment) ID { return ID(a.ID) })
+	return assignment
+}
+
+// Course returns the linked course with the given id, or nil.
+func (t *AuditLinked) Course(id ID) *Course {
+	if t == nil || id == 0 {
+		return nil
+	}
+	course, _ := t.courses.find(t.Courses, id, func(c *Course) ID { return ID(c.ID) })
+	return course
+}
+
+// User returns the linked user with the given id, or nil.
+func (t *AuditLinked) User(id ID) *User {
+	if t == nil || id == 0 {
+		return nil
+	}
+	user, _ := t.users.find(t.Users, id, func(u *User) ID { return ID(u.ID) })
+	return user
+}
+
+// PageView returns the linked page view with the given id, or nil.
+func (t *AuditLinked) PageView(id string) *PageView {
+	if t == nil || id == "" {
+		return nil
+	}
+	pageView, _ := t.pageViews.find(t.PageViews, id, func(p *PageView) string { return p.ID })
+	return pageView
+}
+
+// Login returns the linked login with the given id, or nil.
+func (t *AuditLinked) Login(id ID) *Login {
+	if t == nil || id == 0 {
+		return nil
+	}
+	login, _ := t.logins.find(t.Logins, id, func(l *Login) ID { return ID(l.ID) })
+	return login
+}
+
+// Account returns the linked account with the given id, or nil.
+func (t *AuditLinked) Account(id ID) *Account {
+	if t == nil || id == 0 {
+		return nil
+	}
+	account, _ := t.accounts.find(t.Accounts, id, func(a *Account) ID { return ID(a.ID) })
+	return account
+}
+
+// Append adds the resources of another page that are not linked yet.
+func (t *AuditLinked) Append(page *AuditLinked) {
+	if page == nil {
+		return
+	}
+	for _, assignment := range page.Assignments {
+		if t.Assignment(ID(assignment.ID)) == nil {
+			t.Assignments = append(t.Assignments, assignment)
+		}
+	}
+	for _, course := range page.Courses {
+		if t.Course(ID(course.ID)) == nil {
+			t.Courses = append(t.Courses, course)
+		}
+	}
+	for _, user := range page.Users {
+		if t.User(ID(user.ID)) == nil {
+			t.Users = append(t.Users, user)
+		}
+	}
+	for _, pageView := range page.PageViews {
+		if t.PageView(pageView.ID) == nil {
+			t.PageViews = append(t.PageViews, pageView)
+		}
+	}
+	for _, login := range page.Logins {
+		if t.Login(ID(login.ID)) == nil {
+			t.Logins = append(t.Logins, login)
+		}
+	}
+	for _, account := range page.Accounts {
+		if t.Account(ID(account.ID)) == nil {
+			t.Accounts = append(t.Accounts, account)
+		}
+	}
+}
+
+// GradeChangeEventsResponse is a page of the grade change log.
+type GradeChangeEventsResponse struct {
+	Events []*GradeChangeEvent `json:"events" url:"events,omitempty"`
+	Linked *AuditLinked        `json:"linked" url:"linked,omitempty"`
+	Links  map[string]string   `json:"links" url:"links,omitempty"` // Url templates of the linked resources.Example: {'events.assignment': 'https://canvas.example.com/api/v1/courses/{events.course}/assignments/{events.assignment}'}
+}
+
+func (t *GradeChangeEventsResponse) HasErrors() error {
+	return nil
+}
+
+// Append adds the events and linked resources of the next page.
+func (t *GradeChangeEventsResponse) Append(page *GradeChangeEventsResponse) {
+	t.Events = append(t.Events, page.Events...)
+	if t.Linked == nil {
+		t.Linked = &AuditLinked{}
+	}
+	t.Linked.Append(page.Linked)
+}
+
+// Assignment returns the assignment of an event when it is linked.
+func (t *GradeChangeEventsResponse) Assignment(event *GradeChangeEvent) *Assignment {
+	if event.Links == nil {
+		return nil
+	}
+	return t.Linked.Assignment(event.Links.Assignment)
+}
+
+// Course returns the course of an event when it is linked.
+func (t *GradeChangeEventsResponse) Course(event *GradeChangeEvent) *Course {
+	if event.Links == nil {
+		return nil
+	}
+	return t.Linked.Course(event.Links.Course)
+}
+
+// Student returns the student whose grade changed when it is linked.
+func (t *GradeChangeEventsResponse) Student(event *GradeChangeEvent) *User {
+	if event.Links == nil {
+		return nil
+	}
+	return t.Linked.User(event.Links.Student)
+}
+
+// Grader returns the user who changed the grade when it is linked.
+func (t *GradeChangeEventsResponse) Grader(event *GradeChangeEvent) *User {
+	if event.Links == nil {
+		return nil
+	}
+	return t.Linked.User(event.Links.Grader)
+}
+
+// PageView returns the page view of an event when it is linked.
+func (t *GradeChangeEventsResponse) PageView(event *GradeChangeEvent) *PageView {
+	if event.Links == nil {
+		return nil
+	}
+	return t.Linked.PageView(event.Links.PageView)
+}
+
+// CourseEventsResponse is a page of the course change log.
+type CourseEventsResponse struct {
+	Events []*CourseEvent    `json:"events" url:"events,omitempty"`
+	Linked *AuditLinked      `json:"linked" url:"linked,omitempty"`
+	Links  map[string]string `json:"links" url:"links,omitempty"` // Url templates of the linked resources.Example: {'events.course': 'https://canvas.example.com/api/v1/courses/{events.course}'}
+}
+
+func (t *CourseEventsResponse) HasErrors() error {
+	return nil
+}
+
+// Append adds the events and linked resources of the next page.
+func (t *CourseEventsResponse) Append(page *CourseEventsResponse) {
+	t.Events = append(t.Events, page.Events...)
+	if t.Linked == nil {
+		t.Linked = &AuditLinked{}
+	}
+	t.Linked.Append(page.Linked)
+}
+
+// Course returns the course of an event when it is linked.
+func (t *CourseEventsResponse) Course(event *CourseEvent) *Course {
+	if event.Links == nil {
+		return nil
+	}
+	return t.Linked.Course(event.Links.Course)
+}
+
+// User returns the user who changed the course when it is linked.
+func (t *CourseEventsResponse) User(event *CourseEvent) *User {
+	if event.Links == nil {
+		return nil
+	}
+	return t.Linked.User(event.Links.User)
+}
+
+// PageView returns the page view of an event when it is linked.
+func (t *CourseEventsResponse) PageView(event *CourseEvent) *PageView {
+	if event.Links == nil {
+		return nil
+	}
+	return t.Linked.PageView(event.Links.PageView)
+}
+
+// AuthenticationEventsResponse is a page of the authentication log.
+type AuthenticationEventsResponse struct {
+	Events []*AuthenticationEvent `json:"events" url:"events,omitempty"`
+	Linked *AuditLinked           `json:"linked" url:"linked,omitempty"`
+	Links  map[string]string      `json:"links" url:"links,omitempty"` // Url templates of the linked resources.Example: {'events.user': 'https://canvas.example.com/api/v1/users/{events.user}'}
+}
+
+func (t *AuthenticationEventsResponse) HasErrors() error {
+	return nil
+}
+
+// Append adds the events and linked resources of the next page.
+func (t *AuthenticationEventsResponse) Append(page *AuthenticationEventsResponse) {
+	t.Events = append(t.Events, page.Events...)
+	if t.Linked == nil {
+		t.Linked = &AuditLinked{}
+	}
+	t.Linked.Append(page.Linked)
+}
+
+// Login returns the login of an event when it is linked.
+func (t *AuthenticationEventsResponse) Login(event *AuthenticationEvent) *Login {
+	if event.Links == nil {
+		return t.Linked.Login(ID(event.PseudonymID))
+	}
+	return t.Linked.Login(event.Links.Login)
+}
+
+// Account returns the account of an event when it is linked.
+func (t *AuthenticationEventsResponse) Account(event *AuthenticationEvent) *Account {
+	if event.Links == nil {
+		return t.Linked.Account(ID(event.AccountID))
+	}
+	return t.Linked.Account(event.Links.Account)
+}
+
+// User returns the user of an event when it is linked.
+func (t *AuthenticationEventsResponse) User(event *AuthenticationEvent) *User {
+	if event.Links == nil {
+		return t.Linked.User(ID(event.UserID))
+	}
+	return t.Linked.User(event.Links.User)
+}
+
+// PageView returns the page view of an event when it is linked.
+func (t *AuthenticationEventsResponse) PageView(event *AuthenticationEvent) *PageView {
+	if event.Links == nil {
+		return nil
+	}
+	return t.Linked.PageView(event.Links.PageView)
+}
@@ -0,0 +1,109 @@
+package models
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+)
+
+func TestGradeChangeEventsResponse(t *testing.T) {
+	pages := []string{
+		`{
+			"events": [{"id": "e1", "event_type": "grade_change", "grade_after": "8", "links": {"assignment": 3, "course": 1, "student": 10, "grader": 20, "page_view": "pv1"}}],
+			"linked": {
+				"assignments": [{"id": 3, "name": "Essay"}],
+				"courses": [{"id": 1, "name": "Biology"}],
+				"users": [{"id": 10, "name": "Student"}, {"id": 20, "name": "Teacher"}],
+				"page_views": [{"id": "pv1"}]
+			},
+			"links": {"events.assignment": "https://canvas.test/api/v1/courses/{events.course}/assignments/{events.assignment}"}
+		}`,
+		`{
+			"events": [{"id": "e2", "event_type": "grade_change", "links": {"assignment": "4", "course": "1", "student": "11", "grader": "20"}}],
+			"linked": {
+				"assignments": [{"id": "4", "name": "Quiz"}],
+				"courses": [{"id": "1", "name": "Biology"}],
+				"users": [{"id": "11", "name": "Other Student"}, {"id": "20", "name": "Teacher"}]
+			}
+		}`,
+	}
+	var all *GradeChangeEventsResponse
+	for _, page := range pages {
+		response := &GradeChangeEventsResponse{}
+		if err := json.Unmarshal([]byte(page), response); err != nil {
+			t.Fatal(err)
+		}
+		if all == nil {
+			all = response
+		} else {
+			all.Append(response)
+		}
+	}
+
+	if len(all.Events) != 2 || len(all.Linked.Courses) != 1 || len(all.Linked.Users) != 3 {
+		t.Fatalf("expected linked resources without duplicates, got %d events, %d courses, %d users", len(all.Events), len(all.Linked.Courses), len(all.Linked.Users))
+	}
+	first, second := all.Events[0], all.Events[1]
+	if all.Assignment(first).Name != "Essay" || all.Course(first).Name != "Biology" || all.PageView(first) == nil {
+		t.Errorf("unexpected resources of the first event")
+	}
+	if all.Student(second).Name != "Other Student" || all.Grader(second).Name != "Teacher" || all.Assignment(second).Name != "Quiz" {
+		t.Errorf("unexpected resources of the second event")
+	}
+	if all.PageView(second) != nil {
+		t.Errorf("expected no page view for the second event")
+	}
+	if all.Links["events.assignment"] == "" {
+		t.Errorf("expected the url templates, got %v", all.Links)
+	}
+}
+
+func TestAuditLinkedAppend(t *testing.T) {
+	linked := &AuditLinked{Users: []*User{{ID: 1, Name: "First"}}}
+	if linked.User(2) != nil {
+		t.Fatalf("expected user 2 to be missing")
+	}
+	for page := 0; page < 1000; page++ {
+		linked.Append(&AuditLinked{Users: []*User{{ID: 1, Name: "Again"}, {ID: int64(page + 2), Name: "Other"}}})
+	}
+	if len(linked.Users) != 1001 || linked.User(1).Name != "First" || linked.User(2) == nil || linked.User(1001) == nil {
+		t.Errorf("expected the first of every user once, got %d users", len(linked.Users))
+	}
+}
+
+func TestAuditLinkedConcurrentLookups(t *testing.T) {
+	linked := &AuditLinked{}
+	if err := json.Unmarshal([]byte(`{"users":[{"id":1},{"id":"2"}],"courses":[{"id":3}]}`), linked); err != nil {
+		t.Fatal(err)
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if linked.User(2) == nil || linked.Course(3) == nil || linked.User(4) != nil {
+				t.Errorf("unexpected lookup result")
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestCourseEventsResponse(t *testing.T) {
+	response := &CourseEventsResponse{}
+	err := json.Unmarshal([]byte(`{
+		"events": [{"id": "e1", "event_type": "updated", "event_data": {"name": ["Old", "New"]}, "links": {"course": 1, "user": 2}}],
+		"linked": {"courses": [{"id": 1, "name": "New"}], "users": [{"id": 2, "name": "Admin"}]}
+	}`), response)
+	if err != nil {
+		t.Fatal(err)
+	}
+	event := response.Events[0]
+	if response.Course(event).Name != "New" || response.User(event).Name != "Admin" {
+		t.Errorf("unexpected linked resources")
+	}
+	data := UpdatedEventData{}
+	if err := json.Unmarshal(event.EventData, &data); err != nil || len(data.Name) != 2 {
+		t.Errorf("unexpected event data %v %v", data, err)
+	}
+}
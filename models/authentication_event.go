@@ -1,26 +1,48 @@
 package models
 
 import (
-	"fmt"
+	"encoding/json"
 	"time"
 
+	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
 )
 
 type AuthenticationEvent struct {
-	CreatedAt   time.Time `json:"created_at" url:"created_at,omitempty"`     // timestamp of the event.Example: 2012-07-19T15:00:00-06:00
-	EventType   string    `json:"event_type" url:"event_type,omitempty"`     // authentication event type ('login' or 'logout').Example: login
-	PseudonymID int64     `json:"pseudonym_id" url:"pseudonym_id,omitempty"` // ID of the pseudonym (login) associated with the event.Example: 9478
-	AccountID   int64     `json:"account_id" url:"account_id,omitempty"`     // ID of the account associated with the event. will match the account_id in the associated pseudonym..Example: 2319
-	UserID      int64     `json:"user_id" url:"user_id,omitempty"`           // ID of the user associated with the event will match the user_id in the associated pseudonym..Example: 362
+	CreatedAt   time.Time                 `json:"created_at" url:"created_at,omitempty"`     // timestamp of the event.Example: 2012-07-19T15:00:00-06:00
+	EventType   string                    `json:"event_type" url:"event_type,omitempty"`     // authentication event type ('login' or 'logout').Example: login
+	PseudonymID int64                     `json:"pseudonym_id" url:"pseudonym_id,omitempty"` // ID of the pseudonym (login) associated with the event.Example: 9478
+	AccountID   int64                     `json:"account_id" url:"account_id,omitempty"`     // ID of the account associated with the event. will match the account_id in the associated pseudonym..Example: 2319
+	UserID      int64                     `json:"user_id" url:"user_id,omitempty"`           // ID of the user associated with the event will match the user_id in the associated pseudonym..Example: 362
+	Links       *AuthenticationEventLinks `json:"links" url:"links,omitempty"`               // Jsonapi.org links.Example: 9478, 2319, 362
 }
 
 func (t *AuthenticationEvent) HasErrors() error {
 	var s []string
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	s = []string{"login", "logout"}
 	if t.EventType != "" && !string_utils.Include(s, t.EventType) {
-		errs = append(errs, fmt.Sprintf("expected 'EventType' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "EventType", Rule: canvasapi.RuleOneOf, Allowed: s})
 	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func (t *AuthenticationEvent) UnmarshalJSON(data []byte) error {
+	type model AuthenticationEvent
+	ids := struct {
+		*model
+		PseudonymID ID `json:"pseudonym_id"`
+		AccountID   ID `json:"account_id"`
+		UserID      ID `json:"user_id"`
+	}{model: (*model)(t), PseudonymID: ID(t.PseudonymID), AccountID: ID(t.AccountID), UserID: ID(t.UserID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.PseudonymID = int64(ids.PseudonymID)
+	t.AccountID = int64(ids.AccountID)
+	t.UserID = int64(ids.UserID)
 	return nil
 }
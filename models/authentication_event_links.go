@@ -0,0 +1,12 @@
+package models
+
+type AuthenticationEventLinks struct {
+	Login    ID     `json:"login" url:"login,omitempty"`         // ID of the login (pseudonym) associated with the event.Example: 9478
+	Account  ID     `json:"account" url:"account,omitempty"`     // ID of the account associated with the event.Example: 2319
+	User     ID     `json:"user" url:"user,omitempty"`           // ID of the user associated with the event.Example: 362
+	PageView string `json:"page_view" url:"page_view,omitempty"` // ID of the page view during the event if it exists..Example: e2b76430-27a5-0131-3ca1-48e0eb13f29b
+}
+
+func (t *AuthenticationEventLinks) HasErrors() error {
+	return nil
+}
@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type AuthenticationProvider struct {
 	IDentifierFormat       string                     `json:"identifier_format" url:"identifier_format,omitempty"`             // Valid for SAML providers..Example: urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress
 	AuthType               string                     `json:"auth_type" url:"auth_type,omitempty"`                             // Valid for all providers..Example: saml
@@ -26,3 +30,16 @@ type AuthenticationProvider struct {
 func (t *AuthenticationProvider) HasErrors() error {
 	return nil
 }
+
+func (t *AuthenticationProvider) UnmarshalJSON(data []byte) error {
+	type model AuthenticationProvider
+	ids := struct {
+		*model
+		ID ID `json:"id"`
+	}{model: (*model)(t), ID: ID(t.ID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	return nil
+}
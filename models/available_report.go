@@ -0,0 +1,12 @@
+package models
+
+type AvailableReport struct {
+	Report     string                               `json:"report" url:"report,omitempty"`         // The name of the report, used to start it..Example: sis_export_csv
+	Title      string                               `json:"title" url:"title,omitempty"`           // The human readable title of the report..Example: SIS Export
+	Parameters map[string]*AvailableReportParameter `json:"parameters" url:"parameters,omitempty"` // The parameters the report accepts, keyed by name..
+	LastRun    *Report                              `json:"last_run" url:"last_run,omitempty"`     // (Optional) The last time the report was run..
+}
+
+func (t *AvailableReport) HasErrors() error {
+	return nil
+}
@@ -0,0 +1,10 @@
+package models
+
+type AvailableReportParameter struct {
+	Description string `json:"description" url:"description,omitempty"` // A description of the parameter..Example: The canvas id of the term to get grades from
+	Required    bool   `json:"required" url:"required,omitempty"`       // Whether the parameter is required..Example: true
+}
+
+func (t *AvailableReportParameter) HasErrors() error {
+	return nil
+}
@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type Avatar struct {
 	Type        string `json:"type" url:"type,omitempty"`                 // ['gravatar'|'attachment'|'no_pic'] The type of avatar record, for categorization purposes..Example: gravatar
 	Url         string `json:"url" url:"url,omitempty"`                   // The url of the avatar.Example: https://secure.gravatar.com/avatar/2284.
@@ -14,3 +18,16 @@ type Avatar struct {
 func (t *Avatar) HasErrors() error {
 	return nil
 }
+
+func (t *Avatar) UnmarshalJSON(data []byte) error {
+	type model Avatar
+	ids := struct {
+		*model
+		ID ID `json:"id"`
+	}{model: (*model)(t), ID: ID(t.ID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	return nil
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -20,3 +21,22 @@ type BlueprintMigration struct {
 func (t *BlueprintMigration) HasErrors() error {
 	return nil
 }
+
+func (t *BlueprintMigration) UnmarshalJSON(data []byte) error {
+	type model BlueprintMigration
+	ids := struct {
+		*model
+		ID             ID `json:"id"`
+		TemplateID     ID `json:"template_id"`
+		SubscriptionID ID `json:"subscription_id"`
+		UserID         ID `json:"user_id"`
+	}{model: (*model)(t), ID: ID(t.ID), TemplateID: ID(t.TemplateID), SubscriptionID: ID(t.SubscriptionID), UserID: ID(t.UserID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.TemplateID = int64(ids.TemplateID)
+	t.SubscriptionID = int64(ids.SubscriptionID)
+	t.UserID = int64(ids.UserID)
+	return nil
+}
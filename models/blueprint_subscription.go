@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type BlueprintSubscription struct {
 	ID              int64                    `json:"id" url:"id,omitempty"`                             // The ID of the blueprint course subscription.Example: 101
 	TemplateID      int64                    `json:"template_id" url:"template_id,omitempty"`           // The ID of the blueprint template the associated course is subscribed to.Example: 1
@@ -9,3 +13,18 @@ type BlueprintSubscription struct {
 func (t *BlueprintSubscription) HasErrors() error {
 	return nil
 }
+
+func (t *BlueprintSubscription) UnmarshalJSON(data []byte) error {
+	type model BlueprintSubscription
+	ids := struct {
+		*model
+		ID         ID `json:"id"`
+		TemplateID ID `json:"template_id"`
+	}{model: (*model)(t), ID: ID(t.ID), TemplateID: ID(t.TemplateID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.TemplateID = int64(ids.TemplateID)
+	return nil
+}
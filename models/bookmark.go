@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type Bookmark struct {
 	ID       int64                    `json:"id" url:"id,omitempty"`             // Example: 1
 	Name     string                   `json:"name" url:"name,omitempty"`         // Example: Biology 101
@@ -11,3 +15,16 @@ type Bookmark struct {
 func (t *Bookmark) HasErrors() error {
 	return nil
 }
+
+func (t *Bookmark) UnmarshalJSON(data []byte) error {
+	type model Bookmark
+	ids := struct {
+		*model
+		ID ID `json:"id"`
+	}{model: (*model)(t), ID: ID(t.ID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	return nil
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -43,3 +44,20 @@ type CalendarEvent struct {
 func (t *CalendarEvent) HasErrors() error {
 	return nil
 }
+
+func (t *CalendarEvent) UnmarshalJSON(data []byte) error {
+	type model CalendarEvent
+	ids := struct {
+		*model
+		ID                 ID `json:"id"`
+		ParentEventID      ID `json:"parent_event_id"`
+		AppointmentGroupID ID `json:"appointment_group_id"`
+	}{model: (*model)(t), ID: ID(t.ID), ParentEventID: ID(t.ParentEventID), AppointmentGroupID: ID(t.AppointmentGroupID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.ParentEventID = int64(ids.ParentEventID)
+	t.AppointmentGroupID = int64(ids.AppointmentGroupID)
+	return nil
+}
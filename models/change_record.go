@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type ChangeRecord struct {
 	AssetID    int64    `json:"asset_id" url:"asset_id,omitempty"`       // The ID of the learning object that was changed in the blueprint course..Example: 2
 	AssetType  string   `json:"asset_type" url:"asset_type,omitempty"`   // The type of the learning object that was changed in the blueprint course.  One of 'assignment', 'attachment', 'discussion_topic', 'external_tool', 'quiz', 'wiki_page', 'syllabus', or 'settings'.  For 'syllabus' or 'settings', the asset_id is the course id..Example: assignment
@@ -13,3 +17,16 @@ type ChangeRecord struct {
 func (t *ChangeRecord) HasErrors() error {
 	return nil
 }
+
+func (t *ChangeRecord) UnmarshalJSON(data []byte) error {
+	type model ChangeRecord
+	ids := struct {
+		*model
+		AssetID ID `json:"asset_id"`
+	}{model: (*model)(t), AssetID: ID(t.AssetID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.AssetID = int64(ids.AssetID)
+	return nil
+}
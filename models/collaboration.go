@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -24,3 +25,20 @@ type Collaboration struct {
 func (t *Collaboration) HasErrors() error {
 	return nil
 }
+
+func (t *Collaboration) UnmarshalJSON(data []byte) error {
+	type model Collaboration
+	ids := struct {
+		*model
+		ID        ID `json:"id"`
+		UserID    ID `json:"user_id"`
+		ContextID ID `json:"context_id"`
+	}{model: (*model)(t), ID: ID(t.ID), UserID: ID(t.UserID), ContextID: ID(t.ContextID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.UserID = int64(ids.UserID)
+	t.ContextID = int64(ids.ContextID)
+	return nil
+}
@@ -1,8 +1,8 @@
 package models
 
 import (
-	"fmt"
-
+	"encoding/json"
+	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -14,10 +14,26 @@ type Collaborator struct {
 
 func (t *Collaborator) HasErrors() error {
 	var s []string
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	s = []string{"user", "group"}
 	if t.Type != "" && !string_utils.Include(s, t.Type) {
-		errs = append(errs, fmt.Sprintf("expected 'Type' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "Type", Rule: canvasapi.RuleOneOf, Allowed: s})
+	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func (t *Collaborator) UnmarshalJSON(data []byte) error {
+	type model Collaborator
+	ids := struct {
+		*model
+		ID ID `json:"id"`
+	}{model: (*model)(t), ID: ID(t.ID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
 	}
+	t.ID = int64(ids.ID)
 	return nil
 }
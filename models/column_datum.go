@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type ColumnDatum struct {
 	Content string `json:"content" url:"content,omitempty"` // Example: Nut allergy
 	UserID  int64  `json:"user_id" url:"user_id,omitempty"` // Example: 2
@@ -8,3 +12,16 @@ type ColumnDatum struct {
 func (t *ColumnDatum) HasErrors() error {
 	return nil
 }
+
+func (t *ColumnDatum) UnmarshalJSON(data []byte) error {
+	type model ColumnDatum
+	ids := struct {
+		*model
+		UserID ID `json:"user_id"`
+	}{model: (*model)(t), UserID: ID(t.UserID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.UserID = int64(ids.UserID)
+	return nil
+}
@@ -1,9 +1,10 @@
 package models
 
 import (
-	"fmt"
+	"encoding/json"
 	"time"
 
+	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -23,10 +24,26 @@ type CommMessage struct {
 
 func (t *CommMessage) HasErrors() error {
 	var s []string
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	s = []string{"created", "staged", "sending", "sent", "bounced", "dashboard", "cancelled", "closed"}
 	if t.WorkflowState != "" && !string_utils.Include(s, t.WorkflowState) {
-		errs = append(errs, fmt.Sprintf("expected 'WorkflowState' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "WorkflowState", Rule: canvasapi.RuleOneOf, Allowed: s})
 	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func (t *CommMessage) UnmarshalJSON(data []byte) error {
+	type model CommMessage
+	ids := struct {
+		*model
+		ID ID `json:"id"`
+	}{model: (*model)(t), ID: ID(t.ID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
 	return nil
 }
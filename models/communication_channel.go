@@ -1,8 +1,8 @@
 package models
 
 import (
-	"fmt"
-
+	"encoding/json"
+	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -17,14 +17,32 @@ type CommunicationChannel struct {
 
 func (t *CommunicationChannel) HasErrors() error {
 	var s []string
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	s = []string{"email", "push", "sms", "twitter"}
 	if t.Type != "" && !string_utils.Include(s, t.Type) {
-		errs = append(errs, fmt.Sprintf("expected 'Type' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "Type", Rule: canvasapi.RuleOneOf, Allowed: s})
 	}
 	s = []string{"unconfirmed", "active"}
 	if t.WorkflowState != "" && !string_utils.Include(s, t.WorkflowState) {
-		errs = append(errs, fmt.Sprintf("expected 'WorkflowState' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "WorkflowState", Rule: canvasapi.RuleOneOf, Allowed: s})
+	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func (t *CommunicationChannel) UnmarshalJSON(data []byte) error {
+	type model CommunicationChannel
+	ids := struct {
+		*model
+		ID     ID `json:"id"`
+		UserID ID `json:"user_id"`
+	}{model: (*model)(t), ID: ID(t.ID), UserID: ID(t.UserID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
 	}
+	t.ID = int64(ids.ID)
+	t.UserID = int64(ids.UserID)
 	return nil
 }
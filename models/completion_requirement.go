@@ -1,8 +1,7 @@
 package models
 
 import (
-	"fmt"
-
+	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -14,10 +13,13 @@ type CompletionRequirement struct {
 
 func (t *CompletionRequirement) HasErrors() error {
 	var s []string
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	s = []string{"must_view", "must_submit", "must_contribute", "min_score", "must_mark_done"}
 	if t.Type != "" && !string_utils.Include(s, t.Type) {
-		errs = append(errs, fmt.Sprintf("expected 'Type' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "Type", Rule: canvasapi.RuleOneOf, Allowed: s})
+	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
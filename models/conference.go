@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -27,3 +28,18 @@ type Conference struct {
 func (t *Conference) HasErrors() error {
 	return nil
 }
+
+func (t *Conference) UnmarshalJSON(data []byte) error {
+	type model Conference
+	ids := struct {
+		*model
+		ID        ID `json:"id"`
+		ContextID ID `json:"context_id"`
+	}{model: (*model)(t), ID: ID(t.ID), ContextID: ID(t.ContextID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.ContextID = int64(ids.ContextID)
+	return nil
+}
@@ -1,16 +1,17 @@
 package models
 
 import (
-	"fmt"
+	"encoding/json"
 	"time"
 
+	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
 )
 
 type ContentExport struct {
 	ID            int64     `json:"id" url:"id,omitempty"`                         // the unique identifier for the export.Example: 101
 	CreatedAt     time.Time `json:"created_at" url:"created_at,omitempty"`         // the date and time this export was requested.Example: 2014-01-01T00:00:00Z
-	ExportType    string    `json:"export_type" url:"export_type,omitempty"`       // the type of content migration: 'common_cartridge' or 'qti'.Example: common_cartridge
+	ExportType    string    `json:"export_type" url:"export_type,omitempty"`       // the type of content migration: 'common_cartridge', 'qti' or 'zip'.Example: common_cartridge
 	Attachment    *File     `json:"attachment" url:"attachment,omitempty"`         // attachment api object for the export package (not present before the export completes or after it becomes unavailable for download.).Example: https://example.com/api/v1/attachments/789?download_frd=1&verifier=bG9sY2F0cyEh
 	ProgressUrl   string    `json:"progress_url" url:"progress_url,omitempty"`     // The api endpoint for polling the current progress.Example: https://example.com/api/v1/progress/4
 	UserID        int64     `json:"user_id" url:"user_id,omitempty"`               // The ID of the user who started the export.Example: 4
@@ -19,14 +20,32 @@ type ContentExport struct {
 
 func (t *ContentExport) HasErrors() error {
 	var s []string
-	errs := []string{}
-	s = []string{"common_cartridge", "qti"}
+	errs := []canvasapi.FieldError{}
+	s = []string{"common_cartridge", "qti", "zip"}
 	if t.ExportType != "" && !string_utils.Include(s, t.ExportType) {
-		errs = append(errs, fmt.Sprintf("expected 'ExportType' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "ExportType", Rule: canvasapi.RuleOneOf, Allowed: s})
 	}
 	s = []string{"created", "exporting", "exported", "failed"}
 	if t.WorkflowState != "" && !string_utils.Include(s, t.WorkflowState) {
-		errs = append(errs, fmt.Sprintf("expected 'WorkflowState' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "WorkflowState", Rule: canvasapi.RuleOneOf, Allowed: s})
 	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func (t *ContentExport) UnmarshalJSON(data []byte) error {
+	type model ContentExport
+	ids := struct {
+		*model
+		ID     ID `json:"id"`
+		UserID ID `json:"user_id"`
+	}{model: (*model)(t), ID: ID(t.ID), UserID: ID(t.UserID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.UserID = int64(ids.UserID)
 	return nil
 }
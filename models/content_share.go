@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -21,3 +22,18 @@ type ContentShare struct {
 func (t *ContentShare) HasErrors() error {
 	return nil
 }
+
+func (t *ContentShare) UnmarshalJSON(data []byte) error {
+	type model ContentShare
+	ids := struct {
+		*model
+		ID     ID `json:"id"`
+		UserID ID `json:"user_id"`
+	}{model: (*model)(t), ID: ID(t.ID), UserID: ID(t.UserID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.UserID = int64(ids.UserID)
+	return nil
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -26,3 +27,16 @@ type Conversation struct {
 func (t *Conversation) HasErrors() error {
 	return nil
 }
+
+func (t *Conversation) UnmarshalJSON(data []byte) error {
+	type model Conversation
+	ids := struct {
+		*model
+		ID ID `json:"id"`
+	}{model: (*model)(t), ID: ID(t.ID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	return nil
+}
@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type ConversationParticipant struct {
 	ID        int64  `json:"id" url:"id,omitempty"`                 // The user ID for the participant..Example: 2
 	Name      string `json:"name" url:"name,omitempty"`             // A short name the user has selected, for use in conversations or other less formal places through the site..Example: Shelly
@@ -10,3 +14,16 @@ type ConversationParticipant struct {
 func (t *ConversationParticipant) HasErrors() error {
 	return nil
 }
+
+func (t *ConversationParticipant) UnmarshalJSON(data []byte) error {
+	type model ConversationParticipant
+	ids := struct {
+		*model
+		ID ID `json:"id"`
+	}{model: (*model)(t), ID: ID(t.ID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	return nil
+}
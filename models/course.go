@@ -1,9 +1,10 @@
 package models
 
 import (
-	"fmt"
+	"encoding/json"
 	"time"
 
+	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -62,14 +63,40 @@ type Course struct {
 
 func (t *Course) HasErrors() error {
 	var s []string
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	s = []string{"unpublished", "available", "completed", "deleted"}
 	if t.WorkflowState != "" && !string_utils.Include(s, t.WorkflowState) {
-		errs = append(errs, fmt.Sprintf("expected 'WorkflowState' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "WorkflowState", Rule: canvasapi.RuleOneOf, Allowed: s})
 	}
 	s = []string{"feed", "wiki", "modules", "syllabus", "assignments"}
 	if t.DefaultView != "" && !string_utils.Include(s, t.DefaultView) {
-		errs = append(errs, fmt.Sprintf("expected 'DefaultView' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "DefaultView", Rule: canvasapi.RuleOneOf, Allowed: s})
 	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func (t *Course) UnmarshalJSON(data []byte) error {
+	type model Course
+	ids := struct {
+		*model
+		ID                ID `json:"id"`
+		SISImportID       ID `json:"sis_import_id"`
+		AccountID         ID `json:"account_id"`
+		RootAccountID     ID `json:"root_account_id"`
+		EnrollmentTermID  ID `json:"enrollment_term_id"`
+		GradingStandardID ID `json:"grading_standard_id"`
+	}{model: (*model)(t), ID: ID(t.ID), SISImportID: ID(t.SISImportID), AccountID: ID(t.AccountID), RootAccountID: ID(t.RootAccountID), EnrollmentTermID: ID(t.EnrollmentTermID), GradingStandardID: ID(t.GradingStandardID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.SISImportID = int64(ids.SISImportID)
+	t.AccountID = int64(ids.AccountID)
+	t.RootAccountID = int64(ids.RootAccountID)
+	t.EnrollmentTermID = int64(ids.EnrollmentTermID)
+	t.GradingStandardID = int64(ids.GradingStandardID)
 	return nil
 }
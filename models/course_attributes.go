@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type CourseAttributes struct {
 	ID            int64  `json:"id" url:"id,omitempty"`                         // The unique Canvas identifier for the origin course.Example: 7
 	Name          string `json:"name" url:"name,omitempty"`                     // The name of the origin course..Example: Section A
@@ -10,3 +14,16 @@ type CourseAttributes struct {
 func (t *CourseAttributes) HasErrors() error {
 	return nil
 }
+
+func (t *CourseAttributes) UnmarshalJSON(data []byte) error {
+	type model CourseAttributes
+	ids := struct {
+		*model
+		ID ID `json:"id"`
+	}{model: (*model)(t), ID: ID(t.ID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	return nil
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type CourseCopyStatus struct {
+	ID            int64     `json:"id" url:"id,omitempty"`                         // The ID of the course copy..Example: 1
+	Progress      int64     `json:"progress" url:"progress,omitempty"`             // The progress of the copy, in percent..Example: 100
+	WorkflowState string    `json:"workflow_state" url:"workflow_state,omitempty"` // The state of the copy, one of created, started, completed, failed..Example: completed
+	StatusUrl     string    `json:"status_url" url:"status_url,omitempty"`         // The API URL to poll for the status of the copy..Example: /api/v1/courses/9457/course_copy/12
+	CreatedAt     time.Time `json:"created_at" url:"created_at,omitempty"`         // The time the copy was started..Example: 2012-07-01T23:59:00-06:00
+}
+
+func (t *CourseCopyStatus) HasErrors() error {
+	return nil
+}
+
+func (t *CourseCopyStatus) UnmarshalJSON(data []byte) error {
+	type model CourseCopyStatus
+	ids := struct {
+		*model
+		ID ID `json:"id"`
+	}{model: (*model)(t), ID: ID(t.ID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	return nil
+}
@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type CourseEpubExport struct {
 	ID         int64       `json:"id" url:"id,omitempty"`                   // the unique identifier for the course.Example: 101
 	Name       string      `json:"name" url:"name,omitempty"`               // the name for the course.Example: Maths 101
@@ -9,3 +13,16 @@ type CourseEpubExport struct {
 func (t *CourseEpubExport) HasErrors() error {
 	return nil
 }
+
+func (t *CourseEpubExport) UnmarshalJSON(data []byte) error {
+	type model CourseEpubExport
+	ids := struct {
+		*model
+		ID ID `json:"id"`
+	}{model: (*model)(t), ID: ID(t.ID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	return nil
+}
@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type CourseNickname struct {
 	CourseID int64  `json:"course_id" url:"course_id,omitempty"` // the ID of the course.Example: 88
 	Name     string `json:"name" url:"name,omitempty"`           // the actual name of the course.Example: S1048576 DPMS1200 Intro to Newtonian Mechanics
@@ -9,3 +13,16 @@ type CourseNickname struct {
 func (t *CourseNickname) HasErrors() error {
 	return nil
 }
+
+func (t *CourseNickname) UnmarshalJSON(data []byte) error {
+	type model CourseNickname
+	ids := struct {
+		*model
+		CourseID ID `json:"course_id"`
+	}{model: (*model)(t), CourseID: ID(t.CourseID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.CourseID = int64(ids.CourseID)
+	return nil
+}
@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type CourseQuizExtension struct {
 	UserID           int64  `json:"user_id" url:"user_id,omitempty"`                     // The ID of the Student that needs the quiz extension..Example: 3
 	ExtraAttempts    int64  `json:"extra_attempts" url:"extra_attempts,omitempty"`       // Number of times the student is allowed to re-take the quiz over the multiple-attempt limit..Example: 1
@@ -11,3 +15,16 @@ type CourseQuizExtension struct {
 func (t *CourseQuizExtension) HasErrors() error {
 	return nil
 }
+
+func (t *CourseQuizExtension) UnmarshalJSON(data []byte) error {
+	type model CourseQuizExtension
+	ids := struct {
+		*model
+		UserID ID `json:"user_id"`
+	}{model: (*model)(t), UserID: ID(t.UserID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.UserID = int64(ids.UserID)
+	return nil
+}
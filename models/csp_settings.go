@@ -0,0 +1,15 @@
+package models
+
+// CSPSettings are the Content Security Policy settings of an account or course.
+type CSPSettings struct {
+	Enabled                 bool                     `json:"enabled" url:"enabled,omitempty"`                                     // Whether the content security policy is enabled..Example: true
+	Inherited               bool                     `json:"inherited" url:"inherited,omitempty"`                                 // Whether the setting is inherited from a parent account..Example: false
+	SettingsLocked          bool                     `json:"settings_locked" url:"settings_locked,omitempty"`                     // Whether sub-accounts and courses are prevented from changing the setting..Example: false
+	EffectiveWhitelist      []string                 `json:"effective_whitelist" url:"effective_whitelist,omitempty"`             // The domains that are allowed, including the domains of external tools..Example: example.com
+	ToolsWhitelist          map[string](interface{}) `json:"tools_whitelist" url:"tools_whitelist,omitempty"`                     // The domains allowed because of external tools..
+	CurrentAccountWhitelist []string                 `json:"current_account_whitelist" url:"current_account_whitelist,omitempty"` // The domains allowed on the account..Example: example.com
+}
+
+func (t *CSPSettings) HasErrors() error {
+	return nil
+}
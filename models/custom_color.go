@@ -0,0 +1,9 @@
+package models
+
+type CustomColor struct {
+	Hexcode string `json:"hexcode" url:"hexcode,omitempty"` // The hex code of the color..Example: #abc123
+}
+
+func (t *CustomColor) HasErrors() error {
+	return nil
+}
@@ -0,0 +1,9 @@
+package models
+
+type CustomColors struct {
+	CustomColors map[string]string `json:"custom_colors" url:"custom_colors,omitempty"` // The colors of the user, keyed by asset string..Example: #abc123
+}
+
+func (t *CustomColors) HasErrors() error {
+	return nil
+}
@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type CustomColumn struct {
 	ID           int64  `json:"id" url:"id,omitempty"`                       // The ID of the custom gradebook column.Example: 2
 	TeacherNotes bool   `json:"teacher_notes" url:"teacher_notes,omitempty"` // When true, this column's visibility will be toggled in the Gradebook when a user selects to show or hide notes.
@@ -12,3 +16,16 @@ type CustomColumn struct {
 func (t *CustomColumn) HasErrors() error {
 	return nil
 }
+
+func (t *CustomColumn) UnmarshalJSON(data []byte) error {
+	type model CustomColumn
+	ids := struct {
+		*model
+		ID ID `json:"id"`
+	}{model: (*model)(t), ID: ID(t.ID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	return nil
+}
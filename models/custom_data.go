@@ -0,0 +1,13 @@
+package models
+
+import (
+	"encoding/json"
+)
+
+type CustomData struct {
+	Data json.RawMessage `json:"data" url:"data,omitempty"` // The JSON data stored at the requested scope..
+}
+
+func (t *CustomData) HasErrors() error {
+	return nil
+}
@@ -0,0 +1,9 @@
+package models
+
+type DashboardPositions struct {
+	DashboardPositions map[string]int64 `json:"dashboard_positions" url:"dashboard_positions,omitempty"` // The position of each card on the dashboard, keyed by asset string..Example: 3
+}
+
+func (t *DashboardPositions) HasErrors() error {
+	return nil
+}
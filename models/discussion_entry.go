@@ -0,0 +1,47 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type DiscussionEntry struct {
+	ID              int64              `json:"id" url:"id,omitempty"`                               // The ID of the entry..Example: 1019
+	UserID          int64              `json:"user_id" url:"user_id,omitempty"`                     // The ID of the user that posted the entry..Example: 7086
+	EditorID        int64              `json:"editor_id" url:"editor_id,omitempty"`                 // (Optional) The ID of the user that last edited the entry..Example: 1
+	UserName        string             `json:"user_name" url:"user_name,omitempty"`                 // The name of the user that posted the entry..Example: nobody@example.com
+	Message         string             `json:"message" url:"message,omitempty"`                     // The content of the entry, an HTML fragment..Example: Newer entry
+	ReadState       string             `json:"read_state" url:"read_state,omitempty"`               // The read state of the entry, read or unread..Example: read
+	ForcedReadState bool               `json:"forced_read_state" url:"forced_read_state,omitempty"` // Whether the read state was set explicitly..Example: false
+	ParentID        int64              `json:"parent_id" url:"parent_id,omitempty"`                 // (Optional) The ID of the parent entry for replies..Example: 1016
+	RatingCount     int64              `json:"rating_count" url:"rating_count,omitempty"`           // (Optional) The number of ratings of the entry..Example: 1
+	RatingSum       int64              `json:"rating_sum" url:"rating_sum,omitempty"`               // (Optional) The sum of the ratings of the entry..Example: 1
+	Attachment      *File              `json:"attachment" url:"attachment,omitempty"`               // (Optional) The file attached to the entry..
+	RecentReplies   []*DiscussionEntry `json:"recent_replies" url:"recent_replies,omitempty"`       // (Optional) The most recent replies to the entry..
+	HasMoreReplies  bool               `json:"has_more_replies" url:"has_more_replies,omitempty"`   // (Optional) Whether there are more replies than recent_replies..Example: false
+	CreatedAt       time.Time          `json:"created_at" url:"created_at,omitempty"`               // The time the entry was created..Example: 2011-11-03T21:33:29Z
+	UpdatedAt       time.Time          `json:"updated_at" url:"updated_at,omitempty"`               // The time the entry was last updated..Example: 2011-11-03T21:33:29Z
+}
+
+func (t *DiscussionEntry) HasErrors() error {
+	return nil
+}
+
+func (t *DiscussionEntry) UnmarshalJSON(data []byte) error {
+	type model DiscussionEntry
+	ids := struct {
+		*model
+		ID       ID `json:"id"`
+		UserID   ID `json:"user_id"`
+		EditorID ID `json:"editor_id"`
+		ParentID ID `json:"parent_id"`
+	}{model: (*model)(t), ID: ID(t.ID), UserID: ID(t.UserID), EditorID: ID(t.EditorID), ParentID: ID(t.ParentID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.UserID = int64(ids.UserID)
+	t.EditorID = int64(ids.EditorID)
+	t.ParentID = int64(ids.ParentID)
+	return nil
+}
@@ -1,9 +1,10 @@
 package models
 
 import (
-	"fmt"
+	"encoding/json"
 	"time"
 
+	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -46,18 +47,40 @@ type DiscussionTopic struct {
 
 func (t *DiscussionTopic) HasErrors() error {
 	var s []string
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	s = []string{"read", "unread"}
 	if t.ReadState != "" && !string_utils.Include(s, t.ReadState) {
-		errs = append(errs, fmt.Sprintf("expected 'ReadState' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "ReadState", Rule: canvasapi.RuleOneOf, Allowed: s})
 	}
 	s = []string{"initial_post_required", "not_in_group_set", "not_in_group", "topic_is_announcement"}
 	if t.SubscriptionHold != "" && !string_utils.Include(s, t.SubscriptionHold) {
-		errs = append(errs, fmt.Sprintf("expected 'SubscriptionHold' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "SubscriptionHold", Rule: canvasapi.RuleOneOf, Allowed: s})
 	}
 	s = []string{"side_comment", "threaded"}
 	if t.DiscussionType != "" && !string_utils.Include(s, t.DiscussionType) {
-		errs = append(errs, fmt.Sprintf("expected 'DiscussionType' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "DiscussionType", Rule: canvasapi.RuleOneOf, Allowed: s})
 	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func (t *DiscussionTopic) UnmarshalJSON(data []byte) error {
+	type model DiscussionTopic
+	ids := struct {
+		*model
+		ID              ID `json:"id"`
+		AssignmentID    ID `json:"assignment_id"`
+		RootTopicID     ID `json:"root_topic_id"`
+		GroupCategoryID ID `json:"group_category_id"`
+	}{model: (*model)(t), ID: ID(t.ID), AssignmentID: ID(t.AssignmentID), RootTopicID: ID(t.RootTopicID), GroupCategoryID: ID(t.GroupCategoryID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.AssignmentID = int64(ids.AssignmentID)
+	t.RootTopicID = int64(ids.RootTopicID)
+	t.GroupCategoryID = int64(ids.GroupCategoryID)
 	return nil
 }
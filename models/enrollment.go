@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -54,3 +55,32 @@ type Enrollment struct {
 func (t *Enrollment) HasErrors() error {
 	return nil
 }
+
+func (t *Enrollment) UnmarshalJSON(data []byte) error {
+	type model Enrollment
+	ids := struct {
+		*model
+		ID                     ID `json:"id"`
+		CourseID               ID `json:"course_id"`
+		CourseSectionID        ID `json:"course_section_id"`
+		SISImportID            ID `json:"sis_import_id"`
+		RootAccountID          ID `json:"root_account_id"`
+		UserID                 ID `json:"user_id"`
+		AssociatedUserID       ID `json:"associated_user_id"`
+		RoleID                 ID `json:"role_id"`
+		CurrentGradingPeriodID ID `json:"current_grading_period_id"`
+	}{model: (*model)(t), ID: ID(t.ID), CourseID: ID(t.CourseID), CourseSectionID: ID(t.CourseSectionID), SISImportID: ID(t.SISImportID), RootAccountID: ID(t.RootAccountID), UserID: ID(t.UserID), AssociatedUserID: ID(t.AssociatedUserID), RoleID: ID(t.RoleID), CurrentGradingPeriodID: ID(t.CurrentGradingPeriodID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.CourseID = int64(ids.CourseID)
+	t.CourseSectionID = int64(ids.CourseSectionID)
+	t.SISImportID = int64(ids.SISImportID)
+	t.RootAccountID = int64(ids.RootAccountID)
+	t.UserID = int64(ids.UserID)
+	t.AssociatedUserID = int64(ids.AssociatedUserID)
+	t.RoleID = int64(ids.RoleID)
+	t.CurrentGradingPeriodID = int64(ids.CurrentGradingPeriodID)
+	return nil
+}
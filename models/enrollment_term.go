@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -18,3 +19,18 @@ type EnrollmentTerm struct {
 func (t *EnrollmentTerm) HasErrors() error {
 	return nil
 }
+
+func (t *EnrollmentTerm) UnmarshalJSON(data []byte) error {
+	type model EnrollmentTerm
+	ids := struct {
+		*model
+		ID          ID `json:"id"`
+		SISImportID ID `json:"sis_import_id"`
+	}{model: (*model)(t), ID: ID(t.ID), SISImportID: ID(t.SISImportID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.SISImportID = int64(ids.SISImportID)
+	return nil
+}
@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type ExceptionRecord struct {
 	CourseID           int64    `json:"course_id" url:"course_id,omitempty"`                     // The ID of the associated course.Example: 101
 	ConflictingChanges []string `json:"conflicting_changes" url:"conflicting_changes,omitempty"` // A list of change classes in the associated course's copy of the item that prevented a blueprint change from being applied. One or more of ['content', 'points', 'due_dates', 'availability_dates']..Example: points
@@ -8,3 +12,16 @@ type ExceptionRecord struct {
 func (t *ExceptionRecord) HasErrors() error {
 	return nil
 }
+
+func (t *ExceptionRecord) UnmarshalJSON(data []byte) error {
+	type model ExceptionRecord
+	ids := struct {
+		*model
+		CourseID ID `json:"course_id"`
+	}{model: (*model)(t), CourseID: ID(t.CourseID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.CourseID = int64(ids.CourseID)
+	return nil
+}
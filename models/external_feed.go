@@ -1,9 +1,10 @@
 package models
 
 import (
-	"fmt"
+	"encoding/json"
 	"time"
 
+	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -18,10 +19,26 @@ type ExternalFeed struct {
 
 func (t *ExternalFeed) HasErrors() error {
 	var s []string
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	s = []string{"link_only", "truncate", "full"}
 	if t.Verbosity != "" && !string_utils.Include(s, t.Verbosity) {
-		errs = append(errs, fmt.Sprintf("expected 'Verbosity' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "Verbosity", Rule: canvasapi.RuleOneOf, Allowed: s})
 	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func (t *ExternalFeed) UnmarshalJSON(data []byte) error {
+	type model ExternalFeed
+	ids := struct {
+		*model
+		ID ID `json:"id"`
+	}{model: (*model)(t), ID: ID(t.ID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
 	return nil
 }
@@ -0,0 +1,50 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type ExternalTool struct {
+	ID                 int64                    `json:"id" url:"id,omitempty"`                                   // The ID of the external tool..Example: 1
+	Name               string                   `json:"name" url:"name,omitempty"`                               // The name of the external tool..Example: LTI Tool
+	Description        string                   `json:"description" url:"description,omitempty"`                 // The description of the external tool..Example: This is a super cool LTI tool
+	Url                string                   `json:"url" url:"url,omitempty"`                                 // The launch url of the external tool..Example: http://instructure.com
+	Domain             string                   `json:"domain" url:"domain,omitempty"`                           // The domain the external tool matches..Example: instructure.com
+	ConsumerKey        string                   `json:"consumer_key" url:"consumer_key,omitempty"`               // The consumer key of the external tool..Example: key
+	PrivacyLevel       string                   `json:"privacy_level" url:"privacy_level,omitempty"`             // How much user information is sent to the tool, one of anonymous, name_only, email_only, public..Example: anonymous
+	CustomFields       map[string]string        `json:"custom_fields" url:"custom_fields,omitempty"`             // Custom fields sent to the tool on launch..
+	WorkflowState      string                   `json:"workflow_state" url:"workflow_state,omitempty"`           // The state of the external tool..Example: public
+	IsRceFavorite      bool                     `json:"is_rce_favorite" url:"is_rce_favorite,omitempty"`         // Whether the tool is a favorite in the rich content editor..Example: false
+	NotSelectable      bool                     `json:"not_selectable" url:"not_selectable,omitempty"`           // Whether the tool is hidden from assignment and module selection..Example: false
+	DeploymentID       string                   `json:"deployment_id" url:"deployment_id,omitempty"`             // The LTI 1.3 deployment ID..Example: 1:f6a7a0e4b4d9cb8bbd2f6b2d3f2c1e45a4b6a0e2
+	Version            string                   `json:"version" url:"version,omitempty"`                         // The LTI version of the tool, 1.1 or 1.3..Example: 1.3
+	AccountNavigation  map[string](interface{}) `json:"account_navigation" url:"account_navigation,omitempty"`   // The account navigation placement settings..
+	CourseNavigation   map[string](interface{}) `json:"course_navigation" url:"course_navigation,omitempty"`     // The course navigation placement settings..
+	UserNavigation     map[string](interface{}) `json:"user_navigation" url:"user_navigation,omitempty"`         // The user navigation placement settings..
+	EditorButton       map[string](interface{}) `json:"editor_button" url:"editor_button,omitempty"`             // The editor button placement settings..
+	HomeworkSubmission map[string](interface{}) `json:"homework_submission" url:"homework_submission,omitempty"` // The homework submission placement settings..
+	LinkSelection      map[string](interface{}) `json:"link_selection" url:"link_selection,omitempty"`           // The link selection placement settings..
+	MigrationSelection map[string](interface{}) `json:"migration_selection" url:"migration_selection,omitempty"` // The migration selection placement settings..
+	ResourceSelection  map[string](interface{}) `json:"resource_selection" url:"resource_selection,omitempty"`   // The resource selection placement settings..
+	ToolConfiguration  map[string](interface{}) `json:"tool_configuration" url:"tool_configuration,omitempty"`   // The tool configuration placement settings..
+	CreatedAt          time.Time                `json:"created_at" url:"created_at,omitempty"`                   // The time the external tool was created..Example: 2037-07-21T13:29:31Z
+	UpdatedAt          time.Time                `json:"updated_at" url:"updated_at,omitempty"`                   // The time the external tool was last updated..Example: 2037-07-28T19:38:31Z
+}
+
+func (t *ExternalTool) HasErrors() error {
+	return nil
+}
+
+func (t *ExternalTool) UnmarshalJSON(data []byte) error {
+	type model ExternalTool
+	ids := struct {
+		*model
+		ID ID `json:"id"`
+	}{model: (*model)(t), ID: ID(t.ID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	return nil
+}
@@ -1,8 +1,8 @@
 package models
 
 import (
-	"fmt"
-
+	"encoding/json"
+	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -13,10 +13,26 @@ type Favorite struct {
 
 func (t *Favorite) HasErrors() error {
 	var s []string
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	s = []string{"Course"}
 	if t.ContextType != "" && !string_utils.Include(s, t.ContextType) {
-		errs = append(errs, fmt.Sprintf("expected 'ContextType' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "ContextType", Rule: canvasapi.RuleOneOf, Allowed: s})
+	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func (t *Favorite) UnmarshalJSON(data []byte) error {
+	type model Favorite
+	ids := struct {
+		*model
+		ContextID ID `json:"context_id"`
+	}{model: (*model)(t), ContextID: ID(t.ContextID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
 	}
+	t.ContextID = int64(ids.ContextID)
 	return nil
 }
@@ -1,9 +1,9 @@
 package models
 
 import (
-	"fmt"
 	"time"
 
+	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -26,10 +26,13 @@ type Feature struct {
 
 func (t *Feature) HasErrors() error {
 	var s []string
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	s = []string{"Course", "RootAccount", "Account", "User"}
 	if t.AppliesTo != "" && !string_utils.Include(s, t.AppliesTo) {
-		errs = append(errs, fmt.Sprintf("expected 'AppliesTo' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "AppliesTo", Rule: canvasapi.RuleOneOf, Allowed: s})
+	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
@@ -1,8 +1,8 @@
 package models
 
 import (
-	"fmt"
-
+	"encoding/json"
+	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -16,14 +16,30 @@ type FeatureFlag struct {
 
 func (t *FeatureFlag) HasErrors() error {
 	var s []string
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	s = []string{"Course", "Account", "User"}
 	if t.ContextType != "" && !string_utils.Include(s, t.ContextType) {
-		errs = append(errs, fmt.Sprintf("expected 'ContextType' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "ContextType", Rule: canvasapi.RuleOneOf, Allowed: s})
 	}
 	s = []string{"off", "allowed", "allowed_on", "on"}
 	if t.State != "" && !string_utils.Include(s, t.State) {
-		errs = append(errs, fmt.Sprintf("expected 'State' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "State", Rule: canvasapi.RuleOneOf, Allowed: s})
+	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func (t *FeatureFlag) UnmarshalJSON(data []byte) error {
+	type model FeatureFlag
+	ids := struct {
+		*model
+		ContextID ID `json:"context_id"`
+	}{model: (*model)(t), ContextID: ID(t.ContextID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
 	}
+	t.ContextID = int64(ids.ContextID)
 	return nil
 }
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -17,3 +18,16 @@ type File struct {
 func (t *File) HasErrors() error {
 	return nil
 }
+
+func (t *File) UnmarshalJSON(data []byte) error {
+	type model File
+	ids := struct {
+		*model
+		ID ID `json:"id"`
+	}{model: (*model)(t), ID: ID(t.ID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	return nil
+}
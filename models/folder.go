@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -30,3 +31,20 @@ type Folder struct {
 func (t *Folder) HasErrors() error {
 	return nil
 }
+
+func (t *Folder) UnmarshalJSON(data []byte) error {
+	type model Folder
+	ids := struct {
+		*model
+		ContextID      ID `json:"context_id"`
+		ID             ID `json:"id"`
+		ParentFolderID ID `json:"parent_folder_id"`
+	}{model: (*model)(t), ContextID: ID(t.ContextID), ID: ID(t.ID), ParentFolderID: ID(t.ParentFolderID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ContextID = int64(ids.ContextID)
+	t.ID = int64(ids.ID)
+	t.ParentFolderID = int64(ids.ParentFolderID)
+	return nil
+}
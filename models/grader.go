@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type Grader struct {
 	ID          int64    `json:"id" url:"id,omitempty"`                   // the user_id of the user who graded the contained submissions.Example: 27
 	Name        string   `json:"name" url:"name,omitempty"`               // the name of the user who graded the contained submissions.Example: Some User
@@ -9,3 +13,16 @@ type Grader struct {
 func (t *Grader) HasErrors() error {
 	return nil
 }
+
+func (t *Grader) UnmarshalJSON(data []byte) error {
+	type model Grader
+	ids := struct {
+		*model
+		ID ID `json:"id"`
+	}{model: (*model)(t), ID: ID(t.ID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	return nil
+}
@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type GradingPeriod struct {
 	ID        int64  `json:"id" url:"id,omitempty"`                 // The unique identifier for the grading period..Example: 1023
 	Title     string `json:"title" url:"title,omitempty"`           // The title for the grading period..Example: First Block
@@ -13,3 +17,16 @@ type GradingPeriod struct {
 func (t *GradingPeriod) HasErrors() error {
 	return nil
 }
+
+func (t *GradingPeriod) UnmarshalJSON(data []byte) error {
+	type model GradingPeriod
+	ids := struct {
+		*model
+		ID ID `json:"id"`
+	}{model: (*model)(t), ID: ID(t.ID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	return nil
+}
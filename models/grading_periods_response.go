@@ -0,0 +1,9 @@
+package models
+
+type GradingPeriodsResponse struct {
+	GradingPeriods []*GradingPeriod `json:"grading_periods" url:"grading_periods,omitempty"` // The grading periods..
+}
+
+func (t *GradingPeriodsResponse) HasErrors() error {
+	return nil
+}
@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type GradingStandard struct {
 	Title         string                `json:"title" url:"title,omitempty"`                   // the title of the grading standard.Example: Account Standard
 	ID            int64                 `json:"id" url:"id,omitempty"`                         // the id of the grading standard.Example: 1
@@ -11,3 +15,18 @@ type GradingStandard struct {
 func (t *GradingStandard) HasErrors() error {
 	return nil
 }
+
+func (t *GradingStandard) UnmarshalJSON(data []byte) error {
+	type model GradingStandard
+	ids := struct {
+		*model
+		ID        ID `json:"id"`
+		ContextID ID `json:"context_id"`
+	}{model: (*model)(t), ID: ID(t.ID), ContextID: ID(t.ContextID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.ContextID = int64(ids.ContextID)
+	return nil
+}
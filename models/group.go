@@ -1,8 +1,8 @@
 package models
 
 import (
-	"fmt"
-
+	"encoding/json"
+	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -28,14 +28,36 @@ type Group struct {
 
 func (t *Group) HasErrors() error {
 	var s []string
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	s = []string{"parent_context_auto_join", "parent_context_request", "invitation_only"}
 	if t.JoinLevel != "" && !string_utils.Include(s, t.JoinLevel) {
-		errs = append(errs, fmt.Sprintf("expected 'JoinLevel' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "JoinLevel", Rule: canvasapi.RuleOneOf, Allowed: s})
 	}
 	s = []string{"communities", "student_organized", "imported"}
 	if t.Role != "" && !string_utils.Include(s, t.Role) {
-		errs = append(errs, fmt.Sprintf("expected 'Role' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "Role", Rule: canvasapi.RuleOneOf, Allowed: s})
+	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func (t *Group) UnmarshalJSON(data []byte) error {
+	type model Group
+	ids := struct {
+		*model
+		ID              ID `json:"id"`
+		CourseID        ID `json:"course_id"`
+		GroupCategoryID ID `json:"group_category_id"`
+		SISImportID     ID `json:"sis_import_id"`
+	}{model: (*model)(t), ID: ID(t.ID), CourseID: ID(t.CourseID), GroupCategoryID: ID(t.GroupCategoryID), SISImportID: ID(t.SISImportID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
 	}
+	t.ID = int64(ids.ID)
+	t.CourseID = int64(ids.CourseID)
+	t.GroupCategoryID = int64(ids.GroupCategoryID)
+	t.SISImportID = int64(ids.SISImportID)
 	return nil
 }
@@ -1,8 +1,8 @@
 package models
 
 import (
-	"fmt"
-
+	"encoding/json"
+	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -22,14 +22,34 @@ type GroupCategory struct {
 
 func (t *GroupCategory) HasErrors() error {
 	var s []string
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	s = []string{"restricted", "enabled"}
 	if t.SelfSignup != "" && !string_utils.Include(s, t.SelfSignup) {
-		errs = append(errs, fmt.Sprintf("expected 'SelfSignup' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "SelfSignup", Rule: canvasapi.RuleOneOf, Allowed: s})
 	}
 	s = []string{"first", "random"}
 	if t.AutoLeader != "" && !string_utils.Include(s, t.AutoLeader) {
-		errs = append(errs, fmt.Sprintf("expected 'AutoLeader' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "AutoLeader", Rule: canvasapi.RuleOneOf, Allowed: s})
+	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func (t *GroupCategory) UnmarshalJSON(data []byte) error {
+	type model GroupCategory
+	ids := struct {
+		*model
+		ID          ID `json:"id"`
+		AccountID   ID `json:"account_id"`
+		SISImportID ID `json:"sis_import_id"`
+	}{model: (*model)(t), ID: ID(t.ID), AccountID: ID(t.AccountID), SISImportID: ID(t.SISImportID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
 	}
+	t.ID = int64(ids.ID)
+	t.AccountID = int64(ids.AccountID)
+	t.SISImportID = int64(ids.SISImportID)
 	return nil
 }
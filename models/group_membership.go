@@ -1,8 +1,8 @@
 package models
 
 import (
-	"fmt"
-
+	"encoding/json"
+	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -18,10 +18,32 @@ type GroupMembership struct {
 
 func (t *GroupMembership) HasErrors() error {
 	var s []string
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	s = []string{"accepted", "invited", "requested"}
 	if t.WorkflowState != "" && !string_utils.Include(s, t.WorkflowState) {
-		errs = append(errs, fmt.Sprintf("expected 'WorkflowState' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "WorkflowState", Rule: canvasapi.RuleOneOf, Allowed: s})
+	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func (t *GroupMembership) UnmarshalJSON(data []byte) error {
+	type model GroupMembership
+	ids := struct {
+		*model
+		ID          ID `json:"id"`
+		GroupID     ID `json:"group_id"`
+		UserID      ID `json:"user_id"`
+		SISImportID ID `json:"sis_import_id"`
+	}{model: (*model)(t), ID: ID(t.ID), GroupID: ID(t.GroupID), UserID: ID(t.UserID), SISImportID: ID(t.SISImportID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
 	}
+	t.ID = int64(ids.ID)
+	t.GroupID = int64(ids.GroupID)
+	t.UserID = int64(ids.UserID)
+	t.SISImportID = int64(ids.SISImportID)
 	return nil
 }
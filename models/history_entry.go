@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -20,3 +21,16 @@ type HistoryEntry struct {
 func (t *HistoryEntry) HasErrors() error {
 	return nil
 }
+
+func (t *HistoryEntry) UnmarshalJSON(data []byte) error {
+	type model HistoryEntry
+	ids := struct {
+		*model
+		ContextID ID `json:"context_id"`
+	}{model: (*model)(t), ContextID: ID(t.ContextID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ContextID = int64(ids.ContextID)
+	return nil
+}
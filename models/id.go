@@ -0,0 +1,70 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// ID is the id of a Canvas object. It decodes from a JSON number and from a JSON string,
+// which is how Canvas sends ids when asked for string ids, and holds every 64 bit id
+// without the loss of precision a float64 would cause. Model id fields stay int64 and are
+// decoded through ID, so they accept both forms as well.
+type ID int64
+
+// Int64 returns the id as an int64.
+func (id ID) Int64() int64 {
+	return int64(id)
+}
+
+func (id ID) String() string {
+	return strconv.FormatInt(int64(id), 10)
+}
+
+// UnmarshalJSON accepts a number, a string holding a number, an empty string or null.
+func (id *ID) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		data = []byte(s)
+		if len(data) == 0 {
+			*id = 0
+			return nil
+		}
+	}
+	value, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid Canvas id %s", data)
+	}
+	*id = ID(value)
+	return nil
+}
+
+func idSlice(values []int64) []ID {
+	if values == nil {
+		return nil
+	}
+	ids := make([]ID, len(values))
+	for i, value := range values {
+		ids[i] = ID(value)
+	}
+	return ids
+}
+
+func int64Slice(ids []ID) []int64 {
+	if ids == nil {
+		return nil
+	}
+	values := make([]int64, len(ids))
+	for i, id := range ids {
+		values[i] = int64(id)
+	}
+	return values
+}
@@ -0,0 +1,58 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestIDUnmarshalJSON(t *testing.T) {
+	var course Course
+	body := `{"id":"53000000000000123","account_id":1,"root_account_id":null,"enrollment_term_id":""}`
+	if err := json.Unmarshal([]byte(body), &course); err != nil {
+		t.Fatal(err)
+	}
+	if course.ID != 53000000000000123 || course.AccountID != 1 || course.RootAccountID != 0 || course.EnrollmentTermID != 0 {
+		t.Errorf("unexpected ids %+v", course)
+	}
+
+	encoded, err := json.Marshal(struct {
+		ID ID `json:"id"`
+	}{ID(course.ID)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(encoded) != `{"id":53000000000000123}` {
+		t.Errorf("expected the id to round trip, got %s", encoded)
+	}
+
+	var ids []ID
+	if err := json.Unmarshal([]byte(`[1,"2"]`), &ids); err != nil || len(ids) != 2 || ids[1] != 2 {
+		t.Errorf("expected a mixed list of ids to decode, got %v %v", ids, err)
+	}
+
+	var override AssignmentOverride
+	if err := json.Unmarshal([]byte(`{"id":"7","student_ids":["8",9],"title":"Extra time"}`), &override); err != nil {
+		t.Fatal(err)
+	}
+	if override.ID != 7 || len(override.StudentIDs) != 2 || override.StudentIDs[0] != 8 || override.Title != "Extra time" {
+		t.Errorf("expected the override ids to decode, got %+v", override)
+	}
+
+	links := PageViewLinks{Account: 5}
+	if err := json.Unmarshal([]byte(`{"user":"53000000000000123","context":1234}`), &links); err != nil {
+		t.Fatal(err)
+	}
+	if links.User != 53000000000000123 || links.Context != 1234 || links.Account != 5 {
+		t.Errorf("expected the page view links to decode, got %+v", links)
+	}
+
+	var rollup OutcomeRollupLinks
+	if err := json.Unmarshal([]byte(`{"course":"42","section":57}`), &rollup); err != nil || rollup.Course != 42 || rollup.Section != 57 {
+		t.Errorf("expected the rollup links to decode, got %+v %v", rollup, err)
+	}
+
+	var bad ID
+	if err := json.Unmarshal([]byte(`"sis_course_id:abc"`), &bad); err == nil {
+		t.Errorf("expected a non numeric id to fail")
+	}
+}
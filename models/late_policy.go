@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -21,3 +22,18 @@ type LatePolicy struct {
 func (t *LatePolicy) HasErrors() error {
 	return nil
 }
+
+func (t *LatePolicy) UnmarshalJSON(data []byte) error {
+	type model LatePolicy
+	ids := struct {
+		*model
+		ID       ID `json:"id"`
+		CourseID ID `json:"course_id"`
+	}{model: (*model)(t), ID: ID(t.ID), CourseID: ID(t.CourseID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.CourseID = int64(ids.CourseID)
+	return nil
+}
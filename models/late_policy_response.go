@@ -0,0 +1,9 @@
+package models
+
+type LatePolicyResponse struct {
+	LatePolicy *LatePolicy `json:"late_policy" url:"late_policy,omitempty"` // The late policy..
+}
+
+func (t *LatePolicyResponse) HasErrors() error {
+	return nil
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type Login struct {
+	ID                         int64     `json:"id" url:"id,omitempty"`                                                     // The ID of the login..Example: 2
+	UserID                     int64     `json:"user_id" url:"user_id,omitempty"`                                           // The ID of the user the login belongs to..Example: 1
+	AccountID                  int64     `json:"account_id" url:"account_id,omitempty"`                                     // The ID of the account the login belongs to..Example: 1
+	UniqueID                   string    `json:"unique_id" url:"unique_id,omitempty"`                                       // The unique id of the login, used to sign in..Example: belieber@example.com
+	SISUserID                  string    `json:"sis_user_id" url:"sis_user_id,omitempty"`                                   // The SIS user ID of the login..Example: 2
+	IntegrationID              string    `json:"integration_id" url:"integration_id,omitempty"`                             // The integration ID of the login..Example: abc
+	AuthenticationProviderID   int64     `json:"authentication_provider_id" url:"authentication_provider_id,omitempty"`     // The ID of the authentication provider the login uses..Example: 1
+	AuthenticationProviderType string    `json:"authentication_provider_type" url:"authentication_provider_type,omitempty"` // The type of the authentication provider the login uses..Example: facebook
+	WorkflowState              string    `json:"workflow_state" url:"workflow_state,omitempty"`                             // The state of the login, active or suspended..Example: active
+	CreatedAt                  time.Time `json:"created_at" url:"created_at,omitempty"`                                     // The time the login was created..Example: 2012-07-01T23:59:00-06:00
+}
+
+func (t *Login) HasErrors() error {
+	return nil
+}
+
+func (t *Login) UnmarshalJSON(data []byte) error {
+	type model Login
+	ids := struct {
+		*model
+		ID                       ID `json:"id"`
+		UserID                   ID `json:"user_id"`
+		AccountID                ID `json:"account_id"`
+		AuthenticationProviderID ID `json:"authentication_provider_id"`
+	}{model: (*model)(t), ID: ID(t.ID), UserID: ID(t.UserID), AccountID: ID(t.AccountID), AuthenticationProviderID: ID(t.AuthenticationProviderID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.UserID = int64(ids.UserID)
+	t.AccountID = int64(ids.AccountID)
+	t.AuthenticationProviderID = int64(ids.AuthenticationProviderID)
+	return nil
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -18,3 +19,18 @@ type LtiAssignment struct {
 func (t *LtiAssignment) HasErrors() error {
 	return nil
 }
+
+func (t *LtiAssignment) UnmarshalJSON(data []byte) error {
+	type model LtiAssignment
+	ids := struct {
+		*model
+		ID       ID `json:"id"`
+		CourseID ID `json:"course_id"`
+	}{model: (*model)(t), ID: ID(t.ID), CourseID: ID(t.CourseID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.CourseID = int64(ids.CourseID)
+	return nil
+}
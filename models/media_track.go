@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type MediaTrack struct {
 	ID            int64  `json:"id" url:"id,omitempty"`                           //
 	UserID        int64  `json:"user_id" url:"user_id,omitempty"`                 //
@@ -15,3 +19,20 @@ type MediaTrack struct {
 func (t *MediaTrack) HasErrors() error {
 	return nil
 }
+
+func (t *MediaTrack) UnmarshalJSON(data []byte) error {
+	type model MediaTrack
+	ids := struct {
+		*model
+		ID            ID `json:"id"`
+		UserID        ID `json:"user_id"`
+		MediaObjectID ID `json:"media_object_id"`
+	}{model: (*model)(t), ID: ID(t.ID), UserID: ID(t.UserID), MediaObjectID: ID(t.MediaObjectID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.UserID = int64(ids.UserID)
+	t.MediaObjectID = int64(ids.MediaObjectID)
+	return nil
+}
@@ -1,9 +1,10 @@
 package models
 
 import (
-	"fmt"
+	"encoding/json"
 	"time"
 
+	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -14,7 +15,7 @@ type Module struct {
 	Name                      string        `json:"name" url:"name,omitempty"`                                               // the name of this module.Example: Imaginary Numbers and You
 	UnlockAt                  time.Time     `json:"unlock_at" url:"unlock_at,omitempty"`                                     // (Optional) the date this module will unlock.Example: 2012-12-31T06:00:00-06:00
 	RequireSequentialProgress bool          `json:"require_sequential_progress" url:"require_sequential_progress,omitempty"` // Whether module items must be unlocked in order.Example: true
-	PrerequisiteModuleIDs     []string      `json:"prerequisite_module_ids" url:"prerequisite_module_ids,omitempty"`         // IDs of Modules that must be completed before this one is unlocked.Example: 121, 122
+	PrerequisiteModuleIDs     []int64       `json:"prerequisite_module_ids" url:"prerequisite_module_ids,omitempty"`         // IDs of Modules that must be completed before this one is unlocked.Example: 121, 122
 	ItemsCount                int64         `json:"items_count" url:"items_count,omitempty"`                                 // The number of items in the module.Example: 10
 	ItemsUrl                  string        `json:"items_url" url:"items_url,omitempty"`                                     // The API URL to retrive this module's items.Example: https://canvas.example.com/api/v1/modules/123/items
 	Items                     []*ModuleItem `json:"items" url:"items,omitempty"`                                             // The contents of this module, as an array of Module Items. (Present only if requested via include[]=items AND the module is not deemed too large by Canvas.).
@@ -26,14 +27,32 @@ type Module struct {
 
 func (t *Module) HasErrors() error {
 	var s []string
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	s = []string{"active", "deleted"}
 	if t.WorkflowState != "" && !string_utils.Include(s, t.WorkflowState) {
-		errs = append(errs, fmt.Sprintf("expected 'WorkflowState' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "WorkflowState", Rule: canvasapi.RuleOneOf, Allowed: s})
 	}
 	s = []string{"locked", "unlocked", "started", "completed"}
 	if t.State != "" && !string_utils.Include(s, t.State) {
-		errs = append(errs, fmt.Sprintf("expected 'State' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "State", Rule: canvasapi.RuleOneOf, Allowed: s})
 	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func (t *Module) UnmarshalJSON(data []byte) error {
+	type model Module
+	ids := struct {
+		*model
+		ID                    ID   `json:"id"`
+		PrerequisiteModuleIDs []ID `json:"prerequisite_module_ids"`
+	}{model: (*model)(t), ID: ID(t.ID), PrerequisiteModuleIDs: idSlice(t.PrerequisiteModuleIDs)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.PrerequisiteModuleIDs = int64Slice(ids.PrerequisiteModuleIDs)
 	return nil
 }
@@ -1,8 +1,8 @@
 package models
 
 import (
-	"fmt"
-
+	"encoding/json"
+	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -26,10 +26,30 @@ type ModuleItem struct {
 
 func (t *ModuleItem) HasErrors() error {
 	var s []string
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	s = []string{"File", "Page", "Discussion", "Assignment", "Quiz", "SubHeader", "ExternalUrl", "ExternalTool"}
 	if t.Type != "" && !string_utils.Include(s, t.Type) {
-		errs = append(errs, fmt.Sprintf("expected 'Type' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "Type", Rule: canvasapi.RuleOneOf, Allowed: s})
+	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func (t *ModuleItem) UnmarshalJSON(data []byte) error {
+	type model ModuleItem
+	ids := struct {
+		*model
+		ID        ID `json:"id"`
+		ModuleID  ID `json:"module_id"`
+		ContentID ID `json:"content_id"`
+	}{model: (*model)(t), ID: ID(t.ID), ModuleID: ID(t.ModuleID), ContentID: ID(t.ContentID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
 	}
+	t.ID = int64(ids.ID)
+	t.ModuleID = int64(ids.ModuleID)
+	t.ContentID = int64(ids.ContentID)
 	return nil
 }
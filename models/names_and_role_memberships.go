@@ -9,3 +9,8 @@ type NamesAndRoleMemberships struct {
 func (t *NamesAndRoleMemberships) HasErrors() error {
 	return nil
 }
+
+// Append adds the members of the next page, so that canvasapi.AllPages can collect every page.
+func (t *NamesAndRoleMemberships) Append(page *NamesAndRoleMemberships) {
+	t.Members = append(t.Members, page.Members...)
+}
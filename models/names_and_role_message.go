@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type NamesAndRoleMessage struct {
 	MessageType       string                   `json:"message_type" url:"message_type,omitempty"`                 // The type of LTI message being described. Always set to 'LtiResourceLinkRequest'.Example: LtiResourceLinkRequest
 	Locale            string                   `json:"locale" url:"locale,omitempty"`                             // The member's preferred locale.Example: en
@@ -11,3 +15,16 @@ type NamesAndRoleMessage struct {
 func (t *NamesAndRoleMessage) HasErrors() error {
 	return nil
 }
+
+func (t *NamesAndRoleMessage) UnmarshalJSON(data []byte) error {
+	type model NamesAndRoleMessage
+	ids := struct {
+		*model
+		CanvasUserID ID `json:"canvas_user_id"`
+	}{model: (*model)(t), CanvasUserID: ID(t.CanvasUserID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.CanvasUserID = int64(ids.CanvasUserID)
+	return nil
+}
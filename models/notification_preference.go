@@ -1,8 +1,7 @@
 package models
 
 import (
-	"fmt"
-
+	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -15,10 +14,13 @@ type NotificationPreference struct {
 
 func (t *NotificationPreference) HasErrors() error {
 	var s []string
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	s = []string{"immediately", "daily", "weekly", "never"}
 	if t.Frequency != "" && !string_utils.Include(s, t.Frequency) {
-		errs = append(errs, fmt.Sprintf("expected 'Frequency' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "Frequency", Rule: canvasapi.RuleOneOf, Allowed: s})
+	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
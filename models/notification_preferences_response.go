@@ -0,0 +1,9 @@
+package models
+
+type NotificationPreferencesResponse struct {
+	NotificationPreferences []*NotificationPreference `json:"notification_preferences" url:"notification_preferences,omitempty"` // The notification preferences..
+}
+
+func (t *NotificationPreferencesResponse) HasErrors() error {
+	return nil
+}
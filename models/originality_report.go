@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -19,3 +20,22 @@ type OriginalityReport struct {
 func (t *OriginalityReport) HasErrors() error {
 	return nil
 }
+
+func (t *OriginalityReport) UnmarshalJSON(data []byte) error {
+	type model OriginalityReport
+	ids := struct {
+		*model
+		ID                      ID `json:"id"`
+		FileID                  ID `json:"file_id"`
+		OriginalityReportFileID ID `json:"originality_report_file_id"`
+		RootAccountID           ID `json:"root_account_id"`
+	}{model: (*model)(t), ID: ID(t.ID), FileID: ID(t.FileID), OriginalityReportFileID: ID(t.OriginalityReportFileID), RootAccountID: ID(t.RootAccountID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.FileID = int64(ids.FileID)
+	t.OriginalityReportFileID = int64(ids.OriginalityReportFileID)
+	t.RootAccountID = int64(ids.RootAccountID)
+	return nil
+}
@@ -1,8 +1,8 @@
 package models
 
 import (
-	"fmt"
-
+	"encoding/json"
+	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -28,10 +28,28 @@ type Outcome struct {
 
 func (t *Outcome) HasErrors() error {
 	var s []string
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	s = []string{"decaying_average", "n_mastery", "latest", "highest"}
 	if t.CalculationMethod != "" && !string_utils.Include(s, t.CalculationMethod) {
-		errs = append(errs, fmt.Sprintf("expected 'CalculationMethod' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "CalculationMethod", Rule: canvasapi.RuleOneOf, Allowed: s})
+	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func (t *Outcome) UnmarshalJSON(data []byte) error {
+	type model Outcome
+	ids := struct {
+		*model
+		ID        ID `json:"id"`
+		ContextID ID `json:"context_id"`
+	}{model: (*model)(t), ID: ID(t.ID), ContextID: ID(t.ContextID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
 	}
+	t.ID = int64(ids.ID)
+	t.ContextID = int64(ids.ContextID)
 	return nil
 }
@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type OutcomeAlignment struct {
 	ID              int64  `json:"id" url:"id,omitempty"`                             // the id of the aligned learning outcome..Example: 1
 	AssignmentID    int64  `json:"assignment_id" url:"assignment_id,omitempty"`       // the id of the aligned assignment (null for live assessments)..Example: 2
@@ -12,3 +16,20 @@ type OutcomeAlignment struct {
 func (t *OutcomeAlignment) HasErrors() error {
 	return nil
 }
+
+func (t *OutcomeAlignment) UnmarshalJSON(data []byte) error {
+	type model OutcomeAlignment
+	ids := struct {
+		*model
+		ID           ID `json:"id"`
+		AssignmentID ID `json:"assignment_id"`
+		AssessmentID ID `json:"assessment_id"`
+	}{model: (*model)(t), ID: ID(t.ID), AssignmentID: ID(t.AssignmentID), AssessmentID: ID(t.AssessmentID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.AssignmentID = int64(ids.AssignmentID)
+	t.AssessmentID = int64(ids.AssessmentID)
+	return nil
+}
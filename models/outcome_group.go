@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type OutcomeGroup struct {
 	ID                 int64         `json:"id" url:"id,omitempty"`                                     // the ID of the outcome group.Example: 1
 	Url                string        `json:"url" url:"url,omitempty"`                                   // the URL for fetching/updating the outcome group. should be treated as opaque.Example: /api/v1/accounts/1/outcome_groups/1
@@ -18,3 +22,18 @@ type OutcomeGroup struct {
 func (t *OutcomeGroup) HasErrors() error {
 	return nil
 }
+
+func (t *OutcomeGroup) UnmarshalJSON(data []byte) error {
+	type model OutcomeGroup
+	ids := struct {
+		*model
+		ID        ID `json:"id"`
+		ContextID ID `json:"context_id"`
+	}{model: (*model)(t), ID: ID(t.ID), ContextID: ID(t.ContextID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.ContextID = int64(ids.ContextID)
+	return nil
+}
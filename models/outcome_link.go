@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type OutcomeLink struct {
 	Url          string        `json:"url" url:"url,omitempty"`                     // the URL for fetching/updating the outcome link. should be treated as opaque.Example: /api/v1/accounts/1/outcome_groups/1/outcomes/1
 	ContextID    int64         `json:"context_id" url:"context_id,omitempty"`       // the context owning the outcome link. will match the context owning the outcome group containing the outcome link; included for convenience. may be null for links in global outcome groups..Example: 1
@@ -13,3 +17,16 @@ type OutcomeLink struct {
 func (t *OutcomeLink) HasErrors() error {
 	return nil
 }
+
+func (t *OutcomeLink) UnmarshalJSON(data []byte) error {
+	type model OutcomeLink
+	ids := struct {
+		*model
+		ContextID ID `json:"context_id"`
+	}{model: (*model)(t), ContextID: ID(t.ContextID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ContextID = int64(ids.ContextID)
+	return nil
+}
@@ -0,0 +1,14 @@
+package models
+
+type OutcomeLinked struct {
+	Outcomes      []*Outcome          `json:"outcomes" url:"outcomes,omitempty"`             // (Optional) The outcomes of the rollups or results..
+	OutcomeGroups []*OutcomeGroup     `json:"outcome_groups" url:"outcome_groups,omitempty"` // (Optional) The outcome groups of the outcomes..
+	OutcomeLinks  []*OutcomeLink      `json:"outcome_links" url:"outcome_links,omitempty"`   // (Optional) The outcome links of the outcomes..
+	OutcomePaths  []*OutcomePath      `json:"outcome_paths" url:"outcome_paths,omitempty"`   // (Optional) The outcome paths of the outcomes..
+	Users         []*User             `json:"users" url:"users,omitempty"`                   // (Optional) The users of the rollups or results..
+	Alignments    []*OutcomeAlignment `json:"alignments" url:"alignments,omitempty"`         // (Optional) The alignments of the results..
+}
+
+func (t *OutcomeLinked) HasErrors() error {
+	return nil
+}
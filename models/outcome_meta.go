@@ -0,0 +1,9 @@
+package models
+
+type OutcomeMeta struct {
+	Pagination map[string](interface{}) `json:"pagination" url:"pagination,omitempty"` // The pagination links and page counts..
+}
+
+func (t *OutcomeMeta) HasErrors() error {
+	return nil
+}
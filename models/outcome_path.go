@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type OutcomePath struct {
 	ID    int64            `json:"id" url:"id,omitempty"`       // A unique identifier for this outcome.Example: 42
 	Parts *OutcomePathPart `json:"parts" url:"parts,omitempty"` // an array of OutcomePathPart objects.
@@ -8,3 +12,16 @@ type OutcomePath struct {
 func (t *OutcomePath) HasErrors() error {
 	return nil
 }
+
+func (t *OutcomePath) UnmarshalJSON(data []byte) error {
+	type model OutcomePath
+	ids := struct {
+		*model
+		ID ID `json:"id"`
+	}{model: (*model)(t), ID: ID(t.ID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	return nil
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -15,3 +16,16 @@ type OutcomeResult struct {
 func (t *OutcomeResult) HasErrors() error {
 	return nil
 }
+
+func (t *OutcomeResult) UnmarshalJSON(data []byte) error {
+	type model OutcomeResult
+	ids := struct {
+		*model
+		ID ID `json:"id"`
+	}{model: (*model)(t), ID: ID(t.ID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	return nil
+}
@@ -0,0 +1,11 @@
+package models
+
+type OutcomeResultsResponse struct {
+	OutcomeResults []*OutcomeResult `json:"outcome_results" url:"outcome_results,omitempty"` // The outcome results..
+	Linked         *OutcomeLinked   `json:"linked" url:"linked,omitempty"`                   // (Optional) The resources requested with include[]..
+	Meta           *OutcomeMeta     `json:"meta" url:"meta,omitempty"`                       // (Optional) Pagination information..
+}
+
+func (t *OutcomeResultsResponse) HasErrors() error {
+	return nil
+}
@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type OutcomeRollupLinks struct {
 	Course  int64 `json:"course" url:"course,omitempty"`   // If an aggregate result was requested, the course field will be present. Otherwise, the user and section field will be present (Optional) The id of the course that this rollup applies to.Example: 42
 	User    int64 `json:"user" url:"user,omitempty"`       // (Optional) The id of the user that this rollup applies to.Example: 42
@@ -9,3 +13,20 @@ type OutcomeRollupLinks struct {
 func (t *OutcomeRollupLinks) HasErrors() error {
 	return nil
 }
+
+func (t *OutcomeRollupLinks) UnmarshalJSON(data []byte) error {
+	type model OutcomeRollupLinks
+	ids := struct {
+		*model
+		Course  ID `json:"course"`
+		User    ID `json:"user"`
+		Section ID `json:"section"`
+	}{model: (*model)(t), Course: ID(t.Course), User: ID(t.User), Section: ID(t.Section)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.Course = int64(ids.Course)
+	t.User = int64(ids.User)
+	t.Section = int64(ids.Section)
+	return nil
+}
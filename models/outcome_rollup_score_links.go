@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type OutcomeRollupScoreLinks struct {
 	Outcome int64 `json:"outcome" url:"outcome,omitempty"` // The id of the related outcome.Example: 42
 }
@@ -7,3 +11,16 @@ type OutcomeRollupScoreLinks struct {
 func (t *OutcomeRollupScoreLinks) HasErrors() error {
 	return nil
 }
+
+func (t *OutcomeRollupScoreLinks) UnmarshalJSON(data []byte) error {
+	type model OutcomeRollupScoreLinks
+	ids := struct {
+		*model
+		Outcome ID `json:"outcome"`
+	}{model: (*model)(t), Outcome: ID(t.Outcome)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.Outcome = int64(ids.Outcome)
+	return nil
+}
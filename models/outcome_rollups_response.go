@@ -0,0 +1,11 @@
+package models
+
+type OutcomeRollupsResponse struct {
+	Rollups []*OutcomeRollup `json:"rollups" url:"rollups,omitempty"` // The outcome rollups..
+	Linked  *OutcomeLinked   `json:"linked" url:"linked,omitempty"`   // (Optional) The resources requested with include[]..
+	Meta    *OutcomeMeta     `json:"meta" url:"meta,omitempty"`       // (Optional) Pagination information..
+}
+
+func (t *OutcomeRollupsResponse) HasErrors() error {
+	return nil
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -17,3 +18,16 @@ type PageRevision struct {
 func (t *PageRevision) HasErrors() error {
 	return nil
 }
+
+func (t *PageRevision) UnmarshalJSON(data []byte) error {
+	type model PageRevision
+	ids := struct {
+		*model
+		RevisionID ID `json:"revision_id"`
+	}{model: (*model)(t), RevisionID: ID(t.RevisionID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.RevisionID = int64(ids.RevisionID)
+	return nil
+}
@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type PageViewLinks struct {
 	User     int64 `json:"user" url:"user,omitempty"`           // The ID of the user for this page view.Example: 1234
 	Context  int64 `json:"context" url:"context,omitempty"`     // The ID of the context for the request (course id if context_type is Course, etc).Example: 1234
@@ -11,3 +15,24 @@ type PageViewLinks struct {
 func (t *PageViewLinks) HasErrors() error {
 	return nil
 }
+
+func (t *PageViewLinks) UnmarshalJSON(data []byte) error {
+	type model PageViewLinks
+	ids := struct {
+		*model
+		User     ID `json:"user"`
+		Context  ID `json:"context"`
+		Asset    ID `json:"asset"`
+		RealUser ID `json:"real_user"`
+		Account  ID `json:"account"`
+	}{model: (*model)(t), User: ID(t.User), Context: ID(t.Context), Asset: ID(t.Asset), RealUser: ID(t.RealUser), Account: ID(t.Account)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.User = int64(ids.User)
+	t.Context = int64(ids.Context)
+	t.Asset = int64(ids.Asset)
+	t.RealUser = int64(ids.RealUser)
+	t.Account = int64(ids.Account)
+	return nil
+}
@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type PairingCode struct {
 	UserID        int64  `json:"user_id" url:"user_id,omitempty"`               // The ID of the user..Example: 2
 	Code          string `json:"code" url:"code,omitempty"`                     // The actual code to be sent to other APIs.Example: abc123
@@ -10,3 +14,16 @@ type PairingCode struct {
 func (t *PairingCode) HasErrors() error {
 	return nil
 }
+
+func (t *PairingCode) UnmarshalJSON(data []byte) error {
+	type model PairingCode
+	ids := struct {
+		*model
+		UserID ID `json:"user_id"`
+	}{model: (*model)(t), UserID: ID(t.UserID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.UserID = int64(ids.UserID)
+	return nil
+}
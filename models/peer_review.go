@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type PeerReview struct {
 	AssessorID         int64  `json:"assessor_id" url:"assessor_id,omitempty"`                 // The assessors user id.Example: 23
 	AssetID            int64  `json:"asset_id" url:"asset_id,omitempty"`                       // The id for the asset associated with this Peer Review.Example: 13
@@ -15,3 +19,22 @@ type PeerReview struct {
 func (t *PeerReview) HasErrors() error {
 	return nil
 }
+
+func (t *PeerReview) UnmarshalJSON(data []byte) error {
+	type model PeerReview
+	ids := struct {
+		*model
+		AssessorID ID `json:"assessor_id"`
+		AssetID    ID `json:"asset_id"`
+		ID         ID `json:"id"`
+		UserID     ID `json:"user_id"`
+	}{model: (*model)(t), AssessorID: ID(t.AssessorID), AssetID: ID(t.AssetID), ID: ID(t.ID), UserID: ID(t.UserID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.AssessorID = int64(ids.AssessorID)
+	t.AssetID = int64(ids.AssetID)
+	t.ID = int64(ids.ID)
+	t.UserID = int64(ids.UserID)
+	return nil
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -21,3 +22,22 @@ type PlannerNote struct {
 func (t *PlannerNote) HasErrors() error {
 	return nil
 }
+
+func (t *PlannerNote) UnmarshalJSON(data []byte) error {
+	type model PlannerNote
+	ids := struct {
+		*model
+		ID             ID `json:"id"`
+		UserID         ID `json:"user_id"`
+		CourseID       ID `json:"course_id"`
+		LinkedObjectID ID `json:"linked_object_id"`
+	}{model: (*model)(t), ID: ID(t.ID), UserID: ID(t.UserID), CourseID: ID(t.CourseID), LinkedObjectID: ID(t.LinkedObjectID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.UserID = int64(ids.UserID)
+	t.CourseID = int64(ids.CourseID)
+	t.LinkedObjectID = int64(ids.LinkedObjectID)
+	return nil
+}
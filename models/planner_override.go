@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -21,3 +22,22 @@ type PlannerOverride struct {
 func (t *PlannerOverride) HasErrors() error {
 	return nil
 }
+
+func (t *PlannerOverride) UnmarshalJSON(data []byte) error {
+	type model PlannerOverride
+	ids := struct {
+		*model
+		ID           ID `json:"id"`
+		PlannableID  ID `json:"plannable_id"`
+		UserID       ID `json:"user_id"`
+		AssignmentID ID `json:"assignment_id"`
+	}{model: (*model)(t), ID: ID(t.ID), PlannableID: ID(t.PlannableID), UserID: ID(t.UserID), AssignmentID: ID(t.AssignmentID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.PlannableID = int64(ids.PlannableID)
+	t.UserID = int64(ids.UserID)
+	t.AssignmentID = int64(ids.AssignmentID)
+	return nil
+}
@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type Poll struct {
 	ID           int64                    `json:"id" url:"id,omitempty"`                       // The unique identifier for the poll..Example: 1023
 	Question     string                   `json:"question" url:"question,omitempty"`           // The question/title of the poll..Example: What do you consider most important to your learning in this course?
@@ -12,3 +16,18 @@ type Poll struct {
 func (t *Poll) HasErrors() error {
 	return nil
 }
+
+func (t *Poll) UnmarshalJSON(data []byte) error {
+	type model Poll
+	ids := struct {
+		*model
+		ID     ID `json:"id"`
+		UserID ID `json:"user_id"`
+	}{model: (*model)(t), ID: ID(t.ID), UserID: ID(t.UserID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.UserID = int64(ids.UserID)
+	return nil
+}
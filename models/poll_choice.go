@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type PollChoice struct {
 	ID        int64  `json:"id" url:"id,omitempty"`                 // The unique identifier for the poll choice..Example: 1023
 	PollID    int64  `json:"poll_id" url:"poll_id,omitempty"`       // The id of the poll this poll choice belongs to..Example: 1779
@@ -11,3 +15,18 @@ type PollChoice struct {
 func (t *PollChoice) HasErrors() error {
 	return nil
 }
+
+func (t *PollChoice) UnmarshalJSON(data []byte) error {
+	type model PollChoice
+	ids := struct {
+		*model
+		ID     ID `json:"id"`
+		PollID ID `json:"poll_id"`
+	}{model: (*model)(t), ID: ID(t.ID), PollID: ID(t.PollID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.PollID = int64(ids.PollID)
+	return nil
+}
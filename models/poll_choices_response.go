@@ -0,0 +1,9 @@
+package models
+
+type PollChoicesResponse struct {
+	PollChoices []*PollChoice `json:"poll_choices" url:"poll_choices,omitempty"` // The poll choices..
+}
+
+func (t *PollChoicesResponse) HasErrors() error {
+	return nil
+}
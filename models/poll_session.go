@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type PollSession struct {
 	ID               int64                    `json:"id" url:"id,omitempty"`                                 // The unique identifier for the poll session..Example: 1023
 	PollID           int64                    `json:"poll_id" url:"poll_id,omitempty"`                       // The id of the Poll this poll session is associated with.Example: 55
@@ -9,9 +13,28 @@ type PollSession struct {
 	HasPublicResults bool                     `json:"has_public_results" url:"has_public_results,omitempty"` // Specifies whether the results are viewable by students..Example: true
 	CreatedAt        string                   `json:"created_at" url:"created_at,omitempty"`                 // The time at which the poll session was created..Example: 2014-01-07T15:16:18Z
 	Results          map[string](interface{}) `json:"results" url:"results,omitempty"`                       // The results of the submissions of the poll. Each key is the poll choice id, and the value is the count of submissions..Example: 10, 3, 27, 8
-	PollSubmissions  *PollSubmission          `json:"poll_submissions" url:"poll_submissions,omitempty"`     // If the poll session has public results, this will return an array of all submissions, viewable by both students and teachers. If the results are not public, for students it will return their submission only..
+	PollSubmissions  []*PollSubmission        `json:"poll_submissions" url:"poll_submissions,omitempty"`     // If the poll session has public results, this will return an array of all submissions, viewable by both students and teachers. If the results are not public, for students it will return their submission only..
 }
 
 func (t *PollSession) HasErrors() error {
 	return nil
 }
+
+func (t *PollSession) UnmarshalJSON(data []byte) error {
+	type model PollSession
+	ids := struct {
+		*model
+		ID              ID `json:"id"`
+		PollID          ID `json:"poll_id"`
+		CourseID        ID `json:"course_id"`
+		CourseSectionID ID `json:"course_section_id"`
+	}{model: (*model)(t), ID: ID(t.ID), PollID: ID(t.PollID), CourseID: ID(t.CourseID), CourseSectionID: ID(t.CourseSectionID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.PollID = int64(ids.PollID)
+	t.CourseID = int64(ids.CourseID)
+	t.CourseSectionID = int64(ids.CourseSectionID)
+	return nil
+}
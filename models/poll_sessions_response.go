@@ -0,0 +1,9 @@
+package models
+
+type PollSessionsResponse struct {
+	PollSessions []*PollSession `json:"poll_sessions" url:"poll_sessions,omitempty"` // The poll sessions..
+}
+
+func (t *PollSessionsResponse) HasErrors() error {
+	return nil
+}
@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type PollSubmission struct {
 	ID           int64  `json:"id" url:"id,omitempty"`                         // The unique identifier for the poll submission..Example: 1023
 	PollChoiceID int64  `json:"poll_choice_id" url:"poll_choice_id,omitempty"` // The unique identifier of the poll choice chosen for this submission..Example: 155
@@ -10,3 +14,20 @@ type PollSubmission struct {
 func (t *PollSubmission) HasErrors() error {
 	return nil
 }
+
+func (t *PollSubmission) UnmarshalJSON(data []byte) error {
+	type model PollSubmission
+	ids := struct {
+		*model
+		ID           ID `json:"id"`
+		PollChoiceID ID `json:"poll_choice_id"`
+		UserID       ID `json:"user_id"`
+	}{model: (*model)(t), ID: ID(t.ID), PollChoiceID: ID(t.PollChoiceID), UserID: ID(t.UserID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.PollChoiceID = int64(ids.PollChoiceID)
+	t.UserID = int64(ids.UserID)
+	return nil
+}
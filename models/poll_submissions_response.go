@@ -0,0 +1,9 @@
+package models
+
+type PollSubmissionsResponse struct {
+	PollSubmissions []*PollSubmission `json:"poll_submissions" url:"poll_submissions,omitempty"` // The poll submissions..
+}
+
+func (t *PollSubmissionsResponse) HasErrors() error {
+	return nil
+}
@@ -0,0 +1,9 @@
+package models
+
+type PollsResponse struct {
+	Polls []*Poll `json:"polls" url:"polls,omitempty"` // The polls..
+}
+
+func (t *PollsResponse) HasErrors() error {
+	return nil
+}
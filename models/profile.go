@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type Profile struct {
 	ID           int64         `json:"id" url:"id,omitempty"`                       // The ID of the user..Example: 1234
 	Name         string        `json:"name" url:"name,omitempty"`                   // Sample User.Example: Sample User
@@ -21,3 +25,16 @@ type Profile struct {
 func (t *Profile) HasErrors() error {
 	return nil
 }
+
+func (t *Profile) UnmarshalJSON(data []byte) error {
+	type model Profile
+	ids := struct {
+		*model
+		ID ID `json:"id"`
+	}{model: (*model)(t), ID: ID(t.ID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	return nil
+}
@@ -1,9 +1,10 @@
 package models
 
 import (
-	"fmt"
+	"encoding/json"
 	"time"
 
+	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -24,10 +25,30 @@ type Progress struct {
 
 func (t *Progress) HasErrors() error {
 	var s []string
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	s = []string{"queued", "running", "completed", "failed"}
 	if t.WorkflowState != "" && !string_utils.Include(s, t.WorkflowState) {
-		errs = append(errs, fmt.Sprintf("expected 'WorkflowState' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "WorkflowState", Rule: canvasapi.RuleOneOf, Allowed: s})
 	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func (t *Progress) UnmarshalJSON(data []byte) error {
+	type model Progress
+	ids := struct {
+		*model
+		ID        ID `json:"id"`
+		ContextID ID `json:"context_id"`
+		UserID    ID `json:"user_id"`
+	}{model: (*model)(t), ID: ID(t.ID), ContextID: ID(t.ContextID), UserID: ID(t.UserID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.ContextID = int64(ids.ContextID)
+	t.UserID = int64(ids.UserID)
 	return nil
 }
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -17,3 +18,16 @@ type ProvisionalGrade struct {
 func (t *ProvisionalGrade) HasErrors() error {
 	return nil
 }
+
+func (t *ProvisionalGrade) UnmarshalJSON(data []byte) error {
+	type model ProvisionalGrade
+	ids := struct {
+		*model
+		ProvisionalGradeID ID `json:"provisional_grade_id"`
+	}{model: (*model)(t), ProvisionalGradeID: ID(t.ProvisionalGradeID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ProvisionalGradeID = int64(ids.ProvisionalGradeID)
+	return nil
+}
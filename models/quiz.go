@@ -1,9 +1,10 @@
 package models
 
 import (
-	"fmt"
+	"encoding/json"
 	"time"
 
+	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -51,18 +52,36 @@ type Quiz struct {
 
 func (t *Quiz) HasErrors() error {
 	var s []string
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	s = []string{"practice_quiz", "assignment", "graded_survey", "survey"}
 	if t.QuizType != "" && !string_utils.Include(s, t.QuizType) {
-		errs = append(errs, fmt.Sprintf("expected 'QuizType' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "QuizType", Rule: canvasapi.RuleOneOf, Allowed: s})
 	}
 	s = []string{"always", "until_after_last_attempt"}
 	if t.HideResults != "" && !string_utils.Include(s, t.HideResults) {
-		errs = append(errs, fmt.Sprintf("expected 'HideResults' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "HideResults", Rule: canvasapi.RuleOneOf, Allowed: s})
 	}
 	s = []string{"keep_highest", "keep_latest"}
 	if t.ScoringPolicy != "" && !string_utils.Include(s, t.ScoringPolicy) {
-		errs = append(errs, fmt.Sprintf("expected 'ScoringPolicy' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "ScoringPolicy", Rule: canvasapi.RuleOneOf, Allowed: s})
 	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func (t *Quiz) UnmarshalJSON(data []byte) error {
+	type model Quiz
+	ids := struct {
+		*model
+		ID                ID `json:"id"`
+		AssignmentGroupID ID `json:"assignment_group_id"`
+	}{model: (*model)(t), ID: ID(t.ID), AssignmentGroupID: ID(t.AssignmentGroupID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.AssignmentGroupID = int64(ids.AssignmentGroupID)
 	return nil
 }
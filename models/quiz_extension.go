@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type QuizExtension struct {
 	QuizID           int64  `json:"quiz_id" url:"quiz_id,omitempty"`                     // The ID of the Quiz the quiz extension belongs to..Example: 2
 	UserID           int64  `json:"user_id" url:"user_id,omitempty"`                     // The ID of the Student that needs the quiz extension..Example: 3
@@ -12,3 +16,18 @@ type QuizExtension struct {
 func (t *QuizExtension) HasErrors() error {
 	return nil
 }
+
+func (t *QuizExtension) UnmarshalJSON(data []byte) error {
+	type model QuizExtension
+	ids := struct {
+		*model
+		QuizID ID `json:"quiz_id"`
+		UserID ID `json:"user_id"`
+	}{model: (*model)(t), QuizID: ID(t.QuizID), UserID: ID(t.UserID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.QuizID = int64(ids.QuizID)
+	t.UserID = int64(ids.UserID)
+	return nil
+}
@@ -0,0 +1,9 @@
+package models
+
+type QuizExtensionsResponse struct {
+	QuizExtensions []*QuizExtension `json:"quiz_extensions" url:"quiz_extensions,omitempty"` // The quiz extensions..
+}
+
+func (t *QuizExtensionsResponse) HasErrors() error {
+	return nil
+}
@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type QuizGroup struct {
 	ID                       int64  `json:"id" url:"id,omitempty"`                                                   // The ID of the question group..Example: 1
 	QuizID                   int64  `json:"quiz_id" url:"quiz_id,omitempty"`                                         // The ID of the Quiz the question group belongs to..Example: 2
@@ -13,3 +17,20 @@ type QuizGroup struct {
 func (t *QuizGroup) HasErrors() error {
 	return nil
 }
+
+func (t *QuizGroup) UnmarshalJSON(data []byte) error {
+	type model QuizGroup
+	ids := struct {
+		*model
+		ID                       ID `json:"id"`
+		QuizID                   ID `json:"quiz_id"`
+		AssessmentQuestionBankID ID `json:"assessment_question_bank_id"`
+	}{model: (*model)(t), ID: ID(t.ID), QuizID: ID(t.QuizID), AssessmentQuestionBankID: ID(t.AssessmentQuestionBankID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.QuizID = int64(ids.QuizID)
+	t.AssessmentQuestionBankID = int64(ids.AssessmentQuestionBankID)
+	return nil
+}
@@ -0,0 +1,9 @@
+package models
+
+type QuizGroupsResponse struct {
+	QuizGroups []*QuizGroup `json:"quiz_groups" url:"quiz_groups,omitempty"` // The quiz groups..
+}
+
+func (t *QuizGroupsResponse) HasErrors() error {
+	return nil
+}
@@ -0,0 +1,9 @@
+package models
+
+type QuizIPFiltersResponse struct {
+	QuizIPFilters []*QuizIPFilter `json:"quiz_ip_filters" url:"quiz_ip_filters,omitempty"` // The quiz IP filters..
+}
+
+func (t *QuizIPFiltersResponse) HasErrors() error {
+	return nil
+}
@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type QuizQuestion struct {
 	ID                int64     `json:"id" url:"id,omitempty"`                                 // The ID of the quiz question..Example: 1
 	QuizID            int64     `json:"quiz_id" url:"quiz_id,omitempty"`                       // The ID of the Quiz the question belongs to..Example: 2
@@ -17,3 +21,18 @@ type QuizQuestion struct {
 func (t *QuizQuestion) HasErrors() error {
 	return nil
 }
+
+func (t *QuizQuestion) UnmarshalJSON(data []byte) error {
+	type model QuizQuestion
+	ids := struct {
+		*model
+		ID     ID `json:"id"`
+		QuizID ID `json:"quiz_id"`
+	}{model: (*model)(t), ID: ID(t.ID), QuizID: ID(t.QuizID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.QuizID = int64(ids.QuizID)
+	return nil
+}
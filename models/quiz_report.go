@@ -1,9 +1,10 @@
 package models
 
 import (
-	"fmt"
+	"encoding/json"
 	"time"
 
+	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -25,10 +26,28 @@ type QuizReport struct {
 
 func (t *QuizReport) HasErrors() error {
 	var s []string
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	s = []string{"student_analysis", "item_analysis"}
 	if t.ReportType != "" && !string_utils.Include(s, t.ReportType) {
-		errs = append(errs, fmt.Sprintf("expected 'ReportType' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "ReportType", Rule: canvasapi.RuleOneOf, Allowed: s})
 	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func (t *QuizReport) UnmarshalJSON(data []byte) error {
+	type model QuizReport
+	ids := struct {
+		*model
+		ID     ID `json:"id"`
+		QuizID ID `json:"quiz_id"`
+	}{model: (*model)(t), ID: ID(t.ID), QuizID: ID(t.QuizID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.QuizID = int64(ids.QuizID)
 	return nil
 }
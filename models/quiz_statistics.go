@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -22,3 +23,18 @@ type QuizStatistics struct {
 func (t *QuizStatistics) HasErrors() error {
 	return nil
 }
+
+func (t *QuizStatistics) UnmarshalJSON(data []byte) error {
+	type model QuizStatistics
+	ids := struct {
+		*model
+		ID     ID `json:"id"`
+		QuizID ID `json:"quiz_id"`
+	}{model: (*model)(t), ID: ID(t.ID), QuizID: ID(t.QuizID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.QuizID = int64(ids.QuizID)
+	return nil
+}
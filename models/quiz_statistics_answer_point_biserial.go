@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type QuizStatisticsAnswerPointBiserial struct {
 	AnswerID      int64   `json:"answer_id" url:"answer_id,omitempty"`           // ID of the answer the point biserial is for..Example: 3866
 	PointBiserial float64 `json:"point_biserial" url:"point_biserial,omitempty"` // The point biserial value for this answer. Value ranges between -1 and 1..Example: -0.802955068546966
@@ -10,3 +14,16 @@ type QuizStatisticsAnswerPointBiserial struct {
 func (t *QuizStatisticsAnswerPointBiserial) HasErrors() error {
 	return nil
 }
+
+func (t *QuizStatisticsAnswerPointBiserial) UnmarshalJSON(data []byte) error {
+	type model QuizStatisticsAnswerPointBiserial
+	ids := struct {
+		*model
+		AnswerID ID `json:"answer_id"`
+	}{model: (*model)(t), AnswerID: ID(t.AnswerID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.AnswerID = int64(ids.AnswerID)
+	return nil
+}
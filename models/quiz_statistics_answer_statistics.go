@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type QuizStatisticsAnswerStatistics struct {
 	ID        int64  `json:"id" url:"id,omitempty"`               // ID of the answer..Example: 3866
 	Text      string `json:"text" url:"text,omitempty"`           // The text attached to the answer..Example: Blue.
@@ -10,3 +14,16 @@ type QuizStatisticsAnswerStatistics struct {
 func (t *QuizStatisticsAnswerStatistics) HasErrors() error {
 	return nil
 }
+
+func (t *QuizStatisticsAnswerStatistics) UnmarshalJSON(data []byte) error {
+	type model QuizStatisticsAnswerStatistics
+	ids := struct {
+		*model
+		ID ID `json:"id"`
+	}{model: (*model)(t), ID: ID(t.ID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	return nil
+}
@@ -0,0 +1,9 @@
+package models
+
+type QuizStatisticsResponse struct {
+	QuizStatistics []*QuizStatistics `json:"quiz_statistics" url:"quiz_statistics,omitempty"` // The quiz statistics..
+}
+
+func (t *QuizStatisticsResponse) HasErrors() error {
+	return nil
+}
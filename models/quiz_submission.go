@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type QuizSubmission struct {
 	ID                        int64  `json:"id" url:"id,omitempty"`                                                     // The ID of the quiz submission..Example: 1
 	QuizID                    int64  `json:"quiz_id" url:"quiz_id,omitempty"`                                           // The ID of the Quiz the quiz submission belongs to..Example: 2
@@ -25,3 +29,22 @@ type QuizSubmission struct {
 func (t *QuizSubmission) HasErrors() error {
 	return nil
 }
+
+func (t *QuizSubmission) UnmarshalJSON(data []byte) error {
+	type model QuizSubmission
+	ids := struct {
+		*model
+		ID           ID `json:"id"`
+		QuizID       ID `json:"quiz_id"`
+		UserID       ID `json:"user_id"`
+		SubmissionID ID `json:"submission_id"`
+	}{model: (*model)(t), ID: ID(t.ID), QuizID: ID(t.QuizID), UserID: ID(t.UserID), SubmissionID: ID(t.SubmissionID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.QuizID = int64(ids.QuizID)
+	t.UserID = int64(ids.UserID)
+	t.SubmissionID = int64(ids.SubmissionID)
+	return nil
+}
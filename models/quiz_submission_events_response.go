@@ -0,0 +1,9 @@
+package models
+
+type QuizSubmissionEventsResponse struct {
+	QuizSubmissionEvents []*QuizSubmissionEvent `json:"quiz_submission_events" url:"quiz_submission_events,omitempty"` // The quiz submission events..
+}
+
+func (t *QuizSubmissionEventsResponse) HasErrors() error {
+	return nil
+}
@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type QuizSubmissionQuestion struct {
 	ID      int64    `json:"id" url:"id,omitempty"`           // The ID of the QuizQuestion this answer is for..Example: 1
 	Flagged bool     `json:"flagged" url:"flagged,omitempty"` // Whether this question is flagged..Example: true
@@ -10,3 +14,16 @@ type QuizSubmissionQuestion struct {
 func (t *QuizSubmissionQuestion) HasErrors() error {
 	return nil
 }
+
+func (t *QuizSubmissionQuestion) UnmarshalJSON(data []byte) error {
+	type model QuizSubmissionQuestion
+	ids := struct {
+		*model
+		ID ID `json:"id"`
+	}{model: (*model)(t), ID: ID(t.ID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	return nil
+}
@@ -0,0 +1,9 @@
+package models
+
+type QuizSubmissionQuestionsResponse struct {
+	QuizSubmissionQuestions []*QuizSubmissionQuestion `json:"quiz_submission_questions" url:"quiz_submission_questions,omitempty"` // The quiz submission questions..
+}
+
+func (t *QuizSubmissionQuestionsResponse) HasErrors() error {
+	return nil
+}
@@ -0,0 +1,9 @@
+package models
+
+type QuizSubmissionsResponse struct {
+	QuizSubmissions []*QuizSubmission `json:"quiz_submissions" url:"quiz_submissions,omitempty"` // The quiz submissions..
+}
+
+func (t *QuizSubmissionsResponse) HasErrors() error {
+	return nil
+}
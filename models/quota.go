@@ -0,0 +1,10 @@
+package models
+
+type Quota struct {
+	Quota     int64 `json:"quota" url:"quota,omitempty"`           // The total quota in bytes..Example: 524288000
+	QuotaUsed int64 `json:"quota_used" url:"quota_used,omitempty"` // The quota used in bytes..Example: 402361
+}
+
+func (t *Quota) HasErrors() error {
+	return nil
+}
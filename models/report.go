@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -21,3 +22,16 @@ type Report struct {
 func (t *Report) HasErrors() error {
 	return nil
 }
+
+func (t *Report) UnmarshalJSON(data []byte) error {
+	type model Report
+	ids := struct {
+		*model
+		ID ID `json:"id"`
+	}{model: (*model)(t), ID: ID(t.ID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	return nil
+}
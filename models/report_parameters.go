@@ -1,9 +1,10 @@
 package models
 
 import (
-	"fmt"
+	"encoding/json"
 	"time"
 
+	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -30,10 +31,28 @@ type ReportParameters struct {
 
 func (t *ReportParameters) HasErrors() error {
 	var s []string
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	s = []string{"users", "courses", "outcomes"}
 	if t.Order != "" && !string_utils.Include(s, t.Order) {
-		errs = append(errs, fmt.Sprintf("expected 'Order' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "Order", Rule: canvasapi.RuleOneOf, Allowed: s})
 	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func (t *ReportParameters) UnmarshalJSON(data []byte) error {
+	type model ReportParameters
+	ids := struct {
+		*model
+		EnrollmentTermID ID `json:"enrollment_term_id"`
+		CourseID         ID `json:"course_id"`
+	}{model: (*model)(t), EnrollmentTermID: ID(t.EnrollmentTermID), CourseID: ID(t.CourseID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.EnrollmentTermID = int64(ids.EnrollmentTermID)
+	t.CourseID = int64(ids.CourseID)
 	return nil
 }
@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type Rubric struct {
 	ID                        int64                `json:"id" url:"id,omitempty"`                                                     // the ID of the rubric.Example: 1
 	Title                     string               `json:"title" url:"title,omitempty"`                                               // title of the rubric.Example: some title
@@ -18,3 +22,18 @@ type Rubric struct {
 func (t *Rubric) HasErrors() error {
 	return nil
 }
+
+func (t *Rubric) UnmarshalJSON(data []byte) error {
+	type model Rubric
+	ids := struct {
+		*model
+		ID        ID `json:"id"`
+		ContextID ID `json:"context_id"`
+	}{model: (*model)(t), ID: ID(t.ID), ContextID: ID(t.ContextID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.ContextID = int64(ids.ContextID)
+	return nil
+}
@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type RubricAssessment struct {
 	ID                  int64    `json:"id" url:"id,omitempty"`                                       // the ID of the rubric.Example: 1
 	RubricID            int64    `json:"rubric_id" url:"rubric_id,omitempty"`                         // the rubric the assessment belongs to.Example: 1
@@ -17,3 +21,24 @@ type RubricAssessment struct {
 func (t *RubricAssessment) HasErrors() error {
 	return nil
 }
+
+func (t *RubricAssessment) UnmarshalJSON(data []byte) error {
+	type model RubricAssessment
+	ids := struct {
+		*model
+		ID                  ID `json:"id"`
+		RubricID            ID `json:"rubric_id"`
+		RubricAssociationID ID `json:"rubric_association_id"`
+		ArtifactID          ID `json:"artifact_id"`
+		AssessorID          ID `json:"assessor_id"`
+	}{model: (*model)(t), ID: ID(t.ID), RubricID: ID(t.RubricID), RubricAssociationID: ID(t.RubricAssociationID), ArtifactID: ID(t.ArtifactID), AssessorID: ID(t.AssessorID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.RubricID = int64(ids.RubricID)
+	t.RubricAssociationID = int64(ids.RubricAssociationID)
+	t.ArtifactID = int64(ids.ArtifactID)
+	t.AssessorID = int64(ids.AssessorID)
+	return nil
+}
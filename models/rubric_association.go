@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type RubricAssociation struct {
 	ID                 int64  `json:"id" url:"id,omitempty"`                                     // the ID of the association.Example: 1
 	RubricID           int64  `json:"rubric_id" url:"rubric_id,omitempty"`                       // the ID of the rubric.Example: 1
@@ -16,3 +20,20 @@ type RubricAssociation struct {
 func (t *RubricAssociation) HasErrors() error {
 	return nil
 }
+
+func (t *RubricAssociation) UnmarshalJSON(data []byte) error {
+	type model RubricAssociation
+	ids := struct {
+		*model
+		ID            ID `json:"id"`
+		RubricID      ID `json:"rubric_id"`
+		AssociationID ID `json:"association_id"`
+	}{model: (*model)(t), ID: ID(t.ID), RubricID: ID(t.RubricID), AssociationID: ID(t.AssociationID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.RubricID = int64(ids.RubricID)
+	t.AssociationID = int64(ids.AssociationID)
+	return nil
+}
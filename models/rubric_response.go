@@ -0,0 +1,10 @@
+package models
+
+type RubricResponse struct {
+	Rubric            *Rubric            `json:"rubric" url:"rubric,omitempty"`                         // The rubric..
+	RubricAssociation *RubricAssociation `json:"rubric_association" url:"rubric_association,omitempty"` // (Optional) The association of the rubric, when one was created or updated..
+}
+
+func (t *RubricResponse) HasErrors() error {
+	return nil
+}
@@ -0,0 +1,10 @@
+package models
+
+type ScoreResult struct {
+	ResultUrl  string                 `json:"resultUrl" url:"resultUrl,omitempty"`                                                                         // The url of the Result the score created or updated.Example: http://institution.canvas.com/api/lti/courses/5/line_items/2/results/1
+	Submission map[string]interface{} `json:"https://canvas.instructure.com/lti/submission" url:"https://canvas.instructure.com/lti/submission,omitempty"` // (EXTENSION) The Content Items sent with the score, each with a url pointing to the Progress of its upload.
+}
+
+func (t *ScoreResult) HasErrors() error {
+	return nil
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -22,3 +23,22 @@ type Section struct {
 func (t *Section) HasErrors() error {
 	return nil
 }
+
+func (t *Section) UnmarshalJSON(data []byte) error {
+	type model Section
+	ids := struct {
+		*model
+		ID               ID `json:"id"`
+		SISImportID      ID `json:"sis_import_id"`
+		CourseID         ID `json:"course_id"`
+		NonxlistCourseID ID `json:"nonxlist_course_id"`
+	}{model: (*model)(t), ID: ID(t.ID), SISImportID: ID(t.SISImportID), CourseID: ID(t.CourseID), NonxlistCourseID: ID(t.NonxlistCourseID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.SISImportID = int64(ids.SISImportID)
+	t.CourseID = int64(ids.CourseID)
+	t.NonxlistCourseID = int64(ids.NonxlistCourseID)
+	return nil
+}
@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type SectionAttributes struct {
 	ID            int64                                `json:"id" url:"id,omitempty"`                         // The unique identifier for the section..Example: 1
 	Name          string                               `json:"name" url:"name,omitempty"`                     // The name of the section..Example: Section A
@@ -13,3 +17,16 @@ type SectionAttributes struct {
 func (t *SectionAttributes) HasErrors() error {
 	return nil
 }
+
+func (t *SectionAttributes) UnmarshalJSON(data []byte) error {
+	type model SectionAttributes
+	ids := struct {
+		*model
+		ID ID `json:"id"`
+	}{model: (*model)(t), ID: ID(t.ID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	return nil
+}
@@ -0,0 +1,15 @@
+package models
+
+// SelectiveImportItem is a node of the tree of content a selective import can copy, see https://canvas.instructure.com/doc/api/content_migrations.html#method.content_migrations.content_list
+type SelectiveImportItem struct {
+	Type        string                 `json:"type" url:"type,omitempty"`                   // The type of content of the node..Example: assignments
+	Title       string                 `json:"title" url:"title,omitempty"`                 // Example: Assignments
+	Property    string                 `json:"property" url:"property,omitempty"`           // The copy parameter that imports the node and its children..Example: copy[all_assignments]
+	Count       int64                  `json:"count" url:"count,omitempty"`                 // The number of items of a top-level node..Example: 2
+	SubItemsUrl string                 `json:"sub_items_url" url:"sub_items_url,omitempty"` // API url listing the children of a top-level node..Example: https://example.com/api/v1/courses/22/content_migrations/77/selective_data?type=assignments
+	SubItems    []*SelectiveImportItem `json:"sub_items" url:"sub_items,omitempty"`         // The children of the node, when they are listed with it..
+}
+
+func (t *SelectiveImportItem) HasErrors() error {
+	return nil
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"encoding/json"
+)
+
+type SessionlessLaunch struct {
+	ID   int64  `json:"id" url:"id,omitempty"`     // The ID of the external tool..Example: 1
+	Name string `json:"name" url:"name,omitempty"` // The name of the external tool..Example: LTI Tool
+	Url  string `json:"url" url:"url,omitempty"`   // The URL to launch the tool without a session..Example: https://canvas.instructure.com/courses/1/external_tools/sessionless_launch?verifier=abc
+}
+
+func (t *SessionlessLaunch) HasErrors() error {
+	return nil
+}
+
+func (t *SessionlessLaunch) UnmarshalJSON(data []byte) error {
+	type model SessionlessLaunch
+	ids := struct {
+		*model
+		ID ID `json:"id"`
+	}{model: (*model)(t), ID: ID(t.ID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	return nil
+}
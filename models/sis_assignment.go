@@ -1,9 +1,10 @@
 package models
 
 import (
-	"fmt"
+	"encoding/json"
 	"time"
 
+	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -27,13 +28,31 @@ type SISAssignment struct {
 
 func (t *SISAssignment) HasErrors() error {
 	var s []string
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	s = []string{"discussion_topic", "online_quiz", "on_paper", "not_graded", "none", "external_tool", "online_text_entry", "online_url", "online_upload", "media_recording", "student_annotation"}
 
 	for _, v := range t.SubmissionTypes {
 		if v != "" && !string_utils.Include(s, v) {
-			errs = append(errs, fmt.Sprintf("expected 'SubmissionTypes' to be one of %v", s))
+			errs = append(errs, canvasapi.FieldError{Field: "SubmissionTypes", Rule: canvasapi.RuleOneOf, Allowed: s})
 		}
 	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func (t *SISAssignment) UnmarshalJSON(data []byte) error {
+	type model SISAssignment
+	ids := struct {
+		*model
+		ID       ID `json:"id"`
+		CourseID ID `json:"course_id"`
+	}{model: (*model)(t), ID: ID(t.ID), CourseID: ID(t.CourseID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.CourseID = int64(ids.CourseID)
 	return nil
 }
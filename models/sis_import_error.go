@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type SISImportError struct {
 	SISImportID int64  `json:"sis_import_id" url:"sis_import_id,omitempty"` // The unique identifier for the SIS import..Example: 1
 	File        string `json:"file" url:"file,omitempty"`                   // The file where the error message occurred..Example: courses.csv
@@ -11,3 +15,16 @@ type SISImportError struct {
 func (t *SISImportError) HasErrors() error {
 	return nil
 }
+
+func (t *SISImportError) UnmarshalJSON(data []byte) error {
+	type model SISImportError
+	ids := struct {
+		*model
+		SISImportID ID `json:"sis_import_id"`
+	}{model: (*model)(t), SISImportID: ID(t.SISImportID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.SISImportID = int64(ids.SISImportID)
+	return nil
+}
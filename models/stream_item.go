@@ -0,0 +1,51 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type StreamItem struct {
+	ID                   int64     `json:"id" url:"id,omitempty"`                                       // The ID of the stream item..Example: 1
+	Title                string    `json:"title" url:"title,omitempty"`                                 // The title of the stream item..Example: Stream Item Subject
+	Message              string    `json:"message" url:"message,omitempty"`                             // The body of the stream item..Example: This is the body text of the activity stream item. It is plain-text, and can be multiple paragraphs.
+	Type                 string    `json:"type" url:"type,omitempty"`                                   // The type of the stream item, one of DiscussionTopic, Announcement, Conversation, Message, Submission, Conference, Collaboration, AssessmentRequest..Example: DiscussionTopic
+	ReadState            bool      `json:"read_state" url:"read_state,omitempty"`                       // Whether the stream item has been read..Example: false
+	ContextType          string    `json:"context_type" url:"context_type,omitempty"`                   // The type of the context of the stream item, Course or Group..Example: Course
+	CourseID             int64     `json:"course_id" url:"course_id,omitempty"`                         // The ID of the course, set when context_type is Course..Example: 1
+	GroupID              int64     `json:"group_id" url:"group_id,omitempty"`                           // The ID of the group, set when context_type is Group..Example: 1
+	HtmlUrl              string    `json:"html_url" url:"html_url,omitempty"`                           // The URL to the item in Canvas..Example: http://canvas.instructure.com/api/v1/courses/1/discussion_topics/1
+	DiscussionTopicID    int64     `json:"discussion_topic_id" url:"discussion_topic_id,omitempty"`     // (Optional) The ID of the discussion topic, for DiscussionTopic and Announcement items..Example: 1234
+	ConversationID       int64     `json:"conversation_id" url:"conversation_id,omitempty"`             // (Optional) The ID of the conversation, for Conversation items..Example: 1234
+	MessageID            int64     `json:"message_id" url:"message_id,omitempty"`                       // (Optional) The ID of the message, for Message items..Example: 1234
+	NotificationCategory string    `json:"notification_category" url:"notification_category,omitempty"` // (Optional) The category of the notification, for Message items..Example: Assignment Graded
+	CreatedAt            time.Time `json:"created_at" url:"created_at,omitempty"`                       // The time the stream item was created..Example: 2011-07-13T09:12:00Z
+	UpdatedAt            time.Time `json:"updated_at" url:"updated_at,omitempty"`                       // The time the stream item was last updated..Example: 2011-07-25T08:52:41Z
+}
+
+func (t *StreamItem) HasErrors() error {
+	return nil
+}
+
+func (t *StreamItem) UnmarshalJSON(data []byte) error {
+	type model StreamItem
+	ids := struct {
+		*model
+		ID                ID `json:"id"`
+		CourseID          ID `json:"course_id"`
+		GroupID           ID `json:"group_id"`
+		DiscussionTopicID ID `json:"discussion_topic_id"`
+		ConversationID    ID `json:"conversation_id"`
+		MessageID         ID `json:"message_id"`
+	}{model: (*model)(t), ID: ID(t.ID), CourseID: ID(t.CourseID), GroupID: ID(t.GroupID), DiscussionTopicID: ID(t.DiscussionTopicID), ConversationID: ID(t.ConversationID), MessageID: ID(t.MessageID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.CourseID = int64(ids.CourseID)
+	t.GroupID = int64(ids.GroupID)
+	t.DiscussionTopicID = int64(ids.DiscussionTopicID)
+	t.ConversationID = int64(ids.ConversationID)
+	t.MessageID = int64(ids.MessageID)
+	return nil
+}
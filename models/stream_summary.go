@@ -0,0 +1,12 @@
+package models
+
+type StreamSummary struct {
+	Type                 string `json:"type" url:"type,omitempty"`                                   // The type of the stream items summarized..Example: DiscussionTopic
+	NotificationCategory string `json:"notification_category" url:"notification_category,omitempty"` // The notification category, only set for Message items..Example: Assignment Graded
+	UnreadCount          int64  `json:"unread_count" url:"unread_count,omitempty"`                   // The number of unread items of this type..Example: 2
+	Count                int64  `json:"count" url:"count,omitempty"`                                 // The number of items of this type..Example: 7
+}
+
+func (t *StreamSummary) HasErrors() error {
+	return nil
+}
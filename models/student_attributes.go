@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type StudentAttributes struct {
 	UserID    int64  `json:"user_id" url:"user_id,omitempty"`         // The unique Canvas identifier for the user.Example: 511
 	SISUserID string `json:"sis_user_id" url:"sis_user_id,omitempty"` // The SIS ID associated with the user.  This field is only included if the user came from a SIS import and has permissions to view SIS information..Example: SHEL93921
@@ -8,3 +12,16 @@ type StudentAttributes struct {
 func (t *StudentAttributes) HasErrors() error {
 	return nil
 }
+
+func (t *StudentAttributes) UnmarshalJSON(data []byte) error {
+	type model StudentAttributes
+	ids := struct {
+		*model
+		UserID ID `json:"user_id"`
+	}{model: (*model)(t), UserID: ID(t.UserID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.UserID = int64(ids.UserID)
+	return nil
+}
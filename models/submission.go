@@ -1,9 +1,10 @@
 package models
 
 import (
-	"fmt"
+	"encoding/json"
 	"time"
 
+	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -42,18 +43,38 @@ type Submission struct {
 
 func (t *Submission) HasErrors() error {
 	var s []string
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	s = []string{"online_text_entry", "online_url", "online_upload", "media_recording", "student_annotation"}
 	if t.SubmissionType != "" && !string_utils.Include(s, t.SubmissionType) {
-		errs = append(errs, fmt.Sprintf("expected 'SubmissionType' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "SubmissionType", Rule: canvasapi.RuleOneOf, Allowed: s})
 	}
 	s = []string{"graded", "submitted", "unsubmitted", "pending_review"}
 	if t.WorkflowState != "" && !string_utils.Include(s, t.WorkflowState) {
-		errs = append(errs, fmt.Sprintf("expected 'WorkflowState' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "WorkflowState", Rule: canvasapi.RuleOneOf, Allowed: s})
 	}
 	s = []string{"read", "unread"}
 	if t.ReadStatus != "" && !string_utils.Include(s, t.ReadStatus) {
-		errs = append(errs, fmt.Sprintf("expected 'ReadStatus' to be one of %v", s))
+		errs = append(errs, canvasapi.FieldError{Field: "ReadStatus", Rule: canvasapi.RuleOneOf, Allowed: s})
 	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func (t *Submission) UnmarshalJSON(data []byte) error {
+	type model Submission
+	ids := struct {
+		*model
+		AssignmentID ID `json:"assignment_id"`
+		UserID       ID `json:"user_id"`
+		GraderID     ID `json:"grader_id"`
+	}{model: (*model)(t), AssignmentID: ID(t.AssignmentID), UserID: ID(t.UserID), GraderID: ID(t.GraderID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.AssignmentID = int64(ids.AssignmentID)
+	t.UserID = int64(ids.UserID)
+	t.GraderID = int64(ids.GraderID)
 	return nil
 }
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -18,3 +19,18 @@ type SubmissionComment struct {
 func (t *SubmissionComment) HasErrors() error {
 	return nil
 }
+
+func (t *SubmissionComment) UnmarshalJSON(data []byte) error {
+	type model SubmissionComment
+	ids := struct {
+		*model
+		ID       ID `json:"id"`
+		AuthorID ID `json:"author_id"`
+	}{model: (*model)(t), ID: ID(t.ID), AuthorID: ID(t.AuthorID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.AuthorID = int64(ids.AuthorID)
+	return nil
+}
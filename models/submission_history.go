@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type SubmissionHistory struct {
 	SubmissionID int64                `json:"submission_id" url:"submission_id,omitempty"` // the id of the submission.Example: 4
 	Versions     []*SubmissionVersion `json:"versions" url:"versions,omitempty"`           // an array of all the versions of this submission.
@@ -8,3 +12,16 @@ type SubmissionHistory struct {
 func (t *SubmissionHistory) HasErrors() error {
 	return nil
 }
+
+func (t *SubmissionHistory) UnmarshalJSON(data []byte) error {
+	type model SubmissionHistory
+	ids := struct {
+		*model
+		SubmissionID ID `json:"submission_id"`
+	}{model: (*model)(t), SubmissionID: ID(t.SubmissionID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.SubmissionID = int64(ids.SubmissionID)
+	return nil
+}
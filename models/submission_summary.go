@@ -0,0 +1,11 @@
+package models
+
+type SubmissionSummary struct {
+	Graded       int64 `json:"graded" url:"graded,omitempty"`               // The number of submissions that have been graded..Example: 5
+	Ungraded     int64 `json:"ungraded" url:"ungraded,omitempty"`           // The number of submissions that have not been graded..Example: 10
+	NotSubmitted int64 `json:"not_submitted" url:"not_submitted,omitempty"` // The number of students that have not submitted..Example: 42
+}
+
+func (t *SubmissionSummary) HasErrors() error {
+	return nil
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -33,3 +34,22 @@ type SubmissionVersion struct {
 func (t *SubmissionVersion) HasErrors() error {
 	return nil
 }
+
+func (t *SubmissionVersion) UnmarshalJSON(data []byte) error {
+	type model SubmissionVersion
+	ids := struct {
+		*model
+		AssignmentID ID `json:"assignment_id"`
+		GraderID     ID `json:"grader_id"`
+		ID           ID `json:"id"`
+		UserID       ID `json:"user_id"`
+	}{model: (*model)(t), AssignmentID: ID(t.AssignmentID), GraderID: ID(t.GraderID), ID: ID(t.ID), UserID: ID(t.UserID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.AssignmentID = int64(ids.AssignmentID)
+	t.GraderID = int64(ids.GraderID)
+	t.ID = int64(ids.ID)
+	t.UserID = int64(ids.UserID)
+	return nil
+}
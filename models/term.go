@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -14,3 +15,16 @@ type Term struct {
 func (t *Term) HasErrors() error {
 	return nil
 }
+
+func (t *Term) UnmarshalJSON(data []byte) error {
+	type model Term
+	ids := struct {
+		*model
+		ID ID `json:"id"`
+	}{model: (*model)(t), ID: ID(t.ID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	return nil
+}
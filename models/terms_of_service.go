@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type TermsOfService struct {
 	ID                   int64  `json:"id" url:"id,omitempty"`                                         // Terms Of Service id.Example: 1
 	TermsType            string `json:"terms_type" url:"terms_type,omitempty"`                         // The given type for the Terms of Service.Example: default
@@ -12,3 +16,18 @@ type TermsOfService struct {
 func (t *TermsOfService) HasErrors() error {
 	return nil
 }
+
+func (t *TermsOfService) UnmarshalJSON(data []byte) error {
+	type model TermsOfService
+	ids := struct {
+		*model
+		ID        ID `json:"id"`
+		AccountID ID `json:"account_id"`
+	}{model: (*model)(t), ID: ID(t.ID), AccountID: ID(t.AccountID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.AccountID = int64(ids.AccountID)
+	return nil
+}
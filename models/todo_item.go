@@ -0,0 +1,37 @@
+package models
+
+import (
+	"encoding/json"
+)
+
+type TodoItem struct {
+	Type              string      `json:"type" url:"type,omitempty"`                               // The type of the to do item, grading or submitting..Example: grading
+	Assignment        *Assignment `json:"assignment" url:"assignment,omitempty"`                   // The assignment that needs to be graded or submitted..
+	Quiz              *Quiz       `json:"quiz" url:"quiz,omitempty"`                               // (Optional) The quiz that needs to be submitted..
+	Ignore            string      `json:"ignore" url:"ignore,omitempty"`                           // The API URL to ignore the item until it changes..Example: https://canvas.instructure.com/api/v1/users/self/todo/assignment_1/grading?permanent=0
+	IgnorePermanently string      `json:"ignore_permanently" url:"ignore_permanently,omitempty"`   // The API URL to ignore the item permanently..Example: https://canvas.instructure.com/api/v1/users/self/todo/assignment_1/grading?permanent=1
+	HtmlUrl           string      `json:"html_url" url:"html_url,omitempty"`                       // The URL of the item in Canvas..Example: https://canvas.instructure.com/courses/1/assignments/1#submit
+	NeedsGradingCount int64       `json:"needs_grading_count" url:"needs_grading_count,omitempty"` // The number of submissions that need grading..Example: 3
+	ContextType       string      `json:"context_type" url:"context_type,omitempty"`               // The type of the context of the item, Course or Group..Example: Course
+	CourseID          int64       `json:"course_id" url:"course_id,omitempty"`                     // The ID of the course, set when context_type is Course..Example: 1
+	GroupID           int64       `json:"group_id" url:"group_id,omitempty"`                       // The ID of the group, set when context_type is Group..Example: 1
+}
+
+func (t *TodoItem) HasErrors() error {
+	return nil
+}
+
+func (t *TodoItem) UnmarshalJSON(data []byte) error {
+	type model TodoItem
+	ids := struct {
+		*model
+		CourseID ID `json:"course_id"`
+		GroupID  ID `json:"group_id"`
+	}{model: (*model)(t), CourseID: ID(t.CourseID), GroupID: ID(t.GroupID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.CourseID = int64(ids.CourseID)
+	t.GroupID = int64(ids.GroupID)
+	return nil
+}
@@ -0,0 +1,14 @@
+package models
+
+// UploadParams is the response to the first step of a file upload, see https://canvas.instructure.com/doc/api/file.file_uploads.html
+type UploadParams struct {
+	UploadUrl    string            `json:"upload_url" url:"upload_url,omitempty"`       // The URL the file data is posted to in the second step of a file upload..Example: https://some-bucket.s3.amazonaws.com/
+	UploadParams map[string]string `json:"upload_params" url:"upload_params,omitempty"` // The parameters that must be posted with the file data, before the file..
+	FileParam    string            `json:"file_param" url:"file_param,omitempty"`       // The name of the parameter that holds the file data..Example: file
+	Progress     *Progress         `json:"progress" url:"progress,omitempty"`           // (Optional) Returned instead of upload_url when Canvas downloads the file from a url..
+	Message      string            `json:"message" url:"message,omitempty"`             // (Optional) Returned instead of upload_url when the file can't be uploaded, such as when it exceeds the quota..Example: file exceeded quota
+}
+
+func (t *UploadParams) HasErrors() error {
+	return nil
+}
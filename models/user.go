@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type User struct {
 	ID            int64         `json:"id" url:"id,omitempty"`                         // The ID of the user..Example: 2
 	Name          string        `json:"name" url:"name,omitempty"`                     // The name of the user..Example: Sheldon Cooper
@@ -21,3 +25,18 @@ type User struct {
 func (t *User) HasErrors() error {
 	return nil
 }
+
+func (t *User) UnmarshalJSON(data []byte) error {
+	type model User
+	ids := struct {
+		*model
+		ID          ID `json:"id"`
+		SISImportID ID `json:"sis_import_id"`
+	}{model: (*model)(t), ID: ID(t.ID), SISImportID: ID(t.SISImportID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	t.SISImportID = int64(ids.SISImportID)
+	return nil
+}
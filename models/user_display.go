@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/json"
+)
+
 type UserDisplay struct {
 	ID             int64  `json:"id" url:"id,omitempty"`                             // The ID of the user..Example: 2
 	ShortName      string `json:"short_name" url:"short_name,omitempty"`             // A short name the user has selected, for use in conversations or other less formal places through the site..Example: Shelly
@@ -10,3 +14,16 @@ type UserDisplay struct {
 func (t *UserDisplay) HasErrors() error {
 	return nil
 }
+
+func (t *UserDisplay) UnmarshalJSON(data []byte) error {
+	type model UserDisplay
+	ids := struct {
+		*model
+		ID ID `json:"id"`
+	}{model: (*model)(t), ID: ID(t.ID)}
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	t.ID = int64(ids.ID)
+	return nil
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/atomicjolt/canvasapi"
+)
+
+func TestHasErrors(t *testing.T) {
+	assignment := &Assignment{GradingType: "points", SubmissionTypes: []string{"online_upload"}}
+	if err := assignment.HasErrors(); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	assignment = &Assignment{GradingType: "stars", SubmissionTypes: []string{"online_upload", "carrier_pigeon"}}
+	err := assignment.HasErrors()
+	if err == nil {
+		t.Fatal("expected errors for undocumented values")
+	}
+	var validationError *canvasapi.ValidationError
+	if !errors.As(err, &validationError) {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+	if len(validationError.Errors) != 2 || validationError.Field("SubmissionTypes") == nil || validationError.Field("GradingType") == nil {
+		t.Errorf("unexpected errors %+v", validationError.Errors)
+	}
+	if allowed := validationError.Field("GradingType").Allowed; strings.Join(allowed, ",") != "pass_fail,percent,letter_grade,gpa_scale,points" {
+		t.Errorf("unexpected allowed values %v", allowed)
+	}
+}
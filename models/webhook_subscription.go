@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+)
+
+type WebhookSubscription struct {
+	ID                string                   `json:"id" url:"id,omitempty"`                               // The ID of the subscription..Example: 6f9a2c60-9d58-4a05-bd30-3b3f5a5a2a9e
+	ContextType       string                   `json:"ContextType" url:"ContextType,omitempty"`             // The type of the context the subscription is for, root_account, course or assignment..Example: root_account
+	ContextID         string                   `json:"ContextId" url:"ContextId,omitempty"`                 // The ID of the context the subscription is for..Example: 1
+	EventTypes        []string                 `json:"EventTypes" url:"EventTypes,omitempty"`               // The live events the subscription receives..Example: submission_created, grade_change
+	Format            string                   `json:"Format" url:"Format,omitempty"`                       // The format of the events, live-event or caliper..Example: live-event
+	TransportMetadata map[string](interface{}) `json:"TransportMetadata" url:"TransportMetadata,omitempty"` // The transport configuration, for example the url or the sqs queue..
+	TransportType     string                   `json:"TransportType" url:"TransportType,omitempty"`         // The transport type, https or sqs..Example: https
+	OwnerID           string                   `json:"OwnerId" url:"OwnerId,omitempty"`                     // The ID of the developer key that owns the subscription..
+	AssociatedDomain  string                   `json:"AssociatedDomain" url:"AssociatedDomain,omitempty"`   // The domain of the Canvas instance the subscription belongs to..
+	CreatedAt         time.Time                `json:"CreatedAt" url:"CreatedAt,omitempty"`                 // The time the subscription was created..
+	UpdatedAt         time.Time                `json:"UpdatedAt" url:"UpdatedAt,omitempty"`                 // The time the subscription was last updated..
+}
+
+func (t *WebhookSubscription) HasErrors() error {
+	return nil
+}
@@ -0,0 +1,94 @@
+package canvasapi
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/textproto"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// File is the content of a file sent as part of a multipart/form-data request.
+type File struct {
+	// Name is the filename reported to Canvas.
+	Name string
+	// ContentType defaults to application/octet-stream.
+	ContentType string
+	Content     io.Reader
+}
+
+// MultipartBody encodes values and file as multipart/form-data and returns the body and its
+// content type. The file is written after every value because the storage services Canvas
+// hands uploads to ignore fields that follow the file.
+func MultipartBody(values url.Values, field string, file File) ([]byte, string, error) {
+	body, _, contentType, err := MultipartReader(values, field, file, -1)
+	if err != nil {
+		return nil, "", err
+	}
+	payload, err := ioutil.ReadAll(body)
+	if err != nil {
+		return nil, "", err
+	}
+	return payload, contentType, nil
+}
+
+// MultipartReader is like MultipartBody but streams the content of the file instead of
+// reading it into memory. size is the size of the file, which gives the length of the body.
+// The length is -1 when size is negative because the size of the file is unknown.
+func MultipartReader(values url.Values, field string, file File, size int64) (io.Reader, int64, string, error) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		for _, value := range values[key] {
+			if err := writer.WriteField(key, value); err != nil {
+				return nil, 0, "", err
+			}
+		}
+	}
+
+	if file.Content == nil {
+		if err := writer.Close(); err != nil {
+			return nil, 0, "", err
+		}
+		return bytes.NewReader(buf.Bytes()), int64(buf.Len()), writer.FormDataContentType(), nil
+	}
+
+	contentType := file.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", `form-data; name="`+escapeQuotes(field)+`"; filename="`+escapeQuotes(file.Name)+`"`)
+	header.Set("Content-Type", contentType)
+	if _, err := writer.CreatePart(header); err != nil {
+		return nil, 0, "", err
+	}
+	// The writer only writes the closing boundary on Close, which follows the file content.
+	head := buf.Len()
+	if err := writer.Close(); err != nil {
+		return nil, 0, "", err
+	}
+	prefix, suffix := buf.Bytes()[:head], buf.Bytes()[head:]
+
+	length := int64(-1)
+	if size >= 0 {
+		length = int64(len(prefix)) + size + int64(len(suffix))
+	}
+	body := io.MultiReader(bytes.NewReader(prefix), file.Content, bytes.NewReader(suffix))
+	return body, length, writer.FormDataContentType(), nil
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+func escapeQuotes(s string) string {
+	return quoteEscaper.Replace(s)
+}
@@ -0,0 +1,49 @@
+package canvasapi
+
+import (
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestMultipartReader(t *testing.T) {
+	values := url.Values{"key": {"abc"}, "acl": {"private"}}
+	body, length, contentType, err := MultipartReader(values, "file", File{Name: "notes.txt", Content: strings.NewReader("hello")}, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, err := ioutil.ReadAll(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if int64(len(payload)) != length {
+		t.Errorf("expected a body of %d bytes, got %d", length, len(payload))
+	}
+	buffered, bufferedType, err := MultipartBody(values, "file", File{Name: "notes.txt", Content: strings.NewReader("hello")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(buffered) != len(payload) || !strings.HasPrefix(bufferedType, "multipart/form-data; boundary=") {
+		t.Errorf("expected MultipartBody to match the streamed body, got %q", buffered)
+	}
+
+	_, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	form, err := multipart.NewReader(strings.NewReader(string(payload)), params["boundary"]).ReadForm(1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if form.Value["key"][0] != "abc" || form.Value["acl"][0] != "private" || form.File["file"][0].Filename != "notes.txt" || form.File["file"][0].Size != 5 {
+		t.Errorf("unexpected form %+v %+v", form.Value, form.File)
+	}
+
+	_, length, _, err = MultipartReader(values, "file", File{Name: "notes.txt", Content: strings.NewReader("hello")}, -1)
+	if err != nil || length != -1 {
+		t.Errorf("expected an unknown length, got %d %v", length, err)
+	}
+}
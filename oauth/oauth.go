@@ -0,0 +1,208 @@
+// Package oauth implements the Canvas OAuth2 endpoints, see
+// https://canvas.instructure.com/doc/api/file.oauth_endpoints.html
+// Its token sources plug into canvasapi.WithTokenSource and refresh tokens as they expire.
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Token is the response of the Canvas token endpoint.
+type Token struct {
+	AccessToken  string     `json:"access_token"`
+	TokenType    string     `json:"token_type"`
+	RefreshToken string     `json:"refresh_token,omitempty"`
+	ExpiresIn    int64      `json:"expires_in,omitempty"`
+	Scope        string     `json:"scope,omitempty"`
+	User         *TokenUser `json:"user,omitempty"`
+	CanvasRegion string     `json:"canvas_region,omitempty"`
+	// Expiry is computed from ExpiresIn when the token is received. It is zero for tokens
+	// that don't expire.
+	Expiry time.Time `json:"expiry,omitempty"`
+}
+
+// TokenUser is the user a token was issued to.
+type TokenUser struct {
+	ID       int64  `json:"id"`
+	Name     string `json:"name"`
+	GlobalID string `json:"global_id"`
+}
+
+// expired reports whether the token expires within leeway.
+func (t *Token) expired(leeway time.Duration) bool {
+	return !t.Expiry.IsZero() && time.Now().Add(leeway).After(t.Expiry)
+}
+
+// Error is returned when the token endpoint rejects a request.
+type Error struct {
+	StatusCode  int
+	Code        string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+func (e *Error) Error() string {
+	if e.Description != "" {
+		return fmt.Sprintf("oauth: %s: %s", e.Code, e.Description)
+	}
+	return fmt.Sprintf("oauth: %s (HTTP %d)", e.Code, e.StatusCode)
+}
+
+// Config holds the developer key used for the authorization code flow.
+type Config struct {
+	// BaseURL is the url of the Canvas instance, such as https://canvas.example.edu
+	BaseURL      string
+	ClientID     string
+	ClientSecret string
+	RedirectURI  string
+	// HTTPClient sends the requests. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// AuthCodeURL returns the url users are sent to in order to authorize the developer key.
+func (c *Config) AuthCodeURL(state string, scopes ...string) string {
+	params := url.Values{
+		"client_id":     {c.ClientID},
+		"response_type": {"code"},
+		"redirect_uri":  {c.RedirectURI},
+	}
+	if state != "" {
+		params.Set("state", state)
+	}
+	if len(scopes) > 0 {
+		params.Set("scope", strings.Join(scopes, " "))
+	}
+	return c.endpoint("/login/oauth2/auth") + "?" + params.Encode()
+}
+
+// Exchange trades the code Canvas sent to the redirect uri for a token.
+func (c *Config) Exchange(ctx context.Context, code string) (*Token, error) {
+	return postToken(ctx, c.HTTPClient, c.endpoint("/login/oauth2/token"), url.Values{
+		"grant_type":    {"authorization_code"},
+		"client_id":     {c.ClientID},
+		"client_secret": {c.ClientSecret},
+		"redirect_uri":  {c.RedirectURI},
+		"code":          {code},
+	})
+}
+
+// Refresh gets a new access token with a refresh token. Canvas doesn't return the refresh
+// token again, so it is copied to the new token.
+func (c *Config) Refresh(ctx context.Context, refreshToken string) (*Token, error) {
+	token, err := postToken(ctx, c.HTTPClient, c.endpoint("/login/oauth2/token"), url.Values{
+		"grant_type":    {"refresh_token"},
+		"client_id":     {c.ClientID},
+		"client_secret": {c.ClientSecret},
+		"refresh_token": {refreshToken},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if token.RefreshToken == "" {
+		token.RefreshToken = refreshToken
+	}
+	return token, nil
+}
+
+// DeleteToken logs out by deleting the access token and its refresh token. When
+// expireSessions is set the user's web sessions are ended too and Canvas returns the url the
+// user should be sent to in order to finish logging out.
+func (c *Config) DeleteToken(ctx context.Context, accessToken string, expireSessions bool) (forwardURL string, err error) {
+	endpoint := c.endpoint("/login/oauth2/token")
+	if expireSessions {
+		endpoint += "?expire_sessions=1"
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+	request.Header.Set("Authorization", "Bearer "+accessToken)
+	body, err := do(httpClient(c.HTTPClient), request)
+	if err != nil {
+		return "", err
+	}
+	response := struct {
+		ForwardURL string `json:"forward_url"`
+	}{}
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &response); err != nil {
+			return "", err
+		}
+	}
+	return response.ForwardURL, nil
+}
+
+// TokenSource returns a token source that starts with token and refreshes it with its
+// refresh token.
+func (c *Config) TokenSource(token *Token) *TokenSource {
+	source := &TokenSource{token: token}
+	source.fetch = func(ctx context.Context) (*Token, error) {
+		if source.token == nil || source.token.RefreshToken == "" {
+			return nil, fmt.Errorf("oauth: no refresh token")
+		}
+		return c.Refresh(ctx, source.token.RefreshToken)
+	}
+	return source
+}
+
+func (c *Config) endpoint(path string) string {
+	return strings.TrimRight(c.BaseURL, "/") + path
+}
+
+func postToken(ctx context.Context, client *http.Client, endpoint string, params url.Values) (*Token, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(params.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+	body, err := do(httpClient(client), request)
+	if err != nil {
+		return nil, err
+	}
+	token := &Token{}
+	if err := json.Unmarshal(body, token); err != nil {
+		return nil, err
+	}
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("oauth: the token endpoint did not return an access token")
+	}
+	if token.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
+
+func do(client *http.Client, request *http.Request) ([]byte, error) {
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		oauthError := &Error{StatusCode: response.StatusCode}
+		json.Unmarshal(body, oauthError)
+		if oauthError.Code == "" {
+			oauthError.Code = http.StatusText(response.StatusCode)
+		}
+		return nil, oauthError
+	}
+	return body, nil
+}
+
+func httpClient(client *http.Client) *http.Client {
+	if client == nil {
+		return http.DefaultClient
+	}
+	return client
+}
@@ -0,0 +1,234 @@
+package oauth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAuthCodeURL(t *testing.T) {
+	config := &Config{BaseURL: "https://canvas.example.edu/", ClientID: "10000000000001", RedirectURI: "https://app.example.edu/oauth/callback"}
+	u, err := url.Parse(config.AuthCodeURL("xyz", "url:GET|/api/v1/courses", "url:GET|/api/v1/users/:user_id/profile"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Host != "canvas.example.edu" || u.Path != "/login/oauth2/auth" {
+		t.Errorf("unexpected url %s", u)
+	}
+	query := u.Query()
+	if query.Get("client_id") != "10000000000001" || query.Get("response_type") != "code" || query.Get("state") != "xyz" {
+		t.Errorf("unexpected query %v", query)
+	}
+	if query.Get("scope") != "url:GET|/api/v1/courses url:GET|/api/v1/users/:user_id/profile" {
+		t.Errorf("unexpected scope %q", query.Get("scope"))
+	}
+}
+
+func TestExchangeAndRefresh(t *testing.T) {
+	var grants []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/login/oauth2/token" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		r.ParseForm()
+		grants = append(grants, r.PostForm.Get("grant_type"))
+		switch r.PostForm.Get("grant_type") {
+		case "authorization_code":
+			if r.PostForm.Get("code") != "abc" || r.PostForm.Get("client_secret") != "secret" {
+				t.Errorf("unexpected form %v", r.PostForm)
+			}
+			fmt.Fprint(w, `{"access_token":"1~a","token_type":"Bearer","refresh_token":"1~r","expires_in":3600,"user":{"id":42,"name":"Jimi","global_id":"10000000000042"}}`)
+		case "refresh_token":
+			if r.PostForm.Get("refresh_token") != "1~r" {
+				t.Errorf("unexpected refresh token %q", r.PostForm.Get("refresh_token"))
+			}
+			fmt.Fprint(w, `{"access_token":"1~b","token_type":"Bearer","expires_in":3600}`)
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{BaseURL: server.URL, ClientID: "1", ClientSecret: "secret", RedirectURI: "https://app.example.edu/callback"}
+	token, err := config.Exchange(context.Background(), "abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.AccessToken != "1~a" || token.User == nil || token.User.ID != 42 {
+		t.Errorf("unexpected token %+v", token)
+	}
+	if until := time.Until(token.Expiry); until < 59*time.Minute || until > time.Hour {
+		t.Errorf("unexpected expiry %v", token.Expiry)
+	}
+
+	refreshed, err := config.Refresh(context.Background(), token.RefreshToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if refreshed.AccessToken != "1~b" || refreshed.RefreshToken != "1~r" {
+		t.Errorf("expected the refresh token to be kept, got %+v", refreshed)
+	}
+	if strings.Join(grants, ",") != "authorization_code,refresh_token" {
+		t.Errorf("unexpected grants %v", grants)
+	}
+}
+
+func TestTokenEndpointError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"error":"invalid_grant","error_description":"refresh_token not found"}`)
+	}))
+	defer server.Close()
+
+	config := &Config{BaseURL: server.URL}
+	_, err := config.Refresh(context.Background(), "gone")
+	var oauthError *Error
+	if !errors.As(err, &oauthError) {
+		t.Fatalf("expected an oauth error, got %v", err)
+	}
+	if oauthError.StatusCode != http.StatusBadRequest || oauthError.Code != "invalid_grant" {
+		t.Errorf("unexpected error %+v", oauthError)
+	}
+}
+
+func TestDeleteToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Query().Get("expire_sessions") != "1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		if r.Header.Get("Authorization") != "Bearer 1~a" {
+			t.Errorf("unexpected authorization %q", r.Header.Get("Authorization"))
+		}
+		fmt.Fprint(w, `{"forward_url":"https://sso.example.edu/logout"}`)
+	}))
+	defer server.Close()
+
+	config := &Config{BaseURL: server.URL}
+	forwardURL, err := config.DeleteToken(context.Background(), "1~a", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if forwardURL != "https://sso.example.edu/logout" {
+		t.Errorf("unexpected forward url %q", forwardURL)
+	}
+}
+
+func TestTokenSource(t *testing.T) {
+	var mu sync.Mutex
+	fetches := 0
+	source := NewTokenSource(&Token{AccessToken: "old", Expiry: time.Now().Add(30 * time.Second)}, func(ctx context.Context) (*Token, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		fetches++
+		return &Token{AccessToken: fmt.Sprintf("new%d", fetches), Expiry: time.Now().Add(time.Hour)}, nil
+	})
+	var saved []string
+	source.OnRefresh = func(token *Token) {
+		saved = append(saved, token.AccessToken)
+	}
+
+	// The token expires within the leeway, so it is refreshed once by the concurrent callers.
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if token, err := source.Token(context.Background()); err != nil || token != "new1" {
+				t.Errorf("unexpected token %q %v", token, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// A token that was already replaced isn't refreshed again.
+	token, err := source.Refresh(context.Background(), "old")
+	if err != nil || token != "new1" {
+		t.Errorf("unexpected token %q %v", token, err)
+	}
+	token, err = source.Refresh(context.Background(), "new1")
+	if err != nil || token != "new2" {
+		t.Errorf("unexpected token %q %v", token, err)
+	}
+	if strings.Join(saved, ",") != "new1,new2" {
+		t.Errorf("unexpected saved tokens %v", saved)
+	}
+}
+
+func TestClientCredentials(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tokenURL string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.PostForm.Get("grant_type") != "client_credentials" ||
+			r.PostForm.Get("client_assertion_type") != "urn:ietf:params:oauth:client-assertion-type:jwt-bearer" ||
+			r.PostForm.Get("scope") != "https://purl.imsglobal.org/spec/lti-ags/scope/score" {
+			t.Errorf("unexpected form %v", r.PostForm)
+		}
+
+		parts := strings.Split(r.PostForm.Get("client_assertion"), ".")
+		if len(parts) != 3 {
+			t.Errorf("unexpected assertion %q", r.PostForm.Get("client_assertion"))
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		signature, _ := base64.RawURLEncoding.DecodeString(parts[2])
+		digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+		if err := rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], signature); err != nil {
+			t.Errorf("invalid signature: %v", err)
+		}
+		header := map[string]string{}
+		decodeSegment(t, parts[0], &header)
+		if header["alg"] != "RS256" || header["kid"] != "key-1" {
+			t.Errorf("unexpected header %v", header)
+		}
+		claims := map[string]interface{}{}
+		decodeSegment(t, parts[1], &claims)
+		if claims["iss"] != "10000000000007" || claims["sub"] != "10000000000007" || claims["aud"] != tokenURL || claims["jti"] == "" {
+			t.Errorf("unexpected claims %v", claims)
+		}
+
+		fmt.Fprint(w, `{"access_token":"lti","token_type":"Bearer","expires_in":3600,"scope":"https://purl.imsglobal.org/spec/lti-ags/scope/score"}`)
+	}))
+	defer server.Close()
+	tokenURL = server.URL + "/login/oauth2/token"
+
+	credentials := &ClientCredentials{
+		TokenURL:   tokenURL,
+		ClientID:   "10000000000007",
+		KeyID:      "key-1",
+		PrivateKey: key,
+		Scopes:     []string{"https://purl.imsglobal.org/spec/lti-ags/scope/score"},
+	}
+	token, err := credentials.TokenSource().Token(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "lti" {
+		t.Errorf("unexpected token %q", token)
+	}
+}
+
+func decodeSegment(t *testing.T, segment string, v interface{}) {
+	t.Helper()
+	decoded, err := base64.RawURLEncoding.DecodeString(segment)
+	if err == nil {
+		err = json.Unmarshal(decoded, v)
+	}
+	if err != nil {
+		t.Errorf("invalid segment %q: %v", segment, err)
+	}
+}
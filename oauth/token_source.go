@@ -0,0 +1,79 @@
+package oauth
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// expiryLeeway is how long before its expiry a token is refreshed, so a token doesn't expire
+// while a request is in flight.
+const expiryLeeway = time.Minute
+
+// TokenSource caches a token and fetches a new one when it expires or Canvas rejects it. It
+// implements canvasapi.RefreshingTokenSource and is safe for concurrent use; concurrent callers
+// share a single refresh.
+//
+//	source := config.TokenSource(token)
+//	source.OnRefresh = func(token *oauth.Token) { store.Save(userID, token) }
+//	canvas := canvasapi.New("", canvasURL, canvasapi.WithTokenSource(source))
+type TokenSource struct {
+	// OnRefresh is called with every new token, for example to store it. It is called while
+	// the source is locked and must not call the source.
+	OnRefresh func(*Token)
+
+	mu    sync.Mutex
+	token *Token
+	fetch func(context.Context) (*Token, error)
+}
+
+// NewTokenSource returns a token source that gets its tokens from fetch. token is the initial
+// token and may be nil.
+func NewTokenSource(token *Token, fetch func(context.Context) (*Token, error)) *TokenSource {
+	return &TokenSource{token: token, fetch: fetch}
+}
+
+// Token returns the access token, fetching a new one when it is missing or about to expire.
+func (s *TokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token == nil || s.token.AccessToken == "" || s.token.expired(expiryLeeway) {
+		if err := s.refresh(ctx); err != nil {
+			return "", err
+		}
+	}
+	return s.token.AccessToken, nil
+}
+
+// Refresh fetches a new token to replace rejected. When rejected has already been replaced the
+// current token is returned instead.
+func (s *TokenSource) Refresh(ctx context.Context, rejected string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token != nil && s.token.AccessToken != "" && s.token.AccessToken != rejected {
+		return s.token.AccessToken, nil
+	}
+	if err := s.refresh(ctx); err != nil {
+		return "", err
+	}
+	return s.token.AccessToken, nil
+}
+
+// Current returns the cached token without refreshing it.
+func (s *TokenSource) Current() *Token {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.token
+}
+
+func (s *TokenSource) refresh(ctx context.Context) error {
+	token, err := s.fetch(ctx)
+	if err != nil {
+		return err
+	}
+	s.token = token
+	if s.OnRefresh != nil {
+		s.OnRefresh(token)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package canvasapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"time"
+)
+
+// Opt is an optional request parameter. A plain field tagged omitempty can't send false, 0
+// or "" because they look unset. An Opt is only left out when it was never set, so
+// Some(false) sends false and Some("") sends an empty value. Some(time.Time{}) sends an empty
+// value, or null in a JSON body, which clears a date.
+//
+//	updateCourse.Form.Course.IsPublic = canvasapi.Some(false)
+//	editAssignment.Form.Assignment.DueAt = canvasapi.Some(time.Time{})
+type Opt[T any] struct {
+	value T
+	set   bool
+}
+
+// Some returns an Opt set to v.
+func Some[T any](v T) Opt[T] {
+	return Opt[T]{value: v, set: true}
+}
+
+// None returns an unset Opt. It is the same as the zero value.
+func None[T any]() Opt[T] {
+	return Opt[T]{}
+}
+
+// Get returns the value and whether it was set.
+func (o Opt[T]) Get() (T, bool) {
+	return o.value, o.set
+}
+
+// Value returns the value, or the zero value of T when it is unset.
+func (o Opt[T]) Value() T {
+	return o.value
+}
+
+// IsSet reports whether the Opt holds a value.
+func (o Opt[T]) IsSet() bool {
+	return o.set
+}
+
+// IsZero reports whether the Opt is unset. Encoders use it to leave unset parameters out.
+func (o Opt[T]) IsZero() bool {
+	return !o.set
+}
+
+func (o Opt[T]) String() string {
+	if !o.set {
+		return "<unset>"
+	}
+	return fmt.Sprint(o.value)
+}
+
+// EncodeValues implements Encoder. Unset values are not encoded.
+func (o Opt[T]) EncodeValues(key string, v *url.Values) error {
+	if !o.set {
+		return nil
+	}
+	if o.isZeroTime() {
+		v.Add(key, "")
+		return nil
+	}
+	return encodeValue(*v, key, reflect.ValueOf(&o.value).Elem())
+}
+
+// MarshalJSON encodes the value, or null when the Opt is unset or holds the zero time.
+func (o Opt[T]) MarshalJSON() ([]byte, error) {
+	if !o.set || o.isZeroTime() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+func (o Opt[T]) isZeroTime() bool {
+	t, ok := interface{}(o.value).(time.Time)
+	return ok && t.IsZero()
+}
+
+// UnmarshalJSON sets the Opt from any JSON value other than null.
+func (o *Opt[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*o = Opt[T]{}
+		return nil
+	}
+	if err := json.Unmarshal(data, &o.value); err != nil {
+		return err
+	}
+	o.set = true
+	return nil
+}
@@ -0,0 +1,112 @@
+package canvasapi
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestOptEncoding(t *testing.T) {
+	form := struct {
+		Course struct {
+			Name           string        `json:"name" url:"name,omitempty"`
+			IsPublic       Opt[bool]     `json:"is_public" url:"is_public,omitempty"`
+			HideGrades     Opt[bool]     `json:"hide_final_grades" url:"hide_final_grades,omitempty"`
+			PointsPossible Opt[float64]  `json:"points_possible" url:"points_possible,omitempty"`
+			Position       Opt[int64]    `json:"position" url:"position,omitempty"`
+			Tags           Opt[[]string] `json:"tags" url:"tags,omitempty"`
+		} `json:"course" url:"course,omitempty"`
+	}{}
+	form.Course.IsPublic = Some(false)
+	form.Course.PointsPossible = Some(0.0)
+	form.Course.Tags = Some([]string{"a", "b"})
+
+	values, err := EncodeValues(form)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "course%5Bis_public%5D=false&course%5Bpoints_possible%5D=0&course%5Btags%5D%5B%5D=a&course%5Btags%5D%5B%5D=b"
+	if values.Encode() != expected {
+		t.Errorf("EncodeValues\n got: %v\nwant: %v", values.Encode(), expected)
+	}
+
+	body, err := EncodeJSON(form)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedJSON := `{"course":{"is_public":false,"points_possible":0,"tags":["a","b"]}}`
+	if string(body) != expectedJSON {
+		t.Errorf("EncodeJSON\n got: %s\nwant: %s", body, expectedJSON)
+	}
+}
+
+func TestOptJSON(t *testing.T) {
+	var decoded struct {
+		Published Opt[bool]  `json:"published"`
+		Points    Opt[int64] `json:"points"`
+		Missing   Opt[bool]  `json:"missing"`
+	}
+	if err := json.Unmarshal([]byte(`{"published":false,"points":null}`), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if v, ok := decoded.Published.Get(); !ok || v {
+		t.Errorf("expected published to be set to false, got %v", decoded.Published)
+	}
+	if decoded.Points.IsSet() || decoded.Missing.IsSet() {
+		t.Errorf("expected null and missing values to be unset")
+	}
+}
+
+func TestOptEncodingEachKind(t *testing.T) {
+	dueAt := time.Date(2026, 9, 1, 23, 59, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		form  interface{}
+		query string
+		json  string
+	}{
+		{"false", struct {
+			SettingsLocked Opt[bool] `json:"settings_locked" url:"settings_locked,omitempty"`
+		}{Some(false)}, "settings_locked=false", `{"settings_locked":false}`},
+		{"zero int", struct {
+			Position Opt[int64] `json:"position" url:"position,omitempty"`
+		}{Some(int64(0))}, "position=0", `{"position":0}`},
+		{"zero float", struct {
+			Score Opt[float64] `json:"originality_score" url:"originality_score,omitempty"`
+		}{Some(0.0)}, "originality_score=0", `{"originality_score":0}`},
+		{"empty string", struct {
+			Description Opt[string] `json:"description" url:"description,omitempty"`
+		}{Some("")}, "description=", `{"description":""}`},
+		{"string", struct {
+			Description Opt[string] `json:"description" url:"description,omitempty"`
+		}{Some("notes")}, "description=notes", `{"description":"notes"}`},
+		{"zero time", struct {
+			DueAt Opt[time.Time] `json:"due_at" url:"due_at,omitempty"`
+		}{Some(time.Time{})}, "due_at=", `{"due_at":null}`},
+		{"time", struct {
+			DueAt Opt[time.Time] `json:"due_at" url:"due_at,omitempty"`
+		}{Some(dueAt)}, "due_at=2026-09-01T23%3A59%3A00Z", `{"due_at":"2026-09-01T23:59:00Z"}`},
+		{"unset", struct {
+			Description Opt[string]    `json:"description" url:"description,omitempty"`
+			DueAt       Opt[time.Time] `json:"due_at" url:"due_at,omitempty"`
+		}{}, "", `{}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := EncodeValues(tt.form)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if values.Encode() != tt.query {
+				t.Errorf("EncodeValues got %q, want %q", values.Encode(), tt.query)
+			}
+			body, err := EncodeJSON(tt.form)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(body) != tt.json {
+				t.Errorf("EncodeJSON got %s, want %s", body, tt.json)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package canvasapi
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Option configures a Canvas instance created with New.
+type Option func(*Canvas)
+
+// WithHTTPClient sets the http.Client used to send requests. Use it to configure proxies,
+// timeouts, TLS client certificates or a recording transport for tests.
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *Canvas) {
+		c.HTTPClient = client
+	}
+}
+
+// WithScheme sets the url scheme used to reach Canvas. The default is https.
+func WithScheme(scheme string) Option {
+	return func(c *Canvas) {
+		c.Scheme = scheme
+	}
+}
+
+// WithBaseURL sets the scheme, the host and the base path of the Canvas instance from a url
+// such as "http://localhost:3000" or "https://example.com/canvas". A value without a scheme
+// is treated as a host name.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Canvas) {
+		u, err := url.Parse(baseURL)
+		if err != nil || u.Host == "" {
+			c.CanvasURL = strings.TrimSuffix(baseURL, "/")
+			return
+		}
+		c.Scheme = u.Scheme
+		c.CanvasURL = u.Host
+		c.BasePath = strings.TrimSuffix(u.Path, "/")
+	}
+}
+
+// WithUserAgent overrides the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Canvas) {
+		c.UserAgent = userAgent
+	}
+}
+
+// WithHeader adds a header that is sent with every request.
+func WithHeader(key, value string) Option {
+	return func(c *Canvas) {
+		if c.Header == nil {
+			c.Header = http.Header{}
+		}
+		c.Header.Add(key, value)
+	}
+}
+
+// WithJSONBodies sends every request body as JSON instead of form encoding it.
+func WithJSONBodies() Option {
+	return func(c *Canvas) {
+		c.JSONBodies = true
+	}
+}
+
+// WithStringIDs asks Canvas to return ids as strings.
+func WithStringIDs() Option {
+	return func(c *Canvas) {
+		c.StringIDs = true
+	}
+}
+
+// WithStrictModels validates every decoded response, see Canvas.StrictModels.
+func WithStrictModels() Option {
+	return func(c *Canvas) {
+		c.StrictModels = true
+	}
+}
+
+// WithAsUser makes every request act as the user with the given id. Use Canvas.WithAsUser to
+// act as a user for a single call.
+func WithAsUser(id ID) Option {
+	return func(c *Canvas) {
+		c.AsUserID = id
+	}
+}
@@ -0,0 +1,205 @@
+package canvasapi
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+type testRequest struct {
+	method string
+	path   string
+	query  string
+	body   url.Values
+	json   []byte
+}
+
+func (t *testRequest) GetMethod() string            { return t.method }
+func (t *testRequest) GetURLPath() string           { return t.path }
+func (t *testRequest) GetQuery() (string, error)    { return t.query, nil }
+func (t *testRequest) GetBody() (url.Values, error) { return t.body, nil }
+func (t *testRequest) GetJSON() ([]byte, error)     { return t.json, nil }
+func (t *testRequest) HasErrors() error             { return nil }
+
+func TestNewWithOptions(t *testing.T) {
+	var got *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{}
+	canvas := New("token", "unused.example.com",
+		WithBaseURL(server.URL),
+		WithHTTPClient(client),
+		WithUserAgent("test agent"),
+		WithHeader("X-Test", "yes"),
+	)
+	if canvas.Scheme != "http" || canvas.HTTPClient != client {
+		t.Fatalf("expected options to configure the canvas instance, got %+v", canvas)
+	}
+
+	response, err := canvas.SendRequest(&testRequest{method: http.MethodGet, path: "courses/1"})
+	if err != nil {
+		t.Fatalf("SendRequest failed: %v", err)
+	}
+	response.Body.Close()
+
+	if got.URL.Path != "/api/v1/courses/1" {
+		t.Errorf("expected path /api/v1/courses/1, got %v", got.URL.Path)
+	}
+	if got.UserAgent() != "test agent" {
+		t.Errorf("expected user agent 'test agent', got %v", got.UserAgent())
+	}
+	if got.Header.Get("X-Test") != "yes" {
+		t.Errorf("expected X-Test header to be sent")
+	}
+	if got.Header.Get("Authorization") != "Bearer token" {
+		t.Errorf("expected bearer token to be sent, got %v", got.Header.Get("Authorization"))
+	}
+}
+
+type testJSONRequest struct {
+	testRequest
+}
+
+func (t *testJSONRequest) PrefersJSON() bool { return true }
+
+func TestSendRequestJSONBodies(t *testing.T) {
+	var contentType, body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+	}))
+	defer server.Close()
+
+	request := testRequest{
+		method: http.MethodPost,
+		path:   "courses/1/rubric_associations/2/rubric_assessments",
+		body:   url.Values{"a": {"1"}},
+		json:   []byte(`{"a":1}`),
+	}
+	tests := []struct {
+		name        string
+		options     []Option
+		request     CanvasRequest
+		contentType string
+		body        string
+	}{
+		{"form by default", nil, &request, "application/x-www-form-urlencoded", "a=1"},
+		{"request prefers json", nil, &testJSONRequest{request}, "application/json", `{"a":1}`},
+		{"caller opts in", []Option{WithJSONBodies()}, &request, "application/json", `{"a":1}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			canvas := New("token", "", append([]Option{WithBaseURL(server.URL)}, tt.options...)...)
+			response, err := canvas.SendRequest(tt.request)
+			if err != nil {
+				t.Fatal(err)
+			}
+			response.Body.Close()
+			if contentType != tt.contentType || body != tt.body {
+				t.Errorf("sent %q with body %q, want %q with %q", contentType, body, tt.contentType, tt.body)
+			}
+		})
+	}
+}
+
+func TestWithStringIDs(t *testing.T) {
+	var accept string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accept = r.Header.Get("Accept")
+	}))
+	defer server.Close()
+
+	canvas := New("token", "", WithBaseURL(server.URL), WithStringIDs())
+	response, err := canvas.SendRequest(&testRequest{method: http.MethodGet, path: "courses/1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+	if accept != "application/json+canvas-string-ids" {
+		t.Errorf("expected the string ids Accept header, got %q", accept)
+	}
+}
+
+func TestWithAsUser(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+	}))
+	defer server.Close()
+
+	canvas := New("token", "", WithBaseURL(server.URL))
+	send := func(c *Canvas, request CanvasRequest) {
+		response, err := c.SendRequest(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		response.Body.Close()
+	}
+	send(canvas.WithAsUser(SISUserID("s1")), &testRequest{method: http.MethodGet, path: "users/self/todo", query: "include%5B%5D=ungraded_quizzes"})
+	send(canvas.WithAsUser(IDFromInt(7)), &testRequest{method: http.MethodPost, path: "courses/1/assignments/2/submissions", body: url.Values{"comment": {"x"}}})
+	send(&canvas, &testRequest{method: http.MethodGet, path: "courses"})
+
+	next, _ := url.Parse(server.URL + "/api/v1/users/self/todo?page=2&as_user_id=sis_user_id%3As1")
+	masqueraded := New("token", "", WithBaseURL(server.URL), WithAsUser(SISUserID("s1")))
+	response, err := masqueraded.Send(next, http.MethodGet, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+
+	expected := []string{
+		"include%5B%5D=ungraded_quizzes&as_user_id=sis_user_id%3As1",
+		"as_user_id=7",
+		"",
+		"page=2&as_user_id=sis_user_id%3As1",
+	}
+	if strings.Join(queries, " | ") != strings.Join(expected, " | ") {
+		t.Errorf("unexpected queries\n got: %q\nwant: %q", queries, expected)
+	}
+}
+
+func TestWithBaseURLPath(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.EscapedPath())
+	}))
+	defer server.Close()
+
+	canvas := New("token", "", WithBaseURL(server.URL+"/canvas/"))
+	if canvas.BasePath != "/canvas" {
+		t.Fatalf("expected base path /canvas, got %q", canvas.BasePath)
+	}
+	for _, request := range []CanvasRequest{
+		&testRequest{method: http.MethodGet, path: "courses/sis_course_id:a%2Fb"},
+		&testRequest{method: http.MethodGet, path: "/lti/courses/1/line_items"},
+	} {
+		response, err := canvas.SendRequest(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		response.Body.Close()
+	}
+	expected := "/canvas/api/v1/courses/sis_course_id:a%2Fb /canvas/api/lti/courses/1/line_items"
+	if strings.Join(paths, " ") != expected {
+		t.Errorf("unexpected paths %q", paths)
+	}
+
+	for location, want := range map[string]string{
+		"files/1/download":              server.URL + "/canvas/files/1/download",
+		"/canvas/api/v1/progress/2":     server.URL + "/canvas/api/v1/progress/2",
+		"https://files.example.com/a/b": "https://files.example.com/a/b",
+	} {
+		u, err := canvas.ResolveURL(location)
+		if err != nil || u.String() != want {
+			t.Errorf("ResolveURL(%q) = %v, %v, want %s", location, u, err, want)
+		}
+	}
+}
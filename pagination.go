@@ -1,28 +1,32 @@
 package canvasapi
 
 import (
-	"fmt"
 	"net/http"
 	"net/url"
 	"regexp"
 	"strconv"
-	"strings"
 )
 
 var resourceRegex = regexp.MustCompile(`<(.*?)>; rel="(.*?)"`)
 
+// PagedResource holds the links Canvas sends in the Link header of a paginated response.
+// Any of the links may be nil. Canvas omits last on large collections and Current and
+// First on some endpoints, Next is nil on the last page.
 type PagedResource struct {
 	Current, First, Last, Next *PagedLink
+	// RateLimit holds the rate limit headers of the response the links were read from.
+	RateLimit RateLimit
 }
 
+// PagedLink is one link of the Link header. Page is 0 when the page parameter is not a
+// number, for example when Canvas uses bookmark pagination.
 type PagedLink struct {
 	URL  *url.URL
 	Page int
 }
 
 func ExtractPagedResource(header http.Header) (*PagedResource, error) {
-	errs := []string{}
-	pagedResource := &PagedResource{}
+	pagedResource := &PagedResource{RateLimit: ParseRateLimit(header)}
 	links := header.Get("Link")
 	parts := resourceRegex.FindAllStringSubmatch(links, -1)
 	m := map[string]*PagedLink{}
@@ -34,20 +38,10 @@ func ExtractPagedResource(header http.Header) (*PagedResource, error) {
 			return pagedResource, err
 		}
 	}
-	var ok bool
-	if pagedResource.Current, ok = m["current"]; !ok {
-		errs = append(errs, "could not find current link")
-	}
-	if pagedResource.First, ok = m["first"]; !ok {
-		errs = append(errs, "could not find first link")
-	}
-	if pagedResource.Last, ok = m["last"]; !ok {
-		errs = append(errs, "could not find last link")
-	}
-	pagedResource.Next, _ = m["next"]
-	if len(errs) > 0 {
-		return nil, fmt.Errorf(strings.Join(errs, ", "))
-	}
+	pagedResource.Current = m["current"]
+	pagedResource.First = m["first"]
+	pagedResource.Last = m["last"]
+	pagedResource.Next = m["next"]
 	return pagedResource, nil
 }
 
@@ -56,10 +50,7 @@ func newPagedLink(urlstr string) (*PagedLink, error) {
 	if err != nil {
 		return nil, err
 	}
-	page, err := strconv.ParseInt(u.Query().Get("page"), 10, 32)
-	if err != nil {
-		return nil, fmt.Errorf("could not parse page num: %w", err)
-	}
+	page, _ := strconv.ParseInt(u.Query().Get("page"), 10, 32)
 	return &PagedLink{
 		URL:  u,
 		Page: int(page),
@@ -0,0 +1,160 @@
+package canvasapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sync"
+)
+
+const (
+	defaultParallelWorkers      = 4
+	defaultParallelMinRemaining = 100
+)
+
+// ParallelOptions configures AllParallel.
+type ParallelOptions[T any] struct {
+	// Workers is the maximum number of pages fetched at the same time. Defaults to 4.
+	Workers int
+	// Key identifies an item for de-duplication. Items that move between pages while the
+	// collection is fetched can show up twice. Defaults to comparing the JSON of the items,
+	// which marshals every item, so set Key to return the id for large collections.
+	Key func(T) string
+	// MinRemaining is the X-Rate-Limit-Remaining value below which pages are fetched one at
+	// a time. Defaults to 100.
+	MinRemaining float64
+}
+
+// AllParallel collects every item of a paged request. When the first response has a last
+// link the remaining pages are known up front and are fetched concurrently by up to
+// Workers goroutines. Without a last link the next links are followed one after another.
+// Items are returned in page order with duplicates removed.
+func AllParallel[T any](ctx context.Context, c *Canvas, request PagedRequest[T], options ParallelOptions[T]) ([]T, error) {
+	if options.Workers <= 0 {
+		options.Workers = defaultParallelWorkers
+	}
+	if options.MinRemaining == 0 {
+		options.MinRemaining = defaultParallelMinRemaining
+	}
+	if options.Key == nil {
+		options.Key = jsonKey[T]
+	}
+
+	first, pagedResource, err := request.DoContext(ctx, c, nil)
+	if err != nil {
+		return nil, err
+	}
+	pages := [][]T{first}
+
+	if pagedResource != nil && pagedResource.Last != nil && pagedResource.Last.Page > 1 && pagedResource.Next != nil {
+		rest, err := fetchPages(ctx, c, request, pagedResource.Last, options)
+		if err != nil {
+			return nil, err
+		}
+		pages = append(pages, rest...)
+	} else {
+		seen := map[string]bool{}
+		for pagedResource != nil && pagedResource.Next != nil && !seen[pagedResource.Next.URL.String()] {
+			seen[pagedResource.Next.URL.String()] = true
+			var page []T
+			page, pagedResource, err = request.DoContext(ctx, c, pagedResource.Next.URL)
+			if err != nil {
+				return nil, err
+			}
+			pages = append(pages, page)
+		}
+	}
+
+	all := []T{}
+	keys := map[string]bool{}
+	for _, page := range pages {
+		for _, item := range page {
+			key := options.Key(item)
+			if keys[key] {
+				continue
+			}
+			keys[key] = true
+			all = append(all, item)
+		}
+	}
+	return all, nil
+}
+
+// fetchPages fetches pages 2 through last.Page concurrently and returns them in order.
+func fetchPages[T any](ctx context.Context, c *Canvas, request PagedRequest[T], last *PagedLink, options ParallelOptions[T]) ([][]T, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pages := make([][]T, last.Page-1)
+	pageNumbers := make(chan int)
+	var lowBucket sync.Mutex
+	var once sync.Once
+	var firstErr error
+	var wg sync.WaitGroup
+
+	for i := 0; i < options.Workers && i < len(pages); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for number := range pageNumbers {
+				page, err := fetchPage(ctx, c, request, pageURL(last.URL, number), options.MinRemaining, &lowBucket)
+				if err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+					continue
+				}
+				pages[number-2] = page
+			}
+		}()
+	}
+
+send:
+	for number := 2; number <= last.Page; number++ {
+		select {
+		case pageNumbers <- number:
+		case <-ctx.Done():
+			break send
+		}
+	}
+	close(pageNumbers)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return pages, nil
+}
+
+// fetchPage fetches a single page. While the rate limit bucket is below minRemaining the
+// workers take turns so that only one request is in flight.
+func fetchPage[T any](ctx context.Context, c *Canvas, request PagedRequest[T], u *url.URL, minRemaining float64, lowBucket *sync.Mutex) ([]T, error) {
+	if rateLimit := c.RateLimit(); rateLimit.Present && rateLimit.Remaining < minRemaining {
+		lowBucket.Lock()
+		defer lowBucket.Unlock()
+	}
+	page, _, err := request.DoContext(ctx, c, u)
+	return page, err
+}
+
+// pageURL returns a copy of u requesting the given page.
+func pageURL(u *url.URL, page int) *url.URL {
+	next := *u
+	query := next.Query()
+	query.Set("page", fmt.Sprintf("%d", page))
+	next.RawQuery = query.Encode()
+	return &next
+}
+
+func jsonKey[T any](item T) string {
+	b, err := json.Marshal(item)
+	if err != nil {
+		return fmt.Sprintf("%#v", item)
+	}
+	return string(b)
+}
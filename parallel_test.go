@@ -0,0 +1,89 @@
+package canvasapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAllParallel(t *testing.T) {
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page == 0 {
+			page = 1
+		}
+		links := fmt.Sprintf(`<%s/api/v1/items?page=5&per_page=2>; rel="last"`, server.URL)
+		if page < 5 {
+			links += fmt.Sprintf(`, <%s/api/v1/items?page=%d&per_page=2>; rel="next"`, server.URL, page+1)
+		}
+		w.Header().Set("Link", links)
+		// the last item of every page is repeated as the first item of the next page
+		json.NewEncoder(w).Encode([]int{page*2 - 2, page*2 - 1, page * 2})
+	}))
+	defer server.Close()
+
+	canvas := New("test", "", WithBaseURL(server.URL))
+	request := &testPagedRequest{testRequest{method: http.MethodGet, path: "items"}}
+	items, err := AllParallel[int](context.Background(), &canvas, request, ParallelOptions[int]{Workers: 2})
+	if err != nil {
+		t.Fatalf("AllParallel failed: %v", err)
+	}
+	if fmt.Sprint(items) != fmt.Sprint([]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}) {
+		t.Errorf("expected ordered and de-duplicated items, got %v", items)
+	}
+	if maxInFlight > 2 {
+		t.Errorf("expected at most 2 concurrent requests, got %v", maxInFlight)
+	}
+
+	keys := 0
+	items, err = AllParallel[int](context.Background(), &canvas, request, ParallelOptions[int]{
+		Key: func(item int) string {
+			keys++
+			return strconv.Itoa(item / 2)
+		},
+	})
+	if err != nil {
+		t.Fatalf("AllParallel failed: %v", err)
+	}
+	if fmt.Sprint(items) != fmt.Sprint([]int{0, 2, 4, 6, 8, 10}) || keys != 15 {
+		t.Errorf("expected items de-duplicated by the custom key, got %v after %d keys", items, keys)
+	}
+}
+
+func TestAllParallelFallsBackToNextLinks(t *testing.T) {
+	requested := 0
+	server := newPagedServer(3, &requested)
+	defer server.Close()
+
+	canvas := New("test", "", WithBaseURL(server.URL))
+	request := &testPagedRequest{testRequest{method: http.MethodGet, path: "items"}}
+	items, err := AllParallel[int](context.Background(), &canvas, request, ParallelOptions[int]{})
+	if err != nil {
+		t.Fatalf("AllParallel failed: %v", err)
+	}
+	if fmt.Sprint(items) != fmt.Sprint([]int{1, 2, 3, 4, 5, 6}) || requested != 3 {
+		t.Errorf("expected every page to be walked, got %v after %v requests", items, requested)
+	}
+}
@@ -0,0 +1,136 @@
+package canvasapi
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	defaultThrottleThreshold = 100
+	defaultThrottleMaxDelay  = 2 * time.Second
+)
+
+// RateLimit holds the rate limit headers Canvas sends with every response.
+// See https://canvas.instructure.com/doc/api/file.throttling.html
+type RateLimit struct {
+	// Cost is the value of X-Request-Cost, the amount the request took from the bucket.
+	Cost float64
+	// Remaining is the value of X-Rate-Limit-Remaining, what is left in the bucket.
+	Remaining float64
+	// Present is true if the response carried the rate limit headers.
+	Present bool
+}
+
+// ParseRateLimit reads the Canvas rate limit headers from a response header.
+func ParseRateLimit(header http.Header) RateLimit {
+	rateLimit := RateLimit{}
+	if v := header.Get("X-Request-Cost"); v != "" {
+		rateLimit.Cost, _ = strconv.ParseFloat(v, 64)
+	}
+	if v := header.Get("X-Rate-Limit-Remaining"); v != "" {
+		if remaining, err := strconv.ParseFloat(v, 64); err == nil {
+			rateLimit.Remaining = remaining
+			rateLimit.Present = true
+		}
+	}
+	return rateLimit
+}
+
+// Throttle slows requests down before the Canvas rate limit bucket is empty. When the
+// last seen X-Rate-Limit-Remaining drops below Threshold each request waits for a delay
+// that grows linearly from zero at Threshold to MaxDelay at an empty bucket.
+type Throttle struct {
+	Threshold float64
+	MaxDelay  time.Duration
+}
+
+// WithThrottle enables client side throttling based on the rate limit headers.
+func WithThrottle(throttle Throttle) Option {
+	return func(c *Canvas) {
+		c.Throttle = &throttle
+	}
+}
+
+func (t *Throttle) delay(rateLimit RateLimit) time.Duration {
+	threshold := t.Threshold
+	if threshold <= 0 {
+		threshold = defaultThrottleThreshold
+	}
+	maxDelay := t.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = defaultThrottleMaxDelay
+	}
+	if !rateLimit.Present || rateLimit.Remaining >= threshold {
+		return 0
+	}
+	remaining := rateLimit.Remaining
+	if remaining < 0 {
+		remaining = 0
+	}
+	return time.Duration(float64(maxDelay) * (1 - remaining/threshold))
+}
+
+type rateLimitState struct {
+	mu   sync.Mutex
+	last RateLimit
+}
+
+// rateLimitInit guards the creation of the rate limit state of a Canvas that was not made
+// with New. The state can't hold its own lock because Canvas is copied by value.
+var rateLimitInit sync.Mutex
+
+// rateLimits returns the rate limit state of c, creating it on first use.
+func (c *Canvas) rateLimits() *rateLimitState {
+	rateLimitInit.Lock()
+	defer rateLimitInit.Unlock()
+	if c.rateLimit == nil {
+		c.rateLimit = &rateLimitState{}
+	}
+	return c.rateLimit
+}
+
+// RateLimit returns the rate limit headers of the most recent response from Canvas. The
+// headers of a single paged response are in PagedResource.RateLimit.
+func (c *Canvas) RateLimit() RateLimit {
+	state := c.rateLimits()
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	return state.last
+}
+
+func (c *Canvas) recordRateLimit(header http.Header) RateLimit {
+	rateLimit := ParseRateLimit(header)
+	if rateLimit.Present {
+		state := c.rateLimits()
+		state.mu.Lock()
+		state.last = rateLimit
+		state.mu.Unlock()
+	}
+	return rateLimit
+}
+
+// throttle waits before a request if the Throttle is configured and the bucket is low.
+func (c *Canvas) throttle(ctx context.Context) error {
+	if c.Throttle == nil {
+		return nil
+	}
+	return sleep(ctx, c.Throttle.delay(c.RateLimit()))
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
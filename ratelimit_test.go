@@ -0,0 +1,108 @@
+package canvasapi
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRateLimitDetection(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		body      string
+		rateLimit bool
+	}{
+		{name: "throttled", status: http.StatusForbidden, body: "403 Forbidden (Rate Limit Exceeded)", rateLimit: true},
+		{name: "too many requests", status: http.StatusTooManyRequests, body: "", rateLimit: true},
+		{name: "permission denied", status: http.StatusForbidden, body: `{"status":"unauthorized","errors":[{"message":"user not authorized to perform that action"}]}`, rateLimit: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-Request-Cost", "1.5")
+				w.Header().Set("X-Rate-Limit-Remaining", "0.0")
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			canvas := New("test", "", WithBaseURL(server.URL))
+			_, err := canvas.SendRequest(&testRequest{method: http.MethodGet, path: "courses"})
+			if IsRateLimit(err) != tt.rateLimit {
+				t.Errorf("expected IsRateLimit to be %v for %v", tt.rateLimit, err)
+			}
+			if IsRateLimit(fmt.Errorf("wrapped: %w", err)) != tt.rateLimit {
+				t.Errorf("expected IsRateLimit to see through wrapped errors")
+			}
+			if rateLimit := canvas.RateLimit(); !rateLimit.Present || rateLimit.Cost != 1.5 || rateLimit.Remaining != 0 {
+				t.Errorf("expected rate limit headers to be recorded, got %+v", rateLimit)
+			}
+		})
+	}
+}
+
+func TestThrottleDelay(t *testing.T) {
+	throttle := Throttle{Threshold: 100, MaxDelay: time.Second}
+	tests := []struct {
+		rateLimit RateLimit
+		delay     time.Duration
+	}{
+		{rateLimit: RateLimit{}, delay: 0},
+		{rateLimit: RateLimit{Remaining: 600, Present: true}, delay: 0},
+		{rateLimit: RateLimit{Remaining: 50, Present: true}, delay: 500 * time.Millisecond},
+		{rateLimit: RateLimit{Remaining: -3, Present: true}, delay: time.Second},
+	}
+	for _, tt := range tests {
+		if delay := throttle.delay(tt.rateLimit); delay != tt.delay {
+			t.Errorf("expected delay %v for %+v, got %v", tt.delay, tt.rateLimit, delay)
+		}
+	}
+}
+
+func TestRateLimitCanvasLiteral(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Cost", "2")
+		w.Header().Set("X-Rate-Limit-Remaining", "10")
+	}))
+	defer server.Close()
+
+	canvas := Canvas{
+		AccessToken: "test",
+		CanvasURL:   server.Listener.Addr().String(),
+		Scheme:      "http",
+		Throttle:    &Throttle{Threshold: 100, MaxDelay: time.Second},
+	}
+	masqueraded := canvas.WithAsUser(IDFromInt(3))
+	response, err := canvas.SendRequest(&testRequest{method: http.MethodGet, path: "courses"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+	expected := RateLimit{Cost: 2, Remaining: 10, Present: true}
+	if rateLimit := canvas.RateLimit(); rateLimit != expected {
+		t.Errorf("expected the rate limit to be recorded, got %+v", rateLimit)
+	}
+	if rateLimit := masqueraded.RateLimit(); rateLimit != expected {
+		t.Errorf("expected copies to share the rate limit, got %+v", rateLimit)
+	}
+	if delay := canvas.Throttle.delay(canvas.RateLimit()); delay != 900*time.Millisecond {
+		t.Errorf("expected the throttle to engage, got %v", delay)
+	}
+}
+
+func TestPagedResourceRateLimit(t *testing.T) {
+	header := http.Header{}
+	header.Set("Link", `<https://example.com/api/v1/courses?page=2>; rel="next"`)
+	header.Set("X-Request-Cost", "0.5")
+	header.Set("X-Rate-Limit-Remaining", "599.5")
+	pagedResource, err := ExtractPagedResource(header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pagedResource.RateLimit != (RateLimit{Cost: 0.5, Remaining: 599.5, Present: true}) || pagedResource.Next.Page != 2 {
+		t.Errorf("unexpected paged resource %+v", pagedResource)
+	}
+}
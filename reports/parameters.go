@@ -0,0 +1,103 @@
+package reports
+
+import (
+	"github.com/atomicjolt/canvasapi"
+)
+
+// Names of the built in reports, see
+// https://canvas.instructure.com/doc/api/file.account_reports.html
+const (
+	ProvisioningReport           = "provisioning_csv"
+	SISExportReport              = "sis_export_csv"
+	GradeExportReport            = "grade_export_csv"
+	LastUserAccessReport         = "last_user_access_csv"
+	LastEnrollmentActivityReport = "last_enrollment_activity_csv"
+)
+
+// Parameters are the parameters of a report. The parameter structs of this package name the
+// report they belong to, and Custom runs any other report.
+type Parameters interface {
+	ReportName() string
+}
+
+// Provisioning are the parameters of the provisioning report. Every kind of data that is set
+// becomes a CSV of the report, and the report is zipped when more than one is set.
+type Provisioning struct {
+	// EnrollmentTermID limits the report to a term. Use canvasapi.SISTermID for SIS ids.
+	EnrollmentTermID       canvasapi.ID        `json:"enrollment_term_id,omitempty" url:"enrollment_term_id,omitempty"`
+	Users                  canvasapi.Opt[bool] `json:"users,omitempty" url:"users,omitempty"`
+	Accounts               canvasapi.Opt[bool] `json:"accounts,omitempty" url:"accounts,omitempty"`
+	Terms                  canvasapi.Opt[bool] `json:"terms,omitempty" url:"terms,omitempty"`
+	Courses                canvasapi.Opt[bool] `json:"courses,omitempty" url:"courses,omitempty"`
+	Sections               canvasapi.Opt[bool] `json:"sections,omitempty" url:"sections,omitempty"`
+	Enrollments            canvasapi.Opt[bool] `json:"enrollments,omitempty" url:"enrollments,omitempty"`
+	Groups                 canvasapi.Opt[bool] `json:"groups,omitempty" url:"groups,omitempty"`
+	GroupCategories        canvasapi.Opt[bool] `json:"group_categories,omitempty" url:"group_categories,omitempty"`
+	GroupMembership        canvasapi.Opt[bool] `json:"group_membership,omitempty" url:"group_membership,omitempty"`
+	Xlist                  canvasapi.Opt[bool] `json:"xlist,omitempty" url:"xlist,omitempty"`
+	UserObservers          canvasapi.Opt[bool] `json:"user_observers,omitempty" url:"user_observers,omitempty"`
+	Admins                 canvasapi.Opt[bool] `json:"admins,omitempty" url:"admins,omitempty"`
+	IncludeDeleted         canvasapi.Opt[bool] `json:"include_deleted,omitempty" url:"include_deleted,omitempty"`
+	IncludeEnrollmentState canvasapi.Opt[bool] `json:"include_enrollment_state,omitempty" url:"include_enrollment_state,omitempty"`
+	// EnrollmentState limits enrollments to these states when IncludeEnrollmentState is set,
+	// such as active, invited, completed or all.
+	EnrollmentState []string `json:"enrollment_state,omitempty" url:"enrollment_state,omitempty"`
+	// CreatedBySIS only reports data that was created by a SIS import.
+	CreatedBySIS canvasapi.Opt[bool] `json:"created_by_sis,omitempty" url:"created_by_sis,omitempty"`
+}
+
+func (p *Provisioning) ReportName() string {
+	return ProvisioningReport
+}
+
+// SISExport are the parameters of the SIS export report. It takes the same parameters as the
+// provisioning report but only reports the columns of a SIS import.
+type SISExport Provisioning
+
+func (p *SISExport) ReportName() string {
+	return SISExportReport
+}
+
+// GradeExport are the parameters of the grade export report.
+type GradeExport struct {
+	EnrollmentTermID canvasapi.ID        `json:"enrollment_term_id,omitempty" url:"enrollment_term_id,omitempty"`
+	IncludeDeleted   canvasapi.Opt[bool] `json:"include_deleted,omitempty" url:"include_deleted,omitempty"`
+}
+
+func (p *GradeExport) ReportName() string {
+	return GradeExportReport
+}
+
+// LastUserAccess are the parameters of the last user access report.
+type LastUserAccess struct {
+	EnrollmentTermID canvasapi.ID        `json:"enrollment_term_id,omitempty" url:"enrollment_term_id,omitempty"`
+	CourseID         canvasapi.ID        `json:"course_id,omitempty" url:"course_id,omitempty"`
+	IncludeDeleted   canvasapi.Opt[bool] `json:"include_deleted,omitempty" url:"include_deleted,omitempty"`
+}
+
+func (p *LastUserAccess) ReportName() string {
+	return LastUserAccessReport
+}
+
+// LastEnrollmentActivity are the parameters of the last enrollment activity report.
+type LastEnrollmentActivity struct {
+	EnrollmentTermID canvasapi.ID `json:"enrollment_term_id,omitempty" url:"enrollment_term_id,omitempty"`
+	CourseID         canvasapi.ID `json:"course_id,omitempty" url:"course_id,omitempty"`
+}
+
+func (p *LastEnrollmentActivity) ReportName() string {
+	return LastEnrollmentActivityReport
+}
+
+// Custom runs a report this package has no parameter struct for. List Available Reports
+// describes the parameters of every report of an account.
+type Custom struct {
+	Report string
+	// Parameters are sent as parameters[name]. Values may be strings, numbers, booleans,
+	// times or slices of them.
+	Parameters map[string]interface{}
+}
+
+func (p *Custom) ReportName() string {
+	return p.Report
+}
@@ -0,0 +1,178 @@
+package reports
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// timeLayouts are the formats Canvas writes times in its reports.
+var timeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05 -0700",
+	"2006-01-02 15:04:05 MST",
+	"2006-01-02",
+}
+
+// Reader reads the rows of a report CSV one at a time. Columns are matched by the names in
+// the header row.
+type Reader struct {
+	csv     *csv.Reader
+	header  []string
+	columns map[string]int
+}
+
+// NewReader reads the header row of r.
+func NewReader(r io.Reader) (*Reader, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))
+		header[i] = name
+		columns[name] = i
+	}
+	return &Reader{csv: reader, header: header, columns: columns}, nil
+}
+
+// Header returns the column names of the report.
+func (r *Reader) Header() []string {
+	return r.header
+}
+
+// Read returns the next row by column name. It returns io.EOF after the last row.
+func (r *Reader) Read() (map[string]string, error) {
+	record, err := r.csv.Read()
+	if err != nil {
+		return nil, err
+	}
+	row := make(map[string]string, len(r.header))
+	for i, name := range r.header {
+		if i < len(record) {
+			row[name] = record[i]
+		}
+	}
+	return row, nil
+}
+
+// Decode reads the next row into the struct v points to. Fields are matched to columns by
+// their csv tag and columns the report doesn't have are left unset. Fields may be strings,
+// numbers, booleans, times or pointers to them, which stay nil when the cell is empty. It
+// returns io.EOF after the last row.
+func (r *Reader) Decode(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("reports: Decode needs a pointer to a struct, got %T", v)
+	}
+	record, err := r.csv.Read()
+	if err != nil {
+		return err
+	}
+	rv = rv.Elem()
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		name := rt.Field(i).Tag.Get("csv")
+		if name == "" || name == "-" {
+			continue
+		}
+		column, ok := r.columns[name]
+		if !ok || column >= len(record) {
+			continue
+		}
+		if err := setCell(rv.Field(i), record[column]); err != nil {
+			return fmt.Errorf("reports: column %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Each decodes every row of a report CSV into a T and calls fn with it.
+//
+//	err := reports.Each(file, func(row *reports.LastUserAccessRow) error {
+//		fmt.Println(row.UserName, row.LastAccessAt)
+//		return nil
+//	})
+func Each[T any](r io.Reader, fn func(*T) error) error {
+	reader, err := NewReader(r)
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for {
+		row := new(T)
+		if err := reader.Decode(row); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+}
+
+func setCell(field reflect.Value, cell string) error {
+	cell = strings.TrimSpace(cell)
+	if field.Kind() == reflect.Ptr {
+		if cell == "" {
+			field.Set(reflect.Zero(field.Type()))
+			return nil
+		}
+		value := reflect.New(field.Type().Elem())
+		if err := setCell(value.Elem(), cell); err != nil {
+			return err
+		}
+		field.Set(value)
+		return nil
+	}
+	if cell == "" {
+		field.Set(reflect.Zero(field.Type()))
+		return nil
+	}
+
+	if field.Type() == reflect.TypeOf(time.Time{}) {
+		for _, layout := range timeLayouts {
+			if t, err := time.Parse(layout, cell); err == nil {
+				field.Set(reflect.ValueOf(t))
+				return nil
+			}
+		}
+		return fmt.Errorf("unsupported time %q", cell)
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(cell)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(cell)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(cell, 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetInt(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(cell, 64)
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported type %v", field.Type())
+	}
+	return nil
+}
@@ -0,0 +1,208 @@
+// Package reports runs account reports and reads their CSV files, see
+// https://canvas.instructure.com/doc/api/account_reports.html
+//
+//	report, err := reports.Run(ctx, &canvas, canvasapi.Self, &reports.GradeExport{
+//		EnrollmentTermID: canvasapi.SISTermID("fall"),
+//	}, reports.Options{})
+//	file, err := reports.Open(ctx, &canvas, report)
+//	defer file.Close()
+//	reader, err := reports.NewReader(file)
+//	for {
+//		row := reports.GradeExportRow{}
+//		if err := reader.Decode(&row); err == io.EOF {
+//			break
+//		}
+//	}
+package reports
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"time"
+
+	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
+	"github.com/atomicjolt/canvasapi/requests"
+	"github.com/atomicjolt/canvasapi/workflow"
+)
+
+// Options configure Run and Wait.
+type Options struct {
+	// InitialInterval and MaxInterval pace the polls of the report, see workflow.PollOptions.
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+	// OnProgress is called with the report after it is started and after every poll.
+	OnProgress func(*models.Report)
+}
+
+// ReportError is returned by Wait when a report fails, is aborted or is deleted before it
+// completes.
+type ReportError struct {
+	Report *models.Report
+}
+
+func (e *ReportError) Error() string {
+	return fmt.Sprintf("%s report %d %s", e.Report.Report, e.Report.ID, e.Report.Status)
+}
+
+// Run starts a report and waits for it to complete.
+func Run(ctx context.Context, c *canvasapi.Canvas, accountID canvasapi.ID, params Parameters, opts Options) (*models.Report, error) {
+	report, err := Start(ctx, c, accountID, params)
+	if err != nil {
+		return nil, err
+	}
+	return Wait(ctx, c, accountID, report, opts)
+}
+
+// Start starts a report without waiting for it.
+func Start(ctx context.Context, c *canvasapi.Canvas, accountID canvasapi.ID, params Parameters) (*models.Report, error) {
+	if params == nil || params.ReportName() == "" {
+		return nil, fmt.Errorf("a report name is required to start a report")
+	}
+	start := requests.StartReport{}
+	start.Path.AccountID = accountID
+	start.Path.Report = params.ReportName()
+	if custom, ok := params.(*Custom); ok {
+		if len(custom.Parameters) > 0 {
+			start.Form.Parameters = custom.Parameters
+		}
+	} else {
+		start.Form.Parameters = params
+	}
+	return start.DoContext(ctx, c)
+}
+
+// Wait polls a report until it completes and returns the completed report. It returns a
+// *ReportError when the report fails.
+func Wait(ctx context.Context, c *canvasapi.Canvas, accountID canvasapi.ID, report *models.Report, opts Options) (*models.Report, error) {
+	if report == nil || report.ID == 0 || report.Report == "" {
+		return nil, fmt.Errorf("a report name and id are required to wait for a report")
+	}
+	err := workflow.Poll(ctx, workflow.PollOptions{InitialInterval: opts.InitialInterval, MaxInterval: opts.MaxInterval}, func() (bool, error) {
+		if opts.OnProgress != nil {
+			opts.OnProgress(report)
+		}
+		switch report.Status {
+		case "complete":
+			return true, nil
+		case "error", "aborted", "deleted":
+			return true, &ReportError{Report: report}
+		}
+		return false, nil
+	}, func(ctx context.Context) error {
+		status := requests.StatusOfReport{}
+		status.Path.AccountID = accountID
+		status.Path.Report = report.Report
+		status.Path.ID = canvasapi.IDFromInt(report.ID)
+		next, err := status.DoContext(ctx, c)
+		if err != nil {
+			return err
+		}
+		report = next
+		return nil
+	})
+	return report, err
+}
+
+// Open downloads the file of a completed report. The provisioning and SIS export reports are
+// zipped when they report more than one kind of data, use OpenCSV to read their files.
+func Open(ctx context.Context, c *canvasapi.Canvas, report *models.Report) (io.ReadCloser, error) {
+	location := ""
+	if report.Attachment != nil && report.Attachment.Url != "" {
+		location = report.Attachment.Url
+	} else {
+		location = report.FileUrl
+	}
+	if location == "" {
+		return nil, fmt.Errorf("%s report %d has no file", report.Report, report.ID)
+	}
+	u, err := c.ResolveURL(location)
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.SendContext(ctx, u, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	return response.Body, nil
+}
+
+// OpenCSV returns the named CSV of a report, such as users.csv of a provisioning report. The
+// file of a report that is not zipped is returned whatever the name. The report is spooled to
+// a temporary file, which is removed when the returned reader is closed.
+func OpenCSV(ctx context.Context, c *canvasapi.Canvas, report *models.Report, name string) (io.ReadCloser, error) {
+	file, err := Open(ctx, c, report)
+	if err != nil {
+		return nil, err
+	}
+	spool, err := ioutil.TempFile("", "canvas-report-")
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	r := &spooled{file: spool}
+	size, err := io.Copy(spool, file)
+	file.Close()
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+
+	magic := make([]byte, 4)
+	n, _ := spool.ReadAt(magic, 0)
+	if !bytes.Equal(magic[:n], []byte("PK\x03\x04")) {
+		if _, err := spool.Seek(0, io.SeekStart); err != nil {
+			r.Close()
+			return nil, err
+		}
+		r.Reader = spool
+		return r, nil
+	}
+	reader, err := zip.NewReader(spool, size)
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+	for _, f := range reader.File {
+		if path.Base(f.Name) == name {
+			csv, err := f.Open()
+			if err != nil {
+				r.Close()
+				return nil, err
+			}
+			r.Reader, r.csv = csv, csv
+			return r, nil
+		}
+	}
+	r.Close()
+	return nil, fmt.Errorf("%s report %d has no %s", report.Report, report.ID, name)
+}
+
+// spooled reads a report spooled to a temporary file, or a file of the zip in it, and removes
+// the temporary file when it is closed.
+type spooled struct {
+	io.Reader
+	file *os.File
+	csv  io.Closer
+}
+
+func (s *spooled) Close() error {
+	var err error
+	if s.csv != nil {
+		err = s.csv.Close()
+	}
+	if closeErr := s.file.Close(); err == nil {
+		err = closeErr
+	}
+	if removeErr := os.Remove(s.file.Name()); err == nil {
+		err = removeErr
+	}
+	return err
+}
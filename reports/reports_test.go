@@ -0,0 +1,196 @@
+package reports
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
+)
+
+const gradeExport = "student name,student id,student sis,course,course id,course sis,section,section id,section sis,term,term id,term sis,current score,final score,enrollment state\n" +
+	"Jane Doe,11,s11,Biology,21,bio101,Biology 1,31,bio101-1,Fall,41,fall,91.5,88,active\n" +
+	"Rob Roe,12,s12,Biology,21,bio101,Biology 1,31,bio101-1,Fall,41,fall,,,invited\n"
+
+func TestRun(t *testing.T) {
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/accounts/self/reports/grade_export_csv":
+			r.ParseForm()
+			if r.PostForm.Encode() != "parameters%5Benrollment_term_id%5D=sis_term_id%3Afall&parameters%5Binclude_deleted%5D=false" {
+				t.Errorf("unexpected parameters %v", r.PostForm)
+			}
+			fmt.Fprint(w, `{"id":5,"report":"grade_export_csv","status":"created"}`)
+		case r.URL.Path == "/api/v1/accounts/self/reports/grade_export_csv/5":
+			polls++
+			if polls < 2 {
+				fmt.Fprint(w, `{"id":5,"report":"grade_export_csv","status":"running","progress":50}`)
+				return
+			}
+			fmt.Fprintf(w, `{"id":5,"report":"grade_export_csv","status":"complete","progress":100,
+				"attachment":{"id":9,"url":"http://%s/files/9/download"}}`, r.Host)
+		case r.URL.Path == "/files/9/download":
+			fmt.Fprint(w, gradeExport)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	canvas := canvasapi.New("token", "", canvasapi.WithBaseURL(server.URL))
+	var statuses []string
+	report, err := Run(context.Background(), &canvas, canvasapi.Self, &GradeExport{
+		EnrollmentTermID: canvasapi.SISTermID("fall"),
+		IncludeDeleted:   canvasapi.Some(false),
+	}, Options{
+		InitialInterval: time.Millisecond,
+		OnProgress: func(r *models.Report) {
+			statuses = append(statuses, r.Status)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(statuses, ",") != "created,running,complete" {
+		t.Errorf("unexpected statuses %v", statuses)
+	}
+
+	file, err := Open(context.Background(), &canvas, report)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	rows := []*GradeExportRow{}
+	err = Each(file, func(row *GradeExportRow) error {
+		rows = append(rows, row)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(rows))
+	}
+	if rows[0].StudentID != 11 || rows[0].CourseSISID != "bio101" || *rows[0].CurrentScore != 91.5 || *rows[0].FinalScore != 88 {
+		t.Errorf("unexpected row %+v", rows[0])
+	}
+	if rows[1].CurrentScore != nil || rows[1].EnrollmentState != "invited" {
+		t.Errorf("expected no scores, got %+v", rows[1])
+	}
+}
+
+func TestWaitFailed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":6,"report":"provisioning_csv","status":"error"}`)
+	}))
+	defer server.Close()
+
+	canvas := canvasapi.New("token", "", canvasapi.WithBaseURL(server.URL))
+	report := &models.Report{ID: 6, Report: ProvisioningReport, Status: "running"}
+	_, err := Wait(context.Background(), &canvas, "1", report, Options{InitialInterval: time.Millisecond})
+	var reportError *ReportError
+	if !errors.As(err, &reportError) || err.Error() != "provisioning_csv report 6 error" {
+		t.Errorf("expected a report error, got %v", err)
+	}
+}
+
+func TestOpenCSV(t *testing.T) {
+	archive := &bytes.Buffer{}
+	writer := zip.NewWriter(archive)
+	for name, content := range map[string]string{
+		"users.csv":   "canvas_user_id,user_id,login_id,full_name,status,created_by_sis\n11,s11,jdoe,Jane Doe,active,true\n",
+		"courses.csv": "canvas_course_id,course_id,short_name,status,start_date\n21,bio101,BIO,active,2026-08-24T00:00:00Z\n",
+	} {
+		f, _ := writer.Create(name)
+		io.WriteString(f, content)
+	}
+	writer.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(archive.Bytes())
+	}))
+	defer server.Close()
+
+	canvas := canvasapi.New("token", "", canvasapi.WithBaseURL(server.URL))
+	report := &models.Report{ID: 7, Report: ProvisioningReport, Status: "complete", FileUrl: "/accounts/1/files/3/download"}
+
+	users, err := OpenCSV(context.Background(), &canvas, report, "users.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer users.Close()
+	reader, err := NewReader(users)
+	if err != nil {
+		t.Fatal(err)
+	}
+	row, err := reader.Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if row["login_id"] != "jdoe" || row["full_name"] != "Jane Doe" {
+		t.Errorf("unexpected row %v", row)
+	}
+	if _, err := reader.Read(); err != io.EOF {
+		t.Errorf("expected io.EOF, got %v", err)
+	}
+
+	courses, err := OpenCSV(context.Background(), &canvas, report, "courses.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer courses.Close()
+	err = Each(courses, func(course *ProvisioningCourse) error {
+		if course.CanvasCourseID != 21 || course.StartDate == nil || course.StartDate.Month() != time.August || course.EndDate != nil {
+			t.Errorf("unexpected course %+v", course)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := OpenCSV(context.Background(), &canvas, report, "terms.csv"); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+
+	spool := courses.(*spooled).file.Name()
+	courses.Close()
+	if _, err := os.Stat(spool); !os.IsNotExist(err) {
+		t.Errorf("expected the spooled report to be removed, got %v", err)
+	}
+}
+
+func TestStartCustom(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.URL.Path != "/api/v1/accounts/1/reports/student_assignment_outcome_map_csv" || r.PostForm.Get("parameters[include_deleted]") != "true" {
+			t.Errorf("unexpected request %s %v", r.URL, r.PostForm)
+		}
+		fmt.Fprint(w, `{"id":8,"report":"student_assignment_outcome_map_csv","status":"created"}`)
+	}))
+	defer server.Close()
+
+	canvas := canvasapi.New("token", "", canvasapi.WithBaseURL(server.URL))
+	report, err := Start(context.Background(), &canvas, "1", &Custom{
+		Report:     "student_assignment_outcome_map_csv",
+		Parameters: map[string]interface{}{"include_deleted": true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.ID != 8 {
+		t.Errorf("unexpected report %+v", report)
+	}
+}
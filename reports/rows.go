@@ -0,0 +1,92 @@
+package reports
+
+import (
+	"time"
+
+	"github.com/atomicjolt/canvasapi/models"
+)
+
+// GradeExportRow is a row of the grade export report. Scores are nil when a student has none.
+type GradeExportRow struct {
+	StudentName          string    `csv:"student name"`
+	StudentID            models.ID `csv:"student id"`
+	StudentSISID         string    `csv:"student sis"`
+	Course               string    `csv:"course"`
+	CourseID             models.ID `csv:"course id"`
+	CourseSISID          string    `csv:"course sis"`
+	Section              string    `csv:"section"`
+	SectionID            models.ID `csv:"section id"`
+	SectionSISID         string    `csv:"section sis"`
+	Term                 string    `csv:"term"`
+	TermID               models.ID `csv:"term id"`
+	TermSISID            string    `csv:"term sis"`
+	CurrentScore         *float64  `csv:"current score"`
+	FinalScore           *float64  `csv:"final score"`
+	EnrollmentState      string    `csv:"enrollment state"`
+	UnpostedCurrentScore *float64  `csv:"unposted current score"`
+	UnpostedFinalScore   *float64  `csv:"unposted final score"`
+	OverrideScore        *float64  `csv:"override score"`
+}
+
+// LastUserAccessRow is a row of the last user access report. LastAccessAt is nil for users
+// who never logged in.
+type LastUserAccessRow struct {
+	UserID       models.ID  `csv:"user id"`
+	UserSISID    string     `csv:"user sis id"`
+	UserName     string     `csv:"user name"`
+	LastAccessAt *time.Time `csv:"last access at"`
+	LastIP       string     `csv:"last ip"`
+}
+
+// ProvisioningUser is a row of users.csv of the provisioning report.
+type ProvisioningUser struct {
+	CanvasUserID             models.ID `csv:"canvas_user_id"`
+	UserID                   string    `csv:"user_id"`
+	IntegrationID            string    `csv:"integration_id"`
+	AuthenticationProviderID string    `csv:"authentication_provider_id"`
+	LoginID                  string    `csv:"login_id"`
+	FirstName                string    `csv:"first_name"`
+	LastName                 string    `csv:"last_name"`
+	FullName                 string    `csv:"full_name"`
+	SortableName             string    `csv:"sortable_name"`
+	ShortName                string    `csv:"short_name"`
+	Email                    string    `csv:"email"`
+	Status                   string    `csv:"status"`
+	CreatedBySIS             bool      `csv:"created_by_sis"`
+}
+
+// ProvisioningCourse is a row of courses.csv of the provisioning report.
+type ProvisioningCourse struct {
+	CanvasCourseID  models.ID  `csv:"canvas_course_id"`
+	CourseID        string     `csv:"course_id"`
+	IntegrationID   string     `csv:"integration_id"`
+	ShortName       string     `csv:"short_name"`
+	LongName        string     `csv:"long_name"`
+	CanvasAccountID models.ID  `csv:"canvas_account_id"`
+	AccountID       string     `csv:"account_id"`
+	CanvasTermID    models.ID  `csv:"canvas_term_id"`
+	TermID          string     `csv:"term_id"`
+	Status          string     `csv:"status"`
+	StartDate       *time.Time `csv:"start_date"`
+	EndDate         *time.Time `csv:"end_date"`
+	CourseFormat    string     `csv:"course_format"`
+	CreatedBySIS    bool       `csv:"created_by_sis"`
+}
+
+// ProvisioningEnrollment is a row of enrollments.csv of the provisioning report.
+type ProvisioningEnrollment struct {
+	CanvasCourseID         models.ID `csv:"canvas_course_id"`
+	CourseID               string    `csv:"course_id"`
+	CanvasUserID           models.ID `csv:"canvas_user_id"`
+	UserID                 string    `csv:"user_id"`
+	Role                   string    `csv:"role"`
+	RoleID                 models.ID `csv:"role_id"`
+	CanvasSectionID        models.ID `csv:"canvas_section_id"`
+	SectionID              string    `csv:"section_id"`
+	Status                 string    `csv:"status"`
+	CanvasAssociatedUserID models.ID `csv:"canvas_associated_user_id"`
+	AssociatedUserID       string    `csv:"associated_user_id"`
+	CreatedBySIS           bool      `csv:"created_by_sis"`
+	BaseRoleType           string    `csv:"base_role_type"`
+	LimitSectionPrivileges bool      `csv:"limit_section_privileges"`
+}
@@ -1,7 +1,7 @@
 package requests
 
 import (
-	"fmt"
+	"context"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -17,7 +17,7 @@ import (
 //
 type AbortAllPendingSISImports struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -27,7 +27,7 @@ func (t *AbortAllPendingSISImports) GetMethod() string {
 
 func (t *AbortAllPendingSISImports) GetURLPath() string {
 	path := "accounts/{account_id}/sis_imports/abort_all_pending"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	return path
 }
 
@@ -44,18 +44,22 @@ func (t *AbortAllPendingSISImports) GetJSON() ([]byte, error) {
 }
 
 func (t *AbortAllPendingSISImports) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *AbortAllPendingSISImports) Do(c *canvasapi.Canvas) (bool, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *AbortAllPendingSISImports) DoContext(ctx context.Context, c *canvasapi.Canvas) (bool, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return false, err
 	}
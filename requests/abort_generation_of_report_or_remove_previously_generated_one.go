@@ -1,11 +1,14 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // AbortGenerationOfReportOrRemovePreviouslyGeneratedOne This API allows you to cancel a previous request you issued for a report to
@@ -32,9 +35,9 @@ import (
 //
 type AbortGenerationOfReportOrRemovePreviouslyGeneratedOne struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		QuizID   string `json:"quiz_id" url:"quiz_id,omitempty"`     //  (Required)
-		ID       string `json:"id" url:"id,omitempty"`               //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		QuizID   canvasapi.ID `json:"quiz_id" url:"quiz_id,omitempty"`     //  (Required)
+		ID       canvasapi.ID `json:"id" url:"id,omitempty"`               //  (Required)
 	} `json:"path"`
 }
 
@@ -44,9 +47,9 @@ func (t *AbortGenerationOfReportOrRemovePreviouslyGeneratedOne) GetMethod() stri
 
 func (t *AbortGenerationOfReportOrRemovePreviouslyGeneratedOne) GetURLPath() string {
 	path := "courses/{course_id}/quizzes/{quiz_id}/reports/{id}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{quiz_id}", fmt.Sprintf("%v", t.Path.QuizID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{quiz_id}", t.Path.QuizID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -63,27 +66,50 @@ func (t *AbortGenerationOfReportOrRemovePreviouslyGeneratedOne) GetJSON() ([]byt
 }
 
 func (t *AbortGenerationOfReportOrRemovePreviouslyGeneratedOne) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.QuizID == "" {
-		errs = append(errs, "'Path.QuizID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.QuizID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *AbortGenerationOfReportOrRemovePreviouslyGeneratedOne) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *AbortGenerationOfReportOrRemovePreviouslyGeneratedOne) Do(c *canvasapi.Canvas) (*models.QuizReport, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *AbortGenerationOfReportOrRemovePreviouslyGeneratedOne) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.QuizReport, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	if len(body) == 0 {
+		// 204 No Content once the generation is aborted
+		return nil, nil
+	}
+	ret := models.QuizReport{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
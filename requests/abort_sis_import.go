@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -24,8 +24,8 @@ import (
 //
 type AbortSISImport struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
-		ID        string `json:"id" url:"id,omitempty"`                 //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		ID        canvasapi.ID `json:"id" url:"id,omitempty"`                 //  (Required)
 	} `json:"path"`
 }
 
@@ -35,8 +35,8 @@ func (t *AbortSISImport) GetMethod() string {
 
 func (t *AbortSISImport) GetURLPath() string {
 	path := "accounts/{account_id}/sis_imports/{id}/abort"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -53,21 +53,25 @@ func (t *AbortSISImport) GetJSON() ([]byte, error) {
 }
 
 func (t *AbortSISImport) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *AbortSISImport) Do(c *canvasapi.Canvas) (*models.SISImport, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *AbortSISImport) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.SISImport, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -82,6 +86,10 @@ func (t *AbortSISImport) Do(c *canvasapi.Canvas) (*models.SISImport, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,7 +1,9 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
@@ -17,8 +19,8 @@ import (
 //
 type AcceptCourseInvitation struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		ID       string `json:"id" url:"id,omitempty"`               //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		ID       canvasapi.ID `json:"id" url:"id,omitempty"`               //  (Required)
 	} `json:"path"`
 }
 
@@ -28,8 +30,8 @@ func (t *AcceptCourseInvitation) GetMethod() string {
 
 func (t *AcceptCourseInvitation) GetURLPath() string {
 	path := "courses/{course_id}/enrollments/{id}/accept"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -46,24 +48,39 @@ func (t *AcceptCourseInvitation) GetJSON() ([]byte, error) {
 }
 
 func (t *AcceptCourseInvitation) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *AcceptCourseInvitation) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *AcceptCourseInvitation) Do(c *canvasapi.Canvas) (*canvasapi.SuccessResponse, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *AcceptCourseInvitation) DoContext(ctx context.Context, c *canvasapi.Canvas) (*canvasapi.SuccessResponse, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := canvasapi.SuccessResponse{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -26,8 +24,8 @@ import (
 //
 type ActivateRole struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
-		ID        string `json:"id" url:"id,omitempty"`                 //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		ID        canvasapi.ID `json:"id" url:"id,omitempty"`                 //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -42,8 +40,8 @@ func (t *ActivateRole) GetMethod() string {
 
 func (t *ActivateRole) GetURLPath() string {
 	path := "accounts/{account_id}/roles/{id}/activate"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -52,33 +50,37 @@ func (t *ActivateRole) GetQuery() (string, error) {
 }
 
 func (t *ActivateRole) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *ActivateRole) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *ActivateRole) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ActivateRole) Do(c *canvasapi.Canvas) (*models.Role, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ActivateRole) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Role, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -93,6 +95,10 @@ func (t *ActivateRole) Do(c *canvasapi.Canvas) (*models.Role, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
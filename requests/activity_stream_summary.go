@@ -1,9 +1,13 @@
 package requests
 
 import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // ActivityStreamSummary Returns a summary of the current user's global activity stream.
@@ -17,7 +21,7 @@ func (t *ActivityStreamSummary) GetMethod() string {
 }
 
 func (t *ActivityStreamSummary) GetURLPath() string {
-	return ""
+	return "users/self/activity_stream/summary"
 }
 
 func (t *ActivityStreamSummary) GetQuery() (string, error) {
@@ -36,11 +40,30 @@ func (t *ActivityStreamSummary) HasErrors() error {
 	return nil
 }
 
-func (t *ActivityStreamSummary) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *ActivityStreamSummary) Do(c *canvasapi.Canvas) ([]*models.StreamSummary, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ActivityStreamSummary) DoContext(ctx context.Context, c *canvasapi.Canvas) ([]*models.StreamSummary, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := []*models.StreamSummary{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
 }
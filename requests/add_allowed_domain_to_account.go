@@ -1,13 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 )
 
@@ -23,7 +22,7 @@ import (
 //
 type AddAllowedDomainToAccount struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -37,7 +36,7 @@ func (t *AddAllowedDomainToAccount) GetMethod() string {
 
 func (t *AddAllowedDomainToAccount) GetURLPath() string {
 	path := "accounts/{account_id}/csp_settings/domains"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	return path
 }
 
@@ -46,36 +45,46 @@ func (t *AddAllowedDomainToAccount) GetQuery() (string, error) {
 }
 
 func (t *AddAllowedDomainToAccount) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *AddAllowedDomainToAccount) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *AddAllowedDomainToAccount) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Domain == "" {
-		errs = append(errs, "'Form.Domain' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Domain", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *AddAllowedDomainToAccount) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *AddAllowedDomainToAccount) Do(c *canvasapi.Canvas) (json.RawMessage, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *AddAllowedDomainToAccount) DoContext(ctx context.Context, c *canvasapi.Canvas) (json.RawMessage, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(body), nil
 }
@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -404,7 +404,7 @@ import (
 //
 type AddAuthenticationProvider struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -414,7 +414,7 @@ func (t *AddAuthenticationProvider) GetMethod() string {
 
 func (t *AddAuthenticationProvider) GetURLPath() string {
 	path := "accounts/{account_id}/authentication_providers"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	return path
 }
 
@@ -431,18 +431,22 @@ func (t *AddAuthenticationProvider) GetJSON() ([]byte, error) {
 }
 
 func (t *AddAuthenticationProvider) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *AddAuthenticationProvider) Do(c *canvasapi.Canvas) (*models.AuthenticationProvider, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *AddAuthenticationProvider) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.AuthenticationProvider, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -457,6 +461,10 @@ func (t *AddAuthenticationProvider) Do(c *canvasapi.Canvas) (*models.Authenticat
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -21,7 +21,7 @@ import (
 //
 type AddCourseToFavorites struct {
 	Path struct {
-		ID string `json:"id" url:"id,omitempty"` //  (Required)
+		ID canvasapi.ID `json:"id" url:"id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -31,7 +31,7 @@ func (t *AddCourseToFavorites) GetMethod() string {
 
 func (t *AddCourseToFavorites) GetURLPath() string {
 	path := "users/self/favorites/courses/{id}"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -48,18 +48,22 @@ func (t *AddCourseToFavorites) GetJSON() ([]byte, error) {
 }
 
 func (t *AddCourseToFavorites) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *AddCourseToFavorites) Do(c *canvasapi.Canvas) (*models.Favorite, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *AddCourseToFavorites) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Favorite, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -74,6 +78,10 @@ func (t *AddCourseToFavorites) Do(c *canvasapi.Canvas) (*models.Favorite, error)
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,14 +1,14 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -43,17 +43,17 @@ import (
 //
 type AddMessage struct {
 	Path struct {
-		ID string `json:"id" url:"id,omitempty"` //  (Required)
+		ID canvasapi.ID `json:"id" url:"id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		Body             string   `json:"body" url:"body,omitempty"`                             //  (Required)
-		AttachmentIDs    []string `json:"attachment_ids" url:"attachment_ids,omitempty"`         //  (Optional)
-		MediaCommentID   string   `json:"media_comment_id" url:"media_comment_id,omitempty"`     //  (Optional)
-		MediaCommentType string   `json:"media_comment_type" url:"media_comment_type,omitempty"` //  (Optional) . Must be one of audio, video
-		Recipients       []string `json:"recipients" url:"recipients,omitempty"`                 //  (Optional)
-		IncludedMessages []string `json:"included_messages" url:"included_messages,omitempty"`   //  (Optional)
-		UserNote         bool     `json:"user_note" url:"user_note,omitempty"`                   //  (Optional)
+		Body             string              `json:"body" url:"body,omitempty"`                             //  (Required)
+		AttachmentIDs    []string            `json:"attachment_ids" url:"attachment_ids,omitempty"`         //  (Optional)
+		MediaCommentID   string              `json:"media_comment_id" url:"media_comment_id,omitempty"`     //  (Optional)
+		MediaCommentType string              `json:"media_comment_type" url:"media_comment_type,omitempty"` //  (Optional) . Must be one of audio, video
+		Recipients       []string            `json:"recipients" url:"recipients,omitempty"`                 //  (Optional)
+		IncludedMessages []string            `json:"included_messages" url:"included_messages,omitempty"`   //  (Optional)
+		UserNote         canvasapi.Opt[bool] `json:"user_note" url:"user_note,omitempty"`                   //  (Optional)
 	} `json:"form"`
 }
 
@@ -63,7 +63,7 @@ func (t *AddMessage) GetMethod() string {
 
 func (t *AddMessage) GetURLPath() string {
 	path := "conversations/{id}/add_message"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -72,39 +72,58 @@ func (t *AddMessage) GetQuery() (string, error) {
 }
 
 func (t *AddMessage) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *AddMessage) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *AddMessage) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Body == "" {
-		errs = append(errs, "'Form.Body' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Body", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.MediaCommentType != "" && !string_utils.Include([]string{"audio", "video"}, t.Form.MediaCommentType) {
-		errs = append(errs, "MediaCommentType must be one of audio, video")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.MediaCommentType", Rule: canvasapi.RuleOneOf, Allowed: []string{"audio", "video"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *AddMessage) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *AddMessage) Do(c *canvasapi.Canvas) (*models.Conversation, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *AddMessage) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Conversation, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.Conversation{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -35,7 +33,7 @@ import (
 //
 type AddObserveeWithCredentials struct {
 	Path struct {
-		UserID string `json:"user_id" url:"user_id,omitempty"` //  (Required)
+		UserID canvasapi.ID `json:"user_id" url:"user_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -44,9 +42,9 @@ type AddObserveeWithCredentials struct {
 			Password string `json:"password" url:"password,omitempty"`   //  (Optional)
 		} `json:"observee" url:"observee,omitempty"`
 
-		AccessToken   string `json:"access_token" url:"access_token,omitempty"`       //  (Optional)
-		PairingCode   string `json:"pairing_code" url:"pairing_code,omitempty"`       //  (Optional)
-		RootAccountID int64  `json:"root_account_id" url:"root_account_id,omitempty"` //  (Optional)
+		AccessToken   string               `json:"access_token" url:"access_token,omitempty"`       //  (Optional)
+		PairingCode   string               `json:"pairing_code" url:"pairing_code,omitempty"`       //  (Optional)
+		RootAccountID canvasapi.Opt[int64] `json:"root_account_id" url:"root_account_id,omitempty"` //  (Optional)
 	} `json:"form"`
 }
 
@@ -56,7 +54,7 @@ func (t *AddObserveeWithCredentials) GetMethod() string {
 
 func (t *AddObserveeWithCredentials) GetURLPath() string {
 	path := "users/{user_id}/observees"
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", t.Path.UserID))
+	path = strings.ReplaceAll(path, "{user_id}", t.Path.UserID.Escaped())
 	return path
 }
 
@@ -65,30 +63,34 @@ func (t *AddObserveeWithCredentials) GetQuery() (string, error) {
 }
 
 func (t *AddObserveeWithCredentials) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *AddObserveeWithCredentials) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *AddObserveeWithCredentials) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.UserID == "" {
-		errs = append(errs, "'Path.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *AddObserveeWithCredentials) Do(c *canvasapi.Canvas) (*models.User, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *AddObserveeWithCredentials) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.User, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -103,6 +105,10 @@ func (t *AddObserveeWithCredentials) Do(c *canvasapi.Canvas) (*models.User, erro
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,14 +1,14 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // AddRecipients Add recipients to an existing group conversation. Response is similar to
@@ -26,7 +26,7 @@ import (
 //
 type AddRecipients struct {
 	Path struct {
-		ID string `json:"id" url:"id,omitempty"` //  (Required)
+		ID canvasapi.ID `json:"id" url:"id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -40,7 +40,7 @@ func (t *AddRecipients) GetMethod() string {
 
 func (t *AddRecipients) GetURLPath() string {
 	path := "conversations/{id}/add_recipients"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -49,36 +49,55 @@ func (t *AddRecipients) GetQuery() (string, error) {
 }
 
 func (t *AddRecipients) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *AddRecipients) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *AddRecipients) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Recipients == nil {
-		errs = append(errs, "'Form.Recipients' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Recipients", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *AddRecipients) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *AddRecipients) Do(c *canvasapi.Canvas) (*models.Conversation, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *AddRecipients) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Conversation, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.Conversation{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
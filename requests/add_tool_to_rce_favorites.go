@@ -1,7 +1,9 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
@@ -19,8 +21,8 @@ import (
 //
 type AddToolToRceFavorites struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
-		ID        string `json:"id" url:"id,omitempty"`                 //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		ID        canvasapi.ID `json:"id" url:"id,omitempty"`                 //  (Required)
 	} `json:"path"`
 }
 
@@ -30,8 +32,8 @@ func (t *AddToolToRceFavorites) GetMethod() string {
 
 func (t *AddToolToRceFavorites) GetURLPath() string {
 	path := "accounts/{account_id}/external_tools/rce_favorites/{id}"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -48,24 +50,34 @@ func (t *AddToolToRceFavorites) GetJSON() ([]byte, error) {
 }
 
 func (t *AddToolToRceFavorites) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *AddToolToRceFavorites) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *AddToolToRceFavorites) Do(c *canvasapi.Canvas) (json.RawMessage, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *AddToolToRceFavorites) DoContext(ctx context.Context, c *canvasapi.Canvas) (json.RawMessage, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(body), nil
 }
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -25,8 +23,8 @@ import (
 //
 type AddUsersToContentShare struct {
 	Path struct {
-		UserID string `json:"user_id" url:"user_id,omitempty"` //  (Required)
-		ID     string `json:"id" url:"id,omitempty"`           //  (Required)
+		UserID canvasapi.ID `json:"user_id" url:"user_id,omitempty"` //  (Required)
+		ID     canvasapi.ID `json:"id" url:"id,omitempty"`           //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -40,8 +38,8 @@ func (t *AddUsersToContentShare) GetMethod() string {
 
 func (t *AddUsersToContentShare) GetURLPath() string {
 	path := "users/{user_id}/content_shares/{id}/add_users"
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", t.Path.UserID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{user_id}", t.Path.UserID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -50,33 +48,37 @@ func (t *AddUsersToContentShare) GetQuery() (string, error) {
 }
 
 func (t *AddUsersToContentShare) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *AddUsersToContentShare) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *AddUsersToContentShare) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.UserID == "" {
-		errs = append(errs, "'Path.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *AddUsersToContentShare) Do(c *canvasapi.Canvas) (*models.ContentShare, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *AddUsersToContentShare) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.ContentShare, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -91,6 +93,10 @@ func (t *AddUsersToContentShare) Do(c *canvasapi.Canvas) (*models.ContentShare,
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
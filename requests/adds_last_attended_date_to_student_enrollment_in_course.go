@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -20,8 +20,8 @@ import (
 //
 type AddsLastAttendedDateToStudentEnrollmentInCourse struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		UserID   string `json:"user_id" url:"user_id,omitempty"`     //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		UserID   canvasapi.ID `json:"user_id" url:"user_id,omitempty"`     //  (Required)
 	} `json:"path"`
 }
 
@@ -31,8 +31,8 @@ func (t *AddsLastAttendedDateToStudentEnrollmentInCourse) GetMethod() string {
 
 func (t *AddsLastAttendedDateToStudentEnrollmentInCourse) GetURLPath() string {
 	path := "courses/{course_id}/users/{user_id}/last_attended"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", t.Path.UserID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{user_id}", t.Path.UserID.Escaped())
 	return path
 }
 
@@ -49,21 +49,25 @@ func (t *AddsLastAttendedDateToStudentEnrollmentInCourse) GetJSON() ([]byte, err
 }
 
 func (t *AddsLastAttendedDateToStudentEnrollmentInCourse) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.UserID == "" {
-		errs = append(errs, "'Path.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *AddsLastAttendedDateToStudentEnrollmentInCourse) Do(c *canvasapi.Canvas) (*models.Enrollment, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *AddsLastAttendedDateToStudentEnrollmentInCourse) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Enrollment, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -78,6 +82,10 @@ func (t *AddsLastAttendedDateToStudentEnrollmentInCourse) Do(c *canvasapi.Canvas
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
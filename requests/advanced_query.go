@@ -1,14 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -29,12 +28,12 @@ import (
 //
 type AdvancedQuery struct {
 	Query struct {
-		CourseID     int64     `json:"course_id" url:"course_id,omitempty"`         //  (Optional)
-		AssignmentID int64     `json:"assignment_id" url:"assignment_id,omitempty"` //  (Optional)
-		StudentID    int64     `json:"student_id" url:"student_id,omitempty"`       //  (Optional)
-		GraderID     int64     `json:"grader_id" url:"grader_id,omitempty"`         //  (Optional)
-		StartTime    time.Time `json:"start_time" url:"start_time,omitempty"`       //  (Optional)
-		EndTime      time.Time `json:"end_time" url:"end_time,omitempty"`           //  (Optional)
+		CourseID     canvasapi.Opt[int64] `json:"course_id" url:"course_id,omitempty"`         //  (Optional)
+		AssignmentID canvasapi.Opt[int64] `json:"assignment_id" url:"assignment_id,omitempty"` //  (Optional)
+		StudentID    canvasapi.Opt[int64] `json:"student_id" url:"student_id,omitempty"`       //  (Optional)
+		GraderID     canvasapi.Opt[int64] `json:"grader_id" url:"grader_id,omitempty"`         //  (Optional)
+		StartTime    time.Time            `json:"start_time" url:"start_time,omitempty"`       //  (Optional)
+		EndTime      time.Time            `json:"end_time" url:"end_time,omitempty"`           //  (Optional)
 	} `json:"query"`
 }
 
@@ -43,11 +42,11 @@ func (t *AdvancedQuery) GetMethod() string {
 }
 
 func (t *AdvancedQuery) GetURLPath() string {
-	return ""
+	return "audit/grade_change"
 }
 
 func (t *AdvancedQuery) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -67,12 +66,16 @@ func (t *AdvancedQuery) HasErrors() error {
 }
 
 func (t *AdvancedQuery) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.GradeChangeEvent, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *AdvancedQuery) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.GradeChangeEvent, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -92,6 +95,10 @@ func (t *AdvancedQuery) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.GradeC
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -34,7 +32,7 @@ import (
 //
 type AnsweringQuestions struct {
 	Path struct {
-		QuizSubmissionID string `json:"quiz_submission_id" url:"quiz_submission_id,omitempty"` //  (Required)
+		QuizSubmissionID canvasapi.ID `json:"quiz_submission_id" url:"quiz_submission_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -51,7 +49,7 @@ func (t *AnsweringQuestions) GetMethod() string {
 
 func (t *AnsweringQuestions) GetURLPath() string {
 	path := "quiz_submissions/{quiz_submission_id}/questions"
-	path = strings.ReplaceAll(path, "{quiz_submission_id}", fmt.Sprintf("%v", t.Path.QuizSubmissionID))
+	path = strings.ReplaceAll(path, "{quiz_submission_id}", t.Path.QuizSubmissionID.Escaped())
 	return path
 }
 
@@ -60,38 +58,47 @@ func (t *AnsweringQuestions) GetQuery() (string, error) {
 }
 
 func (t *AnsweringQuestions) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *AnsweringQuestions) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
+// PrefersJSON reports that Canvas expects the body of this request as JSON.
+func (t *AnsweringQuestions) PrefersJSON() bool {
+	return true
+}
+
 func (t *AnsweringQuestions) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.QuizSubmissionID == "" {
-		errs = append(errs, "'Path.QuizSubmissionID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.QuizSubmissionID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.ValidationToken == "" {
-		errs = append(errs, "'Form.ValidationToken' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.ValidationToken", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *AnsweringQuestions) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.QuizSubmissionQuestion, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *AnsweringQuestions) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.QuizSubmissionQuestion, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -111,6 +118,10 @@ func (t *AnsweringQuestions) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Q
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
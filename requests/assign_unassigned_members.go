@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -27,11 +25,11 @@ import (
 //
 type AssignUnassignedMembers struct {
 	Path struct {
-		GroupCategoryID string `json:"group_category_id" url:"group_category_id,omitempty"` //  (Required)
+		GroupCategoryID canvasapi.ID `json:"group_category_id" url:"group_category_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		Sync bool `json:"sync" url:"sync,omitempty"` //  (Optional)
+		Sync canvasapi.Opt[bool] `json:"sync" url:"sync,omitempty"` //  (Optional)
 	} `json:"form"`
 }
 
@@ -41,7 +39,7 @@ func (t *AssignUnassignedMembers) GetMethod() string {
 
 func (t *AssignUnassignedMembers) GetURLPath() string {
 	path := "group_categories/{group_category_id}/assign_unassigned_members"
-	path = strings.ReplaceAll(path, "{group_category_id}", fmt.Sprintf("%v", t.Path.GroupCategoryID))
+	path = strings.ReplaceAll(path, "{group_category_id}", t.Path.GroupCategoryID.Escaped())
 	return path
 }
 
@@ -50,30 +48,34 @@ func (t *AssignUnassignedMembers) GetQuery() (string, error) {
 }
 
 func (t *AssignUnassignedMembers) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *AssignUnassignedMembers) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *AssignUnassignedMembers) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.GroupCategoryID == "" {
-		errs = append(errs, "'Path.GroupCategoryID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.GroupCategoryID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *AssignUnassignedMembers) Do(c *canvasapi.Canvas) (*models.GroupMembership, *models.Progress, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *AssignUnassignedMembers) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.GroupMembership, *models.Progress, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -85,7 +87,7 @@ func (t *AssignUnassignedMembers) Do(c *canvasapi.Canvas) (*models.GroupMembersh
 	}
 	groupMembership := models.GroupMembership{}
 	progress := models.Progress{}
-	if t.Form.Sync {
+	if t.Form.Sync.Value() {
 		err = json.Unmarshal(body, &groupMembership)
 		if err != nil {
 			return nil, nil, err
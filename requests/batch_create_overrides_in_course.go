@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -36,7 +34,7 @@ import (
 //
 type BatchCreateOverridesInCourse struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -50,7 +48,7 @@ func (t *BatchCreateOverridesInCourse) GetMethod() string {
 
 func (t *BatchCreateOverridesInCourse) GetURLPath() string {
 	path := "courses/{course_id}/assignments/overrides"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
@@ -59,38 +57,47 @@ func (t *BatchCreateOverridesInCourse) GetQuery() (string, error) {
 }
 
 func (t *BatchCreateOverridesInCourse) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *BatchCreateOverridesInCourse) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
+// PrefersJSON reports that Canvas expects the body of this request as JSON.
+func (t *BatchCreateOverridesInCourse) PrefersJSON() bool {
+	return true
+}
+
 func (t *BatchCreateOverridesInCourse) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.AssignmentOverrides == nil {
-		errs = append(errs, "'Form.AssignmentOverrides' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.AssignmentOverrides", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *BatchCreateOverridesInCourse) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.AssignmentOverride, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *BatchCreateOverridesInCourse) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.AssignmentOverride, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -110,6 +117,10 @@ func (t *BatchCreateOverridesInCourse) Do(c *canvasapi.Canvas, next *url.URL) ([
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -28,7 +26,7 @@ import (
 //
 type BatchRetrieveOverridesInCourse struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
@@ -45,12 +43,12 @@ func (t *BatchRetrieveOverridesInCourse) GetMethod() string {
 
 func (t *BatchRetrieveOverridesInCourse) GetURLPath() string {
 	path := "courses/{course_id}/assignments/overrides"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
 func (t *BatchRetrieveOverridesInCourse) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -66,29 +64,33 @@ func (t *BatchRetrieveOverridesInCourse) GetJSON() ([]byte, error) {
 }
 
 func (t *BatchRetrieveOverridesInCourse) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Query.AssignmentOverrides.ID == nil {
-		errs = append(errs, "'Query.AssignmentOverrides.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.AssignmentOverrides.ID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Query.AssignmentOverrides.AssignmentID == nil {
-		errs = append(errs, "'Query.AssignmentOverrides.AssignmentID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.AssignmentOverrides.AssignmentID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *BatchRetrieveOverridesInCourse) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.AssignmentOverride, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *BatchRetrieveOverridesInCourse) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.AssignmentOverride, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -108,6 +110,10 @@ func (t *BatchRetrieveOverridesInCourse) Do(c *canvasapi.Canvas, next *url.URL)
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
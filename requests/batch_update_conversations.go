@@ -1,13 +1,10 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
-	"strings"
-
-	"github.com/google/go-querystring/query"
 
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
@@ -34,7 +31,7 @@ func (t *BatchUpdateConversations) GetMethod() string {
 }
 
 func (t *BatchUpdateConversations) GetURLPath() string {
-	return ""
+	return "conversations"
 }
 
 func (t *BatchUpdateConversations) GetQuery() (string, error) {
@@ -42,36 +39,40 @@ func (t *BatchUpdateConversations) GetQuery() (string, error) {
 }
 
 func (t *BatchUpdateConversations) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *BatchUpdateConversations) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *BatchUpdateConversations) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Form.ConversationIDs == nil {
-		errs = append(errs, "'Form.ConversationIDs' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.ConversationIDs", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Event == "" {
-		errs = append(errs, "'Form.Event' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Event", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Event != "" && !string_utils.Include([]string{"mark_as_read", "mark_as_unread", "star", "unstar", "archive", "destroy"}, t.Form.Event) {
-		errs = append(errs, "Event must be one of mark_as_read, mark_as_unread, star, unstar, archive, destroy")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Event", Rule: canvasapi.RuleOneOf, Allowed: []string{"mark_as_read", "mark_as_unread", "star", "unstar", "archive", "destroy"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *BatchUpdateConversations) Do(c *canvasapi.Canvas) (*models.Progress, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *BatchUpdateConversations) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Progress, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -86,6 +87,10 @@ func (t *BatchUpdateConversations) Do(c *canvasapi.Canvas) (*models.Progress, er
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
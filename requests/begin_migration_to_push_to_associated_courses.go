@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -30,15 +28,15 @@ import (
 //
 type BeginMigrationToPushToAssociatedCourses struct {
 	Path struct {
-		CourseID   string `json:"course_id" url:"course_id,omitempty"`     //  (Required)
-		TemplateID string `json:"template_id" url:"template_id,omitempty"` //  (Required)
+		CourseID   canvasapi.ID `json:"course_id" url:"course_id,omitempty"`     //  (Required)
+		TemplateID canvasapi.ID `json:"template_id" url:"template_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		Comment                 string `json:"comment" url:"comment,omitempty"`                                       //  (Optional)
-		SendNotification        bool   `json:"send_notification" url:"send_notification,omitempty"`                   //  (Optional)
-		CopySettings            bool   `json:"copy_settings" url:"copy_settings,omitempty"`                           //  (Optional)
-		PublishAfterInitialSync bool   `json:"publish_after_initial_sync" url:"publish_after_initial_sync,omitempty"` //  (Optional)
+		Comment                 string              `json:"comment" url:"comment,omitempty"`                                       //  (Optional)
+		SendNotification        canvasapi.Opt[bool] `json:"send_notification" url:"send_notification,omitempty"`                   //  (Optional)
+		CopySettings            canvasapi.Opt[bool] `json:"copy_settings" url:"copy_settings,omitempty"`                           //  (Optional)
+		PublishAfterInitialSync canvasapi.Opt[bool] `json:"publish_after_initial_sync" url:"publish_after_initial_sync,omitempty"` //  (Optional)
 	} `json:"form"`
 }
 
@@ -48,8 +46,8 @@ func (t *BeginMigrationToPushToAssociatedCourses) GetMethod() string {
 
 func (t *BeginMigrationToPushToAssociatedCourses) GetURLPath() string {
 	path := "courses/{course_id}/blueprint_templates/{template_id}/migrations"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{template_id}", fmt.Sprintf("%v", t.Path.TemplateID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{template_id}", t.Path.TemplateID.Escaped())
 	return path
 }
 
@@ -58,33 +56,37 @@ func (t *BeginMigrationToPushToAssociatedCourses) GetQuery() (string, error) {
 }
 
 func (t *BeginMigrationToPushToAssociatedCourses) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *BeginMigrationToPushToAssociatedCourses) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *BeginMigrationToPushToAssociatedCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.TemplateID == "" {
-		errs = append(errs, "'Path.TemplateID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.TemplateID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *BeginMigrationToPushToAssociatedCourses) Do(c *canvasapi.Canvas) (*models.BlueprintMigration, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *BeginMigrationToPushToAssociatedCourses) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.BlueprintMigration, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -99,6 +101,10 @@ func (t *BeginMigrationToPushToAssociatedCourses) Do(c *canvasapi.Canvas) (*mode
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
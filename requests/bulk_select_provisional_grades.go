@@ -1,7 +1,9 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
@@ -18,8 +20,8 @@ import (
 //
 type BulkSelectProvisionalGrades struct {
 	Path struct {
-		CourseID     string `json:"course_id" url:"course_id,omitempty"`         //  (Required)
-		AssignmentID string `json:"assignment_id" url:"assignment_id,omitempty"` //  (Required)
+		CourseID     canvasapi.ID `json:"course_id" url:"course_id,omitempty"`         //  (Required)
+		AssignmentID canvasapi.ID `json:"assignment_id" url:"assignment_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -29,8 +31,8 @@ func (t *BulkSelectProvisionalGrades) GetMethod() string {
 
 func (t *BulkSelectProvisionalGrades) GetURLPath() string {
 	path := "courses/{course_id}/assignments/{assignment_id}/provisional_grades/bulk_select"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{assignment_id}", fmt.Sprintf("%v", t.Path.AssignmentID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{assignment_id}", t.Path.AssignmentID.Escaped())
 	return path
 }
 
@@ -47,24 +49,34 @@ func (t *BulkSelectProvisionalGrades) GetJSON() ([]byte, error) {
 }
 
 func (t *BulkSelectProvisionalGrades) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.AssignmentID == "" {
-		errs = append(errs, "'Path.AssignmentID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AssignmentID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *BulkSelectProvisionalGrades) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *BulkSelectProvisionalGrades) Do(c *canvasapi.Canvas) (json.RawMessage, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *BulkSelectProvisionalGrades) DoContext(ctx context.Context, c *canvasapi.Canvas) (json.RawMessage, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(body), nil
 }
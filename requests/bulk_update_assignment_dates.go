@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -34,7 +34,7 @@ import (
 //
 type BulkUpdateAssignmentDates struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -44,7 +44,7 @@ func (t *BulkUpdateAssignmentDates) GetMethod() string {
 
 func (t *BulkUpdateAssignmentDates) GetURLPath() string {
 	path := "courses/{course_id}/assignments/bulk_update"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
@@ -61,18 +61,22 @@ func (t *BulkUpdateAssignmentDates) GetJSON() ([]byte, error) {
 }
 
 func (t *BulkUpdateAssignmentDates) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *BulkUpdateAssignmentDates) Do(c *canvasapi.Canvas) (*models.Progress, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *BulkUpdateAssignmentDates) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Progress, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -87,6 +91,10 @@ func (t *BulkUpdateAssignmentDates) Do(c *canvasapi.Canvas) (*models.Progress, e
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -39,7 +37,7 @@ import (
 //
 type BulkUpdateColumnData struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -53,7 +51,7 @@ func (t *BulkUpdateColumnData) GetMethod() string {
 
 func (t *BulkUpdateColumnData) GetURLPath() string {
 	path := "courses/{course_id}/custom_gradebook_column_data"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
@@ -62,33 +60,37 @@ func (t *BulkUpdateColumnData) GetQuery() (string, error) {
 }
 
 func (t *BulkUpdateColumnData) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *BulkUpdateColumnData) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *BulkUpdateColumnData) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.ColumnData == nil {
-		errs = append(errs, "'Form.ColumnData' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.ColumnData", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *BulkUpdateColumnData) Do(c *canvasapi.Canvas) (*models.Progress, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *BulkUpdateColumnData) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Progress, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -103,6 +105,10 @@ func (t *BulkUpdateColumnData) Do(c *canvasapi.Canvas) (*models.Progress, error)
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,6 +1,9 @@
 package requests
 
 import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 
 	"github.com/atomicjolt/canvasapi"
@@ -17,7 +20,7 @@ func (t *ClearCourseNicknames) GetMethod() string {
 }
 
 func (t *ClearCourseNicknames) GetURLPath() string {
-	return ""
+	return "users/self/course_nicknames"
 }
 
 func (t *ClearCourseNicknames) GetQuery() (string, error) {
@@ -36,11 +39,21 @@ func (t *ClearCourseNicknames) HasErrors() error {
 	return nil
 }
 
-func (t *ClearCourseNicknames) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *ClearCourseNicknames) Do(c *canvasapi.Canvas) (json.RawMessage, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ClearCourseNicknames) DoContext(ctx context.Context, c *canvasapi.Canvas) (json.RawMessage, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(body), nil
 }
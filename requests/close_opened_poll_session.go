@@ -1,11 +1,14 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // CloseOpenedPollSession
@@ -17,8 +20,8 @@ import (
 //
 type CloseOpenedPollSession struct {
 	Path struct {
-		PollID string `json:"poll_id" url:"poll_id,omitempty"` //  (Required)
-		ID     string `json:"id" url:"id,omitempty"`           //  (Required)
+		PollID canvasapi.ID `json:"poll_id" url:"poll_id,omitempty"` //  (Required)
+		ID     canvasapi.ID `json:"id" url:"id,omitempty"`           //  (Required)
 	} `json:"path"`
 }
 
@@ -28,8 +31,8 @@ func (t *CloseOpenedPollSession) GetMethod() string {
 
 func (t *CloseOpenedPollSession) GetURLPath() string {
 	path := "polls/{poll_id}/poll_sessions/{id}/close"
-	path = strings.ReplaceAll(path, "{poll_id}", fmt.Sprintf("%v", t.Path.PollID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{poll_id}", t.Path.PollID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -46,24 +49,43 @@ func (t *CloseOpenedPollSession) GetJSON() ([]byte, error) {
 }
 
 func (t *CloseOpenedPollSession) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.PollID == "" {
-		errs = append(errs, "'Path.PollID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.PollID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *CloseOpenedPollSession) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *CloseOpenedPollSession) Do(c *canvasapi.Canvas) (*models.PollSessionsResponse, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CloseOpenedPollSession) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.PollSessionsResponse, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.PollSessionsResponse{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,14 +1,14 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // CompleteQuizSubmissionTurnItIn Complete the quiz submission by marking it as complete and grading it. When
@@ -41,9 +41,9 @@ import (
 //
 type CompleteQuizSubmissionTurnItIn struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		QuizID   string `json:"quiz_id" url:"quiz_id,omitempty"`     //  (Required)
-		ID       string `json:"id" url:"id,omitempty"`               //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		QuizID   canvasapi.ID `json:"quiz_id" url:"quiz_id,omitempty"`     //  (Required)
+		ID       canvasapi.ID `json:"id" url:"id,omitempty"`               //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -59,9 +59,9 @@ func (t *CompleteQuizSubmissionTurnItIn) GetMethod() string {
 
 func (t *CompleteQuizSubmissionTurnItIn) GetURLPath() string {
 	path := "courses/{course_id}/quizzes/{quiz_id}/submissions/{id}/complete"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{quiz_id}", fmt.Sprintf("%v", t.Path.QuizID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{quiz_id}", t.Path.QuizID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -70,42 +70,61 @@ func (t *CompleteQuizSubmissionTurnItIn) GetQuery() (string, error) {
 }
 
 func (t *CompleteQuizSubmissionTurnItIn) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CompleteQuizSubmissionTurnItIn) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CompleteQuizSubmissionTurnItIn) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.QuizID == "" {
-		errs = append(errs, "'Path.QuizID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.QuizID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.ValidationToken == "" {
-		errs = append(errs, "'Form.ValidationToken' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.ValidationToken", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *CompleteQuizSubmissionTurnItIn) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *CompleteQuizSubmissionTurnItIn) Do(c *canvasapi.Canvas) (*models.QuizSubmissionsResponse, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CompleteQuizSubmissionTurnItIn) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.QuizSubmissionsResponse, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.QuizSubmissionsResponse{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
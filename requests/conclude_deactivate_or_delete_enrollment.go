@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -29,8 +27,8 @@ import (
 //
 type ConcludeDeactivateOrDeleteEnrollment struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		ID       string `json:"id" url:"id,omitempty"`               //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		ID       canvasapi.ID `json:"id" url:"id,omitempty"`               //  (Required)
 	} `json:"path"`
 
 	Query struct {
@@ -44,13 +42,13 @@ func (t *ConcludeDeactivateOrDeleteEnrollment) GetMethod() string {
 
 func (t *ConcludeDeactivateOrDeleteEnrollment) GetURLPath() string {
 	path := "courses/{course_id}/enrollments/{id}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
 func (t *ConcludeDeactivateOrDeleteEnrollment) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -66,24 +64,28 @@ func (t *ConcludeDeactivateOrDeleteEnrollment) GetJSON() ([]byte, error) {
 }
 
 func (t *ConcludeDeactivateOrDeleteEnrollment) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Query.Task != "" && !string_utils.Include([]string{"conclude", "delete", "inactivate", "deactivate"}, t.Query.Task) {
-		errs = append(errs, "Task must be one of conclude, delete, inactivate, deactivate")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.Task", Rule: canvasapi.RuleOneOf, Allowed: []string{"conclude", "delete", "inactivate", "deactivate"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ConcludeDeactivateOrDeleteEnrollment) Do(c *canvasapi.Canvas) (*models.Enrollment, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ConcludeDeactivateOrDeleteEnrollment) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Enrollment, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -98,6 +100,10 @@ func (t *ConcludeDeactivateOrDeleteEnrollment) Do(c *canvasapi.Canvas) (*models.
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
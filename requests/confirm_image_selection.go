@@ -1,7 +1,9 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
@@ -16,7 +18,7 @@ import (
 //
 type ConfirmImageSelection struct {
 	Path struct {
-		ID string `json:"id" url:"id,omitempty"` //  (Required)
+		ID canvasapi.ID `json:"id" url:"id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -26,7 +28,7 @@ func (t *ConfirmImageSelection) GetMethod() string {
 
 func (t *ConfirmImageSelection) GetURLPath() string {
 	path := "image_selection/{id}"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -43,21 +45,31 @@ func (t *ConfirmImageSelection) GetJSON() ([]byte, error) {
 }
 
 func (t *ConfirmImageSelection) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *ConfirmImageSelection) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *ConfirmImageSelection) Do(c *canvasapi.Canvas) (json.RawMessage, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ConfirmImageSelection) DoContext(ctx context.Context, c *canvasapi.Canvas) (json.RawMessage, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(body), nil
 }
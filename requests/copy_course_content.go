@@ -1,14 +1,14 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -33,7 +33,7 @@ import (
 //
 type CopyCourseContent struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -49,7 +49,7 @@ func (t *CopyCourseContent) GetMethod() string {
 
 func (t *CopyCourseContent) GetURLPath() string {
 	path := "courses/{course_id}/course_copy"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
@@ -58,43 +58,62 @@ func (t *CopyCourseContent) GetQuery() (string, error) {
 }
 
 func (t *CopyCourseContent) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CopyCourseContent) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CopyCourseContent) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	for _, v := range t.Form.Except {
 		if v != "" && !string_utils.Include([]string{"course_settings", "assignments", "external_tools", "files", "topics", "calendar_events", "quizzes", "wiki_pages", "modules", "outcomes"}, v) {
-			errs = append(errs, "Except must be one of course_settings, assignments, external_tools, files, topics, calendar_events, quizzes, wiki_pages, modules, outcomes")
+			errs = append(errs, canvasapi.FieldError{Field: "Form.Except", Rule: canvasapi.RuleOneOf, Allowed: []string{"course_settings", "assignments", "external_tools", "files", "topics", "calendar_events", "quizzes", "wiki_pages", "modules", "outcomes"}})
 		}
 	}
 	for _, v := range t.Form.Only {
 		if v != "" && !string_utils.Include([]string{"course_settings", "assignments", "external_tools", "files", "topics", "calendar_events", "quizzes", "wiki_pages", "modules", "outcomes"}, v) {
-			errs = append(errs, "Only must be one of course_settings, assignments, external_tools, files, topics, calendar_events, quizzes, wiki_pages, modules, outcomes")
+			errs = append(errs, canvasapi.FieldError{Field: "Form.Only", Rule: canvasapi.RuleOneOf, Allowed: []string{"course_settings", "assignments", "external_tools", "files", "topics", "calendar_events", "quizzes", "wiki_pages", "modules", "outcomes"}})
 		}
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *CopyCourseContent) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *CopyCourseContent) Do(c *canvasapi.Canvas) (*models.CourseCopyStatus, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CopyCourseContent) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.CourseCopyStatus, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.CourseCopyStatus{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -33,7 +31,7 @@ import (
 //
 type CopyFile struct {
 	Path struct {
-		DestFolderID string `json:"dest_folder_id" url:"dest_folder_id,omitempty"` //  (Required)
+		DestFolderID canvasapi.ID `json:"dest_folder_id" url:"dest_folder_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -48,7 +46,7 @@ func (t *CopyFile) GetMethod() string {
 
 func (t *CopyFile) GetURLPath() string {
 	path := "folders/{dest_folder_id}/copy_file"
-	path = strings.ReplaceAll(path, "{dest_folder_id}", fmt.Sprintf("%v", t.Path.DestFolderID))
+	path = strings.ReplaceAll(path, "{dest_folder_id}", t.Path.DestFolderID.Escaped())
 	return path
 }
 
@@ -57,36 +55,40 @@ func (t *CopyFile) GetQuery() (string, error) {
 }
 
 func (t *CopyFile) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CopyFile) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CopyFile) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.DestFolderID == "" {
-		errs = append(errs, "'Path.DestFolderID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.DestFolderID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.SourceFileID == "" {
-		errs = append(errs, "'Form.SourceFileID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.SourceFileID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.OnDuplicate != "" && !string_utils.Include([]string{"overwrite", "rename"}, t.Form.OnDuplicate) {
-		errs = append(errs, "OnDuplicate must be one of overwrite, rename")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.OnDuplicate", Rule: canvasapi.RuleOneOf, Allowed: []string{"overwrite", "rename"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CopyFile) Do(c *canvasapi.Canvas) (*models.File, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CopyFile) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.File, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -101,6 +103,10 @@ func (t *CopyFile) Do(c *canvasapi.Canvas) (*models.File, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -31,7 +29,7 @@ import (
 //
 type CopyFolder struct {
 	Path struct {
-		DestFolderID string `json:"dest_folder_id" url:"dest_folder_id,omitempty"` //  (Required)
+		DestFolderID canvasapi.ID `json:"dest_folder_id" url:"dest_folder_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -45,7 +43,7 @@ func (t *CopyFolder) GetMethod() string {
 
 func (t *CopyFolder) GetURLPath() string {
 	path := "folders/{dest_folder_id}/copy_folder"
-	path = strings.ReplaceAll(path, "{dest_folder_id}", fmt.Sprintf("%v", t.Path.DestFolderID))
+	path = strings.ReplaceAll(path, "{dest_folder_id}", t.Path.DestFolderID.Escaped())
 	return path
 }
 
@@ -54,33 +52,37 @@ func (t *CopyFolder) GetQuery() (string, error) {
 }
 
 func (t *CopyFolder) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CopyFolder) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CopyFolder) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.DestFolderID == "" {
-		errs = append(errs, "'Path.DestFolderID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.DestFolderID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.SourceFolderID == "" {
-		errs = append(errs, "'Form.SourceFolderID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.SourceFolderID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CopyFolder) Do(c *canvasapi.Canvas) (*models.Folder, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CopyFolder) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Folder, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -95,6 +97,10 @@ func (t *CopyFolder) Do(c *canvasapi.Canvas) (*models.Folder, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,11 +1,15 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
 	"net/url"
 	"strings"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // CourseActivityStream Returns the current user's course-specific activity stream, paginated.
@@ -19,7 +23,7 @@ import (
 //
 type CourseActivityStream struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -29,7 +33,7 @@ func (t *CourseActivityStream) GetMethod() string {
 
 func (t *CourseActivityStream) GetURLPath() string {
 	path := "courses/{course_id}/activity_stream"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
@@ -46,21 +50,52 @@ func (t *CourseActivityStream) GetJSON() ([]byte, error) {
 }
 
 func (t *CourseActivityStream) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *CourseActivityStream) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *CourseActivityStream) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.StreamItem, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *CourseActivityStream) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.StreamItem, *canvasapi.PagedResource, error) {
+	var err error
+	var response *http.Response
+	if next != nil {
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
+	} else {
+		response, err = c.SendRequestContext(ctx, t)
+	}
+
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	ret := []*models.StreamItem{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ret, pagedResource, nil
 }
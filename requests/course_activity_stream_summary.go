@@ -1,11 +1,14 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // CourseActivityStreamSummary Returns a summary of the current user's course-specific activity stream.
@@ -19,7 +22,7 @@ import (
 //
 type CourseActivityStreamSummary struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -29,7 +32,7 @@ func (t *CourseActivityStreamSummary) GetMethod() string {
 
 func (t *CourseActivityStreamSummary) GetURLPath() string {
 	path := "courses/{course_id}/activity_stream/summary"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
@@ -46,21 +49,40 @@ func (t *CourseActivityStreamSummary) GetJSON() ([]byte, error) {
 }
 
 func (t *CourseActivityStreamSummary) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *CourseActivityStreamSummary) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *CourseActivityStreamSummary) Do(c *canvasapi.Canvas) ([]*models.StreamSummary, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CourseActivityStreamSummary) DoContext(ctx context.Context, c *canvasapi.Canvas) ([]*models.StreamSummary, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := []*models.StreamSummary{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
 }
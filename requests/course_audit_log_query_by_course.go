@@ -1,16 +1,14 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -27,7 +25,7 @@ import (
 //
 type CourseAuditLogQueryByCourse struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
@@ -42,12 +40,12 @@ func (t *CourseAuditLogQueryByCourse) GetMethod() string {
 
 func (t *CourseAuditLogQueryByCourse) GetURLPath() string {
 	path := "audit/course/courses/{course_id}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
 func (t *CourseAuditLogQueryByCourse) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -63,28 +61,29 @@ func (t *CourseAuditLogQueryByCourse) GetJSON() ([]byte, error) {
 }
 
 func (t *CourseAuditLogQueryByCourse) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *CourseAuditLogQueryByCourse) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.CourseEvent, *canvasapi.PagedResource, error) {
+func (t *CourseAuditLogQueryByCourse) Do(c *canvasapi.Canvas, next *url.URL) (*models.CourseEventsResponse, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *CourseAuditLogQueryByCourse) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) (*models.CourseEventsResponse, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
-	if err != nil {
-		return nil, nil, err
-	}
 	if err != nil {
 		return nil, nil, err
 	}
@@ -94,16 +93,20 @@ func (t *CourseAuditLogQueryByCourse) Do(c *canvasapi.Canvas, next *url.URL) ([]
 	if err != nil {
 		return nil, nil, err
 	}
-	ret := []*models.CourseEvent{}
+	ret := models.CourseEventsResponse{}
 	err = json.Unmarshal(body, &ret)
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	return ret, pagedResource, nil
+	return &ret, pagedResource, nil
 }
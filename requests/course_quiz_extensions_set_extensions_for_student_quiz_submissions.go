@@ -1,14 +1,14 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // CourseQuizExtensionsSetExtensionsForStudentQuizSubmissions <b>Responses</b>
@@ -38,16 +38,16 @@ import (
 //
 type CourseQuizExtensionsSetExtensionsForStudentQuizSubmissions struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		UserID           int64 `json:"user_id" url:"user_id,omitempty"`                       //  (Required)
-		ExtraAttempts    int64 `json:"extra_attempts" url:"extra_attempts,omitempty"`         //  (Optional)
-		ExtraTime        int64 `json:"extra_time" url:"extra_time,omitempty"`                 //  (Optional)
-		ManuallyUnlocked bool  `json:"manually_unlocked" url:"manually_unlocked,omitempty"`   //  (Optional)
-		ExtendFromNow    int64 `json:"extend_from_now" url:"extend_from_now,omitempty"`       //  (Optional)
-		ExtendFromEndAt  int64 `json:"extend_from_end_at" url:"extend_from_end_at,omitempty"` //  (Optional)
+		UserID           int64                `json:"user_id" url:"user_id,omitempty"`                       //  (Required)
+		ExtraAttempts    canvasapi.Opt[int64] `json:"extra_attempts" url:"extra_attempts,omitempty"`         //  (Optional)
+		ExtraTime        canvasapi.Opt[int64] `json:"extra_time" url:"extra_time,omitempty"`                 //  (Optional)
+		ManuallyUnlocked canvasapi.Opt[bool]  `json:"manually_unlocked" url:"manually_unlocked,omitempty"`   //  (Optional)
+		ExtendFromNow    canvasapi.Opt[int64] `json:"extend_from_now" url:"extend_from_now,omitempty"`       //  (Optional)
+		ExtendFromEndAt  canvasapi.Opt[int64] `json:"extend_from_end_at" url:"extend_from_end_at,omitempty"` //  (Optional)
 	} `json:"form"`
 }
 
@@ -57,7 +57,7 @@ func (t *CourseQuizExtensionsSetExtensionsForStudentQuizSubmissions) GetMethod()
 
 func (t *CourseQuizExtensionsSetExtensionsForStudentQuizSubmissions) GetURLPath() string {
 	path := "courses/{course_id}/quiz_extensions"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
@@ -66,33 +66,52 @@ func (t *CourseQuizExtensionsSetExtensionsForStudentQuizSubmissions) GetQuery()
 }
 
 func (t *CourseQuizExtensionsSetExtensionsForStudentQuizSubmissions) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CourseQuizExtensionsSetExtensionsForStudentQuizSubmissions) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CourseQuizExtensionsSetExtensionsForStudentQuizSubmissions) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *CourseQuizExtensionsSetExtensionsForStudentQuizSubmissions) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *CourseQuizExtensionsSetExtensionsForStudentQuizSubmissions) Do(c *canvasapi.Canvas) (*models.QuizExtensionsResponse, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CourseQuizExtensionsSetExtensionsForStudentQuizSubmissions) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.QuizExtensionsResponse, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.QuizExtensionsResponse{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,11 +1,15 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
 	"net/url"
 	"strings"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // CourseTodoItems Returns the current user's course-specific todo items.
@@ -18,7 +22,7 @@ import (
 //
 type CourseTodoItems struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -28,7 +32,7 @@ func (t *CourseTodoItems) GetMethod() string {
 
 func (t *CourseTodoItems) GetURLPath() string {
 	path := "courses/{course_id}/todo"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
@@ -45,21 +49,52 @@ func (t *CourseTodoItems) GetJSON() ([]byte, error) {
 }
 
 func (t *CourseTodoItems) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *CourseTodoItems) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *CourseTodoItems) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.TodoItem, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *CourseTodoItems) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.TodoItem, *canvasapi.PagedResource, error) {
+	var err error
+	var response *http.Response
+	if next != nil {
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
+	} else {
+		response, err = c.SendRequestContext(ctx, t)
+	}
+
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	ret := []*models.TodoItem{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ret, pagedResource, nil
 }
@@ -1,12 +1,12 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 )
 
@@ -24,7 +24,7 @@ import (
 //
 type CoursesPermissions struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
@@ -38,12 +38,12 @@ func (t *CoursesPermissions) GetMethod() string {
 
 func (t *CoursesPermissions) GetURLPath() string {
 	path := "courses/{course_id}/permissions"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
 func (t *CoursesPermissions) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -59,21 +59,36 @@ func (t *CoursesPermissions) GetJSON() ([]byte, error) {
 }
 
 func (t *CoursesPermissions) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *CoursesPermissions) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *CoursesPermissions) Do(c *canvasapi.Canvas) (map[string]bool, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CoursesPermissions) DoContext(ctx context.Context, c *canvasapi.Canvas) (map[string]bool, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := map[string]bool{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
 }
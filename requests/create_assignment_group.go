@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -30,13 +28,13 @@ import (
 //
 type CreateAssignmentGroup struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		Name            string                   `json:"name" url:"name,omitempty"`                         //  (Optional)
-		Position        int64                    `json:"position" url:"position,omitempty"`                 //  (Optional)
-		GroupWeight     float64                  `json:"group_weight" url:"group_weight,omitempty"`         //  (Optional)
+		Position        canvasapi.Opt[int64]     `json:"position" url:"position,omitempty"`                 //  (Optional)
+		GroupWeight     canvasapi.Opt[float64]   `json:"group_weight" url:"group_weight,omitempty"`         //  (Optional)
 		SISSourceID     string                   `json:"sis_source_id" url:"sis_source_id,omitempty"`       //  (Optional)
 		IntegrationData map[string](interface{}) `json:"integration_data" url:"integration_data,omitempty"` //  (Optional)
 		Rules           string                   `json:"rules" url:"rules,omitempty"`                       //  (Optional)
@@ -49,7 +47,7 @@ func (t *CreateAssignmentGroup) GetMethod() string {
 
 func (t *CreateAssignmentGroup) GetURLPath() string {
 	path := "courses/{course_id}/assignment_groups"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
@@ -58,30 +56,34 @@ func (t *CreateAssignmentGroup) GetQuery() (string, error) {
 }
 
 func (t *CreateAssignmentGroup) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateAssignmentGroup) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateAssignmentGroup) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CreateAssignmentGroup) Do(c *canvasapi.Canvas) (*models.AssignmentGroup, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateAssignmentGroup) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.AssignmentGroup, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -96,6 +98,10 @@ func (t *CreateAssignmentGroup) Do(c *canvasapi.Canvas) (*models.AssignmentGroup
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
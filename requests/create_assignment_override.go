@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -64,19 +62,19 @@ import (
 //
 type CreateAssignmentOverride struct {
 	Path struct {
-		CourseID     string `json:"course_id" url:"course_id,omitempty"`         //  (Required)
-		AssignmentID string `json:"assignment_id" url:"assignment_id,omitempty"` //  (Required)
+		CourseID     canvasapi.ID `json:"course_id" url:"course_id,omitempty"`         //  (Required)
+		AssignmentID canvasapi.ID `json:"assignment_id" url:"assignment_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		AssignmentOverride struct {
-			StudentIDs      []string  `json:"student_ids" url:"student_ids,omitempty"`             //  (Optional)
-			Title           string    `json:"title" url:"title,omitempty"`                         //  (Optional)
-			GroupID         int64     `json:"group_id" url:"group_id,omitempty"`                   //  (Optional)
-			CourseSectionID int64     `json:"course_section_id" url:"course_section_id,omitempty"` //  (Optional)
-			DueAt           time.Time `json:"due_at" url:"due_at,omitempty"`                       //  (Optional)
-			UnlockAt        time.Time `json:"unlock_at" url:"unlock_at,omitempty"`                 //  (Optional)
-			LockAt          time.Time `json:"lock_at" url:"lock_at,omitempty"`                     //  (Optional)
+			StudentIDs      []string             `json:"student_ids" url:"student_ids,omitempty"`             //  (Optional)
+			Title           string               `json:"title" url:"title,omitempty"`                         //  (Optional)
+			GroupID         canvasapi.Opt[int64] `json:"group_id" url:"group_id,omitempty"`                   //  (Optional)
+			CourseSectionID canvasapi.Opt[int64] `json:"course_section_id" url:"course_section_id,omitempty"` //  (Optional)
+			DueAt           time.Time            `json:"due_at" url:"due_at,omitempty"`                       //  (Optional)
+			UnlockAt        time.Time            `json:"unlock_at" url:"unlock_at,omitempty"`                 //  (Optional)
+			LockAt          time.Time            `json:"lock_at" url:"lock_at,omitempty"`                     //  (Optional)
 		} `json:"assignment_override" url:"assignment_override,omitempty"`
 	} `json:"form"`
 }
@@ -87,8 +85,8 @@ func (t *CreateAssignmentOverride) GetMethod() string {
 
 func (t *CreateAssignmentOverride) GetURLPath() string {
 	path := "courses/{course_id}/assignments/{assignment_id}/overrides"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{assignment_id}", fmt.Sprintf("%v", t.Path.AssignmentID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{assignment_id}", t.Path.AssignmentID.Escaped())
 	return path
 }
 
@@ -97,33 +95,37 @@ func (t *CreateAssignmentOverride) GetQuery() (string, error) {
 }
 
 func (t *CreateAssignmentOverride) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateAssignmentOverride) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateAssignmentOverride) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.AssignmentID == "" {
-		errs = append(errs, "'Path.AssignmentID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AssignmentID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CreateAssignmentOverride) Do(c *canvasapi.Canvas) (*models.AssignmentOverride, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateAssignmentOverride) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.AssignmentOverride, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -138,6 +140,10 @@ func (t *CreateAssignmentOverride) Do(c *canvasapi.Canvas) (*models.AssignmentOv
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
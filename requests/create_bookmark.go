@@ -1,12 +1,11 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/url"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -22,10 +21,10 @@ import (
 //
 type CreateBookmark struct {
 	Form struct {
-		Name     string `json:"name" url:"name,omitempty"`         //  (Optional)
-		Url      string `json:"url" url:"url,omitempty"`           //  (Optional)
-		Position int64  `json:"position" url:"position,omitempty"` //  (Optional)
-		Data     string `json:"data" url:"data,omitempty"`         //  (Optional)
+		Name     string               `json:"name" url:"name,omitempty"`         //  (Optional)
+		Url      string               `json:"url" url:"url,omitempty"`           //  (Optional)
+		Position canvasapi.Opt[int64] `json:"position" url:"position,omitempty"` //  (Optional)
+		Data     string               `json:"data" url:"data,omitempty"`         //  (Optional)
 	} `json:"form"`
 }
 
@@ -34,7 +33,7 @@ func (t *CreateBookmark) GetMethod() string {
 }
 
 func (t *CreateBookmark) GetURLPath() string {
-	return ""
+	return "users/self/bookmarks"
 }
 
 func (t *CreateBookmark) GetQuery() (string, error) {
@@ -42,13 +41,13 @@ func (t *CreateBookmark) GetQuery() (string, error) {
 }
 
 func (t *CreateBookmark) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateBookmark) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
@@ -58,7 +57,11 @@ func (t *CreateBookmark) HasErrors() error {
 }
 
 func (t *CreateBookmark) Do(c *canvasapi.Canvas) (*models.Bookmark, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateBookmark) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Bookmark, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -73,6 +76,10 @@ func (t *CreateBookmark) Do(c *canvasapi.Canvas) (*models.Bookmark, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
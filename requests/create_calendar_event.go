@@ -1,15 +1,14 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
-	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -51,13 +50,13 @@ type CreateCalendarEvent struct {
 			LocationName    string                                       `json:"location_name" url:"location_name,omitempty"`       //  (Optional)
 			LocationAddress string                                       `json:"location_address" url:"location_address,omitempty"` //  (Optional)
 			TimeZoneEdited  string                                       `json:"time_zone_edited" url:"time_zone_edited,omitempty"` //  (Optional)
-			AllDay          bool                                         `json:"all_day" url:"all_day,omitempty"`                   //  (Optional)
+			AllDay          canvasapi.Opt[bool]                          `json:"all_day" url:"all_day,omitempty"`                   //  (Optional)
 			ChildEventData  map[string]CreateCalendarEventChildEventData `json:"child_event_data" url:"child_event_data,omitempty"` //  (Optional)
 			Duplicate       struct {
-				Count          float64 `json:"count" url:"count,omitempty"`                     //  (Optional)
-				Interval       float64 `json:"interval" url:"interval,omitempty"`               //  (Optional)
-				Frequency      string  `json:"frequency" url:"frequency,omitempty"`             //  (Optional) . Must be one of daily, weekly, monthly
-				AppendIterator bool    `json:"append_iterator" url:"append_iterator,omitempty"` //  (Optional)
+				Count          canvasapi.Opt[float64] `json:"count" url:"count,omitempty"`                     //  (Optional)
+				Interval       canvasapi.Opt[float64] `json:"interval" url:"interval,omitempty"`               //  (Optional)
+				Frequency      string                 `json:"frequency" url:"frequency,omitempty"`             //  (Optional) . Must be one of daily, weekly, monthly
+				AppendIterator canvasapi.Opt[bool]    `json:"append_iterator" url:"append_iterator,omitempty"` //  (Optional)
 			} `json:"duplicate" url:"duplicate,omitempty"`
 		} `json:"calendar_event" url:"calendar_event,omitempty"`
 	} `json:"form"`
@@ -68,7 +67,7 @@ func (t *CreateCalendarEvent) GetMethod() string {
 }
 
 func (t *CreateCalendarEvent) GetURLPath() string {
-	return ""
+	return "calendar_events"
 }
 
 func (t *CreateCalendarEvent) GetQuery() (string, error) {
@@ -76,38 +75,57 @@ func (t *CreateCalendarEvent) GetQuery() (string, error) {
 }
 
 func (t *CreateCalendarEvent) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateCalendarEvent) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateCalendarEvent) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Form.CalendarEvent.ContextCode == "" {
-		errs = append(errs, "'Form.CalendarEvent.ContextCode' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.CalendarEvent.ContextCode", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.CalendarEvent.Duplicate.Frequency != "" && !string_utils.Include([]string{"daily", "weekly", "monthly"}, t.Form.CalendarEvent.Duplicate.Frequency) {
-		errs = append(errs, "CalendarEvent must be one of daily, weekly, monthly")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.CalendarEvent.Duplicate.Frequency", Rule: canvasapi.RuleOneOf, Allowed: []string{"daily", "weekly", "monthly"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *CreateCalendarEvent) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *CreateCalendarEvent) Do(c *canvasapi.Canvas) (*models.CalendarEvent, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateCalendarEvent) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.CalendarEvent, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.CalendarEvent{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
 
 type CreateCalendarEventChildEventData struct {
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -38,7 +36,7 @@ import (
 //
 type CreateCommunicationChannel struct {
 	Path struct {
-		UserID string `json:"user_id" url:"user_id,omitempty"` //  (Required)
+		UserID canvasapi.ID `json:"user_id" url:"user_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -48,7 +46,7 @@ type CreateCommunicationChannel struct {
 			Token   string `json:"token" url:"token,omitempty"`     //  (Optional)
 		} `json:"communication_channel" url:"communication_channel,omitempty"`
 
-		SkipConfirmation bool `json:"skip_confirmation" url:"skip_confirmation,omitempty"` //  (Optional)
+		SkipConfirmation canvasapi.Opt[bool] `json:"skip_confirmation" url:"skip_confirmation,omitempty"` //  (Optional)
 	} `json:"form"`
 }
 
@@ -58,7 +56,7 @@ func (t *CreateCommunicationChannel) GetMethod() string {
 
 func (t *CreateCommunicationChannel) GetURLPath() string {
 	path := "users/{user_id}/communication_channels"
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", t.Path.UserID))
+	path = strings.ReplaceAll(path, "{user_id}", t.Path.UserID.Escaped())
 	return path
 }
 
@@ -67,39 +65,43 @@ func (t *CreateCommunicationChannel) GetQuery() (string, error) {
 }
 
 func (t *CreateCommunicationChannel) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateCommunicationChannel) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateCommunicationChannel) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.UserID == "" {
-		errs = append(errs, "'Path.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.CommunicationChannel.Address == "" {
-		errs = append(errs, "'Form.CommunicationChannel.Address' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.CommunicationChannel.Address", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.CommunicationChannel.Type == "" {
-		errs = append(errs, "'Form.CommunicationChannel.Type' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.CommunicationChannel.Type", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.CommunicationChannel.Type != "" && !string_utils.Include([]string{"email", "sms", "push"}, t.Form.CommunicationChannel.Type) {
-		errs = append(errs, "CommunicationChannel must be one of email, sms, push")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.CommunicationChannel.Type", Rule: canvasapi.RuleOneOf, Allowed: []string{"email", "sms", "push"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CreateCommunicationChannel) Do(c *canvasapi.Canvas) (*models.CommunicationChannel, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateCommunicationChannel) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.CommunicationChannel, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -114,6 +116,10 @@ func (t *CreateCommunicationChannel) Do(c *canvasapi.Canvas) (*models.Communicat
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
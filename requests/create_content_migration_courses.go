@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -56,8 +54,10 @@ import (
 // # Form.PreAttachment.Name (Optional) Required if uploading a file. This is the first step in uploading a file
 //    to the content migration. See the {file:file_uploads.html File Upload
 //    Documentation} for details on the file upload workflow.
-// # Form.PreAttachment.* (Optional) Other file upload properties, See {file:file_uploads.html File Upload
+// # Form.PreAttachment.Size (Optional) The size of the file in bytes. See {file:file_uploads.html File Upload
 //    Documentation}
+// # Form.PreAttachment.ContentType (Optional) The content type of the file. Canvas guesses it from the name
+//    when it is not given.
 // # Form.Settings.FileUrl (Optional) A URL to download the file from. Must not require authentication.
 // # Form.Settings.ContentExportID (Optional) The id of a ContentExport to import. This allows you to import content previously exported from Canvas
 //    without needing to download and re-upload it.
@@ -106,44 +106,45 @@ import (
 //
 type CreateContentMigrationCourses struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		MigrationType string `json:"migration_type" url:"migration_type,omitempty"` //  (Required)
 		PreAttachment struct {
-			Name    string                       `json:"name" url:"name,omitempty"` //  (Optional)
-			*string `json:"*" url:"*,omitempty"` //  (Optional)
+			Name        string               `json:"name" url:"name,omitempty"`                 //  (Optional)
+			Size        canvasapi.Opt[int64] `json:"size" url:"size,omitempty"`                 //  (Optional)
+			ContentType string               `json:"content_type" url:"content_type,omitempty"` //  (Optional)
 		} `json:"pre_attachment" url:"pre_attachment,omitempty"`
 
 		Settings struct {
-			FileUrl                  string `json:"file_url" url:"file_url,omitempty"`                                       //  (Optional)
-			ContentExportID          string `json:"content_export_id" url:"content_export_id,omitempty"`                     //  (Optional)
-			SourceCourseID           string `json:"source_course_id" url:"source_course_id,omitempty"`                       //  (Optional)
-			FolderID                 string `json:"folder_id" url:"folder_id,omitempty"`                                     //  (Optional)
-			OverwriteQuizzes         bool   `json:"overwrite_quizzes" url:"overwrite_quizzes,omitempty"`                     //  (Optional)
-			QuestionBankID           int64  `json:"question_bank_id" url:"question_bank_id,omitempty"`                       //  (Optional)
-			QuestionBankName         string `json:"question_bank_name" url:"question_bank_name,omitempty"`                   //  (Optional)
-			InsertIntoModuleID       int64  `json:"insert_into_module_id" url:"insert_into_module_id,omitempty"`             //  (Optional)
-			InsertIntoModuleType     string `json:"insert_into_module_type" url:"insert_into_module_type,omitempty"`         //  (Optional) . Must be one of assignment, discussion_topic, file, page, quiz
-			InsertIntoModulePosition int64  `json:"insert_into_module_position" url:"insert_into_module_position,omitempty"` //  (Optional)
-			MoveToAssignmentGroupID  int64  `json:"move_to_assignment_group_id" url:"move_to_assignment_group_id,omitempty"` //  (Optional)
+			FileUrl                  string               `json:"file_url" url:"file_url,omitempty"`                                       //  (Optional)
+			ContentExportID          string               `json:"content_export_id" url:"content_export_id,omitempty"`                     //  (Optional)
+			SourceCourseID           string               `json:"source_course_id" url:"source_course_id,omitempty"`                       //  (Optional)
+			FolderID                 string               `json:"folder_id" url:"folder_id,omitempty"`                                     //  (Optional)
+			OverwriteQuizzes         canvasapi.Opt[bool]  `json:"overwrite_quizzes" url:"overwrite_quizzes,omitempty"`                     //  (Optional)
+			QuestionBankID           canvasapi.Opt[int64] `json:"question_bank_id" url:"question_bank_id,omitempty"`                       //  (Optional)
+			QuestionBankName         string               `json:"question_bank_name" url:"question_bank_name,omitempty"`                   //  (Optional)
+			InsertIntoModuleID       canvasapi.Opt[int64] `json:"insert_into_module_id" url:"insert_into_module_id,omitempty"`             //  (Optional)
+			InsertIntoModuleType     string               `json:"insert_into_module_type" url:"insert_into_module_type,omitempty"`         //  (Optional) . Must be one of assignment, discussion_topic, file, page, quiz
+			InsertIntoModulePosition canvasapi.Opt[int64] `json:"insert_into_module_position" url:"insert_into_module_position,omitempty"` //  (Optional)
+			MoveToAssignmentGroupID  canvasapi.Opt[int64] `json:"move_to_assignment_group_id" url:"move_to_assignment_group_id,omitempty"` //  (Optional)
 		} `json:"settings" url:"settings,omitempty"`
 
 		DateShiftOptions struct {
-			ShiftDates       bool      `json:"shift_dates" url:"shift_dates,omitempty"`       //  (Optional)
-			OldStartDate     time.Time `json:"old_start_date" url:"old_start_date,omitempty"` //  (Optional)
-			OldEndDate       time.Time `json:"old_end_date" url:"old_end_date,omitempty"`     //  (Optional)
-			NewStartDate     time.Time `json:"new_start_date" url:"new_start_date,omitempty"` //  (Optional)
-			NewEndDate       time.Time `json:"new_end_date" url:"new_end_date,omitempty"`     //  (Optional)
+			ShiftDates       canvasapi.Opt[bool] `json:"shift_dates" url:"shift_dates,omitempty"`       //  (Optional)
+			OldStartDate     time.Time           `json:"old_start_date" url:"old_start_date,omitempty"` //  (Optional)
+			OldEndDate       time.Time           `json:"old_end_date" url:"old_end_date,omitempty"`     //  (Optional)
+			NewStartDate     time.Time           `json:"new_start_date" url:"new_start_date,omitempty"` //  (Optional)
+			NewEndDate       time.Time           `json:"new_end_date" url:"new_end_date,omitempty"`     //  (Optional)
 			DaySubstitutions struct {
-				X int64 `json:"x" url:"x,omitempty"` //  (Optional)
+				X canvasapi.Opt[int64] `json:"x" url:"x,omitempty"` //  (Optional)
 			} `json:"day_substitutions" url:"day_substitutions,omitempty"`
 
-			RemoveDates bool `json:"remove_dates" url:"remove_dates,omitempty"` //  (Optional)
+			RemoveDates canvasapi.Opt[bool] `json:"remove_dates" url:"remove_dates,omitempty"` //  (Optional)
 		} `json:"date_shift_options" url:"date_shift_options,omitempty"`
 
-		SelectiveImport bool                     `json:"selective_import" url:"selective_import,omitempty"` //  (Optional)
+		SelectiveImport canvasapi.Opt[bool]      `json:"selective_import" url:"selective_import,omitempty"` //  (Optional)
 		Select          map[string](interface{}) `json:"select" url:"select,omitempty"`                     //  (Optional) . Must be one of folders, files, attachments, quizzes, assignments, announcements, calendar_events, discussion_topics, modules, module_items, pages, rubrics
 	} `json:"form"`
 }
@@ -154,7 +155,7 @@ func (t *CreateContentMigrationCourses) GetMethod() string {
 
 func (t *CreateContentMigrationCourses) GetURLPath() string {
 	path := "courses/{course_id}/content_migrations"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
@@ -163,36 +164,40 @@ func (t *CreateContentMigrationCourses) GetQuery() (string, error) {
 }
 
 func (t *CreateContentMigrationCourses) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateContentMigrationCourses) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateContentMigrationCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.MigrationType == "" {
-		errs = append(errs, "'Form.MigrationType' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.MigrationType", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Settings.InsertIntoModuleType != "" && !string_utils.Include([]string{"assignment", "discussion_topic", "file", "page", "quiz"}, t.Form.Settings.InsertIntoModuleType) {
-		errs = append(errs, "Settings must be one of assignment, discussion_topic, file, page, quiz")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Settings.InsertIntoModuleType", Rule: canvasapi.RuleOneOf, Allowed: []string{"assignment", "discussion_topic", "file", "page", "quiz"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CreateContentMigrationCourses) Do(c *canvasapi.Canvas) (*models.ContentMigration, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateContentMigrationCourses) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.ContentMigration, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -207,6 +212,10 @@ func (t *CreateContentMigrationCourses) Do(c *canvasapi.Canvas) (*models.Content
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -27,7 +25,7 @@ import (
 //
 type CreateContentShare struct {
 	Path struct {
-		UserID string `json:"user_id" url:"user_id,omitempty"` //  (Required)
+		UserID canvasapi.ID `json:"user_id" url:"user_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -43,7 +41,7 @@ func (t *CreateContentShare) GetMethod() string {
 
 func (t *CreateContentShare) GetURLPath() string {
 	path := "users/{user_id}/content_shares"
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", t.Path.UserID))
+	path = strings.ReplaceAll(path, "{user_id}", t.Path.UserID.Escaped())
 	return path
 }
 
@@ -52,39 +50,43 @@ func (t *CreateContentShare) GetQuery() (string, error) {
 }
 
 func (t *CreateContentShare) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateContentShare) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateContentShare) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.UserID == "" {
-		errs = append(errs, "'Path.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.ReceiverIDs == "" {
-		errs = append(errs, "'Form.ReceiverIDs' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.ReceiverIDs", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.ContentType == "" {
-		errs = append(errs, "'Form.ContentType' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.ContentType", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.ContentType != "" && !string_utils.Include([]string{"assignment", "discussion_topic", "page", "quiz", "module", "module_item"}, t.Form.ContentType) {
-		errs = append(errs, "ContentType must be one of assignment, discussion_topic, page, quiz, module, module_item")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.ContentType", Rule: canvasapi.RuleOneOf, Allowed: []string{"assignment", "discussion_topic", "page", "quiz", "module", "module_item"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CreateContentShare) Do(c *canvasapi.Canvas) (*models.ContentShare, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateContentShare) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.ContentShare, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -99,6 +101,10 @@ func (t *CreateContentShare) Do(c *canvasapi.Canvas) (*models.ContentShare, erro
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
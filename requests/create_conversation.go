@@ -1,14 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
-	"strings"
-
-	"github.com/google/go-querystring/query"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -55,20 +54,20 @@ import (
 //
 type CreateConversation struct {
 	Form struct {
-		Recipients        []string `json:"recipients" url:"recipients,omitempty"`                 //  (Required)
-		Subject           string   `json:"subject" url:"subject,omitempty"`                       //  (Optional)
-		Body              string   `json:"body" url:"body,omitempty"`                             //  (Required)
-		ForceNew          bool     `json:"force_new" url:"force_new,omitempty"`                   //  (Optional)
-		GroupConversation bool     `json:"group_conversation" url:"group_conversation,omitempty"` //  (Optional)
-		AttachmentIDs     []string `json:"attachment_ids" url:"attachment_ids,omitempty"`         //  (Optional)
-		MediaCommentID    string   `json:"media_comment_id" url:"media_comment_id,omitempty"`     //  (Optional)
-		MediaCommentType  string   `json:"media_comment_type" url:"media_comment_type,omitempty"` //  (Optional) . Must be one of audio, video
-		UserNote          bool     `json:"user_note" url:"user_note,omitempty"`                   //  (Optional)
-		Mode              string   `json:"mode" url:"mode,omitempty"`                             //  (Optional) . Must be one of sync, async
-		Scope             string   `json:"scope" url:"scope,omitempty"`                           //  (Optional) . Must be one of unread, starred, archived
-		Filter            []string `json:"filter" url:"filter,omitempty"`                         //  (Optional)
-		FilterMode        string   `json:"filter_mode" url:"filter_mode,omitempty"`               //  (Optional) . Must be one of and, or, default or
-		ContextCode       string   `json:"context_code" url:"context_code,omitempty"`             //  (Optional)
+		Recipients        []string            `json:"recipients" url:"recipients,omitempty"`                 //  (Required)
+		Subject           string              `json:"subject" url:"subject,omitempty"`                       //  (Optional)
+		Body              string              `json:"body" url:"body,omitempty"`                             //  (Required)
+		ForceNew          canvasapi.Opt[bool] `json:"force_new" url:"force_new,omitempty"`                   //  (Optional)
+		GroupConversation canvasapi.Opt[bool] `json:"group_conversation" url:"group_conversation,omitempty"` //  (Optional)
+		AttachmentIDs     []string            `json:"attachment_ids" url:"attachment_ids,omitempty"`         //  (Optional)
+		MediaCommentID    string              `json:"media_comment_id" url:"media_comment_id,omitempty"`     //  (Optional)
+		MediaCommentType  string              `json:"media_comment_type" url:"media_comment_type,omitempty"` //  (Optional) . Must be one of audio, video
+		UserNote          canvasapi.Opt[bool] `json:"user_note" url:"user_note,omitempty"`                   //  (Optional)
+		Mode              string              `json:"mode" url:"mode,omitempty"`                             //  (Optional) . Must be one of sync, async
+		Scope             string              `json:"scope" url:"scope,omitempty"`                           //  (Optional) . Must be one of unread, starred, archived
+		Filter            []string            `json:"filter" url:"filter,omitempty"`                         //  (Optional)
+		FilterMode        string              `json:"filter_mode" url:"filter_mode,omitempty"`               //  (Optional) . Must be one of and, or, default or
+		ContextCode       string              `json:"context_code" url:"context_code,omitempty"`             //  (Optional)
 	} `json:"form"`
 }
 
@@ -77,7 +76,7 @@ func (t *CreateConversation) GetMethod() string {
 }
 
 func (t *CreateConversation) GetURLPath() string {
-	return ""
+	return "conversations"
 }
 
 func (t *CreateConversation) GetQuery() (string, error) {
@@ -85,48 +84,67 @@ func (t *CreateConversation) GetQuery() (string, error) {
 }
 
 func (t *CreateConversation) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateConversation) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateConversation) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Form.Recipients == nil {
-		errs = append(errs, "'Form.Recipients' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Recipients", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Body == "" {
-		errs = append(errs, "'Form.Body' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Body", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.MediaCommentType != "" && !string_utils.Include([]string{"audio", "video"}, t.Form.MediaCommentType) {
-		errs = append(errs, "MediaCommentType must be one of audio, video")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.MediaCommentType", Rule: canvasapi.RuleOneOf, Allowed: []string{"audio", "video"}})
 	}
 	if t.Form.Mode != "" && !string_utils.Include([]string{"sync", "async"}, t.Form.Mode) {
-		errs = append(errs, "Mode must be one of sync, async")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Mode", Rule: canvasapi.RuleOneOf, Allowed: []string{"sync", "async"}})
 	}
 	if t.Form.Scope != "" && !string_utils.Include([]string{"unread", "starred", "archived"}, t.Form.Scope) {
-		errs = append(errs, "Scope must be one of unread, starred, archived")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Scope", Rule: canvasapi.RuleOneOf, Allowed: []string{"unread", "starred", "archived"}})
 	}
 	if t.Form.FilterMode != "" && !string_utils.Include([]string{"and", "or", "default or"}, t.Form.FilterMode) {
-		errs = append(errs, "FilterMode must be one of and, or, default or")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.FilterMode", Rule: canvasapi.RuleOneOf, Allowed: []string{"and", "or", "default or"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *CreateConversation) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *CreateConversation) Do(c *canvasapi.Canvas) ([]*models.Conversation, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateConversation) DoContext(ctx context.Context, c *canvasapi.Canvas) ([]*models.Conversation, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := []*models.Conversation{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
 }
@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -31,20 +29,20 @@ import (
 //
 type CreateCourseSection struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		CourseSection struct {
-			Name                              string    `json:"name" url:"name,omitempty"`                                                                   //  (Optional)
-			SISSectionID                      string    `json:"sis_section_id" url:"sis_section_id,omitempty"`                                               //  (Optional)
-			IntegrationID                     string    `json:"integration_id" url:"integration_id,omitempty"`                                               //  (Optional)
-			StartAt                           time.Time `json:"start_at" url:"start_at,omitempty"`                                                           //  (Optional)
-			EndAt                             time.Time `json:"end_at" url:"end_at,omitempty"`                                                               //  (Optional)
-			RestrictEnrollmentsToSectionDates bool      `json:"restrict_enrollments_to_section_dates" url:"restrict_enrollments_to_section_dates,omitempty"` //  (Optional)
+			Name                              string              `json:"name" url:"name,omitempty"`                                                                   //  (Optional)
+			SISSectionID                      string              `json:"sis_section_id" url:"sis_section_id,omitempty"`                                               //  (Optional)
+			IntegrationID                     string              `json:"integration_id" url:"integration_id,omitempty"`                                               //  (Optional)
+			StartAt                           time.Time           `json:"start_at" url:"start_at,omitempty"`                                                           //  (Optional)
+			EndAt                             time.Time           `json:"end_at" url:"end_at,omitempty"`                                                               //  (Optional)
+			RestrictEnrollmentsToSectionDates canvasapi.Opt[bool] `json:"restrict_enrollments_to_section_dates" url:"restrict_enrollments_to_section_dates,omitempty"` //  (Optional)
 		} `json:"course_section" url:"course_section,omitempty"`
 
-		EnableSISReactivation bool `json:"enable_sis_reactivation" url:"enable_sis_reactivation,omitempty"` //  (Optional)
+		EnableSISReactivation canvasapi.Opt[bool] `json:"enable_sis_reactivation" url:"enable_sis_reactivation,omitempty"` //  (Optional)
 	} `json:"form"`
 }
 
@@ -54,7 +52,7 @@ func (t *CreateCourseSection) GetMethod() string {
 
 func (t *CreateCourseSection) GetURLPath() string {
 	path := "courses/{course_id}/sections"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
@@ -63,30 +61,34 @@ func (t *CreateCourseSection) GetQuery() (string, error) {
 }
 
 func (t *CreateCourseSection) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateCourseSection) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateCourseSection) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CreateCourseSection) Do(c *canvasapi.Canvas) (*models.Section, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateCourseSection) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Section, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -101,6 +103,10 @@ func (t *CreateCourseSection) Do(c *canvasapi.Canvas) (*models.Section, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
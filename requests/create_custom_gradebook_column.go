@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -29,16 +27,16 @@ import (
 //
 type CreateCustomGradebookColumn struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		Column struct {
-			Title        string `json:"title" url:"title,omitempty"`                 //  (Required)
-			Position     int64  `json:"position" url:"position,omitempty"`           //  (Optional)
-			Hidden       bool   `json:"hidden" url:"hidden,omitempty"`               //  (Optional)
-			TeacherNotes bool   `json:"teacher_notes" url:"teacher_notes,omitempty"` //  (Optional)
-			ReadOnly     bool   `json:"read_only" url:"read_only,omitempty"`         //  (Optional)
+			Title        string               `json:"title" url:"title,omitempty"`                 //  (Required)
+			Position     canvasapi.Opt[int64] `json:"position" url:"position,omitempty"`           //  (Optional)
+			Hidden       canvasapi.Opt[bool]  `json:"hidden" url:"hidden,omitempty"`               //  (Optional)
+			TeacherNotes canvasapi.Opt[bool]  `json:"teacher_notes" url:"teacher_notes,omitempty"` //  (Optional)
+			ReadOnly     canvasapi.Opt[bool]  `json:"read_only" url:"read_only,omitempty"`         //  (Optional)
 		} `json:"column" url:"column,omitempty"`
 	} `json:"form"`
 }
@@ -49,7 +47,7 @@ func (t *CreateCustomGradebookColumn) GetMethod() string {
 
 func (t *CreateCustomGradebookColumn) GetURLPath() string {
 	path := "courses/{course_id}/custom_gradebook_columns"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
@@ -58,33 +56,37 @@ func (t *CreateCustomGradebookColumn) GetQuery() (string, error) {
 }
 
 func (t *CreateCustomGradebookColumn) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateCustomGradebookColumn) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateCustomGradebookColumn) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Column.Title == "" {
-		errs = append(errs, "'Form.Column.Title' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Column.Title", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CreateCustomGradebookColumn) Do(c *canvasapi.Canvas) (*models.CustomColumn, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateCustomGradebookColumn) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.CustomColumn, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -99,6 +101,10 @@ func (t *CreateCustomGradebookColumn) Do(c *canvasapi.Canvas) (*models.CustomCol
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,12 +1,10 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
-	"strings"
-
-	"github.com/google/go-querystring/query"
 
 	"github.com/atomicjolt/canvasapi"
 )
@@ -45,7 +43,7 @@ func (t *CreateErrorReport) GetMethod() string {
 }
 
 func (t *CreateErrorReport) GetURLPath() string {
-	return ""
+	return "error_reports"
 }
 
 func (t *CreateErrorReport) GetQuery() (string, error) {
@@ -53,33 +51,43 @@ func (t *CreateErrorReport) GetQuery() (string, error) {
 }
 
 func (t *CreateErrorReport) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateErrorReport) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateErrorReport) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Form.Error.Subject == "" {
-		errs = append(errs, "'Form.Error.Subject' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Error.Subject", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *CreateErrorReport) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *CreateErrorReport) Do(c *canvasapi.Canvas) (json.RawMessage, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateErrorReport) DoContext(ctx context.Context, c *canvasapi.Canvas) (json.RawMessage, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(body), nil
 }
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -27,13 +25,13 @@ import (
 //
 type CreateExternalFeedCourses struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		Url         string `json:"url" url:"url,omitempty"`                   //  (Required)
-		HeaderMatch bool   `json:"header_match" url:"header_match,omitempty"` //  (Optional)
-		Verbosity   string `json:"verbosity" url:"verbosity,omitempty"`       //  (Optional) . Must be one of full, truncate, link_only
+		Url         string              `json:"url" url:"url,omitempty"`                   //  (Required)
+		HeaderMatch canvasapi.Opt[bool] `json:"header_match" url:"header_match,omitempty"` //  (Optional)
+		Verbosity   string              `json:"verbosity" url:"verbosity,omitempty"`       //  (Optional) . Must be one of full, truncate, link_only
 	} `json:"form"`
 }
 
@@ -43,7 +41,7 @@ func (t *CreateExternalFeedCourses) GetMethod() string {
 
 func (t *CreateExternalFeedCourses) GetURLPath() string {
 	path := "courses/{course_id}/external_feeds"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
@@ -52,36 +50,40 @@ func (t *CreateExternalFeedCourses) GetQuery() (string, error) {
 }
 
 func (t *CreateExternalFeedCourses) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateExternalFeedCourses) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateExternalFeedCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Url == "" {
-		errs = append(errs, "'Form.Url' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Url", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Verbosity != "" && !string_utils.Include([]string{"full", "truncate", "link_only"}, t.Form.Verbosity) {
-		errs = append(errs, "Verbosity must be one of full, truncate, link_only")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Verbosity", Rule: canvasapi.RuleOneOf, Allowed: []string{"full", "truncate", "link_only"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CreateExternalFeedCourses) Do(c *canvasapi.Canvas) (*models.ExternalFeed, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateExternalFeedCourses) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.ExternalFeed, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -96,6 +98,10 @@ func (t *CreateExternalFeedCourses) Do(c *canvasapi.Canvas) (*models.ExternalFee
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,14 +1,14 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -126,7 +126,7 @@ import (
 //
 type CreateExternalToolCourses struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -144,88 +144,88 @@ type CreateExternalToolCourses struct {
 			FieldName string `json:"field_name" url:"field_name,omitempty"` //  (Optional)
 		} `json:"custom_fields" url:"custom_fields,omitempty"`
 
-		IsRceFavorite     bool `json:"is_rce_favorite" url:"is_rce_favorite,omitempty"` //  (Optional)
+		IsRceFavorite     canvasapi.Opt[bool] `json:"is_rce_favorite" url:"is_rce_favorite,omitempty"` //  (Optional)
 		AccountNavigation struct {
-			Url             string `json:"url" url:"url,omitempty"`                           //  (Optional)
-			Enabled         bool   `json:"enabled" url:"enabled,omitempty"`                   //  (Optional)
-			Text            string `json:"text" url:"text,omitempty"`                         //  (Optional)
-			SelectionWidth  string `json:"selection_width" url:"selection_width,omitempty"`   //  (Optional)
-			SelectionHeight string `json:"selection_height" url:"selection_height,omitempty"` //  (Optional)
-			DisplayType     string `json:"display_type" url:"display_type,omitempty"`         //  (Optional)
+			Url             string              `json:"url" url:"url,omitempty"`                           //  (Optional)
+			Enabled         canvasapi.Opt[bool] `json:"enabled" url:"enabled,omitempty"`                   //  (Optional)
+			Text            string              `json:"text" url:"text,omitempty"`                         //  (Optional)
+			SelectionWidth  string              `json:"selection_width" url:"selection_width,omitempty"`   //  (Optional)
+			SelectionHeight string              `json:"selection_height" url:"selection_height,omitempty"` //  (Optional)
+			DisplayType     string              `json:"display_type" url:"display_type,omitempty"`         //  (Optional)
 		} `json:"account_navigation" url:"account_navigation,omitempty"`
 
 		UserNavigation struct {
-			Url        string `json:"url" url:"url,omitempty"`               //  (Optional)
-			Enabled    bool   `json:"enabled" url:"enabled,omitempty"`       //  (Optional)
-			Text       string `json:"text" url:"text,omitempty"`             //  (Optional)
-			Visibility string `json:"visibility" url:"visibility,omitempty"` //  (Optional) . Must be one of admins, members, public
+			Url        string              `json:"url" url:"url,omitempty"`               //  (Optional)
+			Enabled    canvasapi.Opt[bool] `json:"enabled" url:"enabled,omitempty"`       //  (Optional)
+			Text       string              `json:"text" url:"text,omitempty"`             //  (Optional)
+			Visibility string              `json:"visibility" url:"visibility,omitempty"` //  (Optional) . Must be one of admins, members, public
 		} `json:"user_navigation" url:"user_navigation,omitempty"`
 
 		CourseHomeSubNavigation struct {
-			Url     string `json:"url" url:"url,omitempty"`           //  (Optional)
-			Enabled bool   `json:"enabled" url:"enabled,omitempty"`   //  (Optional)
-			Text    string `json:"text" url:"text,omitempty"`         //  (Optional)
-			IconUrl string `json:"icon_url" url:"icon_url,omitempty"` //  (Optional)
+			Url     string              `json:"url" url:"url,omitempty"`           //  (Optional)
+			Enabled canvasapi.Opt[bool] `json:"enabled" url:"enabled,omitempty"`   //  (Optional)
+			Text    string              `json:"text" url:"text,omitempty"`         //  (Optional)
+			IconUrl string              `json:"icon_url" url:"icon_url,omitempty"` //  (Optional)
 		} `json:"course_home_sub_navigation" url:"course_home_sub_navigation,omitempty"`
 
 		CourseNavigation struct {
-			Enabled      bool   `json:"enabled" url:"enabled,omitempty"`             //  (Optional)
-			Text         string `json:"text" url:"text,omitempty"`                   //  (Optional)
-			Visibility   string `json:"visibility" url:"visibility,omitempty"`       //  (Optional) . Must be one of admins, members
-			WindowTarget string `json:"window_target" url:"window_target,omitempty"` //  (Optional) . Must be one of _blank, _self
-			Default      string `json:"default" url:"default,omitempty"`             //  (Optional) . Must be one of disabled, enabled
-			DisplayType  string `json:"display_type" url:"display_type,omitempty"`   //  (Optional)
+			Enabled      canvasapi.Opt[bool] `json:"enabled" url:"enabled,omitempty"`             //  (Optional)
+			Text         string              `json:"text" url:"text,omitempty"`                   //  (Optional)
+			Visibility   string              `json:"visibility" url:"visibility,omitempty"`       //  (Optional) . Must be one of admins, members
+			WindowTarget string              `json:"window_target" url:"window_target,omitempty"` //  (Optional) . Must be one of _blank, _self
+			Default      string              `json:"default" url:"default,omitempty"`             //  (Optional) . Must be one of disabled, enabled
+			DisplayType  string              `json:"display_type" url:"display_type,omitempty"`   //  (Optional)
 		} `json:"course_navigation" url:"course_navigation,omitempty"`
 
 		EditorButton struct {
-			Url             string `json:"url" url:"url,omitempty"`                           //  (Optional)
-			Enabled         bool   `json:"enabled" url:"enabled,omitempty"`                   //  (Optional)
-			IconUrl         string `json:"icon_url" url:"icon_url,omitempty"`                 //  (Optional)
-			SelectionWidth  string `json:"selection_width" url:"selection_width,omitempty"`   //  (Optional)
-			SelectionHeight string `json:"selection_height" url:"selection_height,omitempty"` //  (Optional)
-			MessageType     string `json:"message_type" url:"message_type,omitempty"`         //  (Optional)
+			Url             string              `json:"url" url:"url,omitempty"`                           //  (Optional)
+			Enabled         canvasapi.Opt[bool] `json:"enabled" url:"enabled,omitempty"`                   //  (Optional)
+			IconUrl         string              `json:"icon_url" url:"icon_url,omitempty"`                 //  (Optional)
+			SelectionWidth  string              `json:"selection_width" url:"selection_width,omitempty"`   //  (Optional)
+			SelectionHeight string              `json:"selection_height" url:"selection_height,omitempty"` //  (Optional)
+			MessageType     string              `json:"message_type" url:"message_type,omitempty"`         //  (Optional)
 		} `json:"editor_button" url:"editor_button,omitempty"`
 
 		HomeworkSubmission struct {
-			Url         string `json:"url" url:"url,omitempty"`                   //  (Optional)
-			Enabled     bool   `json:"enabled" url:"enabled,omitempty"`           //  (Optional)
-			Text        string `json:"text" url:"text,omitempty"`                 //  (Optional)
-			MessageType string `json:"message_type" url:"message_type,omitempty"` //  (Optional)
+			Url         string              `json:"url" url:"url,omitempty"`                   //  (Optional)
+			Enabled     canvasapi.Opt[bool] `json:"enabled" url:"enabled,omitempty"`           //  (Optional)
+			Text        string              `json:"text" url:"text,omitempty"`                 //  (Optional)
+			MessageType string              `json:"message_type" url:"message_type,omitempty"` //  (Optional)
 		} `json:"homework_submission" url:"homework_submission,omitempty"`
 
 		LinkSelection struct {
-			Url         string `json:"url" url:"url,omitempty"`                   //  (Optional)
-			Enabled     bool   `json:"enabled" url:"enabled,omitempty"`           //  (Optional)
-			Text        string `json:"text" url:"text,omitempty"`                 //  (Optional)
-			MessageType string `json:"message_type" url:"message_type,omitempty"` //  (Optional)
+			Url         string              `json:"url" url:"url,omitempty"`                   //  (Optional)
+			Enabled     canvasapi.Opt[bool] `json:"enabled" url:"enabled,omitempty"`           //  (Optional)
+			Text        string              `json:"text" url:"text,omitempty"`                 //  (Optional)
+			MessageType string              `json:"message_type" url:"message_type,omitempty"` //  (Optional)
 		} `json:"link_selection" url:"link_selection,omitempty"`
 
 		MigrationSelection struct {
-			Url         string `json:"url" url:"url,omitempty"`                   //  (Optional)
-			Enabled     bool   `json:"enabled" url:"enabled,omitempty"`           //  (Optional)
-			MessageType string `json:"message_type" url:"message_type,omitempty"` //  (Optional)
+			Url         string              `json:"url" url:"url,omitempty"`                   //  (Optional)
+			Enabled     canvasapi.Opt[bool] `json:"enabled" url:"enabled,omitempty"`           //  (Optional)
+			MessageType string              `json:"message_type" url:"message_type,omitempty"` //  (Optional)
 		} `json:"migration_selection" url:"migration_selection,omitempty"`
 
 		ToolConfiguration struct {
-			Url            string `json:"url" url:"url,omitempty"`                           //  (Optional)
-			Enabled        bool   `json:"enabled" url:"enabled,omitempty"`                   //  (Optional)
-			MessageType    string `json:"message_type" url:"message_type,omitempty"`         //  (Optional)
-			PreferSISEmail bool   `json:"prefer_sis_email" url:"prefer_sis_email,omitempty"` //  (Optional)
+			Url            string              `json:"url" url:"url,omitempty"`                           //  (Optional)
+			Enabled        canvasapi.Opt[bool] `json:"enabled" url:"enabled,omitempty"`                   //  (Optional)
+			MessageType    string              `json:"message_type" url:"message_type,omitempty"`         //  (Optional)
+			PreferSISEmail canvasapi.Opt[bool] `json:"prefer_sis_email" url:"prefer_sis_email,omitempty"` //  (Optional)
 		} `json:"tool_configuration" url:"tool_configuration,omitempty"`
 
 		ResourceSelection struct {
-			Url             string `json:"url" url:"url,omitempty"`                           //  (Optional)
-			Enabled         bool   `json:"enabled" url:"enabled,omitempty"`                   //  (Optional)
-			IconUrl         string `json:"icon_url" url:"icon_url,omitempty"`                 //  (Optional)
-			SelectionWidth  string `json:"selection_width" url:"selection_width,omitempty"`   //  (Optional)
-			SelectionHeight string `json:"selection_height" url:"selection_height,omitempty"` //  (Optional)
+			Url             string              `json:"url" url:"url,omitempty"`                           //  (Optional)
+			Enabled         canvasapi.Opt[bool] `json:"enabled" url:"enabled,omitempty"`                   //  (Optional)
+			IconUrl         string              `json:"icon_url" url:"icon_url,omitempty"`                 //  (Optional)
+			SelectionWidth  string              `json:"selection_width" url:"selection_width,omitempty"`   //  (Optional)
+			SelectionHeight string              `json:"selection_height" url:"selection_height,omitempty"` //  (Optional)
 		} `json:"resource_selection" url:"resource_selection,omitempty"`
 
-		ConfigType     string `json:"config_type" url:"config_type,omitempty"`         //  (Optional)
-		ConfigXml      string `json:"config_xml" url:"config_xml,omitempty"`           //  (Optional)
-		ConfigUrl      string `json:"config_url" url:"config_url,omitempty"`           //  (Optional)
-		NotSelectable  bool   `json:"not_selectable" url:"not_selectable,omitempty"`   //  (Optional)
-		OauthCompliant bool   `json:"oauth_compliant" url:"oauth_compliant,omitempty"` //  (Optional)
+		ConfigType     string              `json:"config_type" url:"config_type,omitempty"`         //  (Optional)
+		ConfigXml      string              `json:"config_xml" url:"config_xml,omitempty"`           //  (Optional)
+		ConfigUrl      string              `json:"config_url" url:"config_url,omitempty"`           //  (Optional)
+		NotSelectable  canvasapi.Opt[bool] `json:"not_selectable" url:"not_selectable,omitempty"`   //  (Optional)
+		OauthCompliant canvasapi.Opt[bool] `json:"oauth_compliant" url:"oauth_compliant,omitempty"` //  (Optional)
 	} `json:"form"`
 }
 
@@ -235,7 +235,7 @@ func (t *CreateExternalToolCourses) GetMethod() string {
 
 func (t *CreateExternalToolCourses) GetURLPath() string {
 	path := "courses/{course_id}/external_tools"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
@@ -244,63 +244,82 @@ func (t *CreateExternalToolCourses) GetQuery() (string, error) {
 }
 
 func (t *CreateExternalToolCourses) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateExternalToolCourses) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateExternalToolCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.ClientID == "" {
-		errs = append(errs, "'Form.ClientID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.ClientID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Name == "" {
-		errs = append(errs, "'Form.Name' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Name", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.PrivacyLevel == "" {
-		errs = append(errs, "'Form.PrivacyLevel' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.PrivacyLevel", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.PrivacyLevel != "" && !string_utils.Include([]string{"anonymous", "name_only", "public"}, t.Form.PrivacyLevel) {
-		errs = append(errs, "PrivacyLevel must be one of anonymous, name_only, public")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.PrivacyLevel", Rule: canvasapi.RuleOneOf, Allowed: []string{"anonymous", "name_only", "public"}})
 	}
 	if t.Form.ConsumerKey == "" {
-		errs = append(errs, "'Form.ConsumerKey' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.ConsumerKey", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.SharedSecret == "" {
-		errs = append(errs, "'Form.SharedSecret' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.SharedSecret", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.UserNavigation.Visibility != "" && !string_utils.Include([]string{"admins", "members", "public"}, t.Form.UserNavigation.Visibility) {
-		errs = append(errs, "UserNavigation must be one of admins, members, public")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.UserNavigation.Visibility", Rule: canvasapi.RuleOneOf, Allowed: []string{"admins", "members", "public"}})
 	}
 	if t.Form.CourseNavigation.Visibility != "" && !string_utils.Include([]string{"admins", "members"}, t.Form.CourseNavigation.Visibility) {
-		errs = append(errs, "CourseNavigation must be one of admins, members")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.CourseNavigation.Visibility", Rule: canvasapi.RuleOneOf, Allowed: []string{"admins", "members"}})
 	}
 	if t.Form.CourseNavigation.WindowTarget != "" && !string_utils.Include([]string{"_blank", "_self"}, t.Form.CourseNavigation.WindowTarget) {
-		errs = append(errs, "CourseNavigation must be one of _blank, _self")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.CourseNavigation.WindowTarget", Rule: canvasapi.RuleOneOf, Allowed: []string{"_blank", "_self"}})
 	}
 	if t.Form.CourseNavigation.Default != "" && !string_utils.Include([]string{"disabled", "enabled"}, t.Form.CourseNavigation.Default) {
-		errs = append(errs, "CourseNavigation must be one of disabled, enabled")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.CourseNavigation.Default", Rule: canvasapi.RuleOneOf, Allowed: []string{"disabled", "enabled"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *CreateExternalToolCourses) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *CreateExternalToolCourses) Do(c *canvasapi.Canvas) (*models.ExternalTool, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateExternalToolCourses) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.ExternalTool, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.ExternalTool{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
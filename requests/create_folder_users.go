@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -32,18 +30,18 @@ import (
 //
 type CreateFolderUsers struct {
 	Path struct {
-		UserID string `json:"user_id" url:"user_id,omitempty"` //  (Required)
+		UserID canvasapi.ID `json:"user_id" url:"user_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		Name             string    `json:"name" url:"name,omitempty"`                             //  (Required)
-		ParentFolderID   string    `json:"parent_folder_id" url:"parent_folder_id,omitempty"`     //  (Optional)
-		ParentFolderPath string    `json:"parent_folder_path" url:"parent_folder_path,omitempty"` //  (Optional)
-		LockAt           time.Time `json:"lock_at" url:"lock_at,omitempty"`                       //  (Optional)
-		UnlockAt         time.Time `json:"unlock_at" url:"unlock_at,omitempty"`                   //  (Optional)
-		Locked           bool      `json:"locked" url:"locked,omitempty"`                         //  (Optional)
-		Hidden           bool      `json:"hidden" url:"hidden,omitempty"`                         //  (Optional)
-		Position         int64     `json:"position" url:"position,omitempty"`                     //  (Optional)
+		Name             string               `json:"name" url:"name,omitempty"`                             //  (Required)
+		ParentFolderID   string               `json:"parent_folder_id" url:"parent_folder_id,omitempty"`     //  (Optional)
+		ParentFolderPath string               `json:"parent_folder_path" url:"parent_folder_path,omitempty"` //  (Optional)
+		LockAt           time.Time            `json:"lock_at" url:"lock_at,omitempty"`                       //  (Optional)
+		UnlockAt         time.Time            `json:"unlock_at" url:"unlock_at,omitempty"`                   //  (Optional)
+		Locked           canvasapi.Opt[bool]  `json:"locked" url:"locked,omitempty"`                         //  (Optional)
+		Hidden           canvasapi.Opt[bool]  `json:"hidden" url:"hidden,omitempty"`                         //  (Optional)
+		Position         canvasapi.Opt[int64] `json:"position" url:"position,omitempty"`                     //  (Optional)
 	} `json:"form"`
 }
 
@@ -53,7 +51,7 @@ func (t *CreateFolderUsers) GetMethod() string {
 
 func (t *CreateFolderUsers) GetURLPath() string {
 	path := "users/{user_id}/folders"
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", t.Path.UserID))
+	path = strings.ReplaceAll(path, "{user_id}", t.Path.UserID.Escaped())
 	return path
 }
 
@@ -62,33 +60,37 @@ func (t *CreateFolderUsers) GetQuery() (string, error) {
 }
 
 func (t *CreateFolderUsers) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateFolderUsers) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateFolderUsers) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.UserID == "" {
-		errs = append(errs, "'Path.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Name == "" {
-		errs = append(errs, "'Form.Name' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Name", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CreateFolderUsers) Do(c *canvasapi.Canvas) (*models.Folder, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateFolderUsers) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Folder, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -103,6 +105,10 @@ func (t *CreateFolderUsers) Do(c *canvasapi.Canvas) (*models.Folder, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,15 +1,15 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -34,7 +34,7 @@ import (
 //
 type CreateGlobalNotification struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -56,7 +56,7 @@ func (t *CreateGlobalNotification) GetMethod() string {
 
 func (t *CreateGlobalNotification) GetURLPath() string {
 	path := "accounts/{account_id}/account_notifications"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	return path
 }
 
@@ -65,48 +65,67 @@ func (t *CreateGlobalNotification) GetQuery() (string, error) {
 }
 
 func (t *CreateGlobalNotification) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateGlobalNotification) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateGlobalNotification) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.AccountNotification.Subject == "" {
-		errs = append(errs, "'Form.AccountNotification.Subject' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.AccountNotification.Subject", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.AccountNotification.Message == "" {
-		errs = append(errs, "'Form.AccountNotification.Message' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.AccountNotification.Message", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.AccountNotification.StartAt.IsZero() {
-		errs = append(errs, "'Form.AccountNotification.StartAt' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.AccountNotification.StartAt", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.AccountNotification.EndAt.IsZero() {
-		errs = append(errs, "'Form.AccountNotification.EndAt' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.AccountNotification.EndAt", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.AccountNotification.Icon != "" && !string_utils.Include([]string{"warning", "information", "question", "error", "calendar"}, t.Form.AccountNotification.Icon) {
-		errs = append(errs, "AccountNotification must be one of warning, information, question, error, calendar")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.AccountNotification.Icon", Rule: canvasapi.RuleOneOf, Allowed: []string{"warning", "information", "question", "error", "calendar"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *CreateGlobalNotification) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *CreateGlobalNotification) Do(c *canvasapi.Canvas) (*models.AccountNotification, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateGlobalNotification) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.AccountNotification, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.AccountNotification{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
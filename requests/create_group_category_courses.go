@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -44,17 +42,17 @@ import (
 //
 type CreateGroupCategoryCourses struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		Name               string `json:"name" url:"name,omitempty"`                                   //  (Required)
-		SelfSignup         string `json:"self_signup" url:"self_signup,omitempty"`                     //  (Optional) . Must be one of enabled, restricted
-		AutoLeader         string `json:"auto_leader" url:"auto_leader,omitempty"`                     //  (Optional) . Must be one of first, random
-		GroupLimit         int64  `json:"group_limit" url:"group_limit,omitempty"`                     //  (Optional)
-		SISGroupCategoryID string `json:"sis_group_category_id" url:"sis_group_category_id,omitempty"` //  (Optional)
-		CreateGroupCount   int64  `json:"create_group_count" url:"create_group_count,omitempty"`       //  (Optional)
-		SplitGroupCount    string `json:"split_group_count" url:"split_group_count,omitempty"`         //  (Optional)
+		Name               string               `json:"name" url:"name,omitempty"`                                   //  (Required)
+		SelfSignup         string               `json:"self_signup" url:"self_signup,omitempty"`                     //  (Optional) . Must be one of enabled, restricted
+		AutoLeader         string               `json:"auto_leader" url:"auto_leader,omitempty"`                     //  (Optional) . Must be one of first, random
+		GroupLimit         canvasapi.Opt[int64] `json:"group_limit" url:"group_limit,omitempty"`                     //  (Optional)
+		SISGroupCategoryID string               `json:"sis_group_category_id" url:"sis_group_category_id,omitempty"` //  (Optional)
+		CreateGroupCount   canvasapi.Opt[int64] `json:"create_group_count" url:"create_group_count,omitempty"`       //  (Optional)
+		SplitGroupCount    string               `json:"split_group_count" url:"split_group_count,omitempty"`         //  (Optional)
 	} `json:"form"`
 }
 
@@ -64,7 +62,7 @@ func (t *CreateGroupCategoryCourses) GetMethod() string {
 
 func (t *CreateGroupCategoryCourses) GetURLPath() string {
 	path := "courses/{course_id}/group_categories"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
@@ -73,39 +71,43 @@ func (t *CreateGroupCategoryCourses) GetQuery() (string, error) {
 }
 
 func (t *CreateGroupCategoryCourses) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateGroupCategoryCourses) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateGroupCategoryCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Name == "" {
-		errs = append(errs, "'Form.Name' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Name", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.SelfSignup != "" && !string_utils.Include([]string{"enabled", "restricted"}, t.Form.SelfSignup) {
-		errs = append(errs, "SelfSignup must be one of enabled, restricted")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.SelfSignup", Rule: canvasapi.RuleOneOf, Allowed: []string{"enabled", "restricted"}})
 	}
 	if t.Form.AutoLeader != "" && !string_utils.Include([]string{"first", "random"}, t.Form.AutoLeader) {
-		errs = append(errs, "AutoLeader must be one of first, random")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.AutoLeader", Rule: canvasapi.RuleOneOf, Allowed: []string{"first", "random"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CreateGroupCategoryCourses) Do(c *canvasapi.Canvas) (*models.GroupCategory, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateGroupCategoryCourses) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.GroupCategory, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -120,6 +122,10 @@ func (t *CreateGroupCategoryCourses) Do(c *canvasapi.Canvas) (*models.GroupCateg
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
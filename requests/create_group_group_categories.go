@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -32,16 +30,16 @@ import (
 //
 type CreateGroupGroupCategories struct {
 	Path struct {
-		GroupCategoryID string `json:"group_category_id" url:"group_category_id,omitempty"` //  (Required)
+		GroupCategoryID canvasapi.ID `json:"group_category_id" url:"group_category_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		Name           string `json:"name" url:"name,omitempty"`                         //  (Optional)
-		Description    string `json:"description" url:"description,omitempty"`           //  (Optional)
-		IsPublic       bool   `json:"is_public" url:"is_public,omitempty"`               //  (Optional)
-		JoinLevel      string `json:"join_level" url:"join_level,omitempty"`             //  (Optional) . Must be one of parent_context_auto_join, parent_context_request, invitation_only
-		StorageQuotaMb int64  `json:"storage_quota_mb" url:"storage_quota_mb,omitempty"` //  (Optional)
-		SISGroupID     string `json:"sis_group_id" url:"sis_group_id,omitempty"`         //  (Optional)
+		Name           string               `json:"name" url:"name,omitempty"`                         //  (Optional)
+		Description    string               `json:"description" url:"description,omitempty"`           //  (Optional)
+		IsPublic       canvasapi.Opt[bool]  `json:"is_public" url:"is_public,omitempty"`               //  (Optional)
+		JoinLevel      string               `json:"join_level" url:"join_level,omitempty"`             //  (Optional) . Must be one of parent_context_auto_join, parent_context_request, invitation_only
+		StorageQuotaMb canvasapi.Opt[int64] `json:"storage_quota_mb" url:"storage_quota_mb,omitempty"` //  (Optional)
+		SISGroupID     string               `json:"sis_group_id" url:"sis_group_id,omitempty"`         //  (Optional)
 	} `json:"form"`
 }
 
@@ -51,7 +49,7 @@ func (t *CreateGroupGroupCategories) GetMethod() string {
 
 func (t *CreateGroupGroupCategories) GetURLPath() string {
 	path := "group_categories/{group_category_id}/groups"
-	path = strings.ReplaceAll(path, "{group_category_id}", fmt.Sprintf("%v", t.Path.GroupCategoryID))
+	path = strings.ReplaceAll(path, "{group_category_id}", t.Path.GroupCategoryID.Escaped())
 	return path
 }
 
@@ -60,33 +58,37 @@ func (t *CreateGroupGroupCategories) GetQuery() (string, error) {
 }
 
 func (t *CreateGroupGroupCategories) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateGroupGroupCategories) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateGroupGroupCategories) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.GroupCategoryID == "" {
-		errs = append(errs, "'Path.GroupCategoryID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.GroupCategoryID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.JoinLevel != "" && !string_utils.Include([]string{"parent_context_auto_join", "parent_context_request", "invitation_only"}, t.Form.JoinLevel) {
-		errs = append(errs, "JoinLevel must be one of parent_context_auto_join, parent_context_request, invitation_only")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.JoinLevel", Rule: canvasapi.RuleOneOf, Allowed: []string{"parent_context_auto_join", "parent_context_request", "invitation_only"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CreateGroupGroupCategories) Do(c *canvasapi.Canvas) (*models.Group, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateGroupGroupCategories) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Group, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -101,6 +103,10 @@ func (t *CreateGroupGroupCategories) Do(c *canvasapi.Canvas) (*models.Group, err
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
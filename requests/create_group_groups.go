@@ -1,13 +1,10 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
-	"strings"
-
-	"github.com/google/go-querystring/query"
 
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
@@ -29,12 +26,12 @@ import (
 //
 type CreateGroupGroups struct {
 	Form struct {
-		Name           string `json:"name" url:"name,omitempty"`                         //  (Optional)
-		Description    string `json:"description" url:"description,omitempty"`           //  (Optional)
-		IsPublic       bool   `json:"is_public" url:"is_public,omitempty"`               //  (Optional)
-		JoinLevel      string `json:"join_level" url:"join_level,omitempty"`             //  (Optional) . Must be one of parent_context_auto_join, parent_context_request, invitation_only
-		StorageQuotaMb int64  `json:"storage_quota_mb" url:"storage_quota_mb,omitempty"` //  (Optional)
-		SISGroupID     string `json:"sis_group_id" url:"sis_group_id,omitempty"`         //  (Optional)
+		Name           string               `json:"name" url:"name,omitempty"`                         //  (Optional)
+		Description    string               `json:"description" url:"description,omitempty"`           //  (Optional)
+		IsPublic       canvasapi.Opt[bool]  `json:"is_public" url:"is_public,omitempty"`               //  (Optional)
+		JoinLevel      string               `json:"join_level" url:"join_level,omitempty"`             //  (Optional) . Must be one of parent_context_auto_join, parent_context_request, invitation_only
+		StorageQuotaMb canvasapi.Opt[int64] `json:"storage_quota_mb" url:"storage_quota_mb,omitempty"` //  (Optional)
+		SISGroupID     string               `json:"sis_group_id" url:"sis_group_id,omitempty"`         //  (Optional)
 	} `json:"form"`
 }
 
@@ -43,7 +40,7 @@ func (t *CreateGroupGroups) GetMethod() string {
 }
 
 func (t *CreateGroupGroups) GetURLPath() string {
-	return ""
+	return "groups"
 }
 
 func (t *CreateGroupGroups) GetQuery() (string, error) {
@@ -51,30 +48,34 @@ func (t *CreateGroupGroups) GetQuery() (string, error) {
 }
 
 func (t *CreateGroupGroups) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateGroupGroups) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateGroupGroups) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Form.JoinLevel != "" && !string_utils.Include([]string{"parent_context_auto_join", "parent_context_request", "invitation_only"}, t.Form.JoinLevel) {
-		errs = append(errs, "JoinLevel must be one of parent_context_auto_join, parent_context_request, invitation_only")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.JoinLevel", Rule: canvasapi.RuleOneOf, Allowed: []string{"parent_context_auto_join", "parent_context_request", "invitation_only"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CreateGroupGroups) Do(c *canvasapi.Canvas) (*models.Group, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateGroupGroups) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Group, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -89,6 +90,10 @@ func (t *CreateGroupGroups) Do(c *canvasapi.Canvas) (*models.Group, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
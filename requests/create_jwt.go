@@ -1,6 +1,7 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/url"
@@ -23,7 +24,7 @@ func (t *CreateJwt) GetMethod() string {
 }
 
 func (t *CreateJwt) GetURLPath() string {
-	return ""
+	return "jwts"
 }
 
 func (t *CreateJwt) GetQuery() (string, error) {
@@ -43,7 +44,11 @@ func (t *CreateJwt) HasErrors() error {
 }
 
 func (t *CreateJwt) Do(c *canvasapi.Canvas) (*models.JWT, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateJwt) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.JWT, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -58,6 +63,10 @@ func (t *CreateJwt) Do(c *canvasapi.Canvas) (*models.JWT, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,14 +1,14 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // CreateLatePolicy Create a late policy. If the course already has a late policy, a
@@ -30,18 +30,18 @@ import (
 //
 type CreateLatePolicy struct {
 	Path struct {
-		ID string `json:"id" url:"id,omitempty"` //  (Required)
+		ID canvasapi.ID `json:"id" url:"id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		LatePolicy struct {
-			MissingSubmissionDeductionEnabled   bool    `json:"missing_submission_deduction_enabled" url:"missing_submission_deduction_enabled,omitempty"`       //  (Optional)
-			MissingSubmissionDeduction          float64 `json:"missing_submission_deduction" url:"missing_submission_deduction,omitempty"`                       //  (Optional)
-			LateSubmissionDeductionEnabled      bool    `json:"late_submission_deduction_enabled" url:"late_submission_deduction_enabled,omitempty"`             //  (Optional)
-			LateSubmissionDeduction             float64 `json:"late_submission_deduction" url:"late_submission_deduction,omitempty"`                             //  (Optional)
-			LateSubmissionInterval              string  `json:"late_submission_interval" url:"late_submission_interval,omitempty"`                               //  (Optional)
-			LateSubmissionMinimumPercentEnabled bool    `json:"late_submission_minimum_percent_enabled" url:"late_submission_minimum_percent_enabled,omitempty"` //  (Optional)
-			LateSubmissionMinimumPercent        float64 `json:"late_submission_minimum_percent" url:"late_submission_minimum_percent,omitempty"`                 //  (Optional)
+			MissingSubmissionDeductionEnabled   canvasapi.Opt[bool]    `json:"missing_submission_deduction_enabled" url:"missing_submission_deduction_enabled,omitempty"`       //  (Optional)
+			MissingSubmissionDeduction          canvasapi.Opt[float64] `json:"missing_submission_deduction" url:"missing_submission_deduction,omitempty"`                       //  (Optional)
+			LateSubmissionDeductionEnabled      canvasapi.Opt[bool]    `json:"late_submission_deduction_enabled" url:"late_submission_deduction_enabled,omitempty"`             //  (Optional)
+			LateSubmissionDeduction             canvasapi.Opt[float64] `json:"late_submission_deduction" url:"late_submission_deduction,omitempty"`                             //  (Optional)
+			LateSubmissionInterval              string                 `json:"late_submission_interval" url:"late_submission_interval,omitempty"`                               //  (Optional)
+			LateSubmissionMinimumPercentEnabled canvasapi.Opt[bool]    `json:"late_submission_minimum_percent_enabled" url:"late_submission_minimum_percent_enabled,omitempty"` //  (Optional)
+			LateSubmissionMinimumPercent        canvasapi.Opt[float64] `json:"late_submission_minimum_percent" url:"late_submission_minimum_percent,omitempty"`                 //  (Optional)
 		} `json:"late_policy" url:"late_policy,omitempty"`
 	} `json:"form"`
 }
@@ -52,7 +52,7 @@ func (t *CreateLatePolicy) GetMethod() string {
 
 func (t *CreateLatePolicy) GetURLPath() string {
 	path := "courses/{id}/late_policy"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -61,33 +61,52 @@ func (t *CreateLatePolicy) GetQuery() (string, error) {
 }
 
 func (t *CreateLatePolicy) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateLatePolicy) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateLatePolicy) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *CreateLatePolicy) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *CreateLatePolicy) Do(c *canvasapi.Canvas) (*models.LatePolicyResponse, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateLatePolicy) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.LatePolicyResponse, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.LatePolicyResponse{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -36,15 +34,15 @@ import (
 //
 type CreateLineItem struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		ScoreMaximum            float64                  `json:"score_maximum" url:"score_maximum,omitempty"`                                                                           //  (Required)
+		ScoreMaximum            canvasapi.Opt[float64]   `json:"scoreMaximum" url:"scoreMaximum,omitempty"`                                                                             //  (Required)
 		Label                   string                   `json:"label" url:"label,omitempty"`                                                                                           //  (Required)
-		ResourceID              string                   `json:"resource_id" url:"resource_id,omitempty"`                                                                               //  (Optional)
+		ResourceID              string                   `json:"resourceId" url:"resourceId,omitempty"`                                                                                 //  (Optional)
 		Tag                     string                   `json:"tag" url:"tag,omitempty"`                                                                                               //  (Optional)
-		ResourceLinkID          string                   `json:"resource_link_id" url:"resource_link_id,omitempty"`                                                                     //  (Optional)
+		ResourceLinkID          string                   `json:"resourceLinkId" url:"resourceLinkId,omitempty"`                                                                         //  (Optional)
 		CanvasLTISubmissionType map[string](interface{}) `json:"https://canvas.instructure.com/lti/submission_type" url:"https://canvas.instructure.com/lti/submission_type,omitempty"` //  (Optional)
 	} `json:"form"`
 }
@@ -55,7 +53,7 @@ func (t *CreateLineItem) GetMethod() string {
 
 func (t *CreateLineItem) GetURLPath() string {
 	path := "/lti/courses/{course_id}/line_items"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
@@ -64,33 +62,50 @@ func (t *CreateLineItem) GetQuery() (string, error) {
 }
 
 func (t *CreateLineItem) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateLineItem) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
+// PrefersJSON reports that Canvas expects the body of this request as JSON.
+func (t *CreateLineItem) PrefersJSON() bool {
+	return true
+}
+
+// MediaTypes returns the IMS media types of this LTI Advantage service.
+func (t *CreateLineItem) MediaTypes() (string, string) {
+	return "application/vnd.ims.lis.v2.lineitem+json", "application/vnd.ims.lis.v2.lineitem+json"
+}
+
 func (t *CreateLineItem) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Label == "" {
-		errs = append(errs, "'Form.Label' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Label", Rule: canvasapi.RuleRequired})
+	}
+	if !t.Form.ScoreMaximum.IsSet() {
+		errs = append(errs, canvasapi.FieldError{Field: "Form.ScoreMaximum", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CreateLineItem) Do(c *canvasapi.Canvas) (*models.LineItem, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateLineItem) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.LineItem, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -105,6 +120,10 @@ func (t *CreateLineItem) Do(c *canvasapi.Canvas) (*models.LineItem, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -58,25 +56,25 @@ import (
 //
 type CreateLinkOutcomeCoursesOutcomeID struct {
 	Path struct {
-		CourseID  string `json:"course_id" url:"course_id,omitempty"`   //  (Required)
-		ID        string `json:"id" url:"id,omitempty"`                 //  (Required)
-		OutcomeID int64  `json:"outcome_id" url:"outcome_id,omitempty"` //  (Required)
+		CourseID  canvasapi.ID `json:"course_id" url:"course_id,omitempty"`   //  (Required)
+		ID        canvasapi.ID `json:"id" url:"id,omitempty"`                 //  (Required)
+		OutcomeID canvasapi.ID `json:"outcome_id" url:"outcome_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		MoveFrom      int64  `json:"move_from" url:"move_from,omitempty"`           //  (Optional)
-		Title         string `json:"title" url:"title,omitempty"`                   //  (Optional)
-		DisplayName   string `json:"display_name" url:"display_name,omitempty"`     //  (Optional)
-		Description   string `json:"description" url:"description,omitempty"`       //  (Optional)
-		VendorGuid    string `json:"vendor_guid" url:"vendor_guid,omitempty"`       //  (Optional)
-		MasteryPoints int64  `json:"mastery_points" url:"mastery_points,omitempty"` //  (Optional)
+		MoveFrom      canvasapi.Opt[int64]  `json:"move_from" url:"move_from,omitempty"`           //  (Optional)
+		Title         canvasapi.Opt[string] `json:"title" url:"title,omitempty"`                   //  (Optional)
+		DisplayName   canvasapi.Opt[string] `json:"display_name" url:"display_name,omitempty"`     //  (Optional)
+		Description   canvasapi.Opt[string] `json:"description" url:"description,omitempty"`       //  (Optional)
+		VendorGuid    canvasapi.Opt[string] `json:"vendor_guid" url:"vendor_guid,omitempty"`       //  (Optional)
+		MasteryPoints canvasapi.Opt[int64]  `json:"mastery_points" url:"mastery_points,omitempty"` //  (Optional)
 		Ratings       struct {
 			Description []string `json:"description" url:"description,omitempty"` //  (Optional)
 			Points      []string `json:"points" url:"points,omitempty"`           //  (Optional)
 		} `json:"ratings" url:"ratings,omitempty"`
 
-		CalculationMethod string `json:"calculation_method" url:"calculation_method,omitempty"` //  (Optional) . Must be one of decaying_average, n_mastery, latest, highest
-		CalculationInt    int64  `json:"calculation_int" url:"calculation_int,omitempty"`       //  (Optional)
+		CalculationMethod canvasapi.Opt[string] `json:"calculation_method" url:"calculation_method,omitempty"` //  (Optional) . Must be one of decaying_average, n_mastery, latest, highest
+		CalculationInt    canvasapi.Opt[int64]  `json:"calculation_int" url:"calculation_int,omitempty"`       //  (Optional)
 	} `json:"form"`
 }
 
@@ -86,9 +84,9 @@ func (t *CreateLinkOutcomeCoursesOutcomeID) GetMethod() string {
 
 func (t *CreateLinkOutcomeCoursesOutcomeID) GetURLPath() string {
 	path := "courses/{course_id}/outcome_groups/{id}/outcomes/{outcome_id}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
-	path = strings.ReplaceAll(path, "{outcome_id}", fmt.Sprintf("%v", t.Path.OutcomeID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
+	path = strings.ReplaceAll(path, "{outcome_id}", t.Path.OutcomeID.Escaped())
 	return path
 }
 
@@ -97,36 +95,43 @@ func (t *CreateLinkOutcomeCoursesOutcomeID) GetQuery() (string, error) {
 }
 
 func (t *CreateLinkOutcomeCoursesOutcomeID) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateLinkOutcomeCoursesOutcomeID) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateLinkOutcomeCoursesOutcomeID) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
+	if t.Path.OutcomeID == "" {
+		errs = append(errs, canvasapi.FieldError{Field: "Path.OutcomeID", Rule: canvasapi.RuleRequired})
+	}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
-	if t.Form.CalculationMethod != "" && !string_utils.Include([]string{"decaying_average", "n_mastery", "latest", "highest"}, t.Form.CalculationMethod) {
-		errs = append(errs, "CalculationMethod must be one of decaying_average, n_mastery, latest, highest")
+	if t.Form.CalculationMethod.Value() != "" && !string_utils.Include([]string{"decaying_average", "n_mastery", "latest", "highest"}, t.Form.CalculationMethod.Value()) {
+		errs = append(errs, canvasapi.FieldError{Field: "Form.CalculationMethod", Rule: canvasapi.RuleOneOf, Allowed: []string{"decaying_average", "n_mastery", "latest", "highest"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CreateLinkOutcomeCoursesOutcomeID) Do(c *canvasapi.Canvas) (*models.OutcomeLink, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateLinkOutcomeCoursesOutcomeID) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.OutcomeLink, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -141,6 +146,10 @@ func (t *CreateLinkOutcomeCoursesOutcomeID) Do(c *canvasapi.Canvas) (*models.Out
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,7 +1,9 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
@@ -17,8 +19,8 @@ import (
 //
 type CreateLiveAssessmentResults struct {
 	Path struct {
-		CourseID     string `json:"course_id" url:"course_id,omitempty"`         //  (Required)
-		AssessmentID string `json:"assessment_id" url:"assessment_id,omitempty"` //  (Required)
+		CourseID     canvasapi.ID `json:"course_id" url:"course_id,omitempty"`         //  (Required)
+		AssessmentID canvasapi.ID `json:"assessment_id" url:"assessment_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -28,8 +30,8 @@ func (t *CreateLiveAssessmentResults) GetMethod() string {
 
 func (t *CreateLiveAssessmentResults) GetURLPath() string {
 	path := "courses/{course_id}/live_assessments/{assessment_id}/results"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{assessment_id}", fmt.Sprintf("%v", t.Path.AssessmentID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{assessment_id}", t.Path.AssessmentID.Escaped())
 	return path
 }
 
@@ -46,24 +48,34 @@ func (t *CreateLiveAssessmentResults) GetJSON() ([]byte, error) {
 }
 
 func (t *CreateLiveAssessmentResults) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.AssessmentID == "" {
-		errs = append(errs, "'Path.AssessmentID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AssessmentID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *CreateLiveAssessmentResults) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *CreateLiveAssessmentResults) Do(c *canvasapi.Canvas) (json.RawMessage, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateLiveAssessmentResults) DoContext(ctx context.Context, c *canvasapi.Canvas) (json.RawMessage, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(body), nil
 }
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -26,7 +24,7 @@ import (
 //
 type CreateMembership struct {
 	Path struct {
-		GroupID string `json:"group_id" url:"group_id,omitempty"` //  (Required)
+		GroupID canvasapi.ID `json:"group_id" url:"group_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -40,7 +38,7 @@ func (t *CreateMembership) GetMethod() string {
 
 func (t *CreateMembership) GetURLPath() string {
 	path := "groups/{group_id}/memberships"
-	path = strings.ReplaceAll(path, "{group_id}", fmt.Sprintf("%v", t.Path.GroupID))
+	path = strings.ReplaceAll(path, "{group_id}", t.Path.GroupID.Escaped())
 	return path
 }
 
@@ -49,30 +47,34 @@ func (t *CreateMembership) GetQuery() (string, error) {
 }
 
 func (t *CreateMembership) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateMembership) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateMembership) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.GroupID == "" {
-		errs = append(errs, "'Path.GroupID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.GroupID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CreateMembership) Do(c *canvasapi.Canvas) (*models.GroupMembership, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateMembership) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.GroupMembership, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -87,6 +89,10 @@ func (t *CreateMembership) Do(c *canvasapi.Canvas) (*models.GroupMembership, err
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
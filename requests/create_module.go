@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -33,17 +31,17 @@ import (
 //
 type CreateModule struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		Module struct {
-			Name                      string    `json:"name" url:"name,omitempty"`                                               //  (Required)
-			UnlockAt                  time.Time `json:"unlock_at" url:"unlock_at,omitempty"`                                     //  (Optional)
-			Position                  int64     `json:"position" url:"position,omitempty"`                                       //  (Optional)
-			RequireSequentialProgress bool      `json:"require_sequential_progress" url:"require_sequential_progress,omitempty"` //  (Optional)
-			PrerequisiteModuleIDs     []string  `json:"prerequisite_module_ids" url:"prerequisite_module_ids,omitempty"`         //  (Optional)
-			PublishFinalGrade         bool      `json:"publish_final_grade" url:"publish_final_grade,omitempty"`                 //  (Optional)
+			Name                      string               `json:"name" url:"name,omitempty"`                                               //  (Required)
+			UnlockAt                  time.Time            `json:"unlock_at" url:"unlock_at,omitempty"`                                     //  (Optional)
+			Position                  canvasapi.Opt[int64] `json:"position" url:"position,omitempty"`                                       //  (Optional)
+			RequireSequentialProgress canvasapi.Opt[bool]  `json:"require_sequential_progress" url:"require_sequential_progress,omitempty"` //  (Optional)
+			PrerequisiteModuleIDs     []string             `json:"prerequisite_module_ids" url:"prerequisite_module_ids,omitempty"`         //  (Optional)
+			PublishFinalGrade         canvasapi.Opt[bool]  `json:"publish_final_grade" url:"publish_final_grade,omitempty"`                 //  (Optional)
 		} `json:"module" url:"module,omitempty"`
 	} `json:"form"`
 }
@@ -54,7 +52,7 @@ func (t *CreateModule) GetMethod() string {
 
 func (t *CreateModule) GetURLPath() string {
 	path := "courses/{course_id}/modules"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
@@ -63,33 +61,37 @@ func (t *CreateModule) GetQuery() (string, error) {
 }
 
 func (t *CreateModule) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateModule) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateModule) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Module.Name == "" {
-		errs = append(errs, "'Form.Module.Name' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Module.Name", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CreateModule) Do(c *canvasapi.Canvas) (*models.Module, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateModule) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Module, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -104,6 +106,10 @@ func (t *CreateModule) Do(c *canvasapi.Canvas) (*models.Module, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
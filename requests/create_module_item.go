@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -45,23 +43,23 @@ import (
 //
 type CreateModuleItem struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		ModuleID string `json:"module_id" url:"module_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		ModuleID canvasapi.ID `json:"module_id" url:"module_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		ModuleItem struct {
-			Title                 string `json:"title" url:"title,omitempty"`               //  (Optional)
-			Type                  string `json:"type" url:"type,omitempty"`                 //  (Required) . Must be one of File, Page, Discussion, Assignment, Quiz, SubHeader, ExternalUrl, ExternalTool
-			ContentID             string `json:"content_id" url:"content_id,omitempty"`     //  (Required)
-			Position              int64  `json:"position" url:"position,omitempty"`         //  (Optional)
-			Indent                int64  `json:"indent" url:"indent,omitempty"`             //  (Optional)
-			PageUrl               string `json:"page_url" url:"page_url,omitempty"`         //  (Optional)
-			ExternalUrl           string `json:"external_url" url:"external_url,omitempty"` //  (Optional)
-			NewTab                bool   `json:"new_tab" url:"new_tab,omitempty"`           //  (Optional)
+			Title                 string               `json:"title" url:"title,omitempty"`               //  (Optional)
+			Type                  string               `json:"type" url:"type,omitempty"`                 //  (Required) . Must be one of File, Page, Discussion, Assignment, Quiz, SubHeader, ExternalUrl, ExternalTool
+			ContentID             string               `json:"content_id" url:"content_id,omitempty"`     //  (Required)
+			Position              canvasapi.Opt[int64] `json:"position" url:"position,omitempty"`         //  (Optional)
+			Indent                canvasapi.Opt[int64] `json:"indent" url:"indent,omitempty"`             //  (Optional)
+			PageUrl               string               `json:"page_url" url:"page_url,omitempty"`         //  (Optional)
+			ExternalUrl           string               `json:"external_url" url:"external_url,omitempty"` //  (Optional)
+			NewTab                canvasapi.Opt[bool]  `json:"new_tab" url:"new_tab,omitempty"`           //  (Optional)
 			CompletionRequirement struct {
-				Type     string `json:"type" url:"type,omitempty"`           //  (Optional) . Must be one of must_view, must_contribute, must_submit, must_mark_done
-				MinScore int64  `json:"min_score" url:"min_score,omitempty"` //  (Optional)
+				Type     string               `json:"type" url:"type,omitempty"`           //  (Optional) . Must be one of must_view, must_contribute, must_submit, must_mark_done
+				MinScore canvasapi.Opt[int64] `json:"min_score" url:"min_score,omitempty"` //  (Optional)
 			} `json:"completion_requirement" url:"completion_requirement,omitempty"`
 		} `json:"module_item" url:"module_item,omitempty"`
 	} `json:"form"`
@@ -73,8 +71,8 @@ func (t *CreateModuleItem) GetMethod() string {
 
 func (t *CreateModuleItem) GetURLPath() string {
 	path := "courses/{course_id}/modules/{module_id}/items"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{module_id}", fmt.Sprintf("%v", t.Path.ModuleID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{module_id}", t.Path.ModuleID.Escaped())
 	return path
 }
 
@@ -83,45 +81,49 @@ func (t *CreateModuleItem) GetQuery() (string, error) {
 }
 
 func (t *CreateModuleItem) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateModuleItem) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateModuleItem) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ModuleID == "" {
-		errs = append(errs, "'Path.ModuleID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ModuleID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.ModuleItem.Type == "" {
-		errs = append(errs, "'Form.ModuleItem.Type' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.ModuleItem.Type", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.ModuleItem.Type != "" && !string_utils.Include([]string{"File", "Page", "Discussion", "Assignment", "Quiz", "SubHeader", "ExternalUrl", "ExternalTool"}, t.Form.ModuleItem.Type) {
-		errs = append(errs, "ModuleItem must be one of File, Page, Discussion, Assignment, Quiz, SubHeader, ExternalUrl, ExternalTool")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.ModuleItem.Type", Rule: canvasapi.RuleOneOf, Allowed: []string{"File", "Page", "Discussion", "Assignment", "Quiz", "SubHeader", "ExternalUrl", "ExternalTool"}})
 	}
 	if t.Form.ModuleItem.ContentID == "" {
-		errs = append(errs, "'Form.ModuleItem.ContentID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.ModuleItem.ContentID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.ModuleItem.CompletionRequirement.Type != "" && !string_utils.Include([]string{"must_view", "must_contribute", "must_submit", "must_mark_done"}, t.Form.ModuleItem.CompletionRequirement.Type) {
-		errs = append(errs, "ModuleItem must be one of must_view, must_contribute, must_submit, must_mark_done")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.ModuleItem.CompletionRequirement.Type", Rule: canvasapi.RuleOneOf, Allowed: []string{"must_view", "must_contribute", "must_submit", "must_mark_done"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CreateModuleItem) Do(c *canvasapi.Canvas) (*models.ModuleItem, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateModuleItem) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.ModuleItem, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -136,6 +138,10 @@ func (t *CreateModuleItem) Do(c *canvasapi.Canvas) (*models.ModuleItem, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -76,43 +74,43 @@ import (
 //
 type CreateNewCourse struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		Course struct {
-			Name                             string    `json:"name" url:"name,omitempty"`                                                                 //  (Optional)
-			CourseCode                       string    `json:"course_code" url:"course_code,omitempty"`                                                   //  (Optional)
-			StartAt                          time.Time `json:"start_at" url:"start_at,omitempty"`                                                         //  (Optional)
-			EndAt                            time.Time `json:"end_at" url:"end_at,omitempty"`                                                             //  (Optional)
-			License                          string    `json:"license" url:"license,omitempty"`                                                           //  (Optional)
-			IsPublic                         bool      `json:"is_public" url:"is_public,omitempty"`                                                       //  (Optional)
-			IsPublicToAuthUsers              bool      `json:"is_public_to_auth_users" url:"is_public_to_auth_users,omitempty"`                           //  (Optional)
-			PublicSyllabus                   bool      `json:"public_syllabus" url:"public_syllabus,omitempty"`                                           //  (Optional)
-			PublicSyllabusToAuth             bool      `json:"public_syllabus_to_auth" url:"public_syllabus_to_auth,omitempty"`                           //  (Optional)
-			PublicDescription                string    `json:"public_description" url:"public_description,omitempty"`                                     //  (Optional)
-			AllowStudentWikiEdits            bool      `json:"allow_student_wiki_edits" url:"allow_student_wiki_edits,omitempty"`                         //  (Optional)
-			AllowWikiComments                bool      `json:"allow_wiki_comments" url:"allow_wiki_comments,omitempty"`                                   //  (Optional)
-			AllowStudentForumAttachments     bool      `json:"allow_student_forum_attachments" url:"allow_student_forum_attachments,omitempty"`           //  (Optional)
-			OpenEnrollment                   bool      `json:"open_enrollment" url:"open_enrollment,omitempty"`                                           //  (Optional)
-			SelfEnrollment                   bool      `json:"self_enrollment" url:"self_enrollment,omitempty"`                                           //  (Optional)
-			RestrictEnrollmentsToCourseDates bool      `json:"restrict_enrollments_to_course_dates" url:"restrict_enrollments_to_course_dates,omitempty"` //  (Optional)
-			TermID                           string    `json:"term_id" url:"term_id,omitempty"`                                                           //  (Optional)
-			SISCourseID                      string    `json:"sis_course_id" url:"sis_course_id,omitempty"`                                               //  (Optional)
-			IntegrationID                    string    `json:"integration_id" url:"integration_id,omitempty"`                                             //  (Optional)
-			HideFinalGrades                  bool      `json:"hide_final_grades" url:"hide_final_grades,omitempty"`                                       //  (Optional)
-			ApplyAssignmentGroupWeights      bool      `json:"apply_assignment_group_weights" url:"apply_assignment_group_weights,omitempty"`             //  (Optional)
-			TimeZone                         string    `json:"time_zone" url:"time_zone,omitempty"`                                                       //  (Optional)
-			DefaultView                      string    `json:"default_view" url:"default_view,omitempty"`                                                 //  (Optional) . Must be one of feed, wiki, modules, syllabus, assignments
-			SyllabusBody                     string    `json:"syllabus_body" url:"syllabus_body,omitempty"`                                               //  (Optional)
-			GradingStandardID                int64     `json:"grading_standard_id" url:"grading_standard_id,omitempty"`                                   //  (Optional)
-			GradePassbackSetting             string    `json:"grade_passback_setting" url:"grade_passback_setting,omitempty"`                             //  (Optional)
-			CourseFormat                     string    `json:"course_format" url:"course_format,omitempty"`                                               //  (Optional)
+			Name                             string               `json:"name" url:"name,omitempty"`                                                                 //  (Optional)
+			CourseCode                       string               `json:"course_code" url:"course_code,omitempty"`                                                   //  (Optional)
+			StartAt                          time.Time            `json:"start_at" url:"start_at,omitempty"`                                                         //  (Optional)
+			EndAt                            time.Time            `json:"end_at" url:"end_at,omitempty"`                                                             //  (Optional)
+			License                          string               `json:"license" url:"license,omitempty"`                                                           //  (Optional)
+			IsPublic                         canvasapi.Opt[bool]  `json:"is_public" url:"is_public,omitempty"`                                                       //  (Optional)
+			IsPublicToAuthUsers              canvasapi.Opt[bool]  `json:"is_public_to_auth_users" url:"is_public_to_auth_users,omitempty"`                           //  (Optional)
+			PublicSyllabus                   canvasapi.Opt[bool]  `json:"public_syllabus" url:"public_syllabus,omitempty"`                                           //  (Optional)
+			PublicSyllabusToAuth             canvasapi.Opt[bool]  `json:"public_syllabus_to_auth" url:"public_syllabus_to_auth,omitempty"`                           //  (Optional)
+			PublicDescription                string               `json:"public_description" url:"public_description,omitempty"`                                     //  (Optional)
+			AllowStudentWikiEdits            canvasapi.Opt[bool]  `json:"allow_student_wiki_edits" url:"allow_student_wiki_edits,omitempty"`                         //  (Optional)
+			AllowWikiComments                canvasapi.Opt[bool]  `json:"allow_wiki_comments" url:"allow_wiki_comments,omitempty"`                                   //  (Optional)
+			AllowStudentForumAttachments     canvasapi.Opt[bool]  `json:"allow_student_forum_attachments" url:"allow_student_forum_attachments,omitempty"`           //  (Optional)
+			OpenEnrollment                   canvasapi.Opt[bool]  `json:"open_enrollment" url:"open_enrollment,omitempty"`                                           //  (Optional)
+			SelfEnrollment                   canvasapi.Opt[bool]  `json:"self_enrollment" url:"self_enrollment,omitempty"`                                           //  (Optional)
+			RestrictEnrollmentsToCourseDates canvasapi.Opt[bool]  `json:"restrict_enrollments_to_course_dates" url:"restrict_enrollments_to_course_dates,omitempty"` //  (Optional)
+			TermID                           string               `json:"term_id" url:"term_id,omitempty"`                                                           //  (Optional)
+			SISCourseID                      string               `json:"sis_course_id" url:"sis_course_id,omitempty"`                                               //  (Optional)
+			IntegrationID                    string               `json:"integration_id" url:"integration_id,omitempty"`                                             //  (Optional)
+			HideFinalGrades                  canvasapi.Opt[bool]  `json:"hide_final_grades" url:"hide_final_grades,omitempty"`                                       //  (Optional)
+			ApplyAssignmentGroupWeights      canvasapi.Opt[bool]  `json:"apply_assignment_group_weights" url:"apply_assignment_group_weights,omitempty"`             //  (Optional)
+			TimeZone                         string               `json:"time_zone" url:"time_zone,omitempty"`                                                       //  (Optional)
+			DefaultView                      string               `json:"default_view" url:"default_view,omitempty"`                                                 //  (Optional) . Must be one of feed, wiki, modules, syllabus, assignments
+			SyllabusBody                     string               `json:"syllabus_body" url:"syllabus_body,omitempty"`                                               //  (Optional)
+			GradingStandardID                canvasapi.Opt[int64] `json:"grading_standard_id" url:"grading_standard_id,omitempty"`                                   //  (Optional)
+			GradePassbackSetting             string               `json:"grade_passback_setting" url:"grade_passback_setting,omitempty"`                             //  (Optional)
+			CourseFormat                     string               `json:"course_format" url:"course_format,omitempty"`                                               //  (Optional)
 		} `json:"course" url:"course,omitempty"`
 
-		Offer                 bool `json:"offer" url:"offer,omitempty"`                                     //  (Optional)
-		EnrollMe              bool `json:"enroll_me" url:"enroll_me,omitempty"`                             //  (Optional)
-		EnableSISReactivation bool `json:"enable_sis_reactivation" url:"enable_sis_reactivation,omitempty"` //  (Optional)
+		Offer                 canvasapi.Opt[bool] `json:"offer" url:"offer,omitempty"`                                     //  (Optional)
+		EnrollMe              canvasapi.Opt[bool] `json:"enroll_me" url:"enroll_me,omitempty"`                             //  (Optional)
+		EnableSISReactivation canvasapi.Opt[bool] `json:"enable_sis_reactivation" url:"enable_sis_reactivation,omitempty"` //  (Optional)
 	} `json:"form"`
 }
 
@@ -122,7 +120,7 @@ func (t *CreateNewCourse) GetMethod() string {
 
 func (t *CreateNewCourse) GetURLPath() string {
 	path := "accounts/{account_id}/courses"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	return path
 }
 
@@ -131,33 +129,37 @@ func (t *CreateNewCourse) GetQuery() (string, error) {
 }
 
 func (t *CreateNewCourse) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateNewCourse) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateNewCourse) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Course.DefaultView != "" && !string_utils.Include([]string{"feed", "wiki", "modules", "syllabus", "assignments"}, t.Form.Course.DefaultView) {
-		errs = append(errs, "Course must be one of feed, wiki, modules, syllabus, assignments")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Course.DefaultView", Rule: canvasapi.RuleOneOf, Allowed: []string{"feed", "wiki", "modules", "syllabus", "assignments"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CreateNewCourse) Do(c *canvasapi.Canvas) (*models.Course, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateNewCourse) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Course, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -172,6 +174,10 @@ func (t *CreateNewCourse) Do(c *canvasapi.Canvas) (*models.Course, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,14 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -63,29 +62,29 @@ import (
 //
 type CreateNewDiscussionTopicGroups struct {
 	Path struct {
-		GroupID string `json:"group_id" url:"group_id,omitempty"` //  (Required)
+		GroupID canvasapi.ID `json:"group_id" url:"group_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		Title                  string             `json:"title" url:"title,omitempty"`                                         //  (Optional)
-		Message                string             `json:"message" url:"message,omitempty"`                                     //  (Optional)
-		DiscussionType         string             `json:"discussion_type" url:"discussion_type,omitempty"`                     //  (Optional) . Must be one of side_comment, threaded
-		Published              bool               `json:"published" url:"published,omitempty"`                                 //  (Optional)
-		DelayedPostAt          time.Time          `json:"delayed_post_at" url:"delayed_post_at,omitempty"`                     //  (Optional)
-		AllowRating            bool               `json:"allow_rating" url:"allow_rating,omitempty"`                           //  (Optional)
-		LockAt                 time.Time          `json:"lock_at" url:"lock_at,omitempty"`                                     //  (Optional)
-		PodcastEnabled         bool               `json:"podcast_enabled" url:"podcast_enabled,omitempty"`                     //  (Optional)
-		PodcastHasStudentPosts bool               `json:"podcast_has_student_posts" url:"podcast_has_student_posts,omitempty"` //  (Optional)
-		RequireInitialPost     bool               `json:"require_initial_post" url:"require_initial_post,omitempty"`           //  (Optional)
-		Assignment             *models.Assignment `json:"assignment" url:"assignment,omitempty"`                               //  (Optional)
-		IsAnnouncement         bool               `json:"is_announcement" url:"is_announcement,omitempty"`                     //  (Optional)
-		Pinned                 bool               `json:"pinned" url:"pinned,omitempty"`                                       //  (Optional)
-		PositionAfter          string             `json:"position_after" url:"position_after,omitempty"`                       //  (Optional)
-		GroupCategoryID        int64              `json:"group_category_id" url:"group_category_id,omitempty"`                 //  (Optional)
-		OnlyGradersCanRate     bool               `json:"only_graders_can_rate" url:"only_graders_can_rate,omitempty"`         //  (Optional)
-		SortByRating           bool               `json:"sort_by_rating" url:"sort_by_rating,omitempty"`                       //  (Optional)
-		Attachment             string             `json:"attachment" url:"attachment,omitempty"`                               //  (Optional)
-		SpecificSections       string             `json:"specific_sections" url:"specific_sections,omitempty"`                 //  (Optional)
+		Title                  string               `json:"title" url:"title,omitempty"`                                         //  (Optional)
+		Message                string               `json:"message" url:"message,omitempty"`                                     //  (Optional)
+		DiscussionType         string               `json:"discussion_type" url:"discussion_type,omitempty"`                     //  (Optional) . Must be one of side_comment, threaded
+		Published              canvasapi.Opt[bool]  `json:"published" url:"published,omitempty"`                                 //  (Optional)
+		DelayedPostAt          time.Time            `json:"delayed_post_at" url:"delayed_post_at,omitempty"`                     //  (Optional)
+		AllowRating            canvasapi.Opt[bool]  `json:"allow_rating" url:"allow_rating,omitempty"`                           //  (Optional)
+		LockAt                 time.Time            `json:"lock_at" url:"lock_at,omitempty"`                                     //  (Optional)
+		PodcastEnabled         canvasapi.Opt[bool]  `json:"podcast_enabled" url:"podcast_enabled,omitempty"`                     //  (Optional)
+		PodcastHasStudentPosts canvasapi.Opt[bool]  `json:"podcast_has_student_posts" url:"podcast_has_student_posts,omitempty"` //  (Optional)
+		RequireInitialPost     canvasapi.Opt[bool]  `json:"require_initial_post" url:"require_initial_post,omitempty"`           //  (Optional)
+		Assignment             *models.Assignment   `json:"assignment" url:"assignment,omitempty"`                               //  (Optional)
+		IsAnnouncement         canvasapi.Opt[bool]  `json:"is_announcement" url:"is_announcement,omitempty"`                     //  (Optional)
+		Pinned                 canvasapi.Opt[bool]  `json:"pinned" url:"pinned,omitempty"`                                       //  (Optional)
+		PositionAfter          string               `json:"position_after" url:"position_after,omitempty"`                       //  (Optional)
+		GroupCategoryID        canvasapi.Opt[int64] `json:"group_category_id" url:"group_category_id,omitempty"`                 //  (Optional)
+		OnlyGradersCanRate     canvasapi.Opt[bool]  `json:"only_graders_can_rate" url:"only_graders_can_rate,omitempty"`         //  (Optional)
+		SortByRating           canvasapi.Opt[bool]  `json:"sort_by_rating" url:"sort_by_rating,omitempty"`                       //  (Optional)
+		Attachment             string               `json:"attachment" url:"attachment,omitempty"`                               //  (Optional)
+		SpecificSections       string               `json:"specific_sections" url:"specific_sections,omitempty"`                 //  (Optional)
 	} `json:"form"`
 }
 
@@ -95,7 +94,7 @@ func (t *CreateNewDiscussionTopicGroups) GetMethod() string {
 
 func (t *CreateNewDiscussionTopicGroups) GetURLPath() string {
 	path := "groups/{group_id}/discussion_topics"
-	path = strings.ReplaceAll(path, "{group_id}", fmt.Sprintf("%v", t.Path.GroupID))
+	path = strings.ReplaceAll(path, "{group_id}", t.Path.GroupID.Escaped())
 	return path
 }
 
@@ -104,36 +103,55 @@ func (t *CreateNewDiscussionTopicGroups) GetQuery() (string, error) {
 }
 
 func (t *CreateNewDiscussionTopicGroups) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateNewDiscussionTopicGroups) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateNewDiscussionTopicGroups) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.GroupID == "" {
-		errs = append(errs, "'Path.GroupID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.GroupID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.DiscussionType != "" && !string_utils.Include([]string{"side_comment", "threaded"}, t.Form.DiscussionType) {
-		errs = append(errs, "DiscussionType must be one of side_comment, threaded")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.DiscussionType", Rule: canvasapi.RuleOneOf, Allowed: []string{"side_comment", "threaded"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *CreateNewDiscussionTopicGroups) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *CreateNewDiscussionTopicGroups) Do(c *canvasapi.Canvas) (*models.DiscussionTopic, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateNewDiscussionTopicGroups) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.DiscussionTopic, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.DiscussionTopic{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
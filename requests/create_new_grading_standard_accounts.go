@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -46,7 +44,7 @@ import (
 //
 type CreateNewGradingStandardAccounts struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -64,7 +62,7 @@ func (t *CreateNewGradingStandardAccounts) GetMethod() string {
 
 func (t *CreateNewGradingStandardAccounts) GetURLPath() string {
 	path := "accounts/{account_id}/grading_standards"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	return path
 }
 
@@ -73,39 +71,43 @@ func (t *CreateNewGradingStandardAccounts) GetQuery() (string, error) {
 }
 
 func (t *CreateNewGradingStandardAccounts) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateNewGradingStandardAccounts) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateNewGradingStandardAccounts) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Title == "" {
-		errs = append(errs, "'Form.Title' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Title", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.GradingSchemeEntry.Name == nil {
-		errs = append(errs, "'Form.GradingSchemeEntry.Name' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.GradingSchemeEntry.Name", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.GradingSchemeEntry.Value == nil {
-		errs = append(errs, "'Form.GradingSchemeEntry.Value' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.GradingSchemeEntry.Value", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CreateNewGradingStandardAccounts) Do(c *canvasapi.Canvas) (*models.GradingStandard, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateNewGradingStandardAccounts) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.GradingStandard, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -120,6 +122,10 @@ func (t *CreateNewGradingStandardAccounts) Do(c *canvasapi.Canvas) (*models.Grad
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -46,7 +44,7 @@ import (
 //
 type CreateNewGradingStandardCourses struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -64,7 +62,7 @@ func (t *CreateNewGradingStandardCourses) GetMethod() string {
 
 func (t *CreateNewGradingStandardCourses) GetURLPath() string {
 	path := "courses/{course_id}/grading_standards"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
@@ -73,39 +71,43 @@ func (t *CreateNewGradingStandardCourses) GetQuery() (string, error) {
 }
 
 func (t *CreateNewGradingStandardCourses) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateNewGradingStandardCourses) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateNewGradingStandardCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Title == "" {
-		errs = append(errs, "'Form.Title' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Title", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.GradingSchemeEntry.Name == nil {
-		errs = append(errs, "'Form.GradingSchemeEntry.Name' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.GradingSchemeEntry.Name", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.GradingSchemeEntry.Value == nil {
-		errs = append(errs, "'Form.GradingSchemeEntry.Value' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.GradingSchemeEntry.Value", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CreateNewGradingStandardCourses) Do(c *canvasapi.Canvas) (*models.GradingStandard, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateNewGradingStandardCourses) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.GradingStandard, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -120,6 +122,10 @@ func (t *CreateNewGradingStandardCourses) Do(c *canvasapi.Canvas) (*models.Gradi
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
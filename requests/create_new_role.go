@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -171,14 +169,14 @@ import (
 //
 type CreateNewRole struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		Label        string `json:"label" url:"label,omitempty"`                   //  (Required)
-		Role         string `json:"role" url:"role,omitempty"`                     //  (Optional)
-		BaseRoleType string `json:"base_role_type" url:"base_role_type,omitempty"` //  (Optional) . Must be one of AccountMembership, StudentEnrollment, TeacherEnrollment, TaEnrollment, ObserverEnrollment, DesignerEnrollment
-		Permissions  map[string]CreateNewRolePermissions
+		Label        string                              `json:"label" url:"label,omitempty"`                   //  (Required)
+		Role         string                              `json:"role" url:"role,omitempty"`                     //  (Optional)
+		BaseRoleType string                              `json:"base_role_type" url:"base_role_type,omitempty"` //  (Optional) . Must be one of AccountMembership, StudentEnrollment, TeacherEnrollment, TaEnrollment, ObserverEnrollment, DesignerEnrollment
+		Permissions  map[string]CreateNewRolePermissions `json:"permissions" url:"permissions,omitempty"`       //  (Optional)
 	} `json:"form"`
 }
 
@@ -188,7 +186,7 @@ func (t *CreateNewRole) GetMethod() string {
 
 func (t *CreateNewRole) GetURLPath() string {
 	path := "accounts/{account_id}/roles"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	return path
 }
 
@@ -197,36 +195,40 @@ func (t *CreateNewRole) GetQuery() (string, error) {
 }
 
 func (t *CreateNewRole) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateNewRole) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateNewRole) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Label == "" {
-		errs = append(errs, "'Form.Label' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Label", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.BaseRoleType != "" && !string_utils.Include([]string{"AccountMembership", "StudentEnrollment", "TeacherEnrollment", "TaEnrollment", "ObserverEnrollment", "DesignerEnrollment"}, t.Form.BaseRoleType) {
-		errs = append(errs, "BaseRoleType must be one of AccountMembership, StudentEnrollment, TeacherEnrollment, TaEnrollment, ObserverEnrollment, DesignerEnrollment")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.BaseRoleType", Rule: canvasapi.RuleOneOf, Allowed: []string{"AccountMembership", "StudentEnrollment", "TeacherEnrollment", "TaEnrollment", "ObserverEnrollment", "DesignerEnrollment"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CreateNewRole) Do(c *canvasapi.Canvas) (*models.Role, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateNewRole) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Role, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -241,14 +243,18 @@ func (t *CreateNewRole) Do(c *canvasapi.Canvas) (*models.Role, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
 
 type CreateNewRolePermissions struct {
-	Explicit             bool `json:"explicit" url:"explicit,omitempty"`                             //  (Optional)
-	Enabled              bool `json:"enabled" url:"enabled,omitempty"`                               //  (Optional)
-	Locked               bool `json:"locked" url:"locked,omitempty"`                                 //  (Optional)
-	AppliesToSelf        bool `json:"applies_to_self" url:"applies_to_self,omitempty"`               //  (Optional)
-	AppliesToDescendants bool `json:"applies_to_descendants" url:"applies_to_descendants,omitempty"` //  (Optional)
+	Explicit             canvasapi.Opt[bool] `json:"explicit" url:"explicit,omitempty"`                             //  (Optional)
+	Enabled              canvasapi.Opt[bool] `json:"enabled" url:"enabled,omitempty"`                               //  (Optional)
+	Locked               canvasapi.Opt[bool] `json:"locked" url:"locked,omitempty"`                                 //  (Optional)
+	AppliesToSelf        canvasapi.Opt[bool] `json:"applies_to_self" url:"applies_to_self,omitempty"`               //  (Optional)
+	AppliesToDescendants canvasapi.Opt[bool] `json:"applies_to_descendants" url:"applies_to_descendants,omitempty"` //  (Optional)
 }
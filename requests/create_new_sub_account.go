@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -28,16 +26,16 @@ import (
 //
 type CreateNewSubAccount struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		Account struct {
-			Name                       string `json:"name" url:"name,omitempty"`                                                     //  (Required)
-			SISAccountID               string `json:"sis_account_id" url:"sis_account_id,omitempty"`                                 //  (Optional)
-			DefaultStorageQuotaMb      int64  `json:"default_storage_quota_mb" url:"default_storage_quota_mb,omitempty"`             //  (Optional)
-			DefaultUserStorageQuotaMb  int64  `json:"default_user_storage_quota_mb" url:"default_user_storage_quota_mb,omitempty"`   //  (Optional)
-			DefaultGroupStorageQuotaMb int64  `json:"default_group_storage_quota_mb" url:"default_group_storage_quota_mb,omitempty"` //  (Optional)
+			Name                       string               `json:"name" url:"name,omitempty"`                                                     //  (Required)
+			SISAccountID               string               `json:"sis_account_id" url:"sis_account_id,omitempty"`                                 //  (Optional)
+			DefaultStorageQuotaMb      canvasapi.Opt[int64] `json:"default_storage_quota_mb" url:"default_storage_quota_mb,omitempty"`             //  (Optional)
+			DefaultUserStorageQuotaMb  canvasapi.Opt[int64] `json:"default_user_storage_quota_mb" url:"default_user_storage_quota_mb,omitempty"`   //  (Optional)
+			DefaultGroupStorageQuotaMb canvasapi.Opt[int64] `json:"default_group_storage_quota_mb" url:"default_group_storage_quota_mb,omitempty"` //  (Optional)
 		} `json:"account" url:"account,omitempty"`
 	} `json:"form"`
 }
@@ -48,7 +46,7 @@ func (t *CreateNewSubAccount) GetMethod() string {
 
 func (t *CreateNewSubAccount) GetURLPath() string {
 	path := "accounts/{account_id}/sub_accounts"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	return path
 }
 
@@ -57,33 +55,37 @@ func (t *CreateNewSubAccount) GetQuery() (string, error) {
 }
 
 func (t *CreateNewSubAccount) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateNewSubAccount) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateNewSubAccount) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Account.Name == "" {
-		errs = append(errs, "'Form.Account.Name' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Account.Name", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CreateNewSubAccount) Do(c *canvasapi.Canvas) (*models.Account, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateNewSubAccount) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Account, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -98,6 +100,10 @@ func (t *CreateNewSubAccount) Do(c *canvasapi.Canvas) (*models.Account, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
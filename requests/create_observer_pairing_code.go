@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -20,7 +20,7 @@ import (
 //
 type CreateObserverPairingCode struct {
 	Path struct {
-		UserID string `json:"user_id" url:"user_id,omitempty"` //  (Required)
+		UserID canvasapi.ID `json:"user_id" url:"user_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -30,7 +30,7 @@ func (t *CreateObserverPairingCode) GetMethod() string {
 
 func (t *CreateObserverPairingCode) GetURLPath() string {
 	path := "users/{user_id}/observer_pairing_codes"
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", t.Path.UserID))
+	path = strings.ReplaceAll(path, "{user_id}", t.Path.UserID.Escaped())
 	return path
 }
 
@@ -47,18 +47,22 @@ func (t *CreateObserverPairingCode) GetJSON() ([]byte, error) {
 }
 
 func (t *CreateObserverPairingCode) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.UserID == "" {
-		errs = append(errs, "'Path.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CreateObserverPairingCode) Do(c *canvasapi.Canvas) (*models.PairingCode, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateObserverPairingCode) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.PairingCode, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -73,6 +77,10 @@ func (t *CreateObserverPairingCode) Do(c *canvasapi.Canvas) (*models.PairingCode
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
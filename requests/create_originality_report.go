@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -51,24 +49,24 @@ import (
 //
 type CreateOriginalityReport struct {
 	Path struct {
-		AssignmentID string `json:"assignment_id" url:"assignment_id,omitempty"` //  (Required)
-		SubmissionID string `json:"submission_id" url:"submission_id,omitempty"` //  (Required)
+		AssignmentID canvasapi.ID `json:"assignment_id" url:"assignment_id,omitempty"` //  (Required)
+		SubmissionID canvasapi.ID `json:"submission_id" url:"submission_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		OriginalityReport struct {
-			FileID                  int64   `json:"file_id" url:"file_id,omitempty"`                                       //  (Optional)
-			OriginalityScore        float64 `json:"originality_score" url:"originality_score,omitempty"`                   //  (Required)
-			OriginalityReportUrl    string  `json:"originality_report_url" url:"originality_report_url,omitempty"`         //  (Optional)
-			OriginalityReportFileID int64   `json:"originality_report_file_id" url:"originality_report_file_id,omitempty"` //  (Optional)
+			FileID                  canvasapi.Opt[int64]   `json:"file_id" url:"file_id,omitempty"`                                       //  (Optional)
+			OriginalityScore        canvasapi.Opt[float64] `json:"originality_score" url:"originality_score,omitempty"`                   //  (Required)
+			OriginalityReportUrl    string                 `json:"originality_report_url" url:"originality_report_url,omitempty"`         //  (Optional)
+			OriginalityReportFileID canvasapi.Opt[int64]   `json:"originality_report_file_id" url:"originality_report_file_id,omitempty"` //  (Optional)
 			ToolSetting             struct {
 				ResourceTypeCode string `json:"resource_type_code" url:"resource_type_code,omitempty"` //  (Optional)
 				ResourceUrl      string `json:"resource_url" url:"resource_url,omitempty"`             //  (Optional)
 			} `json:"tool_setting" url:"tool_setting,omitempty"`
 
-			WorkflowState string `json:"workflow_state" url:"workflow_state,omitempty"` //  (Optional)
-			ErrorMessage  string `json:"error_message" url:"error_message,omitempty"`   //  (Optional)
-			Attempt       int64  `json:"attempt" url:"attempt,omitempty"`               //  (Optional)
+			WorkflowState string               `json:"workflow_state" url:"workflow_state,omitempty"` //  (Optional)
+			ErrorMessage  string               `json:"error_message" url:"error_message,omitempty"`   //  (Optional)
+			Attempt       canvasapi.Opt[int64] `json:"attempt" url:"attempt,omitempty"`               //  (Optional)
 		} `json:"originality_report" url:"originality_report,omitempty"`
 	} `json:"form"`
 }
@@ -79,8 +77,8 @@ func (t *CreateOriginalityReport) GetMethod() string {
 
 func (t *CreateOriginalityReport) GetURLPath() string {
 	path := "/lti/assignments/{assignment_id}/submissions/{submission_id}/originality_report"
-	path = strings.ReplaceAll(path, "{assignment_id}", fmt.Sprintf("%v", t.Path.AssignmentID))
-	path = strings.ReplaceAll(path, "{submission_id}", fmt.Sprintf("%v", t.Path.SubmissionID))
+	path = strings.ReplaceAll(path, "{assignment_id}", t.Path.AssignmentID.Escaped())
+	path = strings.ReplaceAll(path, "{submission_id}", t.Path.SubmissionID.Escaped())
 	return path
 }
 
@@ -89,33 +87,40 @@ func (t *CreateOriginalityReport) GetQuery() (string, error) {
 }
 
 func (t *CreateOriginalityReport) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateOriginalityReport) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateOriginalityReport) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AssignmentID == "" {
-		errs = append(errs, "'Path.AssignmentID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AssignmentID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.SubmissionID == "" {
-		errs = append(errs, "'Path.SubmissionID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.SubmissionID", Rule: canvasapi.RuleRequired})
+	}
+	if !t.Form.OriginalityReport.OriginalityScore.IsSet() {
+		errs = append(errs, canvasapi.FieldError{Field: "Form.OriginalityReport.OriginalityScore", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CreateOriginalityReport) Do(c *canvasapi.Canvas) (*models.OriginalityReport, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateOriginalityReport) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.OriginalityReport, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -130,6 +135,10 @@ func (t *CreateOriginalityReport) Do(c *canvasapi.Canvas) (*models.OriginalityRe
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -36,17 +34,17 @@ import (
 //
 type CreatePageGroups struct {
 	Path struct {
-		GroupID string `json:"group_id" url:"group_id,omitempty"` //  (Required)
+		GroupID canvasapi.ID `json:"group_id" url:"group_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		WikiPage struct {
-			Title          string `json:"title" url:"title,omitempty"`                       //  (Required)
-			Body           string `json:"body" url:"body,omitempty"`                         //  (Optional)
-			EditingRoles   string `json:"editing_roles" url:"editing_roles,omitempty"`       //  (Optional) . Must be one of teachers, students, members, public
-			NotifyOfUpdate bool   `json:"notify_of_update" url:"notify_of_update,omitempty"` //  (Optional)
-			Published      bool   `json:"published" url:"published,omitempty"`               //  (Optional)
-			FrontPage      bool   `json:"front_page" url:"front_page,omitempty"`             //  (Optional)
+			Title          string              `json:"title" url:"title,omitempty"`                       //  (Required)
+			Body           string              `json:"body" url:"body,omitempty"`                         //  (Optional)
+			EditingRoles   string              `json:"editing_roles" url:"editing_roles,omitempty"`       //  (Optional) . Must be one of teachers, students, members, public
+			NotifyOfUpdate canvasapi.Opt[bool] `json:"notify_of_update" url:"notify_of_update,omitempty"` //  (Optional)
+			Published      canvasapi.Opt[bool] `json:"published" url:"published,omitempty"`               //  (Optional)
+			FrontPage      canvasapi.Opt[bool] `json:"front_page" url:"front_page,omitempty"`             //  (Optional)
 		} `json:"wiki_page" url:"wiki_page,omitempty"`
 	} `json:"form"`
 }
@@ -57,7 +55,7 @@ func (t *CreatePageGroups) GetMethod() string {
 
 func (t *CreatePageGroups) GetURLPath() string {
 	path := "groups/{group_id}/pages"
-	path = strings.ReplaceAll(path, "{group_id}", fmt.Sprintf("%v", t.Path.GroupID))
+	path = strings.ReplaceAll(path, "{group_id}", t.Path.GroupID.Escaped())
 	return path
 }
 
@@ -66,36 +64,40 @@ func (t *CreatePageGroups) GetQuery() (string, error) {
 }
 
 func (t *CreatePageGroups) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreatePageGroups) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreatePageGroups) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.GroupID == "" {
-		errs = append(errs, "'Path.GroupID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.GroupID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.WikiPage.Title == "" {
-		errs = append(errs, "'Form.WikiPage.Title' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.WikiPage.Title", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.WikiPage.EditingRoles != "" && !string_utils.Include([]string{"teachers", "students", "members", "public"}, t.Form.WikiPage.EditingRoles) {
-		errs = append(errs, "WikiPage must be one of teachers, students, members, public")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.WikiPage.EditingRoles", Rule: canvasapi.RuleOneOf, Allowed: []string{"teachers", "students", "members", "public"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CreatePageGroups) Do(c *canvasapi.Canvas) (*models.Page, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreatePageGroups) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Page, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -110,6 +112,10 @@ func (t *CreatePageGroups) Do(c *canvasapi.Canvas) (*models.Page, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
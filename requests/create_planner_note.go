@@ -1,13 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/url"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -32,12 +31,12 @@ import (
 //
 type CreatePlannerNote struct {
 	Form struct {
-		Title            string    `json:"title" url:"title,omitempty"`                           //  (Optional)
-		Details          string    `json:"details" url:"details,omitempty"`                       //  (Optional)
-		TodoDate         time.Time `json:"todo_date" url:"todo_date,omitempty"`                   //  (Optional)
-		CourseID         int64     `json:"course_id" url:"course_id,omitempty"`                   //  (Optional)
-		LinkedObjectType string    `json:"linked_object_type" url:"linked_object_type,omitempty"` //  (Optional)
-		LinkedObjectID   int64     `json:"linked_object_id" url:"linked_object_id,omitempty"`     //  (Optional)
+		Title            string               `json:"title" url:"title,omitempty"`                           //  (Optional)
+		Details          string               `json:"details" url:"details,omitempty"`                       //  (Optional)
+		TodoDate         time.Time            `json:"todo_date" url:"todo_date,omitempty"`                   //  (Optional)
+		CourseID         canvasapi.Opt[int64] `json:"course_id" url:"course_id,omitempty"`                   //  (Optional)
+		LinkedObjectType string               `json:"linked_object_type" url:"linked_object_type,omitempty"` //  (Optional)
+		LinkedObjectID   canvasapi.Opt[int64] `json:"linked_object_id" url:"linked_object_id,omitempty"`     //  (Optional)
 	} `json:"form"`
 }
 
@@ -46,7 +45,7 @@ func (t *CreatePlannerNote) GetMethod() string {
 }
 
 func (t *CreatePlannerNote) GetURLPath() string {
-	return ""
+	return "planner_notes"
 }
 
 func (t *CreatePlannerNote) GetQuery() (string, error) {
@@ -54,13 +53,13 @@ func (t *CreatePlannerNote) GetQuery() (string, error) {
 }
 
 func (t *CreatePlannerNote) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreatePlannerNote) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
@@ -70,7 +69,11 @@ func (t *CreatePlannerNote) HasErrors() error {
 }
 
 func (t *CreatePlannerNote) Do(c *canvasapi.Canvas) (*models.PlannerNote, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreatePlannerNote) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.PlannerNote, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -85,6 +88,10 @@ func (t *CreatePlannerNote) Do(c *canvasapi.Canvas) (*models.PlannerNote, error)
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
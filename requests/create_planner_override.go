@@ -1,13 +1,10 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
-	"strings"
-
-	"github.com/google/go-querystring/query"
 
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
@@ -25,10 +22,10 @@ import (
 //
 type CreatePlannerOverride struct {
 	Form struct {
-		PlannableType  string `json:"plannable_type" url:"plannable_type,omitempty"`   //  (Required) . Must be one of announcement, assignment, discussion_topic, quiz, wiki_page, planner_note
-		PlannableID    int64  `json:"plannable_id" url:"plannable_id,omitempty"`       //  (Required)
-		MarkedComplete bool   `json:"marked_complete" url:"marked_complete,omitempty"` //  (Optional)
-		Dismissed      bool   `json:"dismissed" url:"dismissed,omitempty"`             //  (Optional)
+		PlannableType  string              `json:"plannable_type" url:"plannable_type,omitempty"`   //  (Required) . Must be one of announcement, assignment, discussion_topic, quiz, wiki_page, planner_note
+		PlannableID    int64               `json:"plannable_id" url:"plannable_id,omitempty"`       //  (Required)
+		MarkedComplete canvasapi.Opt[bool] `json:"marked_complete" url:"marked_complete,omitempty"` //  (Optional)
+		Dismissed      canvasapi.Opt[bool] `json:"dismissed" url:"dismissed,omitempty"`             //  (Optional)
 	} `json:"form"`
 }
 
@@ -37,7 +34,7 @@ func (t *CreatePlannerOverride) GetMethod() string {
 }
 
 func (t *CreatePlannerOverride) GetURLPath() string {
-	return ""
+	return "planner/overrides"
 }
 
 func (t *CreatePlannerOverride) GetQuery() (string, error) {
@@ -45,33 +42,37 @@ func (t *CreatePlannerOverride) GetQuery() (string, error) {
 }
 
 func (t *CreatePlannerOverride) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreatePlannerOverride) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreatePlannerOverride) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Form.PlannableType == "" {
-		errs = append(errs, "'Form.PlannableType' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.PlannableType", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.PlannableType != "" && !string_utils.Include([]string{"announcement", "assignment", "discussion_topic", "quiz", "wiki_page", "planner_note"}, t.Form.PlannableType) {
-		errs = append(errs, "PlannableType must be one of announcement, assignment, discussion_topic, quiz, wiki_page, planner_note")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.PlannableType", Rule: canvasapi.RuleOneOf, Allowed: []string{"announcement", "assignment", "discussion_topic", "quiz", "wiki_page", "planner_note"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CreatePlannerOverride) Do(c *canvasapi.Canvas) (*models.PlannerOverride, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreatePlannerOverride) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.PlannerOverride, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -86,6 +87,10 @@ func (t *CreatePlannerOverride) Do(c *canvasapi.Canvas) (*models.PlannerOverride
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
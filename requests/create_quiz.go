@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -95,34 +93,34 @@ import (
 //
 type CreateQuiz struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		Quiz struct {
-			Title                         string    `json:"title" url:"title,omitempty"`                                                         //  (Required)
-			Description                   string    `json:"description" url:"description,omitempty"`                                             //  (Optional)
-			QuizType                      string    `json:"quiz_type" url:"quiz_type,omitempty"`                                                 //  (Optional) . Must be one of practice_quiz, assignment, graded_survey, survey
-			AssignmentGroupID             int64     `json:"assignment_group_id" url:"assignment_group_id,omitempty"`                             //  (Optional)
-			TimeLimit                     int64     `json:"time_limit" url:"time_limit,omitempty"`                                               //  (Optional)
-			ShuffleAnswers                bool      `json:"shuffle_answers" url:"shuffle_answers,omitempty"`                                     //  (Optional)
-			HideResults                   string    `json:"hide_results" url:"hide_results,omitempty"`                                           //  (Optional) . Must be one of always, until_after_last_attempt
-			ShowCorrectAnswers            bool      `json:"show_correct_answers" url:"show_correct_answers,omitempty"`                           //  (Optional)
-			ShowCorrectAnswersLastAttempt bool      `json:"show_correct_answers_last_attempt" url:"show_correct_answers_last_attempt,omitempty"` //  (Optional)
-			ShowCorrectAnswersAt          time.Time `json:"show_correct_answers_at" url:"show_correct_answers_at,omitempty"`                     //  (Optional)
-			HideCorrectAnswersAt          time.Time `json:"hide_correct_answers_at" url:"hide_correct_answers_at,omitempty"`                     //  (Optional)
-			AllowedAttempts               int64     `json:"allowed_attempts" url:"allowed_attempts,omitempty"`                                   //  (Optional)
-			ScoringPolicy                 string    `json:"scoring_policy" url:"scoring_policy,omitempty"`                                       //  (Optional) . Must be one of keep_highest, keep_latest
-			OneQuestionAtATime            bool      `json:"one_question_at_a_time" url:"one_question_at_a_time,omitempty"`                       //  (Optional)
-			CantGoBack                    bool      `json:"cant_go_back" url:"cant_go_back,omitempty"`                                           //  (Optional)
-			AccessCode                    string    `json:"access_code" url:"access_code,omitempty"`                                             //  (Optional)
-			IpFilter                      string    `json:"ip_filter" url:"ip_filter,omitempty"`                                                 //  (Optional)
-			DueAt                         time.Time `json:"due_at" url:"due_at,omitempty"`                                                       //  (Optional)
-			LockAt                        time.Time `json:"lock_at" url:"lock_at,omitempty"`                                                     //  (Optional)
-			UnlockAt                      time.Time `json:"unlock_at" url:"unlock_at,omitempty"`                                                 //  (Optional)
-			Published                     bool      `json:"published" url:"published,omitempty"`                                                 //  (Optional)
-			OneTimeResults                bool      `json:"one_time_results" url:"one_time_results,omitempty"`                                   //  (Optional)
-			OnlyVisibleToOverrides        bool      `json:"only_visible_to_overrides" url:"only_visible_to_overrides,omitempty"`                 //  (Optional)
+			Title                         string               `json:"title" url:"title,omitempty"`                                                         //  (Required)
+			Description                   string               `json:"description" url:"description,omitempty"`                                             //  (Optional)
+			QuizType                      string               `json:"quiz_type" url:"quiz_type,omitempty"`                                                 //  (Optional) . Must be one of practice_quiz, assignment, graded_survey, survey
+			AssignmentGroupID             canvasapi.Opt[int64] `json:"assignment_group_id" url:"assignment_group_id,omitempty"`                             //  (Optional)
+			TimeLimit                     canvasapi.Opt[int64] `json:"time_limit" url:"time_limit,omitempty"`                                               //  (Optional)
+			ShuffleAnswers                canvasapi.Opt[bool]  `json:"shuffle_answers" url:"shuffle_answers,omitempty"`                                     //  (Optional)
+			HideResults                   string               `json:"hide_results" url:"hide_results,omitempty"`                                           //  (Optional) . Must be one of always, until_after_last_attempt
+			ShowCorrectAnswers            canvasapi.Opt[bool]  `json:"show_correct_answers" url:"show_correct_answers,omitempty"`                           //  (Optional)
+			ShowCorrectAnswersLastAttempt canvasapi.Opt[bool]  `json:"show_correct_answers_last_attempt" url:"show_correct_answers_last_attempt,omitempty"` //  (Optional)
+			ShowCorrectAnswersAt          time.Time            `json:"show_correct_answers_at" url:"show_correct_answers_at,omitempty"`                     //  (Optional)
+			HideCorrectAnswersAt          time.Time            `json:"hide_correct_answers_at" url:"hide_correct_answers_at,omitempty"`                     //  (Optional)
+			AllowedAttempts               canvasapi.Opt[int64] `json:"allowed_attempts" url:"allowed_attempts,omitempty"`                                   //  (Optional)
+			ScoringPolicy                 string               `json:"scoring_policy" url:"scoring_policy,omitempty"`                                       //  (Optional) . Must be one of keep_highest, keep_latest
+			OneQuestionAtATime            canvasapi.Opt[bool]  `json:"one_question_at_a_time" url:"one_question_at_a_time,omitempty"`                       //  (Optional)
+			CantGoBack                    canvasapi.Opt[bool]  `json:"cant_go_back" url:"cant_go_back,omitempty"`                                           //  (Optional)
+			AccessCode                    string               `json:"access_code" url:"access_code,omitempty"`                                             //  (Optional)
+			IpFilter                      string               `json:"ip_filter" url:"ip_filter,omitempty"`                                                 //  (Optional)
+			DueAt                         time.Time            `json:"due_at" url:"due_at,omitempty"`                                                       //  (Optional)
+			LockAt                        time.Time            `json:"lock_at" url:"lock_at,omitempty"`                                                     //  (Optional)
+			UnlockAt                      time.Time            `json:"unlock_at" url:"unlock_at,omitempty"`                                                 //  (Optional)
+			Published                     canvasapi.Opt[bool]  `json:"published" url:"published,omitempty"`                                                 //  (Optional)
+			OneTimeResults                canvasapi.Opt[bool]  `json:"one_time_results" url:"one_time_results,omitempty"`                                   //  (Optional)
+			OnlyVisibleToOverrides        canvasapi.Opt[bool]  `json:"only_visible_to_overrides" url:"only_visible_to_overrides,omitempty"`                 //  (Optional)
 		} `json:"quiz" url:"quiz,omitempty"`
 	} `json:"form"`
 }
@@ -133,7 +131,7 @@ func (t *CreateQuiz) GetMethod() string {
 
 func (t *CreateQuiz) GetURLPath() string {
 	path := "courses/{course_id}/quizzes"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
@@ -142,42 +140,46 @@ func (t *CreateQuiz) GetQuery() (string, error) {
 }
 
 func (t *CreateQuiz) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateQuiz) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateQuiz) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Quiz.Title == "" {
-		errs = append(errs, "'Form.Quiz.Title' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Quiz.Title", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Quiz.QuizType != "" && !string_utils.Include([]string{"practice_quiz", "assignment", "graded_survey", "survey"}, t.Form.Quiz.QuizType) {
-		errs = append(errs, "Quiz must be one of practice_quiz, assignment, graded_survey, survey")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Quiz.QuizType", Rule: canvasapi.RuleOneOf, Allowed: []string{"practice_quiz", "assignment", "graded_survey", "survey"}})
 	}
 	if t.Form.Quiz.HideResults != "" && !string_utils.Include([]string{"always", "until_after_last_attempt"}, t.Form.Quiz.HideResults) {
-		errs = append(errs, "Quiz must be one of always, until_after_last_attempt")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Quiz.HideResults", Rule: canvasapi.RuleOneOf, Allowed: []string{"always", "until_after_last_attempt"}})
 	}
 	if t.Form.Quiz.ScoringPolicy != "" && !string_utils.Include([]string{"keep_highest", "keep_latest"}, t.Form.Quiz.ScoringPolicy) {
-		errs = append(errs, "Quiz must be one of keep_highest, keep_latest")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Quiz.ScoringPolicy", Rule: canvasapi.RuleOneOf, Allowed: []string{"keep_highest", "keep_latest"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CreateQuiz) Do(c *canvasapi.Canvas) (*models.Quiz, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateQuiz) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Quiz, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -192,6 +194,10 @@ func (t *CreateQuiz) Do(c *canvasapi.Canvas) (*models.Quiz, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -38,14 +36,14 @@ import (
 //
 type CreateQuizReport struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		QuizID   string `json:"quiz_id" url:"quiz_id,omitempty"`     //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		QuizID   canvasapi.ID `json:"quiz_id" url:"quiz_id,omitempty"`     //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		QuizReport struct {
-			ReportType          string `json:"report_type" url:"report_type,omitempty"`                     //  (Required) . Must be one of student_analysis, item_analysis
-			IncludesAllVersions bool   `json:"includes_all_versions" url:"includes_all_versions,omitempty"` //  (Optional)
+			ReportType          string              `json:"report_type" url:"report_type,omitempty"`                     //  (Required) . Must be one of student_analysis, item_analysis
+			IncludesAllVersions canvasapi.Opt[bool] `json:"includes_all_versions" url:"includes_all_versions,omitempty"` //  (Optional)
 		} `json:"quiz_report" url:"quiz_report,omitempty"`
 
 		Include []string `json:"include" url:"include,omitempty"` //  (Optional) . Must be one of file, progress
@@ -58,8 +56,8 @@ func (t *CreateQuizReport) GetMethod() string {
 
 func (t *CreateQuizReport) GetURLPath() string {
 	path := "courses/{course_id}/quizzes/{quiz_id}/reports"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{quiz_id}", fmt.Sprintf("%v", t.Path.QuizID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{quiz_id}", t.Path.QuizID.Escaped())
 	return path
 }
 
@@ -68,44 +66,48 @@ func (t *CreateQuizReport) GetQuery() (string, error) {
 }
 
 func (t *CreateQuizReport) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateQuizReport) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateQuizReport) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.QuizID == "" {
-		errs = append(errs, "'Path.QuizID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.QuizID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.QuizReport.ReportType == "" {
-		errs = append(errs, "'Form.QuizReport.ReportType' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.QuizReport.ReportType", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.QuizReport.ReportType != "" && !string_utils.Include([]string{"student_analysis", "item_analysis"}, t.Form.QuizReport.ReportType) {
-		errs = append(errs, "QuizReport must be one of student_analysis, item_analysis")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.QuizReport.ReportType", Rule: canvasapi.RuleOneOf, Allowed: []string{"student_analysis", "item_analysis"}})
 	}
 	for _, v := range t.Form.Include {
 		if v != "" && !string_utils.Include([]string{"file", "progress"}, v) {
-			errs = append(errs, "Include must be one of file, progress")
+			errs = append(errs, canvasapi.FieldError{Field: "Form.Include", Rule: canvasapi.RuleOneOf, Allowed: []string{"file", "progress"}})
 		}
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CreateQuizReport) Do(c *canvasapi.Canvas) (*models.QuizReport, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateQuizReport) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.QuizReport, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -120,6 +122,10 @@ func (t *CreateQuizReport) Do(c *canvasapi.Canvas) (*models.QuizReport, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
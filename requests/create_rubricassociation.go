@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -34,19 +32,19 @@ import (
 //
 type CreateRubricassociation struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		RubricAssociation struct {
-			RubricID        int64  `json:"rubric_id" url:"rubric_id,omitempty"`               //  (Optional)
-			AssociationID   int64  `json:"association_id" url:"association_id,omitempty"`     //  (Optional)
-			AssociationType string `json:"association_type" url:"association_type,omitempty"` //  (Optional) . Must be one of Assignment, Course, Account
-			Title           string `json:"title" url:"title,omitempty"`                       //  (Optional)
-			UseForGrading   bool   `json:"use_for_grading" url:"use_for_grading,omitempty"`   //  (Optional)
-			HideScoreTotal  bool   `json:"hide_score_total" url:"hide_score_total,omitempty"` //  (Optional)
-			Purpose         string `json:"purpose" url:"purpose,omitempty"`                   //  (Optional) . Must be one of grading, bookmark
-			Bookmarked      bool   `json:"bookmarked" url:"bookmarked,omitempty"`             //  (Optional)
+			RubricID        canvasapi.Opt[int64] `json:"rubric_id" url:"rubric_id,omitempty"`               //  (Optional)
+			AssociationID   canvasapi.Opt[int64] `json:"association_id" url:"association_id,omitempty"`     //  (Optional)
+			AssociationType string               `json:"association_type" url:"association_type,omitempty"` //  (Optional) . Must be one of Assignment, Course, Account
+			Title           string               `json:"title" url:"title,omitempty"`                       //  (Optional)
+			UseForGrading   canvasapi.Opt[bool]  `json:"use_for_grading" url:"use_for_grading,omitempty"`   //  (Optional)
+			HideScoreTotal  canvasapi.Opt[bool]  `json:"hide_score_total" url:"hide_score_total,omitempty"` //  (Optional)
+			Purpose         string               `json:"purpose" url:"purpose,omitempty"`                   //  (Optional) . Must be one of grading, bookmark
+			Bookmarked      canvasapi.Opt[bool]  `json:"bookmarked" url:"bookmarked,omitempty"`             //  (Optional)
 		} `json:"rubric_association" url:"rubric_association,omitempty"`
 	} `json:"form"`
 }
@@ -57,7 +55,7 @@ func (t *CreateRubricassociation) GetMethod() string {
 
 func (t *CreateRubricassociation) GetURLPath() string {
 	path := "courses/{course_id}/rubric_associations"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
@@ -66,36 +64,40 @@ func (t *CreateRubricassociation) GetQuery() (string, error) {
 }
 
 func (t *CreateRubricassociation) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateRubricassociation) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateRubricassociation) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.RubricAssociation.AssociationType != "" && !string_utils.Include([]string{"Assignment", "Course", "Account"}, t.Form.RubricAssociation.AssociationType) {
-		errs = append(errs, "RubricAssociation must be one of Assignment, Course, Account")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.RubricAssociation.AssociationType", Rule: canvasapi.RuleOneOf, Allowed: []string{"Assignment", "Course", "Account"}})
 	}
 	if t.Form.RubricAssociation.Purpose != "" && !string_utils.Include([]string{"grading", "bookmark"}, t.Form.RubricAssociation.Purpose) {
-		errs = append(errs, "RubricAssociation must be one of grading, bookmark")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.RubricAssociation.Purpose", Rule: canvasapi.RuleOneOf, Allowed: []string{"grading", "bookmark"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CreateRubricassociation) Do(c *canvasapi.Canvas) (*models.RubricAssociation, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateRubricassociation) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.RubricAssociation, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -110,6 +112,10 @@ func (t *CreateRubricassociation) Do(c *canvasapi.Canvas) (*models.RubricAssocia
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,16 +1,14 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
-	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // CreateScore Create a new Result from the score params. If this is for the first created line_item for a
@@ -65,17 +63,17 @@ import (
 //
 type CreateScore struct {
 	Path struct {
-		CourseID   string `json:"course_id" url:"course_id,omitempty"`       //  (Required)
-		LineItemID string `json:"line_item_id" url:"line_item_id,omitempty"` //  (Required)
+		CourseID   canvasapi.ID `json:"course_id" url:"course_id,omitempty"`       //  (Required)
+		LineItemID canvasapi.ID `json:"line_item_id" url:"line_item_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		UserID              string                   `json:"user_id" url:"user_id,omitempty"`                                                                             //  (Required)
-		ActivityProgress    string                   `json:"activity_progress" url:"activity_progress,omitempty"`                                                         //  (Required)
-		GradingProgress     string                   `json:"grading_progress" url:"grading_progress,omitempty"`                                                           //  (Required)
+		UserID              string                   `json:"userId" url:"userId,omitempty"`                                                                               //  (Required)
+		ActivityProgress    string                   `json:"activityProgress" url:"activityProgress,omitempty"`                                                           //  (Required)
+		GradingProgress     string                   `json:"gradingProgress" url:"gradingProgress,omitempty"`                                                             //  (Required)
 		Timestamp           string                   `json:"timestamp" url:"timestamp,omitempty"`                                                                         //  (Required)
-		ScoreGiven          float64                  `json:"score_given" url:"score_given,omitempty"`                                                                     //  (Optional)
-		ScoreMaximum        float64                  `json:"score_maximum" url:"score_maximum,omitempty"`                                                                 //  (Optional)
+		ScoreGiven          canvasapi.Opt[float64]   `json:"scoreGiven" url:"scoreGiven,omitempty"`                                                                       //  (Optional)
+		ScoreMaximum        canvasapi.Opt[float64]   `json:"scoreMaximum" url:"scoreMaximum,omitempty"`                                                                   //  (Optional)
 		Comment             string                   `json:"comment" url:"comment,omitempty"`                                                                             //  (Optional)
 		CanvasLTISubmission map[string](interface{}) `json:"https://canvas.instructure.com/lti/submission" url:"https://canvas.instructure.com/lti/submission,omitempty"` //  (Optional)
 	} `json:"form"`
@@ -87,8 +85,8 @@ func (t *CreateScore) GetMethod() string {
 
 func (t *CreateScore) GetURLPath() string {
 	path := "/lti/courses/{course_id}/line_items/{line_item_id}/scores"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{line_item_id}", fmt.Sprintf("%v", t.Path.LineItemID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{line_item_id}", t.Path.LineItemID.Escaped())
 	return path
 }
 
@@ -97,74 +95,77 @@ func (t *CreateScore) GetQuery() (string, error) {
 }
 
 func (t *CreateScore) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateScore) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
+// PrefersJSON reports that Canvas expects the body of this request as JSON.
+func (t *CreateScore) PrefersJSON() bool {
+	return true
+}
+
+// MediaTypes returns the IMS media types of this LTI Advantage service.
+func (t *CreateScore) MediaTypes() (string, string) {
+	return "application/vnd.ims.lis.v1.score+json", ""
+}
+
 func (t *CreateScore) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.LineItemID == "" {
-		errs = append(errs, "'Path.LineItemID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.LineItemID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.UserID == "" {
-		errs = append(errs, "'Form.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.ActivityProgress == "" {
-		errs = append(errs, "'Form.ActivityProgress' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.ActivityProgress", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.GradingProgress == "" {
-		errs = append(errs, "'Form.GradingProgress' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.GradingProgress", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Timestamp == "" {
-		errs = append(errs, "'Form.Timestamp' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Timestamp", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *CreateScore) Do(c *canvasapi.Canvas, next *url.URL) ([]string, *canvasapi.PagedResource, error) {
-	var err error
-	var response *http.Response
-	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
-	} else {
-		response, err = c.SendRequest(t)
-	}
+func (t *CreateScore) Do(c *canvasapi.Canvas) (*models.ScoreResult, error) {
+	return t.DoContext(context.Background(), c)
+}
 
+func (t *CreateScore) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.ScoreResult, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return nil, nil, err
-	}
-	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	body, err := ioutil.ReadAll(response.Body)
 	response.Body.Close()
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
-	ret := []string{}
+	ret := models.ScoreResult{}
 	err = json.Unmarshal(body, &ret)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
-
-	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
+	err = c.ValidateResponse(&ret)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	return ret, pagedResource, nil
+	return &ret, nil
 }
@@ -1,14 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
-	"strings"
-
-	"github.com/google/go-querystring/query"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // CreateSinglePoll Create a new poll for the current user
@@ -32,7 +31,7 @@ func (t *CreateSinglePoll) GetMethod() string {
 }
 
 func (t *CreateSinglePoll) GetURLPath() string {
-	return ""
+	return "polls"
 }
 
 func (t *CreateSinglePoll) GetQuery() (string, error) {
@@ -40,33 +39,52 @@ func (t *CreateSinglePoll) GetQuery() (string, error) {
 }
 
 func (t *CreateSinglePoll) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateSinglePoll) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateSinglePoll) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Form.Polls.Question == nil {
-		errs = append(errs, "'Form.Polls.Question' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Polls.Question", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *CreateSinglePoll) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *CreateSinglePoll) Do(c *canvasapi.Canvas) (*models.PollsResponse, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateSinglePoll) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.PollsResponse, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.PollsResponse{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
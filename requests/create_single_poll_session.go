@@ -1,14 +1,14 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // CreateSinglePollSession Create a new poll session for this poll
@@ -24,7 +24,7 @@ import (
 //
 type CreateSinglePollSession struct {
 	Path struct {
-		PollID string `json:"poll_id" url:"poll_id,omitempty"` //  (Required)
+		PollID canvasapi.ID `json:"poll_id" url:"poll_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -42,7 +42,7 @@ func (t *CreateSinglePollSession) GetMethod() string {
 
 func (t *CreateSinglePollSession) GetURLPath() string {
 	path := "polls/{poll_id}/poll_sessions"
-	path = strings.ReplaceAll(path, "{poll_id}", fmt.Sprintf("%v", t.Path.PollID))
+	path = strings.ReplaceAll(path, "{poll_id}", t.Path.PollID.Escaped())
 	return path
 }
 
@@ -51,36 +51,55 @@ func (t *CreateSinglePollSession) GetQuery() (string, error) {
 }
 
 func (t *CreateSinglePollSession) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateSinglePollSession) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateSinglePollSession) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.PollID == "" {
-		errs = append(errs, "'Path.PollID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.PollID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.PollSessions.CourseID == nil {
-		errs = append(errs, "'Form.PollSessions.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.PollSessions.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *CreateSinglePollSession) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *CreateSinglePollSession) Do(c *canvasapi.Canvas) (*models.PollSessionsResponse, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateSinglePollSession) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.PollSessionsResponse, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.PollSessionsResponse{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
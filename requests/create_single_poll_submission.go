@@ -1,14 +1,14 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // CreateSinglePollSubmission Create a new poll submission for this poll session
@@ -23,8 +23,8 @@ import (
 //
 type CreateSinglePollSubmission struct {
 	Path struct {
-		PollID        string `json:"poll_id" url:"poll_id,omitempty"`                 //  (Required)
-		PollSessionID string `json:"poll_session_id" url:"poll_session_id,omitempty"` //  (Required)
+		PollID        canvasapi.ID `json:"poll_id" url:"poll_id,omitempty"`                 //  (Required)
+		PollSessionID canvasapi.ID `json:"poll_session_id" url:"poll_session_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -40,8 +40,8 @@ func (t *CreateSinglePollSubmission) GetMethod() string {
 
 func (t *CreateSinglePollSubmission) GetURLPath() string {
 	path := "polls/{poll_id}/poll_sessions/{poll_session_id}/poll_submissions"
-	path = strings.ReplaceAll(path, "{poll_id}", fmt.Sprintf("%v", t.Path.PollID))
-	path = strings.ReplaceAll(path, "{poll_session_id}", fmt.Sprintf("%v", t.Path.PollSessionID))
+	path = strings.ReplaceAll(path, "{poll_id}", t.Path.PollID.Escaped())
+	path = strings.ReplaceAll(path, "{poll_session_id}", t.Path.PollSessionID.Escaped())
 	return path
 }
 
@@ -50,39 +50,58 @@ func (t *CreateSinglePollSubmission) GetQuery() (string, error) {
 }
 
 func (t *CreateSinglePollSubmission) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateSinglePollSubmission) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateSinglePollSubmission) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.PollID == "" {
-		errs = append(errs, "'Path.PollID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.PollID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.PollSessionID == "" {
-		errs = append(errs, "'Path.PollSessionID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.PollSessionID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.PollSubmissions.PollChoiceID == nil {
-		errs = append(errs, "'Form.PollSubmissions.PollChoiceID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.PollSubmissions.PollChoiceID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *CreateSinglePollSubmission) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *CreateSinglePollSubmission) Do(c *canvasapi.Canvas) (*models.PollSubmissionsResponse, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateSinglePollSubmission) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.PollSubmissionsResponse, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.PollSubmissionsResponse{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,14 +1,14 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -41,24 +41,24 @@ import (
 //
 type CreateSingleRubric struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		ID                  int64 `json:"id" url:"id,omitempty"`                                       //  (Optional)
-		RubricAssociationID int64 `json:"rubric_association_id" url:"rubric_association_id,omitempty"` //  (Optional)
+		ID                  canvasapi.Opt[int64] `json:"id" url:"id,omitempty"`                                       //  (Optional)
+		RubricAssociationID canvasapi.Opt[int64] `json:"rubric_association_id" url:"rubric_association_id,omitempty"` //  (Optional)
 		Rubric              struct {
 			Title                     string                   `json:"title" url:"title,omitempty"`                                               //  (Optional)
-			FreeFormCriterionComments bool                     `json:"free_form_criterion_comments" url:"free_form_criterion_comments,omitempty"` //  (Optional)
+			FreeFormCriterionComments canvasapi.Opt[bool]      `json:"free_form_criterion_comments" url:"free_form_criterion_comments,omitempty"` //  (Optional)
 			Criteria                  map[string](interface{}) `json:"criteria" url:"criteria,omitempty"`                                         //  (Optional)
 		} `json:"rubric" url:"rubric,omitempty"`
 
 		RubricAssociation struct {
-			AssociationID   int64  `json:"association_id" url:"association_id,omitempty"`     //  (Optional)
-			AssociationType string `json:"association_type" url:"association_type,omitempty"` //  (Optional) . Must be one of Assignment, Course, Account
-			UseForGrading   bool   `json:"use_for_grading" url:"use_for_grading,omitempty"`   //  (Optional)
-			HideScoreTotal  bool   `json:"hide_score_total" url:"hide_score_total,omitempty"` //  (Optional)
-			Purpose         string `json:"purpose" url:"purpose,omitempty"`                   //  (Optional)
+			AssociationID   canvasapi.Opt[int64] `json:"association_id" url:"association_id,omitempty"`     //  (Optional)
+			AssociationType string               `json:"association_type" url:"association_type,omitempty"` //  (Optional) . Must be one of Assignment, Course, Account
+			UseForGrading   canvasapi.Opt[bool]  `json:"use_for_grading" url:"use_for_grading,omitempty"`   //  (Optional)
+			HideScoreTotal  canvasapi.Opt[bool]  `json:"hide_score_total" url:"hide_score_total,omitempty"` //  (Optional)
+			Purpose         string               `json:"purpose" url:"purpose,omitempty"`                   //  (Optional)
 		} `json:"rubric_association" url:"rubric_association,omitempty"`
 	} `json:"form"`
 }
@@ -69,7 +69,7 @@ func (t *CreateSingleRubric) GetMethod() string {
 
 func (t *CreateSingleRubric) GetURLPath() string {
 	path := "courses/{course_id}/rubrics"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
@@ -78,36 +78,55 @@ func (t *CreateSingleRubric) GetQuery() (string, error) {
 }
 
 func (t *CreateSingleRubric) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateSingleRubric) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateSingleRubric) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.RubricAssociation.AssociationType != "" && !string_utils.Include([]string{"Assignment", "Course", "Account"}, t.Form.RubricAssociation.AssociationType) {
-		errs = append(errs, "RubricAssociation must be one of Assignment, Course, Account")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.RubricAssociation.AssociationType", Rule: canvasapi.RuleOneOf, Allowed: []string{"Assignment", "Course", "Account"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *CreateSingleRubric) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *CreateSingleRubric) Do(c *canvasapi.Canvas) (*models.RubricResponse, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateSingleRubric) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.RubricResponse, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.RubricResponse{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,14 +1,14 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // CreateSingleRubricAssessment Returns the rubric assessment with the given id.
@@ -38,14 +38,14 @@ import (
 //
 type CreateSingleRubricAssessment struct {
 	Path struct {
-		CourseID            int64 `json:"course_id" url:"course_id,omitempty"`                         //  (Required)
-		RubricAssociationID int64 `json:"rubric_association_id" url:"rubric_association_id,omitempty"` //  (Required)
+		CourseID            canvasapi.ID `json:"course_id" url:"course_id,omitempty"`                         //  (Required)
+		RubricAssociationID canvasapi.ID `json:"rubric_association_id" url:"rubric_association_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		Provisional       string                   `json:"provisional" url:"provisional,omitempty"`               //  (Optional)
 		Final             string                   `json:"final" url:"final,omitempty"`                           //  (Optional)
-		GradedAnonymously bool                     `json:"graded_anonymously" url:"graded_anonymously,omitempty"` //  (Optional)
+		GradedAnonymously canvasapi.Opt[bool]      `json:"graded_anonymously" url:"graded_anonymously,omitempty"` //  (Optional)
 		RubricAssessment  map[string](interface{}) `json:"rubric_assessment" url:"rubric_assessment,omitempty"`   //  (Optional)
 	} `json:"form"`
 }
@@ -56,8 +56,8 @@ func (t *CreateSingleRubricAssessment) GetMethod() string {
 
 func (t *CreateSingleRubricAssessment) GetURLPath() string {
 	path := "courses/{course_id}/rubric_associations/{rubric_association_id}/rubric_assessments"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{rubric_association_id}", fmt.Sprintf("%v", t.Path.RubricAssociationID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{rubric_association_id}", t.Path.RubricAssociationID.Escaped())
 	return path
 }
 
@@ -66,26 +66,60 @@ func (t *CreateSingleRubricAssessment) GetQuery() (string, error) {
 }
 
 func (t *CreateSingleRubricAssessment) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateSingleRubricAssessment) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
+// PrefersJSON reports that Canvas expects the body of this request as JSON.
+func (t *CreateSingleRubricAssessment) PrefersJSON() bool {
+	return true
+}
+
 func (t *CreateSingleRubricAssessment) HasErrors() error {
+	errs := []canvasapi.FieldError{}
+	if t.Path.CourseID == "" {
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
+	}
+	if t.Path.RubricAssociationID == "" {
+		errs = append(errs, canvasapi.FieldError{Field: "Path.RubricAssociationID", Rule: canvasapi.RuleRequired})
+	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
+	}
 	return nil
 }
 
-func (t *CreateSingleRubricAssessment) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *CreateSingleRubricAssessment) Do(c *canvasapi.Canvas) (*models.RubricAssessment, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateSingleRubricAssessment) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.RubricAssessment, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.RubricAssessment{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
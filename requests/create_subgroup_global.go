@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -27,7 +25,7 @@ import (
 //
 type CreateSubgroupGlobal struct {
 	Path struct {
-		ID string `json:"id" url:"id,omitempty"` //  (Required)
+		ID canvasapi.ID `json:"id" url:"id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -43,7 +41,7 @@ func (t *CreateSubgroupGlobal) GetMethod() string {
 
 func (t *CreateSubgroupGlobal) GetURLPath() string {
 	path := "global/outcome_groups/{id}/subgroups"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -52,33 +50,37 @@ func (t *CreateSubgroupGlobal) GetQuery() (string, error) {
 }
 
 func (t *CreateSubgroupGlobal) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateSubgroupGlobal) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateSubgroupGlobal) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Title == "" {
-		errs = append(errs, "'Form.Title' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Title", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CreateSubgroupGlobal) Do(c *canvasapi.Canvas) (*models.OutcomeGroup, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateSubgroupGlobal) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.OutcomeGroup, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -93,6 +95,10 @@ func (t *CreateSubgroupGlobal) Do(c *canvasapi.Canvas) (*models.OutcomeGroup, er
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -103,41 +101,41 @@ import (
 //
 type CreateUser struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		User struct {
-			Name             string `json:"name" url:"name,omitempty"`                           //  (Optional)
-			ShortName        string `json:"short_name" url:"short_name,omitempty"`               //  (Optional)
-			SortableName     string `json:"sortable_name" url:"sortable_name,omitempty"`         //  (Optional)
-			TimeZone         string `json:"time_zone" url:"time_zone,omitempty"`                 //  (Optional)
-			Locale           string `json:"locale" url:"locale,omitempty"`                       //  (Optional)
-			TermsOfUse       bool   `json:"terms_of_use" url:"terms_of_use,omitempty"`           //  (Optional)
-			SkipRegistration bool   `json:"skip_registration" url:"skip_registration,omitempty"` //  (Optional)
+			Name             string              `json:"name" url:"name,omitempty"`                           //  (Optional)
+			ShortName        string              `json:"short_name" url:"short_name,omitempty"`               //  (Optional)
+			SortableName     string              `json:"sortable_name" url:"sortable_name,omitempty"`         //  (Optional)
+			TimeZone         string              `json:"time_zone" url:"time_zone,omitempty"`                 //  (Optional)
+			Locale           string              `json:"locale" url:"locale,omitempty"`                       //  (Optional)
+			TermsOfUse       canvasapi.Opt[bool] `json:"terms_of_use" url:"terms_of_use,omitempty"`           //  (Optional)
+			SkipRegistration canvasapi.Opt[bool] `json:"skip_registration" url:"skip_registration,omitempty"` //  (Optional)
 		} `json:"user" url:"user,omitempty"`
 
 		Pseudonym struct {
-			UniqueID                 string `json:"unique_id" url:"unique_id,omitempty"`                                   //  (Required)
-			Password                 string `json:"password" url:"password,omitempty"`                                     //  (Optional)
-			SISUserID                string `json:"sis_user_id" url:"sis_user_id,omitempty"`                               //  (Optional)
-			IntegrationID            string `json:"integration_id" url:"integration_id,omitempty"`                         //  (Optional)
-			SendConfirmation         bool   `json:"send_confirmation" url:"send_confirmation,omitempty"`                   //  (Optional)
-			ForceSelfRegistration    bool   `json:"force_self_registration" url:"force_self_registration,omitempty"`       //  (Optional)
-			AuthenticationProviderID string `json:"authentication_provider_id" url:"authentication_provider_id,omitempty"` //  (Optional)
+			UniqueID                 string              `json:"unique_id" url:"unique_id,omitempty"`                                   //  (Required)
+			Password                 string              `json:"password" url:"password,omitempty"`                                     //  (Optional)
+			SISUserID                string              `json:"sis_user_id" url:"sis_user_id,omitempty"`                               //  (Optional)
+			IntegrationID            string              `json:"integration_id" url:"integration_id,omitempty"`                         //  (Optional)
+			SendConfirmation         canvasapi.Opt[bool] `json:"send_confirmation" url:"send_confirmation,omitempty"`                   //  (Optional)
+			ForceSelfRegistration    canvasapi.Opt[bool] `json:"force_self_registration" url:"force_self_registration,omitempty"`       //  (Optional)
+			AuthenticationProviderID string              `json:"authentication_provider_id" url:"authentication_provider_id,omitempty"` //  (Optional)
 		} `json:"pseudonym" url:"pseudonym,omitempty"`
 
 		CommunicationChannel struct {
-			Type             string `json:"type" url:"type,omitempty"`                           //  (Optional)
-			Address          string `json:"address" url:"address,omitempty"`                     //  (Optional)
-			ConfirmationUrl  bool   `json:"confirmation_url" url:"confirmation_url,omitempty"`   //  (Optional)
-			SkipConfirmation bool   `json:"skip_confirmation" url:"skip_confirmation,omitempty"` //  (Optional)
+			Type             string              `json:"type" url:"type,omitempty"`                           //  (Optional)
+			Address          string              `json:"address" url:"address,omitempty"`                     //  (Optional)
+			ConfirmationUrl  canvasapi.Opt[bool] `json:"confirmation_url" url:"confirmation_url,omitempty"`   //  (Optional)
+			SkipConfirmation canvasapi.Opt[bool] `json:"skip_confirmation" url:"skip_confirmation,omitempty"` //  (Optional)
 		} `json:"communication_channel" url:"communication_channel,omitempty"`
 
-		ForceValidations      bool   `json:"force_validations" url:"force_validations,omitempty"`             //  (Optional)
-		EnableSISReactivation bool   `json:"enable_sis_reactivation" url:"enable_sis_reactivation,omitempty"` //  (Optional)
-		Destination           string `json:"destination" url:"destination,omitempty"`                         //  (Optional)
-		InitialEnrollmentType string `json:"initial_enrollment_type" url:"initial_enrollment_type,omitempty"` //  (Optional)
+		ForceValidations      canvasapi.Opt[bool] `json:"force_validations" url:"force_validations,omitempty"`             //  (Optional)
+		EnableSISReactivation canvasapi.Opt[bool] `json:"enable_sis_reactivation" url:"enable_sis_reactivation,omitempty"` //  (Optional)
+		Destination           string              `json:"destination" url:"destination,omitempty"`                         //  (Optional)
+		InitialEnrollmentType string              `json:"initial_enrollment_type" url:"initial_enrollment_type,omitempty"` //  (Optional)
 		PairingCode           struct {
 			Code string `json:"code" url:"code,omitempty"` //  (Optional)
 		} `json:"pairing_code" url:"pairing_code,omitempty"`
@@ -150,7 +148,7 @@ func (t *CreateUser) GetMethod() string {
 
 func (t *CreateUser) GetURLPath() string {
 	path := "accounts/{account_id}/users"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	return path
 }
 
@@ -159,33 +157,37 @@ func (t *CreateUser) GetQuery() (string, error) {
 }
 
 func (t *CreateUser) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateUser) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateUser) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Pseudonym.UniqueID == "" {
-		errs = append(errs, "'Form.Pseudonym.UniqueID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Pseudonym.UniqueID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CreateUser) Do(c *canvasapi.Canvas) (*models.User, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateUser) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.User, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -200,6 +202,10 @@ func (t *CreateUser) Do(c *canvasapi.Canvas) (*models.User, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
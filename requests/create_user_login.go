@@ -1,14 +1,14 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // CreateUserLogin Create a new login for an existing user in the given account.
@@ -36,7 +36,7 @@ import (
 //
 type CreateUserLogin struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -60,7 +60,7 @@ func (t *CreateUserLogin) GetMethod() string {
 
 func (t *CreateUserLogin) GetURLPath() string {
 	path := "accounts/{account_id}/logins"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	return path
 }
 
@@ -69,39 +69,58 @@ func (t *CreateUserLogin) GetQuery() (string, error) {
 }
 
 func (t *CreateUserLogin) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateUserLogin) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateUserLogin) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.User.ID == "" {
-		errs = append(errs, "'Form.User.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.User.ID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Login.UniqueID == "" {
-		errs = append(errs, "'Form.Login.UniqueID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Login.UniqueID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *CreateUserLogin) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *CreateUserLogin) Do(c *canvasapi.Canvas) (*models.Login, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateUserLogin) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Login, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.Login{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,14 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
-	"strings"
-
-	"github.com/google/go-querystring/query"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // CreateWebhookSubscription Creates a webook subscription for the specified event type and
@@ -43,7 +42,7 @@ func (t *CreateWebhookSubscription) GetMethod() string {
 }
 
 func (t *CreateWebhookSubscription) GetURLPath() string {
-	return ""
+	return "/lti/subscriptions"
 }
 
 func (t *CreateWebhookSubscription) GetQuery() (string, error) {
@@ -51,48 +50,67 @@ func (t *CreateWebhookSubscription) GetQuery() (string, error) {
 }
 
 func (t *CreateWebhookSubscription) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *CreateWebhookSubscription) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *CreateWebhookSubscription) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Form.Subscription.ContextID == "" {
-		errs = append(errs, "'Form.Subscription.ContextID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Subscription.ContextID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Subscription.ContextType == "" {
-		errs = append(errs, "'Form.Subscription.ContextType' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Subscription.ContextType", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Subscription.EventTypes == "" {
-		errs = append(errs, "'Form.Subscription.EventTypes' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Subscription.EventTypes", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Subscription.Format == "" {
-		errs = append(errs, "'Form.Subscription.Format' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Subscription.Format", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Subscription.TransportMetadata == nil {
-		errs = append(errs, "'Form.Subscription.TransportMetadata' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Subscription.TransportMetadata", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Subscription.TransportType == "" {
-		errs = append(errs, "'Form.Subscription.TransportType' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Subscription.TransportType", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *CreateWebhookSubscription) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *CreateWebhookSubscription) Do(c *canvasapi.Canvas) (*models.WebhookSubscription, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CreateWebhookSubscription) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.WebhookSubscription, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.WebhookSubscription{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
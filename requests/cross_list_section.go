@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -21,8 +21,8 @@ import (
 //
 type CrossListSection struct {
 	Path struct {
-		ID          string `json:"id" url:"id,omitempty"`                       //  (Required)
-		NewCourseID string `json:"new_course_id" url:"new_course_id,omitempty"` //  (Required)
+		ID          canvasapi.ID `json:"id" url:"id,omitempty"`                       //  (Required)
+		NewCourseID canvasapi.ID `json:"new_course_id" url:"new_course_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -32,8 +32,8 @@ func (t *CrossListSection) GetMethod() string {
 
 func (t *CrossListSection) GetURLPath() string {
 	path := "sections/{id}/crosslist/{new_course_id}"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
-	path = strings.ReplaceAll(path, "{new_course_id}", fmt.Sprintf("%v", t.Path.NewCourseID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
+	path = strings.ReplaceAll(path, "{new_course_id}", t.Path.NewCourseID.Escaped())
 	return path
 }
 
@@ -50,21 +50,25 @@ func (t *CrossListSection) GetJSON() ([]byte, error) {
 }
 
 func (t *CrossListSection) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.NewCourseID == "" {
-		errs = append(errs, "'Path.NewCourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.NewCourseID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *CrossListSection) Do(c *canvasapi.Canvas) (*models.Section, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *CrossListSection) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Section, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -79,6 +83,10 @@ func (t *CrossListSection) Do(c *canvasapi.Canvas) (*models.Section, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -20,7 +20,7 @@ import (
 //
 type DaysInGradebookHistoryForThisCourse struct {
 	Path struct {
-		CourseID int64 `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -30,7 +30,7 @@ func (t *DaysInGradebookHistoryForThisCourse) GetMethod() string {
 
 func (t *DaysInGradebookHistoryForThisCourse) GetURLPath() string {
 	path := "courses/{course_id}/gradebook_history/days"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
@@ -47,16 +47,27 @@ func (t *DaysInGradebookHistoryForThisCourse) GetJSON() ([]byte, error) {
 }
 
 func (t *DaysInGradebookHistoryForThisCourse) HasErrors() error {
+	errs := []canvasapi.FieldError{}
+	if t.Path.CourseID == "" {
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
+	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
+	}
 	return nil
 }
 
 func (t *DaysInGradebookHistoryForThisCourse) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Day, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *DaysInGradebookHistoryForThisCourse) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.Day, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -76,6 +87,10 @@ func (t *DaysInGradebookHistoryForThisCourse) Do(c *canvasapi.Canvas, next *url.
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -19,7 +19,7 @@ import (
 //
 type DeCrossListSection struct {
 	Path struct {
-		ID string `json:"id" url:"id,omitempty"` //  (Required)
+		ID canvasapi.ID `json:"id" url:"id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -29,7 +29,7 @@ func (t *DeCrossListSection) GetMethod() string {
 
 func (t *DeCrossListSection) GetURLPath() string {
 	path := "sections/{id}/crosslist"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -46,18 +46,22 @@ func (t *DeCrossListSection) GetJSON() ([]byte, error) {
 }
 
 func (t *DeCrossListSection) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *DeCrossListSection) Do(c *canvasapi.Canvas) (*models.Section, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *DeCrossListSection) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Section, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -72,6 +76,10 @@ func (t *DeCrossListSection) Do(c *canvasapi.Canvas) (*models.Section, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
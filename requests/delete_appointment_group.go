@@ -1,13 +1,14 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // DeleteAppointmentGroup Delete an appointment group (and associated time slots and reservations)
@@ -22,7 +23,7 @@ import (
 //
 type DeleteAppointmentGroup struct {
 	Path struct {
-		ID string `json:"id" url:"id,omitempty"` //  (Required)
+		ID canvasapi.ID `json:"id" url:"id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
@@ -36,12 +37,12 @@ func (t *DeleteAppointmentGroup) GetMethod() string {
 
 func (t *DeleteAppointmentGroup) GetURLPath() string {
 	path := "appointment_groups/{id}"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
 func (t *DeleteAppointmentGroup) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -57,21 +58,40 @@ func (t *DeleteAppointmentGroup) GetJSON() ([]byte, error) {
 }
 
 func (t *DeleteAppointmentGroup) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *DeleteAppointmentGroup) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *DeleteAppointmentGroup) Do(c *canvasapi.Canvas) (*models.AppointmentGroup, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *DeleteAppointmentGroup) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.AppointmentGroup, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.AppointmentGroup{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
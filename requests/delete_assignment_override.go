@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -21,9 +21,9 @@ import (
 //
 type DeleteAssignmentOverride struct {
 	Path struct {
-		CourseID     string `json:"course_id" url:"course_id,omitempty"`         //  (Required)
-		AssignmentID string `json:"assignment_id" url:"assignment_id,omitempty"` //  (Required)
-		ID           string `json:"id" url:"id,omitempty"`                       //  (Required)
+		CourseID     canvasapi.ID `json:"course_id" url:"course_id,omitempty"`         //  (Required)
+		AssignmentID canvasapi.ID `json:"assignment_id" url:"assignment_id,omitempty"` //  (Required)
+		ID           canvasapi.ID `json:"id" url:"id,omitempty"`                       //  (Required)
 	} `json:"path"`
 }
 
@@ -33,9 +33,9 @@ func (t *DeleteAssignmentOverride) GetMethod() string {
 
 func (t *DeleteAssignmentOverride) GetURLPath() string {
 	path := "courses/{course_id}/assignments/{assignment_id}/overrides/{id}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{assignment_id}", fmt.Sprintf("%v", t.Path.AssignmentID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{assignment_id}", t.Path.AssignmentID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -52,24 +52,28 @@ func (t *DeleteAssignmentOverride) GetJSON() ([]byte, error) {
 }
 
 func (t *DeleteAssignmentOverride) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.AssignmentID == "" {
-		errs = append(errs, "'Path.AssignmentID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AssignmentID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *DeleteAssignmentOverride) Do(c *canvasapi.Canvas) (*models.AssignmentOverride, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *DeleteAssignmentOverride) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.AssignmentOverride, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -84,6 +88,10 @@ func (t *DeleteAssignmentOverride) Do(c *canvasapi.Canvas) (*models.AssignmentOv
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -20,8 +20,8 @@ import (
 //
 type DeleteCommunicationChannelID struct {
 	Path struct {
-		UserID string `json:"user_id" url:"user_id,omitempty"` //  (Required)
-		ID     string `json:"id" url:"id,omitempty"`           //  (Required)
+		UserID canvasapi.ID `json:"user_id" url:"user_id,omitempty"` //  (Required)
+		ID     canvasapi.ID `json:"id" url:"id,omitempty"`           //  (Required)
 	} `json:"path"`
 }
 
@@ -31,8 +31,8 @@ func (t *DeleteCommunicationChannelID) GetMethod() string {
 
 func (t *DeleteCommunicationChannelID) GetURLPath() string {
 	path := "users/{user_id}/communication_channels/{id}"
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", t.Path.UserID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{user_id}", t.Path.UserID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -49,21 +49,25 @@ func (t *DeleteCommunicationChannelID) GetJSON() ([]byte, error) {
 }
 
 func (t *DeleteCommunicationChannelID) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.UserID == "" {
-		errs = append(errs, "'Path.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *DeleteCommunicationChannelID) Do(c *canvasapi.Canvas) (*models.CommunicationChannel, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *DeleteCommunicationChannelID) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.CommunicationChannel, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -78,6 +82,10 @@ func (t *DeleteCommunicationChannelID) Do(c *canvasapi.Canvas) (*models.Communic
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,6 +1,7 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -21,9 +22,9 @@ import (
 //
 type DeleteCommunicationChannelType struct {
 	Path struct {
-		UserID  string `json:"user_id" url:"user_id,omitempty"` //  (Required)
-		Type    string `json:"type" url:"type,omitempty"`       //  (Required)
-		Address string `json:"address" url:"address,omitempty"` //  (Required)
+		UserID  canvasapi.ID `json:"user_id" url:"user_id,omitempty"` //  (Required)
+		Type    string       `json:"type" url:"type,omitempty"`       //  (Required)
+		Address string       `json:"address" url:"address,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -33,7 +34,7 @@ func (t *DeleteCommunicationChannelType) GetMethod() string {
 
 func (t *DeleteCommunicationChannelType) GetURLPath() string {
 	path := "users/{user_id}/communication_channels/{type}/{address}"
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", t.Path.UserID))
+	path = strings.ReplaceAll(path, "{user_id}", t.Path.UserID.Escaped())
 	path = strings.ReplaceAll(path, "{type}", fmt.Sprintf("%v", t.Path.Type))
 	path = strings.ReplaceAll(path, "{address}", fmt.Sprintf("%v", t.Path.Address))
 	return path
@@ -52,24 +53,28 @@ func (t *DeleteCommunicationChannelType) GetJSON() ([]byte, error) {
 }
 
 func (t *DeleteCommunicationChannelType) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.UserID == "" {
-		errs = append(errs, "'Path.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.Type == "" {
-		errs = append(errs, "'Path.Type' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Type", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.Address == "" {
-		errs = append(errs, "'Path.Address' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Address", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *DeleteCommunicationChannelType) Do(c *canvasapi.Canvas) (*models.CommunicationChannel, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *DeleteCommunicationChannelType) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.CommunicationChannel, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -84,6 +89,10 @@ func (t *DeleteCommunicationChannelType) Do(c *canvasapi.Canvas) (*models.Commun
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,12 +1,12 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
 )
@@ -22,7 +22,7 @@ import (
 //
 type DeleteConcludeCourse struct {
 	Path struct {
-		ID string `json:"id" url:"id,omitempty"` //  (Required)
+		ID canvasapi.ID `json:"id" url:"id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
@@ -36,12 +36,12 @@ func (t *DeleteConcludeCourse) GetMethod() string {
 
 func (t *DeleteConcludeCourse) GetURLPath() string {
 	path := "courses/{id}"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
 func (t *DeleteConcludeCourse) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -57,27 +57,42 @@ func (t *DeleteConcludeCourse) GetJSON() ([]byte, error) {
 }
 
 func (t *DeleteConcludeCourse) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Query.Event == "" {
-		errs = append(errs, "'Query.Event' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.Event", Rule: canvasapi.RuleRequired})
 	}
 	if t.Query.Event != "" && !string_utils.Include([]string{"delete", "conclude"}, t.Query.Event) {
-		errs = append(errs, "Event must be one of delete, conclude")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.Event", Rule: canvasapi.RuleOneOf, Allowed: []string{"delete", "conclude"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *DeleteConcludeCourse) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *DeleteConcludeCourse) Do(c *canvasapi.Canvas) (map[string]bool, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *DeleteConcludeCourse) DoContext(ctx context.Context, c *canvasapi.Canvas) (map[string]bool, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := map[string]bool{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
 }
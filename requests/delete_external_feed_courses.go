@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -20,8 +20,8 @@ import (
 //
 type DeleteExternalFeedCourses struct {
 	Path struct {
-		CourseID       string `json:"course_id" url:"course_id,omitempty"`               //  (Required)
-		ExternalFeedID string `json:"external_feed_id" url:"external_feed_id,omitempty"` //  (Required)
+		CourseID       canvasapi.ID `json:"course_id" url:"course_id,omitempty"`               //  (Required)
+		ExternalFeedID canvasapi.ID `json:"external_feed_id" url:"external_feed_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -31,8 +31,8 @@ func (t *DeleteExternalFeedCourses) GetMethod() string {
 
 func (t *DeleteExternalFeedCourses) GetURLPath() string {
 	path := "courses/{course_id}/external_feeds/{external_feed_id}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{external_feed_id}", fmt.Sprintf("%v", t.Path.ExternalFeedID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{external_feed_id}", t.Path.ExternalFeedID.Escaped())
 	return path
 }
 
@@ -49,21 +49,25 @@ func (t *DeleteExternalFeedCourses) GetJSON() ([]byte, error) {
 }
 
 func (t *DeleteExternalFeedCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ExternalFeedID == "" {
-		errs = append(errs, "'Path.ExternalFeedID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ExternalFeedID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *DeleteExternalFeedCourses) Do(c *canvasapi.Canvas) (*models.ExternalFeed, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *DeleteExternalFeedCourses) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.ExternalFeed, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -78,6 +82,10 @@ func (t *DeleteExternalFeedCourses) Do(c *canvasapi.Canvas) (*models.ExternalFee
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
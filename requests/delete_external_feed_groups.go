@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -20,8 +20,8 @@ import (
 //
 type DeleteExternalFeedGroups struct {
 	Path struct {
-		GroupID        string `json:"group_id" url:"group_id,omitempty"`                 //  (Required)
-		ExternalFeedID string `json:"external_feed_id" url:"external_feed_id,omitempty"` //  (Required)
+		GroupID        canvasapi.ID `json:"group_id" url:"group_id,omitempty"`                 //  (Required)
+		ExternalFeedID canvasapi.ID `json:"external_feed_id" url:"external_feed_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -31,8 +31,8 @@ func (t *DeleteExternalFeedGroups) GetMethod() string {
 
 func (t *DeleteExternalFeedGroups) GetURLPath() string {
 	path := "groups/{group_id}/external_feeds/{external_feed_id}"
-	path = strings.ReplaceAll(path, "{group_id}", fmt.Sprintf("%v", t.Path.GroupID))
-	path = strings.ReplaceAll(path, "{external_feed_id}", fmt.Sprintf("%v", t.Path.ExternalFeedID))
+	path = strings.ReplaceAll(path, "{group_id}", t.Path.GroupID.Escaped())
+	path = strings.ReplaceAll(path, "{external_feed_id}", t.Path.ExternalFeedID.Escaped())
 	return path
 }
 
@@ -49,21 +49,25 @@ func (t *DeleteExternalFeedGroups) GetJSON() ([]byte, error) {
 }
 
 func (t *DeleteExternalFeedGroups) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.GroupID == "" {
-		errs = append(errs, "'Path.GroupID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.GroupID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ExternalFeedID == "" {
-		errs = append(errs, "'Path.ExternalFeedID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ExternalFeedID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *DeleteExternalFeedGroups) Do(c *canvasapi.Canvas) (*models.ExternalFeed, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *DeleteExternalFeedGroups) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.ExternalFeed, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -78,6 +82,10 @@ func (t *DeleteExternalFeedGroups) Do(c *canvasapi.Canvas) (*models.ExternalFeed
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
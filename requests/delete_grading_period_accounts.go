@@ -1,7 +1,7 @@
 package requests
 
 import (
-	"fmt"
+	"context"
 	"net/url"
 	"strings"
 
@@ -18,8 +18,8 @@ import (
 //
 type DeleteGradingPeriodAccounts struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
-		ID        string `json:"id" url:"id,omitempty"`                 //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		ID        canvasapi.ID `json:"id" url:"id,omitempty"`                 //  (Required)
 	} `json:"path"`
 }
 
@@ -29,8 +29,8 @@ func (t *DeleteGradingPeriodAccounts) GetMethod() string {
 
 func (t *DeleteGradingPeriodAccounts) GetURLPath() string {
 	path := "accounts/{account_id}/grading_periods/{id}"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -47,24 +47,29 @@ func (t *DeleteGradingPeriodAccounts) GetJSON() ([]byte, error) {
 }
 
 func (t *DeleteGradingPeriodAccounts) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *DeleteGradingPeriodAccounts) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *DeleteGradingPeriodAccounts) DoContext(ctx context.Context, c *canvasapi.Canvas) error {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return err
 	}
+	response.Body.Close()
 
 	return nil
 }
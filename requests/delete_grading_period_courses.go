@@ -1,7 +1,7 @@
 package requests
 
 import (
-	"fmt"
+	"context"
 	"net/url"
 	"strings"
 
@@ -18,8 +18,8 @@ import (
 //
 type DeleteGradingPeriodCourses struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		ID       string `json:"id" url:"id,omitempty"`               //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		ID       canvasapi.ID `json:"id" url:"id,omitempty"`               //  (Required)
 	} `json:"path"`
 }
 
@@ -29,8 +29,8 @@ func (t *DeleteGradingPeriodCourses) GetMethod() string {
 
 func (t *DeleteGradingPeriodCourses) GetURLPath() string {
 	path := "courses/{course_id}/grading_periods/{id}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -47,24 +47,29 @@ func (t *DeleteGradingPeriodCourses) GetJSON() ([]byte, error) {
 }
 
 func (t *DeleteGradingPeriodCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *DeleteGradingPeriodCourses) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *DeleteGradingPeriodCourses) DoContext(ctx context.Context, c *canvasapi.Canvas) error {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return err
 	}
+	response.Body.Close()
 
 	return nil
 }
@@ -1,7 +1,9 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
@@ -17,7 +19,7 @@ import (
 //
 type DeleteGroupCategory struct {
 	Path struct {
-		GroupCategoryID string `json:"group_category_id" url:"group_category_id,omitempty"` //  (Required)
+		GroupCategoryID canvasapi.ID `json:"group_category_id" url:"group_category_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -27,7 +29,7 @@ func (t *DeleteGroupCategory) GetMethod() string {
 
 func (t *DeleteGroupCategory) GetURLPath() string {
 	path := "group_categories/{group_category_id}"
-	path = strings.ReplaceAll(path, "{group_category_id}", fmt.Sprintf("%v", t.Path.GroupCategoryID))
+	path = strings.ReplaceAll(path, "{group_category_id}", t.Path.GroupCategoryID.Escaped())
 	return path
 }
 
@@ -44,21 +46,31 @@ func (t *DeleteGroupCategory) GetJSON() ([]byte, error) {
 }
 
 func (t *DeleteGroupCategory) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.GroupCategoryID == "" {
-		errs = append(errs, "'Path.GroupCategoryID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.GroupCategoryID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *DeleteGroupCategory) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *DeleteGroupCategory) Do(c *canvasapi.Canvas) (json.RawMessage, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *DeleteGroupCategory) DoContext(ctx context.Context, c *canvasapi.Canvas) (json.RawMessage, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(body), nil
 }
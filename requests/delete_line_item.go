@@ -1,14 +1,11 @@
 package requests
 
 import (
-	"encoding/json"
-	"fmt"
-	"io/ioutil"
+	"context"
 	"net/url"
 	"strings"
 
 	"github.com/atomicjolt/canvasapi"
-	"github.com/atomicjolt/canvasapi/models"
 )
 
 // DeleteLineItem Delete an existing Line Item
@@ -20,8 +17,8 @@ import (
 //
 type DeleteLineItem struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		ID       string `json:"id" url:"id,omitempty"`               //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		ID       canvasapi.ID `json:"id" url:"id,omitempty"`               //  (Required)
 	} `json:"path"`
 }
 
@@ -31,8 +28,8 @@ func (t *DeleteLineItem) GetMethod() string {
 
 func (t *DeleteLineItem) GetURLPath() string {
 	path := "/lti/courses/{course_id}/line_items/{id}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -48,36 +45,35 @@ func (t *DeleteLineItem) GetJSON() ([]byte, error) {
 	return nil, nil
 }
 
+// MediaTypes returns the IMS media types of this LTI Advantage service.
+func (t *DeleteLineItem) MediaTypes() (string, string) {
+	return "", ""
+}
+
 func (t *DeleteLineItem) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *DeleteLineItem) Do(c *canvasapi.Canvas) (*models.LineItem, error) {
-	response, err := c.SendRequest(t)
-	if err != nil {
-		return nil, err
-	}
+func (t *DeleteLineItem) Do(c *canvasapi.Canvas) error {
+	return t.DoContext(context.Background(), c)
+}
 
-	body, err := ioutil.ReadAll(response.Body)
-	response.Body.Close()
-	if err != nil {
-		return nil, err
-	}
-	ret := models.LineItem{}
-	err = json.Unmarshal(body, &ret)
+func (t *DeleteLineItem) DoContext(ctx context.Context, c *canvasapi.Canvas) error {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return nil, err
+		return err
 	}
+	response.Body.Close()
 
-	return &ret, nil
+	return nil
 }
@@ -1,6 +1,7 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -20,8 +21,8 @@ import (
 //
 type DeletePageCourses struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		Url      string `json:"url" url:"url,omitempty"`             //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		Url      string       `json:"url" url:"url,omitempty"`             //  (Required)
 	} `json:"path"`
 }
 
@@ -31,7 +32,7 @@ func (t *DeletePageCourses) GetMethod() string {
 
 func (t *DeletePageCourses) GetURLPath() string {
 	path := "courses/{course_id}/pages/{url}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	path = strings.ReplaceAll(path, "{url}", fmt.Sprintf("%v", t.Path.Url))
 	return path
 }
@@ -49,21 +50,25 @@ func (t *DeletePageCourses) GetJSON() ([]byte, error) {
 }
 
 func (t *DeletePageCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.Url == "" {
-		errs = append(errs, "'Path.Url' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Url", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *DeletePageCourses) Do(c *canvasapi.Canvas) (*models.Page, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *DeletePageCourses) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Page, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -78,6 +83,10 @@ func (t *DeletePageCourses) Do(c *canvasapi.Canvas) (*models.Page, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,6 +1,7 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -20,8 +21,8 @@ import (
 //
 type DeletePageGroups struct {
 	Path struct {
-		GroupID string `json:"group_id" url:"group_id,omitempty"` //  (Required)
-		Url     string `json:"url" url:"url,omitempty"`           //  (Required)
+		GroupID canvasapi.ID `json:"group_id" url:"group_id,omitempty"` //  (Required)
+		Url     string       `json:"url" url:"url,omitempty"`           //  (Required)
 	} `json:"path"`
 }
 
@@ -31,7 +32,7 @@ func (t *DeletePageGroups) GetMethod() string {
 
 func (t *DeletePageGroups) GetURLPath() string {
 	path := "groups/{group_id}/pages/{url}"
-	path = strings.ReplaceAll(path, "{group_id}", fmt.Sprintf("%v", t.Path.GroupID))
+	path = strings.ReplaceAll(path, "{group_id}", t.Path.GroupID.Escaped())
 	path = strings.ReplaceAll(path, "{url}", fmt.Sprintf("%v", t.Path.Url))
 	return path
 }
@@ -49,21 +50,25 @@ func (t *DeletePageGroups) GetJSON() ([]byte, error) {
 }
 
 func (t *DeletePageGroups) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.GroupID == "" {
-		errs = append(errs, "'Path.GroupID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.GroupID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.Url == "" {
-		errs = append(errs, "'Path.Url' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Url", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *DeletePageGroups) Do(c *canvasapi.Canvas) (*models.Page, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *DeletePageGroups) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Page, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -78,6 +83,10 @@ func (t *DeletePageGroups) Do(c *canvasapi.Canvas) (*models.Page, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
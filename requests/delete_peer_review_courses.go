@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -26,9 +24,9 @@ import (
 //
 type DeletePeerReviewCourses struct {
 	Path struct {
-		CourseID     string `json:"course_id" url:"course_id,omitempty"`         //  (Required)
-		AssignmentID string `json:"assignment_id" url:"assignment_id,omitempty"` //  (Required)
-		SubmissionID string `json:"submission_id" url:"submission_id,omitempty"` //  (Required)
+		CourseID     canvasapi.ID `json:"course_id" url:"course_id,omitempty"`         //  (Required)
+		AssignmentID canvasapi.ID `json:"assignment_id" url:"assignment_id,omitempty"` //  (Required)
+		SubmissionID canvasapi.ID `json:"submission_id" url:"submission_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
@@ -42,14 +40,14 @@ func (t *DeletePeerReviewCourses) GetMethod() string {
 
 func (t *DeletePeerReviewCourses) GetURLPath() string {
 	path := "courses/{course_id}/assignments/{assignment_id}/submissions/{submission_id}/peer_reviews"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{assignment_id}", fmt.Sprintf("%v", t.Path.AssignmentID))
-	path = strings.ReplaceAll(path, "{submission_id}", fmt.Sprintf("%v", t.Path.SubmissionID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{assignment_id}", t.Path.AssignmentID.Escaped())
+	path = strings.ReplaceAll(path, "{submission_id}", t.Path.SubmissionID.Escaped())
 	return path
 }
 
 func (t *DeletePeerReviewCourses) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -65,24 +63,28 @@ func (t *DeletePeerReviewCourses) GetJSON() ([]byte, error) {
 }
 
 func (t *DeletePeerReviewCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.AssignmentID == "" {
-		errs = append(errs, "'Path.AssignmentID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AssignmentID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.SubmissionID == "" {
-		errs = append(errs, "'Path.SubmissionID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.SubmissionID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *DeletePeerReviewCourses) Do(c *canvasapi.Canvas) (*models.PeerReview, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *DeletePeerReviewCourses) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.PeerReview, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -97,6 +99,10 @@ func (t *DeletePeerReviewCourses) Do(c *canvasapi.Canvas) (*models.PeerReview, e
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -19,7 +19,7 @@ import (
 //
 type DeletePlannerNote struct {
 	Path struct {
-		ID string `json:"id" url:"id,omitempty"` //  (Required)
+		ID canvasapi.ID `json:"id" url:"id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -29,7 +29,7 @@ func (t *DeletePlannerNote) GetMethod() string {
 
 func (t *DeletePlannerNote) GetURLPath() string {
 	path := "planner_notes/{id}"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -46,18 +46,22 @@ func (t *DeletePlannerNote) GetJSON() ([]byte, error) {
 }
 
 func (t *DeletePlannerNote) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *DeletePlannerNote) Do(c *canvasapi.Canvas) (*models.PlannerNote, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *DeletePlannerNote) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.PlannerNote, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -72,6 +76,10 @@ func (t *DeletePlannerNote) Do(c *canvasapi.Canvas) (*models.PlannerNote, error)
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,7 +1,7 @@
 package requests
 
 import (
-	"fmt"
+	"context"
 	"net/url"
 	"strings"
 
@@ -16,7 +16,7 @@ import (
 //
 type DeletePoll struct {
 	Path struct {
-		ID string `json:"id" url:"id,omitempty"` //  (Required)
+		ID canvasapi.ID `json:"id" url:"id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -26,7 +26,7 @@ func (t *DeletePoll) GetMethod() string {
 
 func (t *DeletePoll) GetURLPath() string {
 	path := "polls/{id}"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -43,21 +43,26 @@ func (t *DeletePoll) GetJSON() ([]byte, error) {
 }
 
 func (t *DeletePoll) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *DeletePoll) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *DeletePoll) DoContext(ctx context.Context, c *canvasapi.Canvas) error {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return err
 	}
+	response.Body.Close()
 
 	return nil
 }
@@ -1,7 +1,7 @@
 package requests
 
 import (
-	"fmt"
+	"context"
 	"net/url"
 	"strings"
 
@@ -17,8 +17,8 @@ import (
 //
 type DeletePollChoice struct {
 	Path struct {
-		PollID string `json:"poll_id" url:"poll_id,omitempty"` //  (Required)
-		ID     string `json:"id" url:"id,omitempty"`           //  (Required)
+		PollID canvasapi.ID `json:"poll_id" url:"poll_id,omitempty"` //  (Required)
+		ID     canvasapi.ID `json:"id" url:"id,omitempty"`           //  (Required)
 	} `json:"path"`
 }
 
@@ -28,8 +28,8 @@ func (t *DeletePollChoice) GetMethod() string {
 
 func (t *DeletePollChoice) GetURLPath() string {
 	path := "polls/{poll_id}/poll_choices/{id}"
-	path = strings.ReplaceAll(path, "{poll_id}", fmt.Sprintf("%v", t.Path.PollID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{poll_id}", t.Path.PollID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -46,24 +46,29 @@ func (t *DeletePollChoice) GetJSON() ([]byte, error) {
 }
 
 func (t *DeletePollChoice) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.PollID == "" {
-		errs = append(errs, "'Path.PollID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.PollID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *DeletePollChoice) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *DeletePollChoice) DoContext(ctx context.Context, c *canvasapi.Canvas) error {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return err
 	}
+	response.Body.Close()
 
 	return nil
 }
@@ -1,6 +1,7 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/url"
@@ -19,7 +20,7 @@ func (t *DeletePushNotificationEndpoint) GetMethod() string {
 }
 
 func (t *DeletePushNotificationEndpoint) GetURLPath() string {
-	return ""
+	return "users/self/communication_channels/push"
 }
 
 func (t *DeletePushNotificationEndpoint) GetQuery() (string, error) {
@@ -39,7 +40,11 @@ func (t *DeletePushNotificationEndpoint) HasErrors() error {
 }
 
 func (t *DeletePushNotificationEndpoint) Do(c *canvasapi.Canvas) (*canvasapi.SuccessResponse, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *DeletePushNotificationEndpoint) DoContext(ctx context.Context, c *canvasapi.Canvas) (*canvasapi.SuccessResponse, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -1,7 +1,9 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
@@ -18,8 +20,8 @@ import (
 //
 type DeleteTopicCourses struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		TopicID  string `json:"topic_id" url:"topic_id,omitempty"`   //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		TopicID  canvasapi.ID `json:"topic_id" url:"topic_id,omitempty"`   //  (Required)
 	} `json:"path"`
 }
 
@@ -29,8 +31,8 @@ func (t *DeleteTopicCourses) GetMethod() string {
 
 func (t *DeleteTopicCourses) GetURLPath() string {
 	path := "courses/{course_id}/discussion_topics/{topic_id}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{topic_id}", fmt.Sprintf("%v", t.Path.TopicID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{topic_id}", t.Path.TopicID.Escaped())
 	return path
 }
 
@@ -47,24 +49,34 @@ func (t *DeleteTopicCourses) GetJSON() ([]byte, error) {
 }
 
 func (t *DeleteTopicCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.TopicID == "" {
-		errs = append(errs, "'Path.TopicID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.TopicID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *DeleteTopicCourses) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *DeleteTopicCourses) Do(c *canvasapi.Canvas) (json.RawMessage, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *DeleteTopicCourses) DoContext(ctx context.Context, c *canvasapi.Canvas) (json.RawMessage, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(body), nil
 }
@@ -1,7 +1,9 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
@@ -18,8 +20,8 @@ import (
 //
 type DeleteTopicGroups struct {
 	Path struct {
-		GroupID string `json:"group_id" url:"group_id,omitempty"` //  (Required)
-		TopicID string `json:"topic_id" url:"topic_id,omitempty"` //  (Required)
+		GroupID canvasapi.ID `json:"group_id" url:"group_id,omitempty"` //  (Required)
+		TopicID canvasapi.ID `json:"topic_id" url:"topic_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -29,8 +31,8 @@ func (t *DeleteTopicGroups) GetMethod() string {
 
 func (t *DeleteTopicGroups) GetURLPath() string {
 	path := "groups/{group_id}/discussion_topics/{topic_id}"
-	path = strings.ReplaceAll(path, "{group_id}", fmt.Sprintf("%v", t.Path.GroupID))
-	path = strings.ReplaceAll(path, "{topic_id}", fmt.Sprintf("%v", t.Path.TopicID))
+	path = strings.ReplaceAll(path, "{group_id}", t.Path.GroupID.Escaped())
+	path = strings.ReplaceAll(path, "{topic_id}", t.Path.TopicID.Escaped())
 	return path
 }
 
@@ -47,24 +49,34 @@ func (t *DeleteTopicGroups) GetJSON() ([]byte, error) {
 }
 
 func (t *DeleteTopicGroups) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.GroupID == "" {
-		errs = append(errs, "'Path.GroupID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.GroupID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.TopicID == "" {
-		errs = append(errs, "'Path.TopicID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.TopicID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *DeleteTopicGroups) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *DeleteTopicGroups) Do(c *canvasapi.Canvas) (json.RawMessage, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *DeleteTopicGroups) DoContext(ctx context.Context, c *canvasapi.Canvas) (json.RawMessage, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(body), nil
 }
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -38,17 +36,17 @@ import (
 //
 type DeprecatedSelfRegisterUser struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		User struct {
-			Name         string `json:"name" url:"name,omitempty"`                   //  (Required)
-			ShortName    string `json:"short_name" url:"short_name,omitempty"`       //  (Optional)
-			SortableName string `json:"sortable_name" url:"sortable_name,omitempty"` //  (Optional)
-			TimeZone     string `json:"time_zone" url:"time_zone,omitempty"`         //  (Optional)
-			Locale       string `json:"locale" url:"locale,omitempty"`               //  (Optional)
-			TermsOfUse   bool   `json:"terms_of_use" url:"terms_of_use,omitempty"`   //  (Required)
+			Name         string              `json:"name" url:"name,omitempty"`                   //  (Required)
+			ShortName    string              `json:"short_name" url:"short_name,omitempty"`       //  (Optional)
+			SortableName string              `json:"sortable_name" url:"sortable_name,omitempty"` //  (Optional)
+			TimeZone     string              `json:"time_zone" url:"time_zone,omitempty"`         //  (Optional)
+			Locale       string              `json:"locale" url:"locale,omitempty"`               //  (Optional)
+			TermsOfUse   canvasapi.Opt[bool] `json:"terms_of_use" url:"terms_of_use,omitempty"`   //  (Required)
 		} `json:"user" url:"user,omitempty"`
 
 		Pseudonym struct {
@@ -68,7 +66,7 @@ func (t *DeprecatedSelfRegisterUser) GetMethod() string {
 
 func (t *DeprecatedSelfRegisterUser) GetURLPath() string {
 	path := "accounts/{account_id}/self_registration"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	return path
 }
 
@@ -77,36 +75,43 @@ func (t *DeprecatedSelfRegisterUser) GetQuery() (string, error) {
 }
 
 func (t *DeprecatedSelfRegisterUser) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *DeprecatedSelfRegisterUser) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *DeprecatedSelfRegisterUser) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.User.Name == "" {
-		errs = append(errs, "'Form.User.Name' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.User.Name", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Pseudonym.UniqueID == "" {
-		errs = append(errs, "'Form.Pseudonym.UniqueID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Pseudonym.UniqueID", Rule: canvasapi.RuleRequired})
+	}
+	if !t.Form.User.TermsOfUse.IsSet() {
+		errs = append(errs, canvasapi.FieldError{Field: "Form.User.TermsOfUse", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *DeprecatedSelfRegisterUser) Do(c *canvasapi.Canvas) (*models.User, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *DeprecatedSelfRegisterUser) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.User, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -121,6 +126,10 @@ func (t *DeprecatedSelfRegisterUser) Do(c *canvasapi.Canvas) (*models.User, erro
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -28,12 +26,12 @@ import (
 //
 type DestroyAssignmentGroup struct {
 	Path struct {
-		CourseID          string `json:"course_id" url:"course_id,omitempty"`                     //  (Required)
-		AssignmentGroupID string `json:"assignment_group_id" url:"assignment_group_id,omitempty"` //  (Required)
+		CourseID          canvasapi.ID `json:"course_id" url:"course_id,omitempty"`                     //  (Required)
+		AssignmentGroupID canvasapi.ID `json:"assignment_group_id" url:"assignment_group_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		MoveAssignmentsTo int64 `json:"move_assignments_to" url:"move_assignments_to,omitempty"` //  (Optional)
+		MoveAssignmentsTo canvasapi.Opt[int64] `json:"move_assignments_to" url:"move_assignments_to,omitempty"` //  (Optional)
 	} `json:"query"`
 }
 
@@ -43,13 +41,13 @@ func (t *DestroyAssignmentGroup) GetMethod() string {
 
 func (t *DestroyAssignmentGroup) GetURLPath() string {
 	path := "courses/{course_id}/assignment_groups/{assignment_group_id}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{assignment_group_id}", fmt.Sprintf("%v", t.Path.AssignmentGroupID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{assignment_group_id}", t.Path.AssignmentGroupID.Escaped())
 	return path
 }
 
 func (t *DestroyAssignmentGroup) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -65,21 +63,25 @@ func (t *DestroyAssignmentGroup) GetJSON() ([]byte, error) {
 }
 
 func (t *DestroyAssignmentGroup) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.AssignmentGroupID == "" {
-		errs = append(errs, "'Path.AssignmentGroupID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AssignmentGroupID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *DestroyAssignmentGroup) Do(c *canvasapi.Canvas) (*models.AssignmentGroup, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *DestroyAssignmentGroup) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.AssignmentGroup, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -94,6 +96,10 @@ func (t *DestroyAssignmentGroup) Do(c *canvasapi.Canvas) (*models.AssignmentGrou
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
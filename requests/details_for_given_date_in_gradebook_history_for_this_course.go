@@ -1,6 +1,7 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -23,8 +24,8 @@ import (
 //
 type DetailsForGivenDateInGradebookHistoryForThisCourse struct {
 	Path struct {
-		CourseID int64  `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		Date     string `json:"date" url:"date,omitempty"`           //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		Date     string       `json:"date" url:"date,omitempty"`           //  (Required)
 	} `json:"path"`
 }
 
@@ -34,7 +35,7 @@ func (t *DetailsForGivenDateInGradebookHistoryForThisCourse) GetMethod() string
 
 func (t *DetailsForGivenDateInGradebookHistoryForThisCourse) GetURLPath() string {
 	path := "courses/{course_id}/gradebook_history/{date}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	path = strings.ReplaceAll(path, "{date}", fmt.Sprintf("%v", t.Path.Date))
 	return path
 }
@@ -52,23 +53,30 @@ func (t *DetailsForGivenDateInGradebookHistoryForThisCourse) GetJSON() ([]byte,
 }
 
 func (t *DetailsForGivenDateInGradebookHistoryForThisCourse) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
+	if t.Path.CourseID == "" {
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
+	}
 	if t.Path.Date == "" {
-		errs = append(errs, "'Path.Date' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Date", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *DetailsForGivenDateInGradebookHistoryForThisCourse) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Grader, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *DetailsForGivenDateInGradebookHistoryForThisCourse) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.Grader, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -88,6 +96,10 @@ func (t *DetailsForGivenDateInGradebookHistoryForThisCourse) Do(c *canvasapi.Can
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
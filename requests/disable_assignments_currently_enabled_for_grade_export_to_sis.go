@@ -1,13 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 )
 
@@ -30,11 +29,11 @@ import (
 //
 type DisableAssignmentsCurrentlyEnabledForGradeExportToSIS struct {
 	Path struct {
-		CourseID int64 `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		GradingPeriodID int64 `json:"grading_period_id" url:"grading_period_id,omitempty"` //  (Optional)
+		GradingPeriodID canvasapi.Opt[int64] `json:"grading_period_id" url:"grading_period_id,omitempty"` //  (Optional)
 	} `json:"form"`
 }
 
@@ -44,7 +43,7 @@ func (t *DisableAssignmentsCurrentlyEnabledForGradeExportToSIS) GetMethod() stri
 
 func (t *DisableAssignmentsCurrentlyEnabledForGradeExportToSIS) GetURLPath() string {
 	path := "/sis/courses/{course_id}/disable_post_to_sis"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
@@ -53,26 +52,43 @@ func (t *DisableAssignmentsCurrentlyEnabledForGradeExportToSIS) GetQuery() (stri
 }
 
 func (t *DisableAssignmentsCurrentlyEnabledForGradeExportToSIS) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *DisableAssignmentsCurrentlyEnabledForGradeExportToSIS) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *DisableAssignmentsCurrentlyEnabledForGradeExportToSIS) HasErrors() error {
+	errs := []canvasapi.FieldError{}
+	if t.Path.CourseID == "" {
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
+	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
+	}
 	return nil
 }
 
-func (t *DisableAssignmentsCurrentlyEnabledForGradeExportToSIS) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *DisableAssignmentsCurrentlyEnabledForGradeExportToSIS) Do(c *canvasapi.Canvas) (json.RawMessage, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *DisableAssignmentsCurrentlyEnabledForGradeExportToSIS) DoContext(ctx context.Context, c *canvasapi.Canvas) (json.RawMessage, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(body), nil
 }
@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -20,8 +20,8 @@ import (
 //
 type DuplicateDiscussionTopicCourses struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		TopicID  string `json:"topic_id" url:"topic_id,omitempty"`   //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		TopicID  canvasapi.ID `json:"topic_id" url:"topic_id,omitempty"`   //  (Required)
 	} `json:"path"`
 }
 
@@ -31,8 +31,8 @@ func (t *DuplicateDiscussionTopicCourses) GetMethod() string {
 
 func (t *DuplicateDiscussionTopicCourses) GetURLPath() string {
 	path := "courses/{course_id}/discussion_topics/{topic_id}/duplicate"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{topic_id}", fmt.Sprintf("%v", t.Path.TopicID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{topic_id}", t.Path.TopicID.Escaped())
 	return path
 }
 
@@ -49,21 +49,25 @@ func (t *DuplicateDiscussionTopicCourses) GetJSON() ([]byte, error) {
 }
 
 func (t *DuplicateDiscussionTopicCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.TopicID == "" {
-		errs = append(errs, "'Path.TopicID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.TopicID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *DuplicateDiscussionTopicCourses) Do(c *canvasapi.Canvas) (*models.DiscussionTopic, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *DuplicateDiscussionTopicCourses) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.DiscussionTopic, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -78,6 +82,10 @@ func (t *DuplicateDiscussionTopicCourses) Do(c *canvasapi.Canvas) (*models.Discu
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -20,8 +20,8 @@ import (
 //
 type DuplicateDiscussionTopicGroups struct {
 	Path struct {
-		GroupID string `json:"group_id" url:"group_id,omitempty"` //  (Required)
-		TopicID string `json:"topic_id" url:"topic_id,omitempty"` //  (Required)
+		GroupID canvasapi.ID `json:"group_id" url:"group_id,omitempty"` //  (Required)
+		TopicID canvasapi.ID `json:"topic_id" url:"topic_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -31,8 +31,8 @@ func (t *DuplicateDiscussionTopicGroups) GetMethod() string {
 
 func (t *DuplicateDiscussionTopicGroups) GetURLPath() string {
 	path := "groups/{group_id}/discussion_topics/{topic_id}/duplicate"
-	path = strings.ReplaceAll(path, "{group_id}", fmt.Sprintf("%v", t.Path.GroupID))
-	path = strings.ReplaceAll(path, "{topic_id}", fmt.Sprintf("%v", t.Path.TopicID))
+	path = strings.ReplaceAll(path, "{group_id}", t.Path.GroupID.Escaped())
+	path = strings.ReplaceAll(path, "{topic_id}", t.Path.TopicID.Escaped())
 	return path
 }
 
@@ -49,21 +49,25 @@ func (t *DuplicateDiscussionTopicGroups) GetJSON() ([]byte, error) {
 }
 
 func (t *DuplicateDiscussionTopicGroups) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.GroupID == "" {
-		errs = append(errs, "'Path.GroupID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.GroupID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.TopicID == "" {
-		errs = append(errs, "'Path.TopicID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.TopicID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *DuplicateDiscussionTopicGroups) Do(c *canvasapi.Canvas) (*models.DiscussionTopic, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *DuplicateDiscussionTopicGroups) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.DiscussionTopic, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -78,6 +82,10 @@ func (t *DuplicateDiscussionTopicGroups) Do(c *canvasapi.Canvas) (*models.Discus
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
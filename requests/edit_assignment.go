@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -130,49 +128,49 @@ import (
 //
 type EditAssignment struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		ID       string `json:"id" url:"id,omitempty"`               //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		ID       canvasapi.ID `json:"id" url:"id,omitempty"`               //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		Assignment struct {
-			Name                             string                       `json:"name" url:"name,omitempty"`                                                                   //  (Optional)
-			Position                         int64                        `json:"position" url:"position,omitempty"`                                                           //  (Optional)
+			Name                             canvasapi.Opt[string]        `json:"name" url:"name,omitempty"`                                                                   //  (Optional)
+			Position                         canvasapi.Opt[int64]         `json:"position" url:"position,omitempty"`                                                           //  (Optional)
 			SubmissionTypes                  []string                     `json:"submission_types" url:"submission_types,omitempty"`                                           //  (Optional) . Must be one of online_quiz, none, on_paper, discussion_topic, external_tool, online_upload, online_text_entry, online_url, media_recording, student_annotation
 			AllowedExtensions                []string                     `json:"allowed_extensions" url:"allowed_extensions,omitempty"`                                       //  (Optional)
-			TurnitinEnabled                  bool                         `json:"turnitin_enabled" url:"turnitin_enabled,omitempty"`                                           //  (Optional)
-			VericiteEnabled                  bool                         `json:"vericite_enabled" url:"vericite_enabled,omitempty"`                                           //  (Optional)
-			TurnitinSettings                 string                       `json:"turnitin_settings" url:"turnitin_settings,omitempty"`                                         //  (Optional)
-			SISAssignmentID                  string                       `json:"sis_assignment_id" url:"sis_assignment_id,omitempty"`                                         //  (Optional)
-			IntegrationData                  string                       `json:"integration_data" url:"integration_data,omitempty"`                                           //  (Optional)
-			IntegrationID                    string                       `json:"integration_id" url:"integration_id,omitempty"`                                               //  (Optional)
-			PeerReviews                      bool                         `json:"peer_reviews" url:"peer_reviews,omitempty"`                                                   //  (Optional)
-			AutomaticPeerReviews             bool                         `json:"automatic_peer_reviews" url:"automatic_peer_reviews,omitempty"`                               //  (Optional)
-			NotifyOfUpdate                   bool                         `json:"notify_of_update" url:"notify_of_update,omitempty"`                                           //  (Optional)
-			GroupCategoryID                  int64                        `json:"group_category_id" url:"group_category_id,omitempty"`                                         //  (Optional)
-			GradeGroupStudentsIndividually   int64                        `json:"grade_group_students_individually" url:"grade_group_students_individually,omitempty"`         //  (Optional)
-			ExternalToolTagAttributes        string                       `json:"external_tool_tag_attributes" url:"external_tool_tag_attributes,omitempty"`                   //  (Optional)
-			PointsPossible                   float64                      `json:"points_possible" url:"points_possible,omitempty"`                                             //  (Optional)
-			GradingType                      string                       `json:"grading_type" url:"grading_type,omitempty"`                                                   //  (Optional) . Must be one of pass_fail, percent, letter_grade, gpa_scale, points, not_graded
-			DueAt                            time.Time                    `json:"due_at" url:"due_at,omitempty"`                                                               //  (Optional)
-			LockAt                           time.Time                    `json:"lock_at" url:"lock_at,omitempty"`                                                             //  (Optional)
-			UnlockAt                         time.Time                    `json:"unlock_at" url:"unlock_at,omitempty"`                                                         //  (Optional)
-			Description                      string                       `json:"description" url:"description,omitempty"`                                                     //  (Optional)
-			AssignmentGroupID                int64                        `json:"assignment_group_id" url:"assignment_group_id,omitempty"`                                     //  (Optional)
+			TurnitinEnabled                  canvasapi.Opt[bool]          `json:"turnitin_enabled" url:"turnitin_enabled,omitempty"`                                           //  (Optional)
+			VericiteEnabled                  canvasapi.Opt[bool]          `json:"vericite_enabled" url:"vericite_enabled,omitempty"`                                           //  (Optional)
+			TurnitinSettings                 canvasapi.Opt[string]        `json:"turnitin_settings" url:"turnitin_settings,omitempty"`                                         //  (Optional)
+			SISAssignmentID                  canvasapi.Opt[string]        `json:"sis_assignment_id" url:"sis_assignment_id,omitempty"`                                         //  (Optional)
+			IntegrationData                  canvasapi.Opt[string]        `json:"integration_data" url:"integration_data,omitempty"`                                           //  (Optional)
+			IntegrationID                    canvasapi.Opt[string]        `json:"integration_id" url:"integration_id,omitempty"`                                               //  (Optional)
+			PeerReviews                      canvasapi.Opt[bool]          `json:"peer_reviews" url:"peer_reviews,omitempty"`                                                   //  (Optional)
+			AutomaticPeerReviews             canvasapi.Opt[bool]          `json:"automatic_peer_reviews" url:"automatic_peer_reviews,omitempty"`                               //  (Optional)
+			NotifyOfUpdate                   canvasapi.Opt[bool]          `json:"notify_of_update" url:"notify_of_update,omitempty"`                                           //  (Optional)
+			GroupCategoryID                  canvasapi.Opt[int64]         `json:"group_category_id" url:"group_category_id,omitempty"`                                         //  (Optional)
+			GradeGroupStudentsIndividually   canvasapi.Opt[int64]         `json:"grade_group_students_individually" url:"grade_group_students_individually,omitempty"`         //  (Optional)
+			ExternalToolTagAttributes        canvasapi.Opt[string]        `json:"external_tool_tag_attributes" url:"external_tool_tag_attributes,omitempty"`                   //  (Optional)
+			PointsPossible                   canvasapi.Opt[float64]       `json:"points_possible" url:"points_possible,omitempty"`                                             //  (Optional)
+			GradingType                      canvasapi.Opt[string]        `json:"grading_type" url:"grading_type,omitempty"`                                                   //  (Optional) . Must be one of pass_fail, percent, letter_grade, gpa_scale, points, not_graded
+			DueAt                            canvasapi.Opt[time.Time]     `json:"due_at" url:"due_at,omitempty"`                                                               //  (Optional)
+			LockAt                           canvasapi.Opt[time.Time]     `json:"lock_at" url:"lock_at,omitempty"`                                                             //  (Optional)
+			UnlockAt                         canvasapi.Opt[time.Time]     `json:"unlock_at" url:"unlock_at,omitempty"`                                                         //  (Optional)
+			Description                      canvasapi.Opt[string]        `json:"description" url:"description,omitempty"`                                                     //  (Optional)
+			AssignmentGroupID                canvasapi.Opt[int64]         `json:"assignment_group_id" url:"assignment_group_id,omitempty"`                                     //  (Optional)
 			AssignmentOverrides              []*models.AssignmentOverride `json:"assignment_overrides" url:"assignment_overrides,omitempty"`                                   //  (Optional)
-			OnlyVisibleToOverrides           bool                         `json:"only_visible_to_overrides" url:"only_visible_to_overrides,omitempty"`                         //  (Optional)
-			Published                        bool                         `json:"published" url:"published,omitempty"`                                                         //  (Optional)
-			GradingStandardID                int64                        `json:"grading_standard_id" url:"grading_standard_id,omitempty"`                                     //  (Optional)
-			OmitFromFinalGrade               bool                         `json:"omit_from_final_grade" url:"omit_from_final_grade,omitempty"`                                 //  (Optional)
-			ModeratedGrading                 bool                         `json:"moderated_grading" url:"moderated_grading,omitempty"`                                         //  (Optional)
-			GraderCount                      int64                        `json:"grader_count" url:"grader_count,omitempty"`                                                   //  (Optional)
-			FinalGraderID                    int64                        `json:"final_grader_id" url:"final_grader_id,omitempty"`                                             //  (Optional)
-			GraderCommentsVisibleToGraders   bool                         `json:"grader_comments_visible_to_graders" url:"grader_comments_visible_to_graders,omitempty"`       //  (Optional)
-			GradersAnonymousToGraders        bool                         `json:"graders_anonymous_to_graders" url:"graders_anonymous_to_graders,omitempty"`                   //  (Optional)
-			GradersNamesVisibleToFinalGrader bool                         `json:"graders_names_visible_to_final_grader" url:"graders_names_visible_to_final_grader,omitempty"` //  (Optional)
-			AnonymousGrading                 bool                         `json:"anonymous_grading" url:"anonymous_grading,omitempty"`                                         //  (Optional)
-			AllowedAttempts                  int64                        `json:"allowed_attempts" url:"allowed_attempts,omitempty"`                                           //  (Optional)
-			AnnotatableAttachmentID          int64                        `json:"annotatable_attachment_id" url:"annotatable_attachment_id,omitempty"`                         //  (Optional)
+			OnlyVisibleToOverrides           canvasapi.Opt[bool]          `json:"only_visible_to_overrides" url:"only_visible_to_overrides,omitempty"`                         //  (Optional)
+			Published                        canvasapi.Opt[bool]          `json:"published" url:"published,omitempty"`                                                         //  (Optional)
+			GradingStandardID                canvasapi.Opt[int64]         `json:"grading_standard_id" url:"grading_standard_id,omitempty"`                                     //  (Optional)
+			OmitFromFinalGrade               canvasapi.Opt[bool]          `json:"omit_from_final_grade" url:"omit_from_final_grade,omitempty"`                                 //  (Optional)
+			ModeratedGrading                 canvasapi.Opt[bool]          `json:"moderated_grading" url:"moderated_grading,omitempty"`                                         //  (Optional)
+			GraderCount                      canvasapi.Opt[int64]         `json:"grader_count" url:"grader_count,omitempty"`                                                   //  (Optional)
+			FinalGraderID                    canvasapi.Opt[int64]         `json:"final_grader_id" url:"final_grader_id,omitempty"`                                             //  (Optional)
+			GraderCommentsVisibleToGraders   canvasapi.Opt[bool]          `json:"grader_comments_visible_to_graders" url:"grader_comments_visible_to_graders,omitempty"`       //  (Optional)
+			GradersAnonymousToGraders        canvasapi.Opt[bool]          `json:"graders_anonymous_to_graders" url:"graders_anonymous_to_graders,omitempty"`                   //  (Optional)
+			GradersNamesVisibleToFinalGrader canvasapi.Opt[bool]          `json:"graders_names_visible_to_final_grader" url:"graders_names_visible_to_final_grader,omitempty"` //  (Optional)
+			AnonymousGrading                 canvasapi.Opt[bool]          `json:"anonymous_grading" url:"anonymous_grading,omitempty"`                                         //  (Optional)
+			AllowedAttempts                  canvasapi.Opt[int64]         `json:"allowed_attempts" url:"allowed_attempts,omitempty"`                                           //  (Optional)
+			AnnotatableAttachmentID          canvasapi.Opt[int64]         `json:"annotatable_attachment_id" url:"annotatable_attachment_id,omitempty"`                         //  (Optional)
 		} `json:"assignment" url:"assignment,omitempty"`
 	} `json:"form"`
 }
@@ -183,8 +181,8 @@ func (t *EditAssignment) GetMethod() string {
 
 func (t *EditAssignment) GetURLPath() string {
 	path := "courses/{course_id}/assignments/{id}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -193,41 +191,45 @@ func (t *EditAssignment) GetQuery() (string, error) {
 }
 
 func (t *EditAssignment) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *EditAssignment) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *EditAssignment) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	for _, v := range t.Form.Assignment.SubmissionTypes {
 		if v != "" && !string_utils.Include([]string{"online_quiz", "none", "on_paper", "discussion_topic", "external_tool", "online_upload", "online_text_entry", "online_url", "media_recording", "student_annotation"}, v) {
-			errs = append(errs, "Assignment must be one of online_quiz, none, on_paper, discussion_topic, external_tool, online_upload, online_text_entry, online_url, media_recording, student_annotation")
+			errs = append(errs, canvasapi.FieldError{Field: "Form.Assignment.SubmissionTypes", Rule: canvasapi.RuleOneOf, Allowed: []string{"online_quiz", "none", "on_paper", "discussion_topic", "external_tool", "online_upload", "online_text_entry", "online_url", "media_recording", "student_annotation"}})
 		}
 	}
-	if t.Form.Assignment.GradingType != "" && !string_utils.Include([]string{"pass_fail", "percent", "letter_grade", "gpa_scale", "points", "not_graded"}, t.Form.Assignment.GradingType) {
-		errs = append(errs, "Assignment must be one of pass_fail, percent, letter_grade, gpa_scale, points, not_graded")
+	if t.Form.Assignment.GradingType.Value() != "" && !string_utils.Include([]string{"pass_fail", "percent", "letter_grade", "gpa_scale", "points", "not_graded"}, t.Form.Assignment.GradingType.Value()) {
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Assignment.GradingType", Rule: canvasapi.RuleOneOf, Allowed: []string{"pass_fail", "percent", "letter_grade", "gpa_scale", "points", "not_graded"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *EditAssignment) Do(c *canvasapi.Canvas) (*models.Assignment, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *EditAssignment) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Assignment, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -242,6 +244,10 @@ func (t *EditAssignment) Do(c *canvasapi.Canvas) (*models.Assignment, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -21,8 +21,8 @@ import (
 //
 type EditAssignmentGroup struct {
 	Path struct {
-		CourseID          string `json:"course_id" url:"course_id,omitempty"`                     //  (Required)
-		AssignmentGroupID string `json:"assignment_group_id" url:"assignment_group_id,omitempty"` //  (Required)
+		CourseID          canvasapi.ID `json:"course_id" url:"course_id,omitempty"`                     //  (Required)
+		AssignmentGroupID canvasapi.ID `json:"assignment_group_id" url:"assignment_group_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -32,8 +32,8 @@ func (t *EditAssignmentGroup) GetMethod() string {
 
 func (t *EditAssignmentGroup) GetURLPath() string {
 	path := "courses/{course_id}/assignment_groups/{assignment_group_id}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{assignment_group_id}", fmt.Sprintf("%v", t.Path.AssignmentGroupID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{assignment_group_id}", t.Path.AssignmentGroupID.Escaped())
 	return path
 }
 
@@ -50,21 +50,25 @@ func (t *EditAssignmentGroup) GetJSON() ([]byte, error) {
 }
 
 func (t *EditAssignmentGroup) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.AssignmentGroupID == "" {
-		errs = append(errs, "'Path.AssignmentGroupID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AssignmentGroupID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *EditAssignmentGroup) Do(c *canvasapi.Canvas) (*models.AssignmentGroup, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *EditAssignmentGroup) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.AssignmentGroup, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -79,6 +83,10 @@ func (t *EditAssignmentGroup) Do(c *canvasapi.Canvas) (*models.AssignmentGroup,
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
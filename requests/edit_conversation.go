@@ -1,14 +1,14 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -34,19 +34,19 @@ import (
 //
 type EditConversation struct {
 	Path struct {
-		ID string `json:"id" url:"id,omitempty"` //  (Required)
+		ID canvasapi.ID `json:"id" url:"id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		Conversation struct {
-			WorkflowState string `json:"workflow_state" url:"workflow_state,omitempty"` //  (Optional) . Must be one of read, unread, archived
-			Subscribed    bool   `json:"subscribed" url:"subscribed,omitempty"`         //  (Optional)
-			Starred       bool   `json:"starred" url:"starred,omitempty"`               //  (Optional)
+			WorkflowState canvasapi.Opt[string] `json:"workflow_state" url:"workflow_state,omitempty"` //  (Optional) . Must be one of read, unread, archived
+			Subscribed    canvasapi.Opt[bool]   `json:"subscribed" url:"subscribed,omitempty"`         //  (Optional)
+			Starred       canvasapi.Opt[bool]   `json:"starred" url:"starred,omitempty"`               //  (Optional)
 		} `json:"conversation" url:"conversation,omitempty"`
 
-		Scope      string   `json:"scope" url:"scope,omitempty"`             //  (Optional) . Must be one of unread, starred, archived
-		Filter     []string `json:"filter" url:"filter,omitempty"`           //  (Optional)
-		FilterMode string   `json:"filter_mode" url:"filter_mode,omitempty"` //  (Optional) . Must be one of and, or, default or
+		Scope      canvasapi.Opt[string] `json:"scope" url:"scope,omitempty"`             //  (Optional) . Must be one of unread, starred, archived
+		Filter     []string              `json:"filter" url:"filter,omitempty"`           //  (Optional)
+		FilterMode canvasapi.Opt[string] `json:"filter_mode" url:"filter_mode,omitempty"` //  (Optional) . Must be one of and, or, default or
 	} `json:"form"`
 }
 
@@ -56,7 +56,7 @@ func (t *EditConversation) GetMethod() string {
 
 func (t *EditConversation) GetURLPath() string {
 	path := "conversations/{id}"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -65,42 +65,61 @@ func (t *EditConversation) GetQuery() (string, error) {
 }
 
 func (t *EditConversation) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *EditConversation) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *EditConversation) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
-	if t.Form.Conversation.WorkflowState != "" && !string_utils.Include([]string{"read", "unread", "archived"}, t.Form.Conversation.WorkflowState) {
-		errs = append(errs, "Conversation must be one of read, unread, archived")
+	if t.Form.Conversation.WorkflowState.Value() != "" && !string_utils.Include([]string{"read", "unread", "archived"}, t.Form.Conversation.WorkflowState.Value()) {
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Conversation.WorkflowState", Rule: canvasapi.RuleOneOf, Allowed: []string{"read", "unread", "archived"}})
 	}
-	if t.Form.Scope != "" && !string_utils.Include([]string{"unread", "starred", "archived"}, t.Form.Scope) {
-		errs = append(errs, "Scope must be one of unread, starred, archived")
+	if t.Form.Scope.Value() != "" && !string_utils.Include([]string{"unread", "starred", "archived"}, t.Form.Scope.Value()) {
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Scope", Rule: canvasapi.RuleOneOf, Allowed: []string{"unread", "starred", "archived"}})
 	}
-	if t.Form.FilterMode != "" && !string_utils.Include([]string{"and", "or", "default or"}, t.Form.FilterMode) {
-		errs = append(errs, "FilterMode must be one of and, or, default or")
+	if t.Form.FilterMode.Value() != "" && !string_utils.Include([]string{"and", "or", "default or"}, t.Form.FilterMode.Value()) {
+		errs = append(errs, canvasapi.FieldError{Field: "Form.FilterMode", Rule: canvasapi.RuleOneOf, Allowed: []string{"and", "or", "default or"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *EditConversation) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *EditConversation) Do(c *canvasapi.Canvas) (*models.Conversation, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *EditConversation) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Conversation, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.Conversation{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
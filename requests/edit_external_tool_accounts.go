@@ -1,11 +1,14 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // EditExternalToolAccounts Update the specified external tool. Uses same parameters as create
@@ -17,8 +20,8 @@ import (
 //
 type EditExternalToolAccounts struct {
 	Path struct {
-		AccountID      string `json:"account_id" url:"account_id,omitempty"`             //  (Required)
-		ExternalToolID string `json:"external_tool_id" url:"external_tool_id,omitempty"` //  (Required)
+		AccountID      canvasapi.ID `json:"account_id" url:"account_id,omitempty"`             //  (Required)
+		ExternalToolID canvasapi.ID `json:"external_tool_id" url:"external_tool_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -28,8 +31,8 @@ func (t *EditExternalToolAccounts) GetMethod() string {
 
 func (t *EditExternalToolAccounts) GetURLPath() string {
 	path := "accounts/{account_id}/external_tools/{external_tool_id}"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
-	path = strings.ReplaceAll(path, "{external_tool_id}", fmt.Sprintf("%v", t.Path.ExternalToolID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
+	path = strings.ReplaceAll(path, "{external_tool_id}", t.Path.ExternalToolID.Escaped())
 	return path
 }
 
@@ -46,24 +49,43 @@ func (t *EditExternalToolAccounts) GetJSON() ([]byte, error) {
 }
 
 func (t *EditExternalToolAccounts) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ExternalToolID == "" {
-		errs = append(errs, "'Path.ExternalToolID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ExternalToolID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *EditExternalToolAccounts) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *EditExternalToolAccounts) Do(c *canvasapi.Canvas) (*models.ExternalTool, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *EditExternalToolAccounts) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.ExternalTool, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.ExternalTool{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
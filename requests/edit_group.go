@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -41,18 +39,18 @@ import (
 //
 type EditGroup struct {
 	Path struct {
-		GroupID string `json:"group_id" url:"group_id,omitempty"` //  (Required)
+		GroupID canvasapi.ID `json:"group_id" url:"group_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		Name           string   `json:"name" url:"name,omitempty"`                         //  (Optional)
-		Description    string   `json:"description" url:"description,omitempty"`           //  (Optional)
-		IsPublic       bool     `json:"is_public" url:"is_public,omitempty"`               //  (Optional)
-		JoinLevel      string   `json:"join_level" url:"join_level,omitempty"`             //  (Optional) . Must be one of parent_context_auto_join, parent_context_request, invitation_only
-		AvatarID       int64    `json:"avatar_id" url:"avatar_id,omitempty"`               //  (Optional)
-		StorageQuotaMb int64    `json:"storage_quota_mb" url:"storage_quota_mb,omitempty"` //  (Optional)
-		Members        []string `json:"members" url:"members,omitempty"`                   //  (Optional)
-		SISGroupID     string   `json:"sis_group_id" url:"sis_group_id,omitempty"`         //  (Optional)
+		Name           canvasapi.Opt[string] `json:"name" url:"name,omitempty"`                         //  (Optional)
+		Description    canvasapi.Opt[string] `json:"description" url:"description,omitempty"`           //  (Optional)
+		IsPublic       canvasapi.Opt[bool]   `json:"is_public" url:"is_public,omitempty"`               //  (Optional)
+		JoinLevel      canvasapi.Opt[string] `json:"join_level" url:"join_level,omitempty"`             //  (Optional) . Must be one of parent_context_auto_join, parent_context_request, invitation_only
+		AvatarID       canvasapi.Opt[int64]  `json:"avatar_id" url:"avatar_id,omitempty"`               //  (Optional)
+		StorageQuotaMb canvasapi.Opt[int64]  `json:"storage_quota_mb" url:"storage_quota_mb,omitempty"` //  (Optional)
+		Members        []string              `json:"members" url:"members,omitempty"`                   //  (Optional)
+		SISGroupID     canvasapi.Opt[string] `json:"sis_group_id" url:"sis_group_id,omitempty"`         //  (Optional)
 	} `json:"form"`
 }
 
@@ -62,7 +60,7 @@ func (t *EditGroup) GetMethod() string {
 
 func (t *EditGroup) GetURLPath() string {
 	path := "groups/{group_id}"
-	path = strings.ReplaceAll(path, "{group_id}", fmt.Sprintf("%v", t.Path.GroupID))
+	path = strings.ReplaceAll(path, "{group_id}", t.Path.GroupID.Escaped())
 	return path
 }
 
@@ -71,33 +69,37 @@ func (t *EditGroup) GetQuery() (string, error) {
 }
 
 func (t *EditGroup) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *EditGroup) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *EditGroup) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.GroupID == "" {
-		errs = append(errs, "'Path.GroupID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.GroupID", Rule: canvasapi.RuleRequired})
 	}
-	if t.Form.JoinLevel != "" && !string_utils.Include([]string{"parent_context_auto_join", "parent_context_request", "invitation_only"}, t.Form.JoinLevel) {
-		errs = append(errs, "JoinLevel must be one of parent_context_auto_join, parent_context_request, invitation_only")
+	if t.Form.JoinLevel.Value() != "" && !string_utils.Include([]string{"parent_context_auto_join", "parent_context_request", "invitation_only"}, t.Form.JoinLevel.Value()) {
+		errs = append(errs, canvasapi.FieldError{Field: "Form.JoinLevel", Rule: canvasapi.RuleOneOf, Allowed: []string{"parent_context_auto_join", "parent_context_request", "invitation_only"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *EditGroup) Do(c *canvasapi.Canvas) (*models.Group, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *EditGroup) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Group, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -112,6 +114,10 @@ func (t *EditGroup) Do(c *canvasapi.Canvas) (*models.Group, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
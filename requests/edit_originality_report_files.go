@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -44,22 +42,22 @@ import (
 //
 type EditOriginalityReportFiles struct {
 	Path struct {
-		AssignmentID string `json:"assignment_id" url:"assignment_id,omitempty"` //  (Required)
-		FileID       string `json:"file_id" url:"file_id,omitempty"`             //  (Required)
+		AssignmentID canvasapi.ID `json:"assignment_id" url:"assignment_id,omitempty"` //  (Required)
+		FileID       canvasapi.ID `json:"file_id" url:"file_id,omitempty"`             //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		OriginalityReport struct {
-			OriginalityScore        float64 `json:"originality_score" url:"originality_score,omitempty"`                   //  (Optional)
-			OriginalityReportUrl    string  `json:"originality_report_url" url:"originality_report_url,omitempty"`         //  (Optional)
-			OriginalityReportFileID int64   `json:"originality_report_file_id" url:"originality_report_file_id,omitempty"` //  (Optional)
+			OriginalityScore        canvasapi.Opt[float64] `json:"originality_score" url:"originality_score,omitempty"`                   //  (Optional)
+			OriginalityReportUrl    canvasapi.Opt[string]  `json:"originality_report_url" url:"originality_report_url,omitempty"`         //  (Optional)
+			OriginalityReportFileID canvasapi.Opt[int64]   `json:"originality_report_file_id" url:"originality_report_file_id,omitempty"` //  (Optional)
 			ToolSetting             struct {
-				ResourceTypeCode string `json:"resource_type_code" url:"resource_type_code,omitempty"` //  (Optional)
-				ResourceUrl      string `json:"resource_url" url:"resource_url,omitempty"`             //  (Optional)
+				ResourceTypeCode canvasapi.Opt[string] `json:"resource_type_code" url:"resource_type_code,omitempty"` //  (Optional)
+				ResourceUrl      canvasapi.Opt[string] `json:"resource_url" url:"resource_url,omitempty"`             //  (Optional)
 			} `json:"tool_setting" url:"tool_setting,omitempty"`
 
-			WorkflowState string `json:"workflow_state" url:"workflow_state,omitempty"` //  (Optional)
-			ErrorMessage  string `json:"error_message" url:"error_message,omitempty"`   //  (Optional)
+			WorkflowState canvasapi.Opt[string] `json:"workflow_state" url:"workflow_state,omitempty"` //  (Optional)
+			ErrorMessage  canvasapi.Opt[string] `json:"error_message" url:"error_message,omitempty"`   //  (Optional)
 		} `json:"originality_report" url:"originality_report,omitempty"`
 	} `json:"form"`
 }
@@ -70,8 +68,8 @@ func (t *EditOriginalityReportFiles) GetMethod() string {
 
 func (t *EditOriginalityReportFiles) GetURLPath() string {
 	path := "/lti/assignments/{assignment_id}/files/{file_id}/originality_report"
-	path = strings.ReplaceAll(path, "{assignment_id}", fmt.Sprintf("%v", t.Path.AssignmentID))
-	path = strings.ReplaceAll(path, "{file_id}", fmt.Sprintf("%v", t.Path.FileID))
+	path = strings.ReplaceAll(path, "{assignment_id}", t.Path.AssignmentID.Escaped())
+	path = strings.ReplaceAll(path, "{file_id}", t.Path.FileID.Escaped())
 	return path
 }
 
@@ -80,33 +78,37 @@ func (t *EditOriginalityReportFiles) GetQuery() (string, error) {
 }
 
 func (t *EditOriginalityReportFiles) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *EditOriginalityReportFiles) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *EditOriginalityReportFiles) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AssignmentID == "" {
-		errs = append(errs, "'Path.AssignmentID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AssignmentID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.FileID == "" {
-		errs = append(errs, "'Path.FileID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.FileID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *EditOriginalityReportFiles) Do(c *canvasapi.Canvas) (*models.OriginalityReport, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *EditOriginalityReportFiles) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.OriginalityReport, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -121,6 +123,10 @@ func (t *EditOriginalityReportFiles) Do(c *canvasapi.Canvas) (*models.Originalit
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -30,17 +28,17 @@ import (
 //
 type EditSection struct {
 	Path struct {
-		ID string `json:"id" url:"id,omitempty"` //  (Required)
+		ID canvasapi.ID `json:"id" url:"id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		CourseSection struct {
-			Name                              string    `json:"name" url:"name,omitempty"`                                                                   //  (Optional)
-			SISSectionID                      string    `json:"sis_section_id" url:"sis_section_id,omitempty"`                                               //  (Optional)
-			IntegrationID                     string    `json:"integration_id" url:"integration_id,omitempty"`                                               //  (Optional)
-			StartAt                           time.Time `json:"start_at" url:"start_at,omitempty"`                                                           //  (Optional)
-			EndAt                             time.Time `json:"end_at" url:"end_at,omitempty"`                                                               //  (Optional)
-			RestrictEnrollmentsToSectionDates bool      `json:"restrict_enrollments_to_section_dates" url:"restrict_enrollments_to_section_dates,omitempty"` //  (Optional)
+			Name                              canvasapi.Opt[string]    `json:"name" url:"name,omitempty"`                                                                   //  (Optional)
+			SISSectionID                      canvasapi.Opt[string]    `json:"sis_section_id" url:"sis_section_id,omitempty"`                                               //  (Optional)
+			IntegrationID                     canvasapi.Opt[string]    `json:"integration_id" url:"integration_id,omitempty"`                                               //  (Optional)
+			StartAt                           canvasapi.Opt[time.Time] `json:"start_at" url:"start_at,omitempty"`                                                           //  (Optional)
+			EndAt                             canvasapi.Opt[time.Time] `json:"end_at" url:"end_at,omitempty"`                                                               //  (Optional)
+			RestrictEnrollmentsToSectionDates canvasapi.Opt[bool]      `json:"restrict_enrollments_to_section_dates" url:"restrict_enrollments_to_section_dates,omitempty"` //  (Optional)
 		} `json:"course_section" url:"course_section,omitempty"`
 	} `json:"form"`
 }
@@ -51,7 +49,7 @@ func (t *EditSection) GetMethod() string {
 
 func (t *EditSection) GetURLPath() string {
 	path := "sections/{id}"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -60,30 +58,34 @@ func (t *EditSection) GetQuery() (string, error) {
 }
 
 func (t *EditSection) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *EditSection) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *EditSection) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *EditSection) Do(c *canvasapi.Canvas) (*models.Section, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *EditSection) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Section, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -98,6 +100,10 @@ func (t *EditSection) Do(c *canvasapi.Canvas) (*models.Section, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -50,25 +48,25 @@ import (
 //
 type EditUser struct {
 	Path struct {
-		ID string `json:"id" url:"id,omitempty"` //  (Required)
+		ID canvasapi.ID `json:"id" url:"id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		User struct {
-			Name         string `json:"name" url:"name,omitempty"`                   //  (Optional)
-			ShortName    string `json:"short_name" url:"short_name,omitempty"`       //  (Optional)
-			SortableName string `json:"sortable_name" url:"sortable_name,omitempty"` //  (Optional)
-			TimeZone     string `json:"time_zone" url:"time_zone,omitempty"`         //  (Optional)
-			Email        string `json:"email" url:"email,omitempty"`                 //  (Optional)
-			Locale       string `json:"locale" url:"locale,omitempty"`               //  (Optional)
+			Name         canvasapi.Opt[string] `json:"name" url:"name,omitempty"`                   //  (Optional)
+			ShortName    canvasapi.Opt[string] `json:"short_name" url:"short_name,omitempty"`       //  (Optional)
+			SortableName canvasapi.Opt[string] `json:"sortable_name" url:"sortable_name,omitempty"` //  (Optional)
+			TimeZone     canvasapi.Opt[string] `json:"time_zone" url:"time_zone,omitempty"`         //  (Optional)
+			Email        canvasapi.Opt[string] `json:"email" url:"email,omitempty"`                 //  (Optional)
+			Locale       canvasapi.Opt[string] `json:"locale" url:"locale,omitempty"`               //  (Optional)
 			Avatar       struct {
-				Token string `json:"token" url:"token,omitempty"` //  (Optional)
-				Url   string `json:"url" url:"url,omitempty"`     //  (Optional)
+				Token canvasapi.Opt[string] `json:"token" url:"token,omitempty"` //  (Optional)
+				Url   canvasapi.Opt[string] `json:"url" url:"url,omitempty"`     //  (Optional)
 			} `json:"avatar" url:"avatar,omitempty"`
 
-			Title    string `json:"title" url:"title,omitempty"`       //  (Optional)
-			Bio      string `json:"bio" url:"bio,omitempty"`           //  (Optional)
-			Pronouns string `json:"pronouns" url:"pronouns,omitempty"` //  (Optional)
+			Title    canvasapi.Opt[string] `json:"title" url:"title,omitempty"`       //  (Optional)
+			Bio      canvasapi.Opt[string] `json:"bio" url:"bio,omitempty"`           //  (Optional)
+			Pronouns canvasapi.Opt[string] `json:"pronouns" url:"pronouns,omitempty"` //  (Optional)
 		} `json:"user" url:"user,omitempty"`
 	} `json:"form"`
 }
@@ -79,7 +77,7 @@ func (t *EditUser) GetMethod() string {
 
 func (t *EditUser) GetURLPath() string {
 	path := "users/{id}"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -88,30 +86,34 @@ func (t *EditUser) GetQuery() (string, error) {
 }
 
 func (t *EditUser) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *EditUser) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *EditUser) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *EditUser) Do(c *canvasapi.Canvas) (*models.User, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *EditUser) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.User, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -126,6 +128,10 @@ func (t *EditUser) Do(c *canvasapi.Canvas) (*models.User, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
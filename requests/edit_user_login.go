@@ -1,14 +1,14 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // EditUserLogin Update an existing login for a user in the given account.
@@ -37,17 +37,17 @@ import (
 //
 type EditUserLogin struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
-		ID        string `json:"id" url:"id,omitempty"`                 //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		ID        canvasapi.ID `json:"id" url:"id,omitempty"`                 //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		Login struct {
-			UniqueID                 string `json:"unique_id" url:"unique_id,omitempty"`                                   //  (Optional)
-			Password                 string `json:"password" url:"password,omitempty"`                                     //  (Optional)
-			SISUserID                string `json:"sis_user_id" url:"sis_user_id,omitempty"`                               //  (Optional)
-			IntegrationID            string `json:"integration_id" url:"integration_id,omitempty"`                         //  (Optional)
-			AuthenticationProviderID string `json:"authentication_provider_id" url:"authentication_provider_id,omitempty"` //  (Optional)
+			UniqueID                 canvasapi.Opt[string] `json:"unique_id" url:"unique_id,omitempty"`                                   //  (Optional)
+			Password                 canvasapi.Opt[string] `json:"password" url:"password,omitempty"`                                     //  (Optional)
+			SISUserID                canvasapi.Opt[string] `json:"sis_user_id" url:"sis_user_id,omitempty"`                               //  (Optional)
+			IntegrationID            canvasapi.Opt[string] `json:"integration_id" url:"integration_id,omitempty"`                         //  (Optional)
+			AuthenticationProviderID canvasapi.Opt[string] `json:"authentication_provider_id" url:"authentication_provider_id,omitempty"` //  (Optional)
 		} `json:"login" url:"login,omitempty"`
 	} `json:"form"`
 }
@@ -58,8 +58,8 @@ func (t *EditUserLogin) GetMethod() string {
 
 func (t *EditUserLogin) GetURLPath() string {
 	path := "accounts/{account_id}/logins/{id}"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -68,36 +68,55 @@ func (t *EditUserLogin) GetQuery() (string, error) {
 }
 
 func (t *EditUserLogin) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *EditUserLogin) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *EditUserLogin) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *EditUserLogin) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *EditUserLogin) Do(c *canvasapi.Canvas) (*models.Login, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *EditUserLogin) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Login, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.Login{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,14 +1,14 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -32,7 +32,7 @@ import (
 //
 type EnableDisableOrClearExplicitCspSettingCourses struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -46,7 +46,7 @@ func (t *EnableDisableOrClearExplicitCspSettingCourses) GetMethod() string {
 
 func (t *EnableDisableOrClearExplicitCspSettingCourses) GetURLPath() string {
 	path := "courses/{course_id}/csp_settings"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
@@ -55,39 +55,58 @@ func (t *EnableDisableOrClearExplicitCspSettingCourses) GetQuery() (string, erro
 }
 
 func (t *EnableDisableOrClearExplicitCspSettingCourses) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *EnableDisableOrClearExplicitCspSettingCourses) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *EnableDisableOrClearExplicitCspSettingCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Status == "" {
-		errs = append(errs, "'Form.Status' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Status", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Status != "" && !string_utils.Include([]string{"enabled", "disabled", "inherited"}, t.Form.Status) {
-		errs = append(errs, "Status must be one of enabled, disabled, inherited")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Status", Rule: canvasapi.RuleOneOf, Allowed: []string{"enabled", "disabled", "inherited"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *EnableDisableOrClearExplicitCspSettingCourses) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *EnableDisableOrClearExplicitCspSettingCourses) Do(c *canvasapi.Canvas) (*models.CSPSettings, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *EnableDisableOrClearExplicitCspSettingCourses) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.CSPSettings, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.CSPSettings{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
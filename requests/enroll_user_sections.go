@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -64,24 +62,24 @@ import (
 //
 type EnrollUserSections struct {
 	Path struct {
-		SectionID string `json:"section_id" url:"section_id,omitempty"` //  (Required)
+		SectionID canvasapi.ID `json:"section_id" url:"section_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		Enrollment struct {
-			StartAt                        time.Time `json:"start_at" url:"start_at,omitempty"`                                                     //  (Optional)
-			EndAt                          time.Time `json:"end_at" url:"end_at,omitempty"`                                                         //  (Optional)
-			UserID                         string    `json:"user_id" url:"user_id,omitempty"`                                                       //  (Required)
-			Type                           string    `json:"type" url:"type,omitempty"`                                                             //  (Required) . Must be one of StudentEnrollment, TeacherEnrollment, TaEnrollment, ObserverEnrollment, DesignerEnrollment
-			Role                           string    `json:"role" url:"role,omitempty"`                                                             //  (Optional)
-			RoleID                         int64     `json:"role_id" url:"role_id,omitempty"`                                                       //  (Optional)
-			EnrollmentState                string    `json:"enrollment_state" url:"enrollment_state,omitempty"`                                     //  (Optional) . Must be one of active, invited, inactive
-			CourseSectionID                int64     `json:"course_section_id" url:"course_section_id,omitempty"`                                   //  (Optional)
-			LimitPrivilegesToCourseSection bool      `json:"limit_privileges_to_course_section" url:"limit_privileges_to_course_section,omitempty"` //  (Optional)
-			Notify                         bool      `json:"notify" url:"notify,omitempty"`                                                         //  (Optional)
-			SelfEnrollmentCode             string    `json:"self_enrollment_code" url:"self_enrollment_code,omitempty"`                             //  (Optional)
-			SelfEnrolled                   bool      `json:"self_enrolled" url:"self_enrolled,omitempty"`                                           //  (Optional)
-			AssociatedUserID               int64     `json:"associated_user_id" url:"associated_user_id,omitempty"`                                 //  (Optional)
+			StartAt                        time.Time            `json:"start_at" url:"start_at,omitempty"`                                                     //  (Optional)
+			EndAt                          time.Time            `json:"end_at" url:"end_at,omitempty"`                                                         //  (Optional)
+			UserID                         string               `json:"user_id" url:"user_id,omitempty"`                                                       //  (Required)
+			Type                           string               `json:"type" url:"type,omitempty"`                                                             //  (Required) . Must be one of StudentEnrollment, TeacherEnrollment, TaEnrollment, ObserverEnrollment, DesignerEnrollment
+			Role                           string               `json:"role" url:"role,omitempty"`                                                             //  (Optional)
+			RoleID                         canvasapi.Opt[int64] `json:"role_id" url:"role_id,omitempty"`                                                       //  (Optional)
+			EnrollmentState                string               `json:"enrollment_state" url:"enrollment_state,omitempty"`                                     //  (Optional) . Must be one of active, invited, inactive
+			CourseSectionID                canvasapi.Opt[int64] `json:"course_section_id" url:"course_section_id,omitempty"`                                   //  (Optional)
+			LimitPrivilegesToCourseSection canvasapi.Opt[bool]  `json:"limit_privileges_to_course_section" url:"limit_privileges_to_course_section,omitempty"` //  (Optional)
+			Notify                         canvasapi.Opt[bool]  `json:"notify" url:"notify,omitempty"`                                                         //  (Optional)
+			SelfEnrollmentCode             string               `json:"self_enrollment_code" url:"self_enrollment_code,omitempty"`                             //  (Optional)
+			SelfEnrolled                   canvasapi.Opt[bool]  `json:"self_enrolled" url:"self_enrolled,omitempty"`                                           //  (Optional)
+			AssociatedUserID               canvasapi.Opt[int64] `json:"associated_user_id" url:"associated_user_id,omitempty"`                                 //  (Optional)
 		} `json:"enrollment" url:"enrollment,omitempty"`
 	} `json:"form"`
 }
@@ -92,7 +90,7 @@ func (t *EnrollUserSections) GetMethod() string {
 
 func (t *EnrollUserSections) GetURLPath() string {
 	path := "sections/{section_id}/enrollments"
-	path = strings.ReplaceAll(path, "{section_id}", fmt.Sprintf("%v", t.Path.SectionID))
+	path = strings.ReplaceAll(path, "{section_id}", t.Path.SectionID.Escaped())
 	return path
 }
 
@@ -101,42 +99,46 @@ func (t *EnrollUserSections) GetQuery() (string, error) {
 }
 
 func (t *EnrollUserSections) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *EnrollUserSections) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *EnrollUserSections) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.SectionID == "" {
-		errs = append(errs, "'Path.SectionID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.SectionID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Enrollment.UserID == "" {
-		errs = append(errs, "'Form.Enrollment.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Enrollment.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Enrollment.Type == "" {
-		errs = append(errs, "'Form.Enrollment.Type' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Enrollment.Type", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Enrollment.Type != "" && !string_utils.Include([]string{"StudentEnrollment", "TeacherEnrollment", "TaEnrollment", "ObserverEnrollment", "DesignerEnrollment"}, t.Form.Enrollment.Type) {
-		errs = append(errs, "Enrollment must be one of StudentEnrollment, TeacherEnrollment, TaEnrollment, ObserverEnrollment, DesignerEnrollment")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Enrollment.Type", Rule: canvasapi.RuleOneOf, Allowed: []string{"StudentEnrollment", "TeacherEnrollment", "TaEnrollment", "ObserverEnrollment", "DesignerEnrollment"}})
 	}
 	if t.Form.Enrollment.EnrollmentState != "" && !string_utils.Include([]string{"active", "invited", "inactive"}, t.Form.Enrollment.EnrollmentState) {
-		errs = append(errs, "Enrollment must be one of active, invited, inactive")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Enrollment.EnrollmentState", Rule: canvasapi.RuleOneOf, Allowed: []string{"active", "invited", "inactive"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *EnrollUserSections) Do(c *canvasapi.Canvas) (*models.Enrollment, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *EnrollUserSections) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Enrollment, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -151,6 +153,10 @@ func (t *EnrollUserSections) Do(c *canvasapi.Canvas) (*models.Enrollment, error)
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
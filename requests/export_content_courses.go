@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -46,12 +44,12 @@ import (
 //
 type ExportContentCourses struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		ExportType        string                   `json:"export_type" url:"export_type,omitempty"`               //  (Required) . Must be one of common_cartridge, qti, zip
-		SkipNotifications bool                     `json:"skip_notifications" url:"skip_notifications,omitempty"` //  (Optional)
+		SkipNotifications canvasapi.Opt[bool]      `json:"skip_notifications" url:"skip_notifications,omitempty"` //  (Optional)
 		Select            map[string](interface{}) `json:"select" url:"select,omitempty"`                         //  (Optional) . Must be one of folders, files, attachments, quizzes, assignments, announcements, calendar_events, discussion_topics, modules, module_items, pages, rubrics
 	} `json:"form"`
 }
@@ -62,7 +60,7 @@ func (t *ExportContentCourses) GetMethod() string {
 
 func (t *ExportContentCourses) GetURLPath() string {
 	path := "courses/{course_id}/content_exports"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
@@ -71,36 +69,40 @@ func (t *ExportContentCourses) GetQuery() (string, error) {
 }
 
 func (t *ExportContentCourses) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *ExportContentCourses) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *ExportContentCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.ExportType == "" {
-		errs = append(errs, "'Form.ExportType' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.ExportType", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.ExportType != "" && !string_utils.Include([]string{"common_cartridge", "qti", "zip"}, t.Form.ExportType) {
-		errs = append(errs, "ExportType must be one of common_cartridge, qti, zip")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.ExportType", Rule: canvasapi.RuleOneOf, Allowed: []string{"common_cartridge", "qti", "zip"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ExportContentCourses) Do(c *canvasapi.Canvas) (*models.ContentExport, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ExportContentCourses) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.ContentExport, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -115,6 +117,10 @@ func (t *ExportContentCourses) Do(c *canvasapi.Canvas) (*models.ContentExport, e
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -46,12 +44,12 @@ import (
 //
 type ExportContentUsers struct {
 	Path struct {
-		UserID string `json:"user_id" url:"user_id,omitempty"` //  (Required)
+		UserID canvasapi.ID `json:"user_id" url:"user_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		ExportType        string                   `json:"export_type" url:"export_type,omitempty"`               //  (Required) . Must be one of common_cartridge, qti, zip
-		SkipNotifications bool                     `json:"skip_notifications" url:"skip_notifications,omitempty"` //  (Optional)
+		SkipNotifications canvasapi.Opt[bool]      `json:"skip_notifications" url:"skip_notifications,omitempty"` //  (Optional)
 		Select            map[string](interface{}) `json:"select" url:"select,omitempty"`                         //  (Optional) . Must be one of folders, files, attachments, quizzes, assignments, announcements, calendar_events, discussion_topics, modules, module_items, pages, rubrics
 	} `json:"form"`
 }
@@ -62,7 +60,7 @@ func (t *ExportContentUsers) GetMethod() string {
 
 func (t *ExportContentUsers) GetURLPath() string {
 	path := "users/{user_id}/content_exports"
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", t.Path.UserID))
+	path = strings.ReplaceAll(path, "{user_id}", t.Path.UserID.Escaped())
 	return path
 }
 
@@ -71,36 +69,40 @@ func (t *ExportContentUsers) GetQuery() (string, error) {
 }
 
 func (t *ExportContentUsers) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *ExportContentUsers) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *ExportContentUsers) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.UserID == "" {
-		errs = append(errs, "'Path.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.ExportType == "" {
-		errs = append(errs, "'Form.ExportType' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.ExportType", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.ExportType != "" && !string_utils.Include([]string{"common_cartridge", "qti", "zip"}, t.Form.ExportType) {
-		errs = append(errs, "ExportType must be one of common_cartridge, qti, zip")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.ExportType", Rule: canvasapi.RuleOneOf, Allowed: []string{"common_cartridge", "qti", "zip"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ExportContentUsers) Do(c *canvasapi.Canvas) (*models.ContentExport, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ExportContentUsers) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.ContentExport, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -115,6 +117,10 @@ func (t *ExportContentUsers) Do(c *canvasapi.Canvas) (*models.ContentExport, err
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
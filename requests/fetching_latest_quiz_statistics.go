@@ -1,13 +1,14 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // FetchingLatestQuizStatistics This endpoint provides statistics for all quiz versions, or for a specific
@@ -26,12 +27,12 @@ import (
 //
 type FetchingLatestQuizStatistics struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		QuizID   string `json:"quiz_id" url:"quiz_id,omitempty"`     //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		QuizID   canvasapi.ID `json:"quiz_id" url:"quiz_id,omitempty"`     //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		AllVersions bool `json:"all_versions" url:"all_versions,omitempty"` //  (Optional)
+		AllVersions canvasapi.Opt[bool] `json:"all_versions" url:"all_versions,omitempty"` //  (Optional)
 	} `json:"query"`
 }
 
@@ -41,13 +42,13 @@ func (t *FetchingLatestQuizStatistics) GetMethod() string {
 
 func (t *FetchingLatestQuizStatistics) GetURLPath() string {
 	path := "courses/{course_id}/quizzes/{quiz_id}/statistics"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{quiz_id}", fmt.Sprintf("%v", t.Path.QuizID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{quiz_id}", t.Path.QuizID.Escaped())
 	return path
 }
 
 func (t *FetchingLatestQuizStatistics) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -63,24 +64,43 @@ func (t *FetchingLatestQuizStatistics) GetJSON() ([]byte, error) {
 }
 
 func (t *FetchingLatestQuizStatistics) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.QuizID == "" {
-		errs = append(errs, "'Path.QuizID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.QuizID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *FetchingLatestQuizStatistics) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *FetchingLatestQuizStatistics) Do(c *canvasapi.Canvas) (*models.QuizStatisticsResponse, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *FetchingLatestQuizStatistics) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.QuizStatisticsResponse, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.QuizStatisticsResponse{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,11 +1,15 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
+	"github.com/atomicjolt/string_utils"
 )
 
 // FilesUploadFile Upload a file to a folder.
@@ -21,10 +25,25 @@ import (
 // Path Parameters:
 // # Path.FolderID (Required) ID
 //
+// Form Parameters:
+// # Form.Name (Optional) The filename of the file. Any UTF-8 name is allowed. Path components such as `/` and `\` will be treated as part of the filename, not a path to a sub-folder.
+// # Form.Size (Optional) The size of the file, in bytes. This field is recommended, as it will let you find out if there's a quota issue before uploading the raw file.
+// # Form.ContentType (Optional) The content type of the file. If not given, it will be guessed based on the file extension.
+// # Form.OnDuplicate (Optional) . Must be one of overwrite, renameHow to handle duplicate filenames. If `overwrite`, then this file upload will overwrite any other file in the folder with the same name. If `rename`, then this file will be renamed if another file in the folder exists with the given name.
+// # Form.Url (Optional) The URL to download the file from. Canvas fetches the file itself and returns a progress object instead of an upload_url.
+//
 type FilesUploadFile struct {
 	Path struct {
-		FolderID string `json:"folder_id" url:"folder_id,omitempty"` //  (Required)
+		FolderID canvasapi.ID `json:"folder_id" url:"folder_id,omitempty"` //  (Required)
 	} `json:"path"`
+
+	Form struct {
+		Name        string               `json:"name" url:"name,omitempty"`                 //  (Optional)
+		Size        canvasapi.Opt[int64] `json:"size" url:"size,omitempty"`                 //  (Optional)
+		ContentType string               `json:"content_type" url:"content_type,omitempty"` //  (Optional)
+		OnDuplicate string               `json:"on_duplicate" url:"on_duplicate,omitempty"` //  (Optional)
+		Url         string               `json:"url" url:"url,omitempty"`                   //  (Optional)
+	} `json:"form"`
 }
 
 func (t *FilesUploadFile) GetMethod() string {
@@ -33,7 +52,7 @@ func (t *FilesUploadFile) GetMethod() string {
 
 func (t *FilesUploadFile) GetURLPath() string {
 	path := "folders/{folder_id}/files"
-	path = strings.ReplaceAll(path, "{folder_id}", fmt.Sprintf("%v", t.Path.FolderID))
+	path = strings.ReplaceAll(path, "{folder_id}", t.Path.FolderID.Escaped())
 	return path
 }
 
@@ -42,29 +61,55 @@ func (t *FilesUploadFile) GetQuery() (string, error) {
 }
 
 func (t *FilesUploadFile) GetBody() (url.Values, error) {
-	return nil, nil
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *FilesUploadFile) GetJSON() ([]byte, error) {
-	return nil, nil
+	j, err := canvasapi.EncodeJSON(t.Form)
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
 }
 
 func (t *FilesUploadFile) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.FolderID == "" {
-		errs = append(errs, "'Path.FolderID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.FolderID", Rule: canvasapi.RuleRequired})
+	}
+	if t.Form.OnDuplicate != "" && !string_utils.Include([]string{"overwrite", "rename"}, t.Form.OnDuplicate) {
+		errs = append(errs, canvasapi.FieldError{Field: "Form.OnDuplicate", Rule: canvasapi.RuleOneOf, Allowed: []string{"overwrite", "rename"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *FilesUploadFile) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *FilesUploadFile) Do(c *canvasapi.Canvas) (*models.UploadParams, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *FilesUploadFile) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.UploadParams, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.UploadParams{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,11 +1,10 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
-	"strings"
-
-	"github.com/google/go-querystring/query"
 
 	"github.com/atomicjolt/canvasapi"
 )
@@ -27,11 +26,11 @@ func (t *FindImages) GetMethod() string {
 }
 
 func (t *FindImages) GetURLPath() string {
-	return ""
+	return "image_search"
 }
 
 func (t *FindImages) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -47,21 +46,31 @@ func (t *FindImages) GetJSON() ([]byte, error) {
 }
 
 func (t *FindImages) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Query.Query == "" {
-		errs = append(errs, "'Query.Query' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.Query", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *FindImages) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *FindImages) Do(c *canvasapi.Canvas) (json.RawMessage, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *FindImages) DoContext(ctx context.Context, c *canvasapi.Canvas) (json.RawMessage, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(body), nil
 }
@@ -1,6 +1,10 @@
 package requests
 
 import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
 	"net/url"
 
 	"github.com/atomicjolt/canvasapi"
@@ -17,7 +21,7 @@ func (t *FindRecipients) GetMethod() string {
 }
 
 func (t *FindRecipients) GetURLPath() string {
-	return ""
+	return "conversations/find_recipients"
 }
 
 func (t *FindRecipients) GetQuery() (string, error) {
@@ -36,11 +40,38 @@ func (t *FindRecipients) HasErrors() error {
 	return nil
 }
 
-func (t *FindRecipients) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *FindRecipients) Do(c *canvasapi.Canvas, next *url.URL) ([]json.RawMessage, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *FindRecipients) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]json.RawMessage, *canvasapi.PagedResource, error) {
+	var err error
+	var response *http.Response
+	if next != nil {
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
+	} else {
+		response, err = c.SendRequestContext(ctx, t)
+	}
+
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	ret := []json.RawMessage{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ret, pagedResource, nil
 }
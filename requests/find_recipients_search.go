@@ -1,11 +1,11 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
 	"net/url"
-	"strings"
-
-	"github.com/google/go-querystring/query"
 
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
@@ -41,13 +41,13 @@ import (
 //
 type FindRecipientsSearch struct {
 	Query struct {
-		Search             string   `json:"search" url:"search,omitempty"`                             //  (Optional)
-		Context            string   `json:"context" url:"context,omitempty"`                           //  (Optional)
-		Exclude            []string `json:"exclude" url:"exclude,omitempty"`                           //  (Optional)
-		Type               string   `json:"type" url:"type,omitempty"`                                 //  (Optional) . Must be one of user, context
-		UserID             int64    `json:"user_id" url:"user_id,omitempty"`                           //  (Optional)
-		FromConversationID int64    `json:"from_conversation_id" url:"from_conversation_id,omitempty"` //  (Optional)
-		Permissions        []string `json:"permissions" url:"permissions,omitempty"`                   //  (Optional)
+		Search             string               `json:"search" url:"search,omitempty"`                             //  (Optional)
+		Context            string               `json:"context" url:"context,omitempty"`                           //  (Optional)
+		Exclude            []string             `json:"exclude" url:"exclude,omitempty"`                           //  (Optional)
+		Type               string               `json:"type" url:"type,omitempty"`                                 //  (Optional) . Must be one of user, context
+		UserID             canvasapi.Opt[int64] `json:"user_id" url:"user_id,omitempty"`                           //  (Optional)
+		FromConversationID canvasapi.Opt[int64] `json:"from_conversation_id" url:"from_conversation_id,omitempty"` //  (Optional)
+		Permissions        []string             `json:"permissions" url:"permissions,omitempty"`                   //  (Optional)
 	} `json:"query"`
 }
 
@@ -56,11 +56,11 @@ func (t *FindRecipientsSearch) GetMethod() string {
 }
 
 func (t *FindRecipientsSearch) GetURLPath() string {
-	return ""
+	return "search/recipients"
 }
 
 func (t *FindRecipientsSearch) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -76,21 +76,48 @@ func (t *FindRecipientsSearch) GetJSON() ([]byte, error) {
 }
 
 func (t *FindRecipientsSearch) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Query.Type != "" && !string_utils.Include([]string{"user", "context"}, t.Query.Type) {
-		errs = append(errs, "Type must be one of user, context")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.Type", Rule: canvasapi.RuleOneOf, Allowed: []string{"user", "context"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *FindRecipientsSearch) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *FindRecipientsSearch) Do(c *canvasapi.Canvas, next *url.URL) ([]json.RawMessage, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *FindRecipientsSearch) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]json.RawMessage, *canvasapi.PagedResource, error) {
+	var err error
+	var response *http.Response
+	if next != nil {
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
+	} else {
+		response, err = c.SendRequestContext(ctx, t)
+	}
+
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	ret := []json.RawMessage{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ret, pagedResource, nil
 }
@@ -1,13 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 )
 
@@ -29,14 +28,14 @@ import (
 //
 type FlaggingQuestion struct {
 	Path struct {
-		QuizSubmissionID string `json:"quiz_submission_id" url:"quiz_submission_id,omitempty"` //  (Required)
-		ID               string `json:"id" url:"id,omitempty"`                                 //  (Required)
+		QuizSubmissionID canvasapi.ID `json:"quiz_submission_id" url:"quiz_submission_id,omitempty"` //  (Required)
+		ID               canvasapi.ID `json:"id" url:"id,omitempty"`                                 //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		Attempt         int64  `json:"attempt" url:"attempt,omitempty"`                   //  (Required)
-		ValidationToken string `json:"validation_token" url:"validation_token,omitempty"` //  (Required)
-		AccessCode      string `json:"access_code" url:"access_code,omitempty"`           //  (Optional)
+		Attempt         int64                 `json:"attempt" url:"attempt,omitempty"`                   //  (Required)
+		ValidationToken string                `json:"validation_token" url:"validation_token,omitempty"` //  (Required)
+		AccessCode      canvasapi.Opt[string] `json:"access_code" url:"access_code,omitempty"`           //  (Optional)
 	} `json:"form"`
 }
 
@@ -46,8 +45,8 @@ func (t *FlaggingQuestion) GetMethod() string {
 
 func (t *FlaggingQuestion) GetURLPath() string {
 	path := "quiz_submissions/{quiz_submission_id}/questions/{id}/flag"
-	path = strings.ReplaceAll(path, "{quiz_submission_id}", fmt.Sprintf("%v", t.Path.QuizSubmissionID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{quiz_submission_id}", t.Path.QuizSubmissionID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -56,39 +55,49 @@ func (t *FlaggingQuestion) GetQuery() (string, error) {
 }
 
 func (t *FlaggingQuestion) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *FlaggingQuestion) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *FlaggingQuestion) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.QuizSubmissionID == "" {
-		errs = append(errs, "'Path.QuizSubmissionID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.QuizSubmissionID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.ValidationToken == "" {
-		errs = append(errs, "'Form.ValidationToken' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.ValidationToken", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *FlaggingQuestion) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *FlaggingQuestion) Do(c *canvasapi.Canvas) (json.RawMessage, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *FlaggingQuestion) DoContext(ctx context.Context, c *canvasapi.Canvas) (json.RawMessage, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(body), nil
 }
@@ -1,6 +1,7 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
@@ -23,7 +24,7 @@ func (t *GetAccountsThatAdminsCanManage) GetMethod() string {
 }
 
 func (t *GetAccountsThatAdminsCanManage) GetURLPath() string {
-	return ""
+	return "manageable_accounts"
 }
 
 func (t *GetAccountsThatAdminsCanManage) GetQuery() (string, error) {
@@ -43,12 +44,16 @@ func (t *GetAccountsThatAdminsCanManage) HasErrors() error {
 }
 
 func (t *GetAccountsThatAdminsCanManage) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Account, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *GetAccountsThatAdminsCanManage) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.Account, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -68,6 +73,10 @@ func (t *GetAccountsThatAdminsCanManage) Do(c *canvasapi.Canvas, next *url.URL)
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -25,11 +23,11 @@ import (
 //
 type GetAlignedAssignmentsForOutcomeInCourseForParticularStudent struct {
 	Path struct {
-		CourseID int64 `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		StudentID int64 `json:"student_id" url:"student_id,omitempty"` //  (Optional)
+		StudentID canvasapi.Opt[int64] `json:"student_id" url:"student_id,omitempty"` //  (Optional)
 	} `json:"query"`
 }
 
@@ -39,12 +37,12 @@ func (t *GetAlignedAssignmentsForOutcomeInCourseForParticularStudent) GetMethod(
 
 func (t *GetAlignedAssignmentsForOutcomeInCourseForParticularStudent) GetURLPath() string {
 	path := "courses/{course_id}/outcome_alignments"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
 func (t *GetAlignedAssignmentsForOutcomeInCourseForParticularStudent) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -60,16 +58,27 @@ func (t *GetAlignedAssignmentsForOutcomeInCourseForParticularStudent) GetJSON()
 }
 
 func (t *GetAlignedAssignmentsForOutcomeInCourseForParticularStudent) HasErrors() error {
+	errs := []canvasapi.FieldError{}
+	if t.Path.CourseID == "" {
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
+	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
+	}
 	return nil
 }
 
 func (t *GetAlignedAssignmentsForOutcomeInCourseForParticularStudent) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.OutcomeAlignment, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *GetAlignedAssignmentsForOutcomeInCourseForParticularStudent) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.OutcomeAlignment, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -89,6 +98,10 @@ func (t *GetAlignedAssignmentsForOutcomeInCourseForParticularStudent) Do(c *canv
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
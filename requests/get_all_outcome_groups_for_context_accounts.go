@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -20,7 +20,7 @@ import (
 //
 type GetAllOutcomeGroupsForContextAccounts struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -30,7 +30,7 @@ func (t *GetAllOutcomeGroupsForContextAccounts) GetMethod() string {
 
 func (t *GetAllOutcomeGroupsForContextAccounts) GetURLPath() string {
 	path := "accounts/{account_id}/outcome_groups"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	return path
 }
 
@@ -47,23 +47,27 @@ func (t *GetAllOutcomeGroupsForContextAccounts) GetJSON() ([]byte, error) {
 }
 
 func (t *GetAllOutcomeGroupsForContextAccounts) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *GetAllOutcomeGroupsForContextAccounts) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.OutcomeGroup, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *GetAllOutcomeGroupsForContextAccounts) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.OutcomeGroup, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -83,6 +87,10 @@ func (t *GetAllOutcomeGroupsForContextAccounts) Do(c *canvasapi.Canvas, next *ur
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
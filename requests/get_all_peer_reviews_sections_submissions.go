@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -28,9 +26,9 @@ import (
 //
 type GetAllPeerReviewsSectionsSubmissions struct {
 	Path struct {
-		SectionID    string `json:"section_id" url:"section_id,omitempty"`       //  (Required)
-		AssignmentID string `json:"assignment_id" url:"assignment_id,omitempty"` //  (Required)
-		SubmissionID string `json:"submission_id" url:"submission_id,omitempty"` //  (Required)
+		SectionID    canvasapi.ID `json:"section_id" url:"section_id,omitempty"`       //  (Required)
+		AssignmentID canvasapi.ID `json:"assignment_id" url:"assignment_id,omitempty"` //  (Required)
+		SubmissionID canvasapi.ID `json:"submission_id" url:"submission_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
@@ -44,14 +42,14 @@ func (t *GetAllPeerReviewsSectionsSubmissions) GetMethod() string {
 
 func (t *GetAllPeerReviewsSectionsSubmissions) GetURLPath() string {
 	path := "sections/{section_id}/assignments/{assignment_id}/submissions/{submission_id}/peer_reviews"
-	path = strings.ReplaceAll(path, "{section_id}", fmt.Sprintf("%v", t.Path.SectionID))
-	path = strings.ReplaceAll(path, "{assignment_id}", fmt.Sprintf("%v", t.Path.AssignmentID))
-	path = strings.ReplaceAll(path, "{submission_id}", fmt.Sprintf("%v", t.Path.SubmissionID))
+	path = strings.ReplaceAll(path, "{section_id}", t.Path.SectionID.Escaped())
+	path = strings.ReplaceAll(path, "{assignment_id}", t.Path.AssignmentID.Escaped())
+	path = strings.ReplaceAll(path, "{submission_id}", t.Path.SubmissionID.Escaped())
 	return path
 }
 
 func (t *GetAllPeerReviewsSectionsSubmissions) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -67,34 +65,38 @@ func (t *GetAllPeerReviewsSectionsSubmissions) GetJSON() ([]byte, error) {
 }
 
 func (t *GetAllPeerReviewsSectionsSubmissions) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.SectionID == "" {
-		errs = append(errs, "'Path.SectionID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.SectionID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.AssignmentID == "" {
-		errs = append(errs, "'Path.AssignmentID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AssignmentID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.SubmissionID == "" {
-		errs = append(errs, "'Path.SubmissionID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.SubmissionID", Rule: canvasapi.RuleRequired})
 	}
 	for _, v := range t.Query.Include {
 		if v != "" && !string_utils.Include([]string{"submission_comments", "user"}, v) {
-			errs = append(errs, "Include must be one of submission_comments, user")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.Include", Rule: canvasapi.RuleOneOf, Allowed: []string{"submission_comments", "user"}})
 		}
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *GetAllPeerReviewsSectionsSubmissions) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.PeerReview, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *GetAllPeerReviewsSectionsSubmissions) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.PeerReview, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -114,6 +116,10 @@ func (t *GetAllPeerReviewsSectionsSubmissions) Do(c *canvasapi.Canvas, next *url
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
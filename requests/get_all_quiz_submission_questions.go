@@ -1,13 +1,14 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -24,7 +25,7 @@ import (
 //
 type GetAllQuizSubmissionQuestions struct {
 	Path struct {
-		QuizSubmissionID string `json:"quiz_submission_id" url:"quiz_submission_id,omitempty"` //  (Required)
+		QuizSubmissionID canvasapi.ID `json:"quiz_submission_id" url:"quiz_submission_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
@@ -38,12 +39,12 @@ func (t *GetAllQuizSubmissionQuestions) GetMethod() string {
 
 func (t *GetAllQuizSubmissionQuestions) GetURLPath() string {
 	path := "quiz_submissions/{quiz_submission_id}/questions"
-	path = strings.ReplaceAll(path, "{quiz_submission_id}", fmt.Sprintf("%v", t.Path.QuizSubmissionID))
+	path = strings.ReplaceAll(path, "{quiz_submission_id}", t.Path.QuizSubmissionID.Escaped())
 	return path
 }
 
 func (t *GetAllQuizSubmissionQuestions) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -59,26 +60,45 @@ func (t *GetAllQuizSubmissionQuestions) GetJSON() ([]byte, error) {
 }
 
 func (t *GetAllQuizSubmissionQuestions) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.QuizSubmissionID == "" {
-		errs = append(errs, "'Path.QuizSubmissionID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.QuizSubmissionID", Rule: canvasapi.RuleRequired})
 	}
 	for _, v := range t.Query.Include {
 		if v != "" && !string_utils.Include([]string{"quiz_question"}, v) {
-			errs = append(errs, "Include must be one of quiz_question")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.Include", Rule: canvasapi.RuleOneOf, Allowed: []string{"quiz_question"}})
 		}
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *GetAllQuizSubmissionQuestions) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *GetAllQuizSubmissionQuestions) Do(c *canvasapi.Canvas) (*models.QuizSubmissionQuestionsResponse, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetAllQuizSubmissionQuestions) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.QuizSubmissionQuestionsResponse, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.QuizSubmissionQuestionsResponse{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -32,14 +30,14 @@ import (
 //
 type GetAssignmentGroup struct {
 	Path struct {
-		CourseID          string `json:"course_id" url:"course_id,omitempty"`                     //  (Required)
-		AssignmentGroupID string `json:"assignment_group_id" url:"assignment_group_id,omitempty"` //  (Required)
+		CourseID          canvasapi.ID `json:"course_id" url:"course_id,omitempty"`                     //  (Required)
+		AssignmentGroupID canvasapi.ID `json:"assignment_group_id" url:"assignment_group_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		Include                 []string `json:"include" url:"include,omitempty"`                                     //  (Optional) . Must be one of assignments, discussion_topic, assignment_visibility, submission, score_statistics
-		OverrideAssignmentDates bool     `json:"override_assignment_dates" url:"override_assignment_dates,omitempty"` //  (Optional)
-		GradingPeriodID         int64    `json:"grading_period_id" url:"grading_period_id,omitempty"`                 //  (Optional)
+		Include                 []string             `json:"include" url:"include,omitempty"`                                     //  (Optional) . Must be one of assignments, discussion_topic, assignment_visibility, submission, score_statistics
+		OverrideAssignmentDates canvasapi.Opt[bool]  `json:"override_assignment_dates" url:"override_assignment_dates,omitempty"` //  (Optional)
+		GradingPeriodID         canvasapi.Opt[int64] `json:"grading_period_id" url:"grading_period_id,omitempty"`                 //  (Optional)
 	} `json:"query"`
 }
 
@@ -49,13 +47,13 @@ func (t *GetAssignmentGroup) GetMethod() string {
 
 func (t *GetAssignmentGroup) GetURLPath() string {
 	path := "courses/{course_id}/assignment_groups/{assignment_group_id}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{assignment_group_id}", fmt.Sprintf("%v", t.Path.AssignmentGroupID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{assignment_group_id}", t.Path.AssignmentGroupID.Escaped())
 	return path
 }
 
 func (t *GetAssignmentGroup) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -71,26 +69,30 @@ func (t *GetAssignmentGroup) GetJSON() ([]byte, error) {
 }
 
 func (t *GetAssignmentGroup) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.AssignmentGroupID == "" {
-		errs = append(errs, "'Path.AssignmentGroupID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AssignmentGroupID", Rule: canvasapi.RuleRequired})
 	}
 	for _, v := range t.Query.Include {
 		if v != "" && !string_utils.Include([]string{"assignments", "discussion_topic", "assignment_visibility", "submission", "score_statistics"}, v) {
-			errs = append(errs, "Include must be one of assignments, discussion_topic, assignment_visibility, submission, score_statistics")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.Include", Rule: canvasapi.RuleOneOf, Allowed: []string{"assignments", "discussion_topic", "assignment_visibility", "submission", "score_statistics"}})
 		}
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *GetAssignmentGroup) Do(c *canvasapi.Canvas) (*models.AssignmentGroup, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetAssignmentGroup) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.AssignmentGroup, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -105,6 +107,10 @@ func (t *GetAssignmentGroup) Do(c *canvasapi.Canvas) (*models.AssignmentGroup, e
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -21,8 +21,8 @@ import (
 //
 type GetBlueprintInformation struct {
 	Path struct {
-		CourseID   string `json:"course_id" url:"course_id,omitempty"`     //  (Required)
-		TemplateID string `json:"template_id" url:"template_id,omitempty"` //  (Required)
+		CourseID   canvasapi.ID `json:"course_id" url:"course_id,omitempty"`     //  (Required)
+		TemplateID canvasapi.ID `json:"template_id" url:"template_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -32,8 +32,8 @@ func (t *GetBlueprintInformation) GetMethod() string {
 
 func (t *GetBlueprintInformation) GetURLPath() string {
 	path := "courses/{course_id}/blueprint_templates/{template_id}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{template_id}", fmt.Sprintf("%v", t.Path.TemplateID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{template_id}", t.Path.TemplateID.Escaped())
 	return path
 }
 
@@ -50,21 +50,25 @@ func (t *GetBlueprintInformation) GetJSON() ([]byte, error) {
 }
 
 func (t *GetBlueprintInformation) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.TemplateID == "" {
-		errs = append(errs, "'Path.TemplateID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.TemplateID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *GetBlueprintInformation) Do(c *canvasapi.Canvas) (*models.BlueprintTemplate, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetBlueprintInformation) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.BlueprintTemplate, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -79,6 +83,10 @@ func (t *GetBlueprintInformation) Do(c *canvasapi.Canvas) (*models.BlueprintTemp
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
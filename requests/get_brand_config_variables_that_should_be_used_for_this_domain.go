@@ -1,6 +1,9 @@
 package requests
 
 import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 
 	"github.com/atomicjolt/canvasapi"
@@ -20,7 +23,7 @@ func (t *GetBrandConfigVariablesThatShouldBeUsedForThisDomain) GetMethod() strin
 }
 
 func (t *GetBrandConfigVariablesThatShouldBeUsedForThisDomain) GetURLPath() string {
-	return ""
+	return "brand_variables"
 }
 
 func (t *GetBrandConfigVariablesThatShouldBeUsedForThisDomain) GetQuery() (string, error) {
@@ -39,11 +42,21 @@ func (t *GetBrandConfigVariablesThatShouldBeUsedForThisDomain) HasErrors() error
 	return nil
 }
 
-func (t *GetBrandConfigVariablesThatShouldBeUsedForThisDomain) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *GetBrandConfigVariablesThatShouldBeUsedForThisDomain) Do(c *canvasapi.Canvas) (json.RawMessage, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetBrandConfigVariablesThatShouldBeUsedForThisDomain) DoContext(ctx context.Context, c *canvasapi.Canvas) (json.RawMessage, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(body), nil
 }
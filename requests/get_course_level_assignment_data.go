@@ -1,12 +1,12 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 )
 
@@ -28,11 +28,11 @@ import (
 //
 type GetCourseLevelAssignmentData struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		Async bool `json:"async" url:"async,omitempty"` //  (Optional)
+		Async canvasapi.Opt[bool] `json:"async" url:"async,omitempty"` //  (Optional)
 	} `json:"query"`
 }
 
@@ -42,12 +42,12 @@ func (t *GetCourseLevelAssignmentData) GetMethod() string {
 
 func (t *GetCourseLevelAssignmentData) GetURLPath() string {
 	path := "courses/{course_id}/analytics/assignments"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
 func (t *GetCourseLevelAssignmentData) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -63,21 +63,31 @@ func (t *GetCourseLevelAssignmentData) GetJSON() ([]byte, error) {
 }
 
 func (t *GetCourseLevelAssignmentData) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *GetCourseLevelAssignmentData) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *GetCourseLevelAssignmentData) Do(c *canvasapi.Canvas) (json.RawMessage, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetCourseLevelAssignmentData) DoContext(ctx context.Context, c *canvasapi.Canvas) (json.RawMessage, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(body), nil
 }
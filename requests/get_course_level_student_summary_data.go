@@ -1,12 +1,13 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
 )
@@ -30,7 +31,7 @@ import (
 //
 type GetCourseLevelStudentSummaryData struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
@@ -45,12 +46,12 @@ func (t *GetCourseLevelStudentSummaryData) GetMethod() string {
 
 func (t *GetCourseLevelStudentSummaryData) GetURLPath() string {
 	path := "courses/{course_id}/analytics/student_summaries"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
 func (t *GetCourseLevelStudentSummaryData) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -66,24 +67,51 @@ func (t *GetCourseLevelStudentSummaryData) GetJSON() ([]byte, error) {
 }
 
 func (t *GetCourseLevelStudentSummaryData) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Query.SortColumn != "" && !string_utils.Include([]string{"name", "name_descending", "score", "score_descending", "participations", "participations_descending", "page_views", "page_views_descending"}, t.Query.SortColumn) {
-		errs = append(errs, "SortColumn must be one of name, name_descending, score, score_descending, participations, participations_descending, page_views, page_views_descending")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.SortColumn", Rule: canvasapi.RuleOneOf, Allowed: []string{"name", "name_descending", "score", "score_descending", "participations", "participations_descending", "page_views", "page_views_descending"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *GetCourseLevelStudentSummaryData) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *GetCourseLevelStudentSummaryData) Do(c *canvasapi.Canvas, next *url.URL) ([]json.RawMessage, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *GetCourseLevelStudentSummaryData) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]json.RawMessage, *canvasapi.PagedResource, error) {
+	var err error
+	var response *http.Response
+	if next != nil {
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
+	} else {
+		response, err = c.SendRequestContext(ctx, t)
+	}
+
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	ret := []json.RawMessage{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ret, pagedResource, nil
 }
@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -24,7 +24,7 @@ import (
 //
 type GetCurrentImportingSISImport struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -34,7 +34,7 @@ func (t *GetCurrentImportingSISImport) GetMethod() string {
 
 func (t *GetCurrentImportingSISImport) GetURLPath() string {
 	path := "accounts/{account_id}/sis_imports/importing"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	return path
 }
 
@@ -51,18 +51,22 @@ func (t *GetCurrentImportingSISImport) GetJSON() ([]byte, error) {
 }
 
 func (t *GetCurrentImportingSISImport) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *GetCurrentImportingSISImport) Do(c *canvasapi.Canvas) (*models.SISImport, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetCurrentImportingSISImport) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.SISImport, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -77,6 +81,10 @@ func (t *GetCurrentImportingSISImport) Do(c *canvasapi.Canvas) (*models.SISImpor
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
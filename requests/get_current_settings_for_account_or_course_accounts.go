@@ -1,11 +1,14 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // GetCurrentSettingsForAccountOrCourseAccounts Update multiple modules in an account.
@@ -16,7 +19,7 @@ import (
 //
 type GetCurrentSettingsForAccountOrCourseAccounts struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -26,7 +29,7 @@ func (t *GetCurrentSettingsForAccountOrCourseAccounts) GetMethod() string {
 
 func (t *GetCurrentSettingsForAccountOrCourseAccounts) GetURLPath() string {
 	path := "accounts/{account_id}/csp_settings"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	return path
 }
 
@@ -43,21 +46,40 @@ func (t *GetCurrentSettingsForAccountOrCourseAccounts) GetJSON() ([]byte, error)
 }
 
 func (t *GetCurrentSettingsForAccountOrCourseAccounts) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *GetCurrentSettingsForAccountOrCourseAccounts) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *GetCurrentSettingsForAccountOrCourseAccounts) Do(c *canvasapi.Canvas) (*models.CSPSettings, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetCurrentSettingsForAccountOrCourseAccounts) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.CSPSettings, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.CSPSettings{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,11 +1,14 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // GetCurrentSettingsForAccountOrCourseCourses Update multiple modules in an account.
@@ -16,7 +19,7 @@ import (
 //
 type GetCurrentSettingsForAccountOrCourseCourses struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -26,7 +29,7 @@ func (t *GetCurrentSettingsForAccountOrCourseCourses) GetMethod() string {
 
 func (t *GetCurrentSettingsForAccountOrCourseCourses) GetURLPath() string {
 	path := "courses/{course_id}/csp_settings"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
@@ -43,21 +46,40 @@ func (t *GetCurrentSettingsForAccountOrCourseCourses) GetJSON() ([]byte, error)
 }
 
 func (t *GetCurrentSettingsForAccountOrCourseCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *GetCurrentSettingsForAccountOrCourseCourses) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *GetCurrentSettingsForAccountOrCourseCourses) Do(c *canvasapi.Canvas) (*models.CSPSettings, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetCurrentSettingsForAccountOrCourseCourses) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.CSPSettings, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.CSPSettings{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,11 +1,15 @@
 package requests
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // GetCustomColor Returns the custom colors that have been saved for a user for a given context.
@@ -20,8 +24,8 @@ import (
 //
 type GetCustomColor struct {
 	Path struct {
-		ID          string `json:"id" url:"id,omitempty"`                     //  (Required)
-		AssetString string `json:"asset_string" url:"asset_string,omitempty"` //  (Required)
+		ID          canvasapi.ID `json:"id" url:"id,omitempty"`                     //  (Required)
+		AssetString string       `json:"asset_string" url:"asset_string,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -31,7 +35,7 @@ func (t *GetCustomColor) GetMethod() string {
 
 func (t *GetCustomColor) GetURLPath() string {
 	path := "users/{id}/colors/{asset_string}"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	path = strings.ReplaceAll(path, "{asset_string}", fmt.Sprintf("%v", t.Path.AssetString))
 	return path
 }
@@ -49,24 +53,43 @@ func (t *GetCustomColor) GetJSON() ([]byte, error) {
 }
 
 func (t *GetCustomColor) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.AssetString == "" {
-		errs = append(errs, "'Path.AssetString' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AssetString", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *GetCustomColor) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *GetCustomColor) Do(c *canvasapi.Canvas) (*models.CustomColor, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetCustomColor) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.CustomColor, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.CustomColor{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,11 +1,14 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // GetDashboardPositions Returns all dashboard positions that have been saved for a user.
@@ -16,7 +19,7 @@ import (
 //
 type GetDashboardPositions struct {
 	Path struct {
-		ID string `json:"id" url:"id,omitempty"` //  (Required)
+		ID canvasapi.ID `json:"id" url:"id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -26,7 +29,7 @@ func (t *GetDashboardPositions) GetMethod() string {
 
 func (t *GetDashboardPositions) GetURLPath() string {
 	path := "users/{id}/dashboard_positions"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -43,21 +46,40 @@ func (t *GetDashboardPositions) GetJSON() ([]byte, error) {
 }
 
 func (t *GetDashboardPositions) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *GetDashboardPositions) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *GetDashboardPositions) Do(c *canvasapi.Canvas) (*models.DashboardPositions, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetDashboardPositions) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.DashboardPositions, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.DashboardPositions{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
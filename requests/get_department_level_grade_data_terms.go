@@ -1,7 +1,9 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
@@ -27,8 +29,8 @@ import (
 //
 type GetDepartmentLevelGradeDataTerms struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
-		TermID    string `json:"term_id" url:"term_id,omitempty"`       //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		TermID    canvasapi.ID `json:"term_id" url:"term_id,omitempty"`       //  (Required)
 	} `json:"path"`
 }
 
@@ -38,8 +40,8 @@ func (t *GetDepartmentLevelGradeDataTerms) GetMethod() string {
 
 func (t *GetDepartmentLevelGradeDataTerms) GetURLPath() string {
 	path := "accounts/{account_id}/analytics/terms/{term_id}/grades"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
-	path = strings.ReplaceAll(path, "{term_id}", fmt.Sprintf("%v", t.Path.TermID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
+	path = strings.ReplaceAll(path, "{term_id}", t.Path.TermID.Escaped())
 	return path
 }
 
@@ -56,24 +58,34 @@ func (t *GetDepartmentLevelGradeDataTerms) GetJSON() ([]byte, error) {
 }
 
 func (t *GetDepartmentLevelGradeDataTerms) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.TermID == "" {
-		errs = append(errs, "'Path.TermID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.TermID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *GetDepartmentLevelGradeDataTerms) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *GetDepartmentLevelGradeDataTerms) Do(c *canvasapi.Canvas) (json.RawMessage, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetDepartmentLevelGradeDataTerms) DoContext(ctx context.Context, c *canvasapi.Canvas) (json.RawMessage, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(body), nil
 }
@@ -1,7 +1,9 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
@@ -38,7 +40,7 @@ import (
 //
 type GetDepartmentLevelParticipationDataCompleted struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -48,7 +50,7 @@ func (t *GetDepartmentLevelParticipationDataCompleted) GetMethod() string {
 
 func (t *GetDepartmentLevelParticipationDataCompleted) GetURLPath() string {
 	path := "accounts/{account_id}/analytics/completed/activity"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	return path
 }
 
@@ -65,21 +67,31 @@ func (t *GetDepartmentLevelParticipationDataCompleted) GetJSON() ([]byte, error)
 }
 
 func (t *GetDepartmentLevelParticipationDataCompleted) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *GetDepartmentLevelParticipationDataCompleted) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *GetDepartmentLevelParticipationDataCompleted) Do(c *canvasapi.Canvas) (json.RawMessage, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetDepartmentLevelParticipationDataCompleted) DoContext(ctx context.Context, c *canvasapi.Canvas) (json.RawMessage, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(body), nil
 }
@@ -1,7 +1,9 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
@@ -19,8 +21,8 @@ import (
 //
 type GetDepartmentLevelStatisticsTerms struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
-		TermID    string `json:"term_id" url:"term_id,omitempty"`       //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		TermID    canvasapi.ID `json:"term_id" url:"term_id,omitempty"`       //  (Required)
 	} `json:"path"`
 }
 
@@ -30,8 +32,8 @@ func (t *GetDepartmentLevelStatisticsTerms) GetMethod() string {
 
 func (t *GetDepartmentLevelStatisticsTerms) GetURLPath() string {
 	path := "accounts/{account_id}/analytics/terms/{term_id}/statistics"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
-	path = strings.ReplaceAll(path, "{term_id}", fmt.Sprintf("%v", t.Path.TermID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
+	path = strings.ReplaceAll(path, "{term_id}", t.Path.TermID.Escaped())
 	return path
 }
 
@@ -48,24 +50,34 @@ func (t *GetDepartmentLevelStatisticsTerms) GetJSON() ([]byte, error) {
 }
 
 func (t *GetDepartmentLevelStatisticsTerms) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.TermID == "" {
-		errs = append(errs, "'Path.TermID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.TermID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *GetDepartmentLevelStatisticsTerms) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *GetDepartmentLevelStatisticsTerms) Do(c *canvasapi.Canvas) (json.RawMessage, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetDepartmentLevelStatisticsTerms) DoContext(ctx context.Context, c *canvasapi.Canvas) (json.RawMessage, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(body), nil
 }
@@ -1,6 +1,7 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -24,8 +25,8 @@ import (
 //
 type GetFeatureFlagAccounts struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
-		Feature   string `json:"feature" url:"feature,omitempty"`       //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		Feature   string       `json:"feature" url:"feature,omitempty"`       //  (Required)
 	} `json:"path"`
 }
 
@@ -35,7 +36,7 @@ func (t *GetFeatureFlagAccounts) GetMethod() string {
 
 func (t *GetFeatureFlagAccounts) GetURLPath() string {
 	path := "accounts/{account_id}/features/flags/{feature}"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	path = strings.ReplaceAll(path, "{feature}", fmt.Sprintf("%v", t.Path.Feature))
 	return path
 }
@@ -53,21 +54,25 @@ func (t *GetFeatureFlagAccounts) GetJSON() ([]byte, error) {
 }
 
 func (t *GetFeatureFlagAccounts) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.Feature == "" {
-		errs = append(errs, "'Path.Feature' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Feature", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *GetFeatureFlagAccounts) Do(c *canvasapi.Canvas) (*models.FeatureFlag, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetFeatureFlagAccounts) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.FeatureFlag, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -82,6 +87,10 @@ func (t *GetFeatureFlagAccounts) Do(c *canvasapi.Canvas) (*models.FeatureFlag, e
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,6 +1,7 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -24,8 +25,8 @@ import (
 //
 type GetFeatureFlagCourses struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		Feature  string `json:"feature" url:"feature,omitempty"`     //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		Feature  string       `json:"feature" url:"feature,omitempty"`     //  (Required)
 	} `json:"path"`
 }
 
@@ -35,7 +36,7 @@ func (t *GetFeatureFlagCourses) GetMethod() string {
 
 func (t *GetFeatureFlagCourses) GetURLPath() string {
 	path := "courses/{course_id}/features/flags/{feature}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	path = strings.ReplaceAll(path, "{feature}", fmt.Sprintf("%v", t.Path.Feature))
 	return path
 }
@@ -53,21 +54,25 @@ func (t *GetFeatureFlagCourses) GetJSON() ([]byte, error) {
 }
 
 func (t *GetFeatureFlagCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.Feature == "" {
-		errs = append(errs, "'Path.Feature' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Feature", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *GetFeatureFlagCourses) Do(c *canvasapi.Canvas) (*models.FeatureFlag, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetFeatureFlagCourses) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.FeatureFlag, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -82,6 +87,10 @@ func (t *GetFeatureFlagCourses) Do(c *canvasapi.Canvas) (*models.FeatureFlag, er
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
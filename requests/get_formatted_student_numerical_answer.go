@@ -1,12 +1,12 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 )
 
@@ -23,12 +23,12 @@ import (
 //
 type GetFormattedStudentNumericalAnswer struct {
 	Path struct {
-		QuizSubmissionID string `json:"quiz_submission_id" url:"quiz_submission_id,omitempty"` //  (Required)
-		ID               string `json:"id" url:"id,omitempty"`                                 //  (Required)
+		QuizSubmissionID canvasapi.ID `json:"quiz_submission_id" url:"quiz_submission_id,omitempty"` //  (Required)
+		ID               canvasapi.ID `json:"id" url:"id,omitempty"`                                 //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		Answer float64 `json:"answer" url:"answer,omitempty"` //  (Required)
+		Answer canvasapi.Opt[float64] `json:"answer" url:"answer,omitempty"` //  (Required)
 	} `json:"query"`
 }
 
@@ -38,13 +38,13 @@ func (t *GetFormattedStudentNumericalAnswer) GetMethod() string {
 
 func (t *GetFormattedStudentNumericalAnswer) GetURLPath() string {
 	path := "quiz_submissions/{quiz_submission_id}/questions/{id}/formatted_answer"
-	path = strings.ReplaceAll(path, "{quiz_submission_id}", fmt.Sprintf("%v", t.Path.QuizSubmissionID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{quiz_submission_id}", t.Path.QuizSubmissionID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
 func (t *GetFormattedStudentNumericalAnswer) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -60,24 +60,37 @@ func (t *GetFormattedStudentNumericalAnswer) GetJSON() ([]byte, error) {
 }
 
 func (t *GetFormattedStudentNumericalAnswer) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.QuizSubmissionID == "" {
-		errs = append(errs, "'Path.QuizSubmissionID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.QuizSubmissionID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
+	}
+	if !t.Query.Answer.IsSet() {
+		errs = append(errs, canvasapi.FieldError{Field: "Query.Answer", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *GetFormattedStudentNumericalAnswer) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *GetFormattedStudentNumericalAnswer) Do(c *canvasapi.Canvas) (json.RawMessage, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetFormattedStudentNumericalAnswer) DoContext(ctx context.Context, c *canvasapi.Canvas) (json.RawMessage, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(body), nil
 }
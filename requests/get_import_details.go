@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -23,9 +23,9 @@ import (
 //
 type GetImportDetails struct {
 	Path struct {
-		CourseID       string `json:"course_id" url:"course_id,omitempty"`             //  (Required)
-		SubscriptionID string `json:"subscription_id" url:"subscription_id,omitempty"` //  (Required)
-		ID             string `json:"id" url:"id,omitempty"`                           //  (Required)
+		CourseID       canvasapi.ID `json:"course_id" url:"course_id,omitempty"`             //  (Required)
+		SubscriptionID canvasapi.ID `json:"subscription_id" url:"subscription_id,omitempty"` //  (Required)
+		ID             canvasapi.ID `json:"id" url:"id,omitempty"`                           //  (Required)
 	} `json:"path"`
 }
 
@@ -35,9 +35,9 @@ func (t *GetImportDetails) GetMethod() string {
 
 func (t *GetImportDetails) GetURLPath() string {
 	path := "courses/{course_id}/blueprint_subscriptions/{subscription_id}/migrations/{id}/details"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{subscription_id}", fmt.Sprintf("%v", t.Path.SubscriptionID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{subscription_id}", t.Path.SubscriptionID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -54,29 +54,33 @@ func (t *GetImportDetails) GetJSON() ([]byte, error) {
 }
 
 func (t *GetImportDetails) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.SubscriptionID == "" {
-		errs = append(errs, "'Path.SubscriptionID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.SubscriptionID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *GetImportDetails) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.ChangeRecord, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *GetImportDetails) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.ChangeRecord, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -96,6 +100,10 @@ func (t *GetImportDetails) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Cha
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
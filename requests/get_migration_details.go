@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -23,9 +23,9 @@ import (
 //
 type GetMigrationDetails struct {
 	Path struct {
-		CourseID   string `json:"course_id" url:"course_id,omitempty"`     //  (Required)
-		TemplateID string `json:"template_id" url:"template_id,omitempty"` //  (Required)
-		ID         string `json:"id" url:"id,omitempty"`                   //  (Required)
+		CourseID   canvasapi.ID `json:"course_id" url:"course_id,omitempty"`     //  (Required)
+		TemplateID canvasapi.ID `json:"template_id" url:"template_id,omitempty"` //  (Required)
+		ID         canvasapi.ID `json:"id" url:"id,omitempty"`                   //  (Required)
 	} `json:"path"`
 }
 
@@ -35,9 +35,9 @@ func (t *GetMigrationDetails) GetMethod() string {
 
 func (t *GetMigrationDetails) GetURLPath() string {
 	path := "courses/{course_id}/blueprint_templates/{template_id}/migrations/{id}/details"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{template_id}", fmt.Sprintf("%v", t.Path.TemplateID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{template_id}", t.Path.TemplateID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -54,29 +54,33 @@ func (t *GetMigrationDetails) GetJSON() ([]byte, error) {
 }
 
 func (t *GetMigrationDetails) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.TemplateID == "" {
-		errs = append(errs, "'Path.TemplateID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.TemplateID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *GetMigrationDetails) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.ChangeRecord, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *GetMigrationDetails) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.ChangeRecord, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -96,6 +100,10 @@ func (t *GetMigrationDetails) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
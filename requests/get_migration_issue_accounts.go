@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -21,9 +21,9 @@ import (
 //
 type GetMigrationIssueAccounts struct {
 	Path struct {
-		AccountID          string `json:"account_id" url:"account_id,omitempty"`                     //  (Required)
-		ContentMigrationID string `json:"content_migration_id" url:"content_migration_id,omitempty"` //  (Required)
-		ID                 string `json:"id" url:"id,omitempty"`                                     //  (Required)
+		AccountID          canvasapi.ID `json:"account_id" url:"account_id,omitempty"`                     //  (Required)
+		ContentMigrationID canvasapi.ID `json:"content_migration_id" url:"content_migration_id,omitempty"` //  (Required)
+		ID                 canvasapi.ID `json:"id" url:"id,omitempty"`                                     //  (Required)
 	} `json:"path"`
 }
 
@@ -33,9 +33,9 @@ func (t *GetMigrationIssueAccounts) GetMethod() string {
 
 func (t *GetMigrationIssueAccounts) GetURLPath() string {
 	path := "accounts/{account_id}/content_migrations/{content_migration_id}/migration_issues/{id}"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
-	path = strings.ReplaceAll(path, "{content_migration_id}", fmt.Sprintf("%v", t.Path.ContentMigrationID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
+	path = strings.ReplaceAll(path, "{content_migration_id}", t.Path.ContentMigrationID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -52,24 +52,28 @@ func (t *GetMigrationIssueAccounts) GetJSON() ([]byte, error) {
 }
 
 func (t *GetMigrationIssueAccounts) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ContentMigrationID == "" {
-		errs = append(errs, "'Path.ContentMigrationID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ContentMigrationID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *GetMigrationIssueAccounts) Do(c *canvasapi.Canvas) (*models.MigrationIssue, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetMigrationIssueAccounts) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.MigrationIssue, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -84,6 +88,10 @@ func (t *GetMigrationIssueAccounts) Do(c *canvasapi.Canvas) (*models.MigrationIs
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
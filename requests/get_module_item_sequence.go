@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -29,12 +27,12 @@ import (
 //
 type GetModuleItemSequence struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		AssetType string `json:"asset_type" url:"asset_type,omitempty"` //  (Optional) . Must be one of ModuleItem, File, Page, Discussion, Assignment, Quiz, ExternalTool
-		AssetID   int64  `json:"asset_id" url:"asset_id,omitempty"`     //  (Optional)
+		AssetType string               `json:"asset_type" url:"asset_type,omitempty"` //  (Optional) . Must be one of ModuleItem, File, Page, Discussion, Assignment, Quiz, ExternalTool
+		AssetID   canvasapi.Opt[int64] `json:"asset_id" url:"asset_id,omitempty"`     //  (Optional)
 	} `json:"query"`
 }
 
@@ -44,12 +42,12 @@ func (t *GetModuleItemSequence) GetMethod() string {
 
 func (t *GetModuleItemSequence) GetURLPath() string {
 	path := "courses/{course_id}/module_item_sequence"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
 func (t *GetModuleItemSequence) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -65,21 +63,25 @@ func (t *GetModuleItemSequence) GetJSON() ([]byte, error) {
 }
 
 func (t *GetModuleItemSequence) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Query.AssetType != "" && !string_utils.Include([]string{"ModuleItem", "File", "Page", "Discussion", "Assignment", "Quiz", "ExternalTool"}, t.Query.AssetType) {
-		errs = append(errs, "AssetType must be one of ModuleItem, File, Page, Discussion, Assignment, Quiz, ExternalTool")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.AssetType", Rule: canvasapi.RuleOneOf, Allowed: []string{"ModuleItem", "File", "Page", "Discussion", "Assignment", "Quiz", "ExternalTool"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *GetModuleItemSequence) Do(c *canvasapi.Canvas) (*models.ModuleItemSequence, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetModuleItemSequence) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.ModuleItemSequence, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -94,6 +96,10 @@ func (t *GetModuleItemSequence) Do(c *canvasapi.Canvas) (*models.ModuleItemSeque
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,13 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -31,11 +30,11 @@ func (t *GetNextAppointment) GetMethod() string {
 }
 
 func (t *GetNextAppointment) GetURLPath() string {
-	return ""
+	return "appointment_groups/next_appointment"
 }
 
 func (t *GetNextAppointment) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -55,12 +54,16 @@ func (t *GetNextAppointment) HasErrors() error {
 }
 
 func (t *GetNextAppointment) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.CalendarEvent, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *GetNextAppointment) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.CalendarEvent, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -80,6 +83,10 @@ func (t *GetNextAppointment) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.C
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
@@ -1,13 +1,15 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -46,19 +48,19 @@ import (
 //
 type GetOutcomeResultRollups struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		Aggregate     string   `json:"aggregate" url:"aggregate,omitempty"`             //  (Optional) . Must be one of course
-		AggregateStat string   `json:"aggregate_stat" url:"aggregate_stat,omitempty"`   //  (Optional) . Must be one of mean, median
-		UserIDs       []string `json:"user_ids" url:"user_ids,omitempty"`               //  (Optional)
-		OutcomeIDs    []string `json:"outcome_ids" url:"outcome_ids,omitempty"`         //  (Optional)
-		Include       []string `json:"include" url:"include,omitempty"`                 //  (Optional)
-		Exclude       []string `json:"exclude" url:"exclude,omitempty"`                 //  (Optional) . Must be one of missing_user_rollups
-		SortBy        string   `json:"sort_by" url:"sort_by,omitempty"`                 //  (Optional) . Must be one of student, outcome
-		SortOutcomeID int64    `json:"sort_outcome_id" url:"sort_outcome_id,omitempty"` //  (Optional)
-		SortOrder     string   `json:"sort_order" url:"sort_order,omitempty"`           //  (Optional) . Must be one of asc, desc
+		Aggregate     string               `json:"aggregate" url:"aggregate,omitempty"`             //  (Optional) . Must be one of course
+		AggregateStat string               `json:"aggregate_stat" url:"aggregate_stat,omitempty"`   //  (Optional) . Must be one of mean, median
+		UserIDs       []string             `json:"user_ids" url:"user_ids,omitempty"`               //  (Optional)
+		OutcomeIDs    []string             `json:"outcome_ids" url:"outcome_ids,omitempty"`         //  (Optional)
+		Include       []string             `json:"include" url:"include,omitempty"`                 //  (Optional)
+		Exclude       []string             `json:"exclude" url:"exclude,omitempty"`                 //  (Optional) . Must be one of missing_user_rollups
+		SortBy        string               `json:"sort_by" url:"sort_by,omitempty"`                 //  (Optional) . Must be one of student, outcome
+		SortOutcomeID canvasapi.Opt[int64] `json:"sort_outcome_id" url:"sort_outcome_id,omitempty"` //  (Optional)
+		SortOrder     string               `json:"sort_order" url:"sort_order,omitempty"`           //  (Optional) . Must be one of asc, desc
 	} `json:"query"`
 }
 
@@ -68,12 +70,12 @@ func (t *GetOutcomeResultRollups) GetMethod() string {
 
 func (t *GetOutcomeResultRollups) GetURLPath() string {
 	path := "courses/{course_id}/outcome_rollups"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
 func (t *GetOutcomeResultRollups) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -89,38 +91,69 @@ func (t *GetOutcomeResultRollups) GetJSON() ([]byte, error) {
 }
 
 func (t *GetOutcomeResultRollups) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Query.Aggregate != "" && !string_utils.Include([]string{"course"}, t.Query.Aggregate) {
-		errs = append(errs, "Aggregate must be one of course")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.Aggregate", Rule: canvasapi.RuleOneOf, Allowed: []string{"course"}})
 	}
 	if t.Query.AggregateStat != "" && !string_utils.Include([]string{"mean", "median"}, t.Query.AggregateStat) {
-		errs = append(errs, "AggregateStat must be one of mean, median")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.AggregateStat", Rule: canvasapi.RuleOneOf, Allowed: []string{"mean", "median"}})
 	}
 	for _, v := range t.Query.Exclude {
 		if v != "" && !string_utils.Include([]string{"missing_user_rollups"}, v) {
-			errs = append(errs, "Exclude must be one of missing_user_rollups")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.Exclude", Rule: canvasapi.RuleOneOf, Allowed: []string{"missing_user_rollups"}})
 		}
 	}
 	if t.Query.SortBy != "" && !string_utils.Include([]string{"student", "outcome"}, t.Query.SortBy) {
-		errs = append(errs, "SortBy must be one of student, outcome")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.SortBy", Rule: canvasapi.RuleOneOf, Allowed: []string{"student", "outcome"}})
 	}
 	if t.Query.SortOrder != "" && !string_utils.Include([]string{"asc", "desc"}, t.Query.SortOrder) {
-		errs = append(errs, "SortOrder must be one of asc, desc")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.SortOrder", Rule: canvasapi.RuleOneOf, Allowed: []string{"asc", "desc"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *GetOutcomeResultRollups) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *GetOutcomeResultRollups) Do(c *canvasapi.Canvas, next *url.URL) (*models.OutcomeRollupsResponse, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *GetOutcomeResultRollups) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) (*models.OutcomeRollupsResponse, *canvasapi.PagedResource, error) {
+	var err error
+	var response *http.Response
+	if next != nil {
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
+	} else {
+		response, err = c.SendRequestContext(ctx, t)
+	}
+
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	ret := models.OutcomeRollupsResponse{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &ret, pagedResource, nil
 }
@@ -1,13 +1,15 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // GetOutcomeResults Gets the outcome results for users and outcomes in the specified context.
@@ -35,14 +37,14 @@ import (
 //
 type GetOutcomeResults struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		UserIDs       []string `json:"user_ids" url:"user_ids,omitempty"`             //  (Optional)
-		OutcomeIDs    []string `json:"outcome_ids" url:"outcome_ids,omitempty"`       //  (Optional)
-		Include       []string `json:"include" url:"include,omitempty"`               //  (Optional)
-		IncludeHidden bool     `json:"include_hidden" url:"include_hidden,omitempty"` //  (Optional)
+		UserIDs       []string            `json:"user_ids" url:"user_ids,omitempty"`             //  (Optional)
+		OutcomeIDs    []string            `json:"outcome_ids" url:"outcome_ids,omitempty"`       //  (Optional)
+		Include       []string            `json:"include" url:"include,omitempty"`               //  (Optional)
+		IncludeHidden canvasapi.Opt[bool] `json:"include_hidden" url:"include_hidden,omitempty"` //  (Optional)
 	} `json:"query"`
 }
 
@@ -52,12 +54,12 @@ func (t *GetOutcomeResults) GetMethod() string {
 
 func (t *GetOutcomeResults) GetURLPath() string {
 	path := "courses/{course_id}/outcome_results"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
 func (t *GetOutcomeResults) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -73,21 +75,52 @@ func (t *GetOutcomeResults) GetJSON() ([]byte, error) {
 }
 
 func (t *GetOutcomeResults) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *GetOutcomeResults) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *GetOutcomeResults) Do(c *canvasapi.Canvas, next *url.URL) (*models.OutcomeResultsResponse, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *GetOutcomeResults) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) (*models.OutcomeResultsResponse, *canvasapi.PagedResource, error) {
+	var err error
+	var response *http.Response
+	if next != nil {
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
+	} else {
+		response, err = c.SendRequestContext(ctx, t)
+	}
+
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	ret := models.OutcomeResultsResponse{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &ret, pagedResource, nil
 }
@@ -1,11 +1,11 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
+	"io/ioutil"
 	"net/url"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 )
 
@@ -29,7 +29,7 @@ func (t *GetPandataEventsJwtTokenAndItsExpirationDate) GetMethod() string {
 }
 
 func (t *GetPandataEventsJwtTokenAndItsExpirationDate) GetURLPath() string {
-	return ""
+	return "users/self/pandata_events_token"
 }
 
 func (t *GetPandataEventsJwtTokenAndItsExpirationDate) GetQuery() (string, error) {
@@ -37,13 +37,13 @@ func (t *GetPandataEventsJwtTokenAndItsExpirationDate) GetQuery() (string, error
 }
 
 func (t *GetPandataEventsJwtTokenAndItsExpirationDate) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *GetPandataEventsJwtTokenAndItsExpirationDate) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
@@ -52,11 +52,21 @@ func (t *GetPandataEventsJwtTokenAndItsExpirationDate) HasErrors() error {
 	return nil
 }
 
-func (t *GetPandataEventsJwtTokenAndItsExpirationDate) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *GetPandataEventsJwtTokenAndItsExpirationDate) Do(c *canvasapi.Canvas) (json.RawMessage, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetPandataEventsJwtTokenAndItsExpirationDate) DoContext(ctx context.Context, c *canvasapi.Canvas) (json.RawMessage, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(body), nil
 }
@@ -1,6 +1,7 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -21,9 +22,9 @@ import (
 //
 type GetPreferenceCommunicationChannelID struct {
 	Path struct {
-		UserID                 string `json:"user_id" url:"user_id,omitempty"`                                   //  (Required)
-		CommunicationChannelID string `json:"communication_channel_id" url:"communication_channel_id,omitempty"` //  (Required)
-		Notification           string `json:"notification" url:"notification,omitempty"`                         //  (Required)
+		UserID                 canvasapi.ID `json:"user_id" url:"user_id,omitempty"`                                   //  (Required)
+		CommunicationChannelID canvasapi.ID `json:"communication_channel_id" url:"communication_channel_id,omitempty"` //  (Required)
+		Notification           string       `json:"notification" url:"notification,omitempty"`                         //  (Required)
 	} `json:"path"`
 }
 
@@ -33,8 +34,8 @@ func (t *GetPreferenceCommunicationChannelID) GetMethod() string {
 
 func (t *GetPreferenceCommunicationChannelID) GetURLPath() string {
 	path := "users/{user_id}/communication_channels/{communication_channel_id}/notification_preferences/{notification}"
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", t.Path.UserID))
-	path = strings.ReplaceAll(path, "{communication_channel_id}", fmt.Sprintf("%v", t.Path.CommunicationChannelID))
+	path = strings.ReplaceAll(path, "{user_id}", t.Path.UserID.Escaped())
+	path = strings.ReplaceAll(path, "{communication_channel_id}", t.Path.CommunicationChannelID.Escaped())
 	path = strings.ReplaceAll(path, "{notification}", fmt.Sprintf("%v", t.Path.Notification))
 	return path
 }
@@ -52,24 +53,28 @@ func (t *GetPreferenceCommunicationChannelID) GetJSON() ([]byte, error) {
 }
 
 func (t *GetPreferenceCommunicationChannelID) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.UserID == "" {
-		errs = append(errs, "'Path.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.CommunicationChannelID == "" {
-		errs = append(errs, "'Path.CommunicationChannelID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CommunicationChannelID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.Notification == "" {
-		errs = append(errs, "'Path.Notification' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Notification", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *GetPreferenceCommunicationChannelID) Do(c *canvasapi.Canvas) (*models.NotificationPreference, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetPreferenceCommunicationChannelID) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.NotificationPreference, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -84,6 +89,10 @@ func (t *GetPreferenceCommunicationChannelID) Do(c *canvasapi.Canvas) (*models.N
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,6 +1,7 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -22,10 +23,10 @@ import (
 //
 type GetPreferenceType struct {
 	Path struct {
-		UserID       string `json:"user_id" url:"user_id,omitempty"`           //  (Required)
-		Type         string `json:"type" url:"type,omitempty"`                 //  (Required)
-		Address      string `json:"address" url:"address,omitempty"`           //  (Required)
-		Notification string `json:"notification" url:"notification,omitempty"` //  (Required)
+		UserID       canvasapi.ID `json:"user_id" url:"user_id,omitempty"`           //  (Required)
+		Type         string       `json:"type" url:"type,omitempty"`                 //  (Required)
+		Address      string       `json:"address" url:"address,omitempty"`           //  (Required)
+		Notification string       `json:"notification" url:"notification,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -35,7 +36,7 @@ func (t *GetPreferenceType) GetMethod() string {
 
 func (t *GetPreferenceType) GetURLPath() string {
 	path := "users/{user_id}/communication_channels/{type}/{address}/notification_preferences/{notification}"
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", t.Path.UserID))
+	path = strings.ReplaceAll(path, "{user_id}", t.Path.UserID.Escaped())
 	path = strings.ReplaceAll(path, "{type}", fmt.Sprintf("%v", t.Path.Type))
 	path = strings.ReplaceAll(path, "{address}", fmt.Sprintf("%v", t.Path.Address))
 	path = strings.ReplaceAll(path, "{notification}", fmt.Sprintf("%v", t.Path.Notification))
@@ -55,27 +56,31 @@ func (t *GetPreferenceType) GetJSON() ([]byte, error) {
 }
 
 func (t *GetPreferenceType) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.UserID == "" {
-		errs = append(errs, "'Path.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.Type == "" {
-		errs = append(errs, "'Path.Type' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Type", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.Address == "" {
-		errs = append(errs, "'Path.Address' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Address", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.Notification == "" {
-		errs = append(errs, "'Path.Notification' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Notification", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *GetPreferenceType) Do(c *canvasapi.Canvas) (*models.NotificationPreference, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetPreferenceType) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.NotificationPreference, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -90,6 +95,10 @@ func (t *GetPreferenceType) Do(c *canvasapi.Canvas) (*models.NotificationPrefere
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
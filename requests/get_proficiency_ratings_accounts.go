@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -25,7 +25,7 @@ import (
 //
 type GetProficiencyRatingsAccounts struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -35,7 +35,7 @@ func (t *GetProficiencyRatingsAccounts) GetMethod() string {
 
 func (t *GetProficiencyRatingsAccounts) GetURLPath() string {
 	path := "accounts/{account_id}/outcome_proficiency"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	return path
 }
 
@@ -52,18 +52,22 @@ func (t *GetProficiencyRatingsAccounts) GetJSON() ([]byte, error) {
 }
 
 func (t *GetProficiencyRatingsAccounts) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *GetProficiencyRatingsAccounts) Do(c *canvasapi.Canvas) (*models.Proficiency, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetProficiencyRatingsAccounts) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Proficiency, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -78,6 +82,10 @@ func (t *GetProficiencyRatingsAccounts) Do(c *canvasapi.Canvas) (*models.Profici
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
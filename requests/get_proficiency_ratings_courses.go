@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -25,7 +25,7 @@ import (
 //
 type GetProficiencyRatingsCourses struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -35,7 +35,7 @@ func (t *GetProficiencyRatingsCourses) GetMethod() string {
 
 func (t *GetProficiencyRatingsCourses) GetURLPath() string {
 	path := "courses/{course_id}/outcome_proficiency"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
@@ -52,18 +52,22 @@ func (t *GetProficiencyRatingsCourses) GetJSON() ([]byte, error) {
 }
 
 func (t *GetProficiencyRatingsCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *GetProficiencyRatingsCourses) Do(c *canvasapi.Canvas) (*models.Proficiency, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetProficiencyRatingsCourses) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Proficiency, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -78,6 +82,10 @@ func (t *GetProficiencyRatingsCourses) Do(c *canvasapi.Canvas) (*models.Proficie
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
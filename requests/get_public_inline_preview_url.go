@@ -1,12 +1,12 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 )
 
@@ -23,11 +23,11 @@ import (
 //
 type GetPublicInlinePreviewUrl struct {
 	Path struct {
-		ID string `json:"id" url:"id,omitempty"` //  (Required)
+		ID canvasapi.ID `json:"id" url:"id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		SubmissionID int64 `json:"submission_id" url:"submission_id,omitempty"` //  (Optional)
+		SubmissionID canvasapi.Opt[int64] `json:"submission_id" url:"submission_id,omitempty"` //  (Optional)
 	} `json:"query"`
 }
 
@@ -37,12 +37,12 @@ func (t *GetPublicInlinePreviewUrl) GetMethod() string {
 
 func (t *GetPublicInlinePreviewUrl) GetURLPath() string {
 	path := "files/{id}/public_url"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
 func (t *GetPublicInlinePreviewUrl) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -58,21 +58,31 @@ func (t *GetPublicInlinePreviewUrl) GetJSON() ([]byte, error) {
 }
 
 func (t *GetPublicInlinePreviewUrl) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *GetPublicInlinePreviewUrl) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *GetPublicInlinePreviewUrl) Do(c *canvasapi.Canvas) (json.RawMessage, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetPublicInlinePreviewUrl) DoContext(ctx context.Context, c *canvasapi.Canvas) (json.RawMessage, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(body), nil
 }
@@ -1,11 +1,14 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // GetQuotaInformationGroups Returns the total and used storage quota for the course, group, or user.
@@ -16,7 +19,7 @@ import (
 //
 type GetQuotaInformationGroups struct {
 	Path struct {
-		GroupID string `json:"group_id" url:"group_id,omitempty"` //  (Required)
+		GroupID canvasapi.ID `json:"group_id" url:"group_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -26,7 +29,7 @@ func (t *GetQuotaInformationGroups) GetMethod() string {
 
 func (t *GetQuotaInformationGroups) GetURLPath() string {
 	path := "groups/{group_id}/files/quota"
-	path = strings.ReplaceAll(path, "{group_id}", fmt.Sprintf("%v", t.Path.GroupID))
+	path = strings.ReplaceAll(path, "{group_id}", t.Path.GroupID.Escaped())
 	return path
 }
 
@@ -43,21 +46,40 @@ func (t *GetQuotaInformationGroups) GetJSON() ([]byte, error) {
 }
 
 func (t *GetQuotaInformationGroups) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.GroupID == "" {
-		errs = append(errs, "'Path.GroupID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.GroupID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *GetQuotaInformationGroups) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *GetQuotaInformationGroups) Do(c *canvasapi.Canvas) (*models.Quota, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetQuotaInformationGroups) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Quota, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.Quota{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,11 +1,14 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // GetQuotaInformationUsers Returns the total and used storage quota for the course, group, or user.
@@ -16,7 +19,7 @@ import (
 //
 type GetQuotaInformationUsers struct {
 	Path struct {
-		UserID string `json:"user_id" url:"user_id,omitempty"` //  (Required)
+		UserID canvasapi.ID `json:"user_id" url:"user_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -26,7 +29,7 @@ func (t *GetQuotaInformationUsers) GetMethod() string {
 
 func (t *GetQuotaInformationUsers) GetURLPath() string {
 	path := "users/{user_id}/files/quota"
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", t.Path.UserID))
+	path = strings.ReplaceAll(path, "{user_id}", t.Path.UserID.Escaped())
 	return path
 }
 
@@ -43,21 +46,40 @@ func (t *GetQuotaInformationUsers) GetJSON() ([]byte, error) {
 }
 
 func (t *GetQuotaInformationUsers) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.UserID == "" {
-		errs = append(errs, "'Path.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *GetQuotaInformationUsers) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *GetQuotaInformationUsers) Do(c *canvasapi.Canvas) (*models.Quota, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetQuotaInformationUsers) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Quota, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.Quota{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,6 +1,9 @@
 package requests
 
 import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 
 	"github.com/atomicjolt/canvasapi"
@@ -19,7 +22,7 @@ func (t *GetRunningBatches) GetMethod() string {
 }
 
 func (t *GetRunningBatches) GetURLPath() string {
-	return ""
+	return "conversations/batches"
 }
 
 func (t *GetRunningBatches) GetQuery() (string, error) {
@@ -38,11 +41,21 @@ func (t *GetRunningBatches) HasErrors() error {
 	return nil
 }
 
-func (t *GetRunningBatches) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *GetRunningBatches) Do(c *canvasapi.Canvas) (json.RawMessage, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetRunningBatches) DoContext(ctx context.Context, c *canvasapi.Canvas) (json.RawMessage, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(body), nil
 }
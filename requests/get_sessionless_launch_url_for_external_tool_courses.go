@@ -1,13 +1,14 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -30,7 +31,7 @@ import (
 //
 type GetSessionlessLaunchUrlForExternalToolCourses struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
@@ -48,12 +49,12 @@ func (t *GetSessionlessLaunchUrlForExternalToolCourses) GetMethod() string {
 
 func (t *GetSessionlessLaunchUrlForExternalToolCourses) GetURLPath() string {
 	path := "courses/{course_id}/external_tools/sessionless_launch"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
 func (t *GetSessionlessLaunchUrlForExternalToolCourses) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -69,24 +70,43 @@ func (t *GetSessionlessLaunchUrlForExternalToolCourses) GetJSON() ([]byte, error
 }
 
 func (t *GetSessionlessLaunchUrlForExternalToolCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Query.LaunchType != "" && !string_utils.Include([]string{"assessment", "module_item"}, t.Query.LaunchType) {
-		errs = append(errs, "LaunchType must be one of assessment, module_item")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.LaunchType", Rule: canvasapi.RuleOneOf, Allowed: []string{"assessment", "module_item"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *GetSessionlessLaunchUrlForExternalToolCourses) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *GetSessionlessLaunchUrlForExternalToolCourses) Do(c *canvasapi.Canvas) (*models.SessionlessLaunch, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetSessionlessLaunchUrlForExternalToolCourses) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.SessionlessLaunch, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.SessionlessLaunch{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,13 +1,14 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -27,7 +28,7 @@ import (
 //
 type GetSingleAppointmentGroup struct {
 	Path struct {
-		ID string `json:"id" url:"id,omitempty"` //  (Required)
+		ID canvasapi.ID `json:"id" url:"id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
@@ -41,12 +42,12 @@ func (t *GetSingleAppointmentGroup) GetMethod() string {
 
 func (t *GetSingleAppointmentGroup) GetURLPath() string {
 	path := "appointment_groups/{id}"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
 func (t *GetSingleAppointmentGroup) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -62,26 +63,45 @@ func (t *GetSingleAppointmentGroup) GetJSON() ([]byte, error) {
 }
 
 func (t *GetSingleAppointmentGroup) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	for _, v := range t.Query.Include {
 		if v != "" && !string_utils.Include([]string{"child_events", "appointments", "all_context_codes"}, v) {
-			errs = append(errs, "Include must be one of child_events, appointments, all_context_codes")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.Include", Rule: canvasapi.RuleOneOf, Allowed: []string{"child_events", "appointments", "all_context_codes"}})
 		}
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *GetSingleAppointmentGroup) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *GetSingleAppointmentGroup) Do(c *canvasapi.Canvas) (*models.AppointmentGroup, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetSingleAppointmentGroup) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.AppointmentGroup, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.AppointmentGroup{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
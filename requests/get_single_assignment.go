@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -32,15 +30,15 @@ import (
 //
 type GetSingleAssignment struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		ID       string `json:"id" url:"id,omitempty"`               //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		ID       canvasapi.ID `json:"id" url:"id,omitempty"`               //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		Include                    []string `json:"include" url:"include,omitempty"`                                               //  (Optional) . Must be one of submission, assignment_visibility, overrides, observed_users, can_edit, score_statistics
-		OverrideAssignmentDates    bool     `json:"override_assignment_dates" url:"override_assignment_dates,omitempty"`           //  (Optional)
-		NeedsGradingCountBySection bool     `json:"needs_grading_count_by_section" url:"needs_grading_count_by_section,omitempty"` //  (Optional)
-		AllDates                   bool     `json:"all_dates" url:"all_dates,omitempty"`                                           //  (Optional)
+		Include                    []string            `json:"include" url:"include,omitempty"`                                               //  (Optional) . Must be one of submission, assignment_visibility, overrides, observed_users, can_edit, score_statistics
+		OverrideAssignmentDates    canvasapi.Opt[bool] `json:"override_assignment_dates" url:"override_assignment_dates,omitempty"`           //  (Optional)
+		NeedsGradingCountBySection canvasapi.Opt[bool] `json:"needs_grading_count_by_section" url:"needs_grading_count_by_section,omitempty"` //  (Optional)
+		AllDates                   canvasapi.Opt[bool] `json:"all_dates" url:"all_dates,omitempty"`                                           //  (Optional)
 	} `json:"query"`
 }
 
@@ -50,13 +48,13 @@ func (t *GetSingleAssignment) GetMethod() string {
 
 func (t *GetSingleAssignment) GetURLPath() string {
 	path := "courses/{course_id}/assignments/{id}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
 func (t *GetSingleAssignment) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -72,26 +70,30 @@ func (t *GetSingleAssignment) GetJSON() ([]byte, error) {
 }
 
 func (t *GetSingleAssignment) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	for _, v := range t.Query.Include {
 		if v != "" && !string_utils.Include([]string{"submission", "assignment_visibility", "overrides", "observed_users", "can_edit", "score_statistics"}, v) {
-			errs = append(errs, "Include must be one of submission, assignment_visibility, overrides, observed_users, can_edit, score_statistics")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.Include", Rule: canvasapi.RuleOneOf, Allowed: []string{"submission", "assignment_visibility", "overrides", "observed_users", "can_edit", "score_statistics"}})
 		}
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *GetSingleAssignment) Do(c *canvasapi.Canvas) (*models.Assignment, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetSingleAssignment) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Assignment, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -106,6 +108,10 @@ func (t *GetSingleAssignment) Do(c *canvasapi.Canvas) (*models.Assignment, error
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -25,7 +23,7 @@ import (
 //
 type GetSingleAssignmentLti struct {
 	Path struct {
-		AssignmentID string `json:"assignment_id" url:"assignment_id,omitempty"` //  (Required)
+		AssignmentID canvasapi.ID `json:"assignment_id" url:"assignment_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
@@ -39,12 +37,12 @@ func (t *GetSingleAssignmentLti) GetMethod() string {
 
 func (t *GetSingleAssignmentLti) GetURLPath() string {
 	path := "/lti/assignments/{assignment_id}"
-	path = strings.ReplaceAll(path, "{assignment_id}", fmt.Sprintf("%v", t.Path.AssignmentID))
+	path = strings.ReplaceAll(path, "{assignment_id}", t.Path.AssignmentID.Escaped())
 	return path
 }
 
 func (t *GetSingleAssignmentLti) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -60,18 +58,22 @@ func (t *GetSingleAssignmentLti) GetJSON() ([]byte, error) {
 }
 
 func (t *GetSingleAssignmentLti) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AssignmentID == "" {
-		errs = append(errs, "'Path.AssignmentID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AssignmentID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *GetSingleAssignmentLti) Do(c *canvasapi.Canvas) (*models.LtiAssignment, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetSingleAssignmentLti) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.LtiAssignment, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -86,6 +88,10 @@ func (t *GetSingleAssignmentLti) Do(c *canvasapi.Canvas) (*models.LtiAssignment,
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,13 +1,14 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -35,15 +36,15 @@ import (
 //
 type GetSingleConversation struct {
 	Path struct {
-		ID string `json:"id" url:"id,omitempty"` //  (Required)
+		ID canvasapi.ID `json:"id" url:"id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		InterleaveSubmissions bool     `json:"interleave_submissions" url:"interleave_submissions,omitempty"` //  (Optional)
-		Scope                 string   `json:"scope" url:"scope,omitempty"`                                   //  (Optional) . Must be one of unread, starred, archived
-		Filter                []string `json:"filter" url:"filter,omitempty"`                                 //  (Optional)
-		FilterMode            string   `json:"filter_mode" url:"filter_mode,omitempty"`                       //  (Optional) . Must be one of and, or, default or
-		AutoMarkAsRead        bool     `json:"auto_mark_as_read" url:"auto_mark_as_read,omitempty"`           //  (Optional)
+		InterleaveSubmissions canvasapi.Opt[bool] `json:"interleave_submissions" url:"interleave_submissions,omitempty"` //  (Optional)
+		Scope                 string              `json:"scope" url:"scope,omitempty"`                                   //  (Optional) . Must be one of unread, starred, archived
+		Filter                []string            `json:"filter" url:"filter,omitempty"`                                 //  (Optional)
+		FilterMode            string              `json:"filter_mode" url:"filter_mode,omitempty"`                       //  (Optional) . Must be one of and, or, default or
+		AutoMarkAsRead        canvasapi.Opt[bool] `json:"auto_mark_as_read" url:"auto_mark_as_read,omitempty"`           //  (Optional)
 	} `json:"query"`
 }
 
@@ -53,12 +54,12 @@ func (t *GetSingleConversation) GetMethod() string {
 
 func (t *GetSingleConversation) GetURLPath() string {
 	path := "conversations/{id}"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
 func (t *GetSingleConversation) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -74,27 +75,46 @@ func (t *GetSingleConversation) GetJSON() ([]byte, error) {
 }
 
 func (t *GetSingleConversation) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Query.Scope != "" && !string_utils.Include([]string{"unread", "starred", "archived"}, t.Query.Scope) {
-		errs = append(errs, "Scope must be one of unread, starred, archived")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.Scope", Rule: canvasapi.RuleOneOf, Allowed: []string{"unread", "starred", "archived"}})
 	}
 	if t.Query.FilterMode != "" && !string_utils.Include([]string{"and", "or", "default or"}, t.Query.FilterMode) {
-		errs = append(errs, "FilterMode must be one of and, or, default or")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.FilterMode", Rule: canvasapi.RuleOneOf, Allowed: []string{"and", "or", "default or"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *GetSingleConversation) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *GetSingleConversation) Do(c *canvasapi.Canvas) (*models.Conversation, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetSingleConversation) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Conversation, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.Conversation{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -20,8 +20,8 @@ import (
 //
 type GetSingleGradingStandardInContextAccounts struct {
 	Path struct {
-		AccountID         string `json:"account_id" url:"account_id,omitempty"`                   //  (Required)
-		GradingStandardID string `json:"grading_standard_id" url:"grading_standard_id,omitempty"` //  (Required)
+		AccountID         canvasapi.ID `json:"account_id" url:"account_id,omitempty"`                   //  (Required)
+		GradingStandardID canvasapi.ID `json:"grading_standard_id" url:"grading_standard_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -31,8 +31,8 @@ func (t *GetSingleGradingStandardInContextAccounts) GetMethod() string {
 
 func (t *GetSingleGradingStandardInContextAccounts) GetURLPath() string {
 	path := "accounts/{account_id}/grading_standards/{grading_standard_id}"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
-	path = strings.ReplaceAll(path, "{grading_standard_id}", fmt.Sprintf("%v", t.Path.GradingStandardID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
+	path = strings.ReplaceAll(path, "{grading_standard_id}", t.Path.GradingStandardID.Escaped())
 	return path
 }
 
@@ -49,21 +49,25 @@ func (t *GetSingleGradingStandardInContextAccounts) GetJSON() ([]byte, error) {
 }
 
 func (t *GetSingleGradingStandardInContextAccounts) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.GradingStandardID == "" {
-		errs = append(errs, "'Path.GradingStandardID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.GradingStandardID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *GetSingleGradingStandardInContextAccounts) Do(c *canvasapi.Canvas) (*models.GradingStandard, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetSingleGradingStandardInContextAccounts) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.GradingStandard, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -78,6 +82,10 @@ func (t *GetSingleGradingStandardInContextAccounts) Do(c *canvasapi.Canvas) (*mo
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -20,8 +20,8 @@ import (
 //
 type GetSingleGradingStandardInContextCourses struct {
 	Path struct {
-		CourseID          string `json:"course_id" url:"course_id,omitempty"`                     //  (Required)
-		GradingStandardID string `json:"grading_standard_id" url:"grading_standard_id,omitempty"` //  (Required)
+		CourseID          canvasapi.ID `json:"course_id" url:"course_id,omitempty"`                     //  (Required)
+		GradingStandardID canvasapi.ID `json:"grading_standard_id" url:"grading_standard_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -31,8 +31,8 @@ func (t *GetSingleGradingStandardInContextCourses) GetMethod() string {
 
 func (t *GetSingleGradingStandardInContextCourses) GetURLPath() string {
 	path := "courses/{course_id}/grading_standards/{grading_standard_id}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{grading_standard_id}", fmt.Sprintf("%v", t.Path.GradingStandardID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{grading_standard_id}", t.Path.GradingStandardID.Escaped())
 	return path
 }
 
@@ -49,21 +49,25 @@ func (t *GetSingleGradingStandardInContextCourses) GetJSON() ([]byte, error) {
 }
 
 func (t *GetSingleGradingStandardInContextCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.GradingStandardID == "" {
-		errs = append(errs, "'Path.GradingStandardID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.GradingStandardID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *GetSingleGradingStandardInContextCourses) Do(c *canvasapi.Canvas) (*models.GradingStandard, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetSingleGradingStandardInContextCourses) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.GradingStandard, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -78,6 +82,10 @@ func (t *GetSingleGradingStandardInContextCourses) Do(c *canvasapi.Canvas) (*mod
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -20,7 +20,7 @@ import (
 //
 type GetSingleGroupCategory struct {
 	Path struct {
-		GroupCategoryID string `json:"group_category_id" url:"group_category_id,omitempty"` //  (Required)
+		GroupCategoryID canvasapi.ID `json:"group_category_id" url:"group_category_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -30,7 +30,7 @@ func (t *GetSingleGroupCategory) GetMethod() string {
 
 func (t *GetSingleGroupCategory) GetURLPath() string {
 	path := "group_categories/{group_category_id}"
-	path = strings.ReplaceAll(path, "{group_category_id}", fmt.Sprintf("%v", t.Path.GroupCategoryID))
+	path = strings.ReplaceAll(path, "{group_category_id}", t.Path.GroupCategoryID.Escaped())
 	return path
 }
 
@@ -47,18 +47,22 @@ func (t *GetSingleGroupCategory) GetJSON() ([]byte, error) {
 }
 
 func (t *GetSingleGroupCategory) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.GroupCategoryID == "" {
-		errs = append(errs, "'Path.GroupCategoryID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.GroupCategoryID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *GetSingleGroupCategory) Do(c *canvasapi.Canvas) (*models.GroupCategory, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetSingleGroupCategory) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.GroupCategory, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -73,6 +77,10 @@ func (t *GetSingleGroupCategory) Do(c *canvasapi.Canvas) (*models.GroupCategory,
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -20,8 +20,8 @@ import (
 //
 type GetSingleGroupMembershipMemberships struct {
 	Path struct {
-		GroupID      string `json:"group_id" url:"group_id,omitempty"`           //  (Required)
-		MembershipID string `json:"membership_id" url:"membership_id,omitempty"` //  (Required)
+		GroupID      canvasapi.ID `json:"group_id" url:"group_id,omitempty"`           //  (Required)
+		MembershipID canvasapi.ID `json:"membership_id" url:"membership_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -31,8 +31,8 @@ func (t *GetSingleGroupMembershipMemberships) GetMethod() string {
 
 func (t *GetSingleGroupMembershipMemberships) GetURLPath() string {
 	path := "groups/{group_id}/memberships/{membership_id}"
-	path = strings.ReplaceAll(path, "{group_id}", fmt.Sprintf("%v", t.Path.GroupID))
-	path = strings.ReplaceAll(path, "{membership_id}", fmt.Sprintf("%v", t.Path.MembershipID))
+	path = strings.ReplaceAll(path, "{group_id}", t.Path.GroupID.Escaped())
+	path = strings.ReplaceAll(path, "{membership_id}", t.Path.MembershipID.Escaped())
 	return path
 }
 
@@ -49,21 +49,25 @@ func (t *GetSingleGroupMembershipMemberships) GetJSON() ([]byte, error) {
 }
 
 func (t *GetSingleGroupMembershipMemberships) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.GroupID == "" {
-		errs = append(errs, "'Path.GroupID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.GroupID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.MembershipID == "" {
-		errs = append(errs, "'Path.MembershipID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.MembershipID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *GetSingleGroupMembershipMemberships) Do(c *canvasapi.Canvas) (*models.GroupMembership, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetSingleGroupMembershipMemberships) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.GroupMembership, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -78,6 +82,10 @@ func (t *GetSingleGroupMembershipMemberships) Do(c *canvasapi.Canvas) (*models.G
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,11 +1,14 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // GetSinglePollSubmission Returns the poll submission with the given id
@@ -18,9 +21,9 @@ import (
 //
 type GetSinglePollSubmission struct {
 	Path struct {
-		PollID        string `json:"poll_id" url:"poll_id,omitempty"`                 //  (Required)
-		PollSessionID string `json:"poll_session_id" url:"poll_session_id,omitempty"` //  (Required)
-		ID            string `json:"id" url:"id,omitempty"`                           //  (Required)
+		PollID        canvasapi.ID `json:"poll_id" url:"poll_id,omitempty"`                 //  (Required)
+		PollSessionID canvasapi.ID `json:"poll_session_id" url:"poll_session_id,omitempty"` //  (Required)
+		ID            canvasapi.ID `json:"id" url:"id,omitempty"`                           //  (Required)
 	} `json:"path"`
 }
 
@@ -30,9 +33,9 @@ func (t *GetSinglePollSubmission) GetMethod() string {
 
 func (t *GetSinglePollSubmission) GetURLPath() string {
 	path := "polls/{poll_id}/poll_sessions/{poll_session_id}/poll_submissions/{id}"
-	path = strings.ReplaceAll(path, "{poll_id}", fmt.Sprintf("%v", t.Path.PollID))
-	path = strings.ReplaceAll(path, "{poll_session_id}", fmt.Sprintf("%v", t.Path.PollSessionID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{poll_id}", t.Path.PollID.Escaped())
+	path = strings.ReplaceAll(path, "{poll_session_id}", t.Path.PollSessionID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -49,27 +52,46 @@ func (t *GetSinglePollSubmission) GetJSON() ([]byte, error) {
 }
 
 func (t *GetSinglePollSubmission) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.PollID == "" {
-		errs = append(errs, "'Path.PollID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.PollID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.PollSessionID == "" {
-		errs = append(errs, "'Path.PollSessionID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.PollSessionID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *GetSinglePollSubmission) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *GetSinglePollSubmission) Do(c *canvasapi.Canvas) (*models.PollSubmissionsResponse, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetSinglePollSubmission) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.PollSubmissionsResponse, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.PollSubmissionsResponse{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
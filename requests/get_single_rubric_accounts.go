@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -27,8 +25,8 @@ import (
 //
 type GetSingleRubricAccounts struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
-		ID        string `json:"id" url:"id,omitempty"`                 //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		ID        canvasapi.ID `json:"id" url:"id,omitempty"`                 //  (Required)
 	} `json:"path"`
 
 	Query struct {
@@ -43,13 +41,13 @@ func (t *GetSingleRubricAccounts) GetMethod() string {
 
 func (t *GetSingleRubricAccounts) GetURLPath() string {
 	path := "accounts/{account_id}/rubrics/{id}"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
 func (t *GetSingleRubricAccounts) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -65,29 +63,33 @@ func (t *GetSingleRubricAccounts) GetJSON() ([]byte, error) {
 }
 
 func (t *GetSingleRubricAccounts) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	for _, v := range t.Query.Include {
 		if v != "" && !string_utils.Include([]string{"assessments", "graded_assessments", "peer_assessments", "associations", "assignment_associations", "course_associations", "account_associations"}, v) {
-			errs = append(errs, "Include must be one of assessments, graded_assessments, peer_assessments, associations, assignment_associations, course_associations, account_associations")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.Include", Rule: canvasapi.RuleOneOf, Allowed: []string{"assessments", "graded_assessments", "peer_assessments", "associations", "assignment_associations", "course_associations", "account_associations"}})
 		}
 	}
 	if t.Query.Style != "" && !string_utils.Include([]string{"full", "comments_only"}, t.Query.Style) {
-		errs = append(errs, "Style must be one of full, comments_only")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.Style", Rule: canvasapi.RuleOneOf, Allowed: []string{"full", "comments_only"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *GetSingleRubricAccounts) Do(c *canvasapi.Canvas) (*models.Rubric, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetSingleRubricAccounts) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Rubric, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -102,6 +104,10 @@ func (t *GetSingleRubricAccounts) Do(c *canvasapi.Canvas) (*models.Rubric, error
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
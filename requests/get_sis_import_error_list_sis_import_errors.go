@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -34,11 +32,11 @@ import (
 //
 type GetSISImportErrorListSISImportErrors struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		Failure bool `json:"failure" url:"failure,omitempty"` //  (Optional)
+		Failure canvasapi.Opt[bool] `json:"failure" url:"failure,omitempty"` //  (Optional)
 	} `json:"query"`
 }
 
@@ -48,12 +46,12 @@ func (t *GetSISImportErrorListSISImportErrors) GetMethod() string {
 
 func (t *GetSISImportErrorListSISImportErrors) GetURLPath() string {
 	path := "accounts/{account_id}/sis_import_errors"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	return path
 }
 
 func (t *GetSISImportErrorListSISImportErrors) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -69,23 +67,27 @@ func (t *GetSISImportErrorListSISImportErrors) GetJSON() ([]byte, error) {
 }
 
 func (t *GetSISImportErrorListSISImportErrors) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *GetSISImportErrorListSISImportErrors) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.SISImportError, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *GetSISImportErrorListSISImportErrors) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.SISImportError, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -105,6 +107,10 @@ func (t *GetSISImportErrorListSISImportErrors) Do(c *canvasapi.Canvas, next *url
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -27,11 +25,11 @@ import (
 //
 type GetSubAccountsOfAccount struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		Recursive bool `json:"recursive" url:"recursive,omitempty"` //  (Optional)
+		Recursive canvasapi.Opt[bool] `json:"recursive" url:"recursive,omitempty"` //  (Optional)
 	} `json:"query"`
 }
 
@@ -41,12 +39,12 @@ func (t *GetSubAccountsOfAccount) GetMethod() string {
 
 func (t *GetSubAccountsOfAccount) GetURLPath() string {
 	path := "accounts/{account_id}/sub_accounts"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	return path
 }
 
 func (t *GetSubAccountsOfAccount) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -62,23 +60,27 @@ func (t *GetSubAccountsOfAccount) GetJSON() ([]byte, error) {
 }
 
 func (t *GetSubAccountsOfAccount) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *GetSubAccountsOfAccount) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Account, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *GetSubAccountsOfAccount) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.Account, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -98,6 +100,10 @@ func (t *GetSubAccountsOfAccount) Do(c *canvasapi.Canvas, next *url.URL) ([]*mod
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
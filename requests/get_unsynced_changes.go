@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -21,8 +21,8 @@ import (
 //
 type GetUnsyncedChanges struct {
 	Path struct {
-		CourseID   string `json:"course_id" url:"course_id,omitempty"`     //  (Required)
-		TemplateID string `json:"template_id" url:"template_id,omitempty"` //  (Required)
+		CourseID   canvasapi.ID `json:"course_id" url:"course_id,omitempty"`     //  (Required)
+		TemplateID canvasapi.ID `json:"template_id" url:"template_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -32,8 +32,8 @@ func (t *GetUnsyncedChanges) GetMethod() string {
 
 func (t *GetUnsyncedChanges) GetURLPath() string {
 	path := "courses/{course_id}/blueprint_templates/{template_id}/unsynced_changes"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{template_id}", fmt.Sprintf("%v", t.Path.TemplateID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{template_id}", t.Path.TemplateID.Escaped())
 	return path
 }
 
@@ -50,26 +50,30 @@ func (t *GetUnsyncedChanges) GetJSON() ([]byte, error) {
 }
 
 func (t *GetUnsyncedChanges) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.TemplateID == "" {
-		errs = append(errs, "'Path.TemplateID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.TemplateID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *GetUnsyncedChanges) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.ChangeRecord, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *GetUnsyncedChanges) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.ChangeRecord, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -89,6 +93,10 @@ func (t *GetUnsyncedChanges) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.C
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
@@ -1,7 +1,9 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
@@ -20,8 +22,8 @@ import (
 //
 type GetUserInACourseLevelAssignmentData struct {
 	Path struct {
-		CourseID  string `json:"course_id" url:"course_id,omitempty"`   //  (Required)
-		StudentID string `json:"student_id" url:"student_id,omitempty"` //  (Required)
+		CourseID  canvasapi.ID `json:"course_id" url:"course_id,omitempty"`   //  (Required)
+		StudentID canvasapi.ID `json:"student_id" url:"student_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -31,8 +33,8 @@ func (t *GetUserInACourseLevelAssignmentData) GetMethod() string {
 
 func (t *GetUserInACourseLevelAssignmentData) GetURLPath() string {
 	path := "courses/{course_id}/analytics/users/{student_id}/assignments"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{student_id}", fmt.Sprintf("%v", t.Path.StudentID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{student_id}", t.Path.StudentID.Escaped())
 	return path
 }
 
@@ -49,24 +51,34 @@ func (t *GetUserInACourseLevelAssignmentData) GetJSON() ([]byte, error) {
 }
 
 func (t *GetUserInACourseLevelAssignmentData) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.StudentID == "" {
-		errs = append(errs, "'Path.StudentID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.StudentID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *GetUserInACourseLevelAssignmentData) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *GetUserInACourseLevelAssignmentData) Do(c *canvasapi.Canvas) (json.RawMessage, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetUserInACourseLevelAssignmentData) DoContext(ctx context.Context, c *canvasapi.Canvas) (json.RawMessage, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(body), nil
 }
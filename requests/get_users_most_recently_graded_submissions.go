@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -28,13 +26,13 @@ import (
 //
 type GetUsersMostRecentlyGradedSubmissions struct {
 	Path struct {
-		ID string `json:"id" url:"id,omitempty"` //  (Required)
+		ID canvasapi.ID `json:"id" url:"id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		Include                  []string `json:"include" url:"include,omitempty"`                                       //  (Optional) . Must be one of assignment
-		OnlyCurrentEnrollments   bool     `json:"only_current_enrollments" url:"only_current_enrollments,omitempty"`     //  (Optional)
-		OnlyPublishedAssignments bool     `json:"only_published_assignments" url:"only_published_assignments,omitempty"` //  (Optional)
+		Include                  []string            `json:"include" url:"include,omitempty"`                                       //  (Optional) . Must be one of assignment
+		OnlyCurrentEnrollments   canvasapi.Opt[bool] `json:"only_current_enrollments" url:"only_current_enrollments,omitempty"`     //  (Optional)
+		OnlyPublishedAssignments canvasapi.Opt[bool] `json:"only_published_assignments" url:"only_published_assignments,omitempty"` //  (Optional)
 	} `json:"query"`
 }
 
@@ -44,12 +42,12 @@ func (t *GetUsersMostRecentlyGradedSubmissions) GetMethod() string {
 
 func (t *GetUsersMostRecentlyGradedSubmissions) GetURLPath() string {
 	path := "users/{id}/graded_submissions"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
 func (t *GetUsersMostRecentlyGradedSubmissions) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -65,28 +63,32 @@ func (t *GetUsersMostRecentlyGradedSubmissions) GetJSON() ([]byte, error) {
 }
 
 func (t *GetUsersMostRecentlyGradedSubmissions) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	for _, v := range t.Query.Include {
 		if v != "" && !string_utils.Include([]string{"assignment"}, v) {
-			errs = append(errs, "Include must be one of assignment")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.Include", Rule: canvasapi.RuleOneOf, Allowed: []string{"assignment"}})
 		}
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *GetUsersMostRecentlyGradedSubmissions) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Submission, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *GetUsersMostRecentlyGradedSubmissions) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.Submission, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -106,6 +108,10 @@ func (t *GetUsersMostRecentlyGradedSubmissions) Do(c *canvasapi.Canvas, next *ur
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
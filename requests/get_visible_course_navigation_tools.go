@@ -1,13 +1,13 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
-	"strings"
-
-	"github.com/google/go-querystring/query"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // GetVisibleCourseNavigationTools Get a list of external tools with the course_navigation placement that have not been hidden in
@@ -33,11 +33,11 @@ func (t *GetVisibleCourseNavigationTools) GetMethod() string {
 }
 
 func (t *GetVisibleCourseNavigationTools) GetURLPath() string {
-	return ""
+	return "external_tools/visible_course_nav_tools"
 }
 
 func (t *GetVisibleCourseNavigationTools) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -53,21 +53,40 @@ func (t *GetVisibleCourseNavigationTools) GetJSON() ([]byte, error) {
 }
 
 func (t *GetVisibleCourseNavigationTools) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Query.ContextCodes == nil {
-		errs = append(errs, "'Query.ContextCodes' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.ContextCodes", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *GetVisibleCourseNavigationTools) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *GetVisibleCourseNavigationTools) Do(c *canvasapi.Canvas) ([]*models.ExternalTool, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetVisibleCourseNavigationTools) DoContext(ctx context.Context, c *canvasapi.Canvas) ([]*models.ExternalTool, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := []*models.ExternalTool{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
 }
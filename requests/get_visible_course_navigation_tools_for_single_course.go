@@ -1,11 +1,14 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // GetVisibleCourseNavigationToolsForSingleCourse Get a list of external tools with the course_navigation placement that have not been hidden in
@@ -20,7 +23,7 @@ import (
 //
 type GetVisibleCourseNavigationToolsForSingleCourse struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -30,7 +33,7 @@ func (t *GetVisibleCourseNavigationToolsForSingleCourse) GetMethod() string {
 
 func (t *GetVisibleCourseNavigationToolsForSingleCourse) GetURLPath() string {
 	path := "courses/{course_id}/external_tools/visible_course_nav_tools"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
@@ -47,21 +50,40 @@ func (t *GetVisibleCourseNavigationToolsForSingleCourse) GetJSON() ([]byte, erro
 }
 
 func (t *GetVisibleCourseNavigationToolsForSingleCourse) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *GetVisibleCourseNavigationToolsForSingleCourse) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *GetVisibleCourseNavigationToolsForSingleCourse) Do(c *canvasapi.Canvas) ([]*models.ExternalTool, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GetVisibleCourseNavigationToolsForSingleCourse) DoContext(ctx context.Context, c *canvasapi.Canvas) ([]*models.ExternalTool, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := []*models.ExternalTool{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
 }
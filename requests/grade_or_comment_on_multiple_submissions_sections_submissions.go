@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -41,11 +39,11 @@ import (
 //
 type GradeOrCommentOnMultipleSubmissionsSectionsSubmissions struct {
 	Path struct {
-		SectionID string `json:"section_id" url:"section_id,omitempty"` //  (Required)
+		SectionID canvasapi.ID `json:"section_id" url:"section_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		GradeData map[string]GradeOrCommentOnMultipleSubmissionsSectionsSubmissionsGradeData
+		GradeData map[string]GradeOrCommentOnMultipleSubmissionsSectionsSubmissionsGradeData `json:"grade_data" url:"grade_data,omitempty"` //  (Optional)
 	} `json:"form"`
 }
 
@@ -55,7 +53,7 @@ func (t *GradeOrCommentOnMultipleSubmissionsSectionsSubmissions) GetMethod() str
 
 func (t *GradeOrCommentOnMultipleSubmissionsSectionsSubmissions) GetURLPath() string {
 	path := "sections/{section_id}/submissions/update_grades"
-	path = strings.ReplaceAll(path, "{section_id}", fmt.Sprintf("%v", t.Path.SectionID))
+	path = strings.ReplaceAll(path, "{section_id}", t.Path.SectionID.Escaped())
 	return path
 }
 
@@ -64,30 +62,34 @@ func (t *GradeOrCommentOnMultipleSubmissionsSectionsSubmissions) GetQuery() (str
 }
 
 func (t *GradeOrCommentOnMultipleSubmissionsSectionsSubmissions) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *GradeOrCommentOnMultipleSubmissionsSectionsSubmissions) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *GradeOrCommentOnMultipleSubmissionsSectionsSubmissions) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.SectionID == "" {
-		errs = append(errs, "'Path.SectionID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.SectionID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *GradeOrCommentOnMultipleSubmissionsSectionsSubmissions) Do(c *canvasapi.Canvas) (*models.Progress, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GradeOrCommentOnMultipleSubmissionsSectionsSubmissions) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Progress, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -102,18 +104,22 @@ func (t *GradeOrCommentOnMultipleSubmissionsSectionsSubmissions) Do(c *canvasapi
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
 
 type GradeOrCommentOnMultipleSubmissionsSectionsSubmissionsGradeData struct {
 	PostedGrade      string                   `json:"posted_grade" url:"posted_grade,omitempty"`             //  (Optional)
-	Excuse           bool                     `json:"excuse" url:"excuse,omitempty"`                         //  (Optional)
+	Excuse           canvasapi.Opt[bool]      `json:"excuse" url:"excuse,omitempty"`                         //  (Optional)
 	RubricAssessment *models.RubricAssessment `json:"rubric_assessment" url:"rubric_assessment,omitempty"`   //  (Optional)
 	TextComment      string                   `json:"text_comment" url:"text_comment,omitempty"`             //  (Optional)
-	GroupComment     bool                     `json:"group_comment" url:"group_comment,omitempty"`           //  (Optional)
+	GroupComment     canvasapi.Opt[bool]      `json:"group_comment" url:"group_comment,omitempty"`           //  (Optional)
 	MediaCommentID   string                   `json:"media_comment_id" url:"media_comment_id,omitempty"`     //  (Optional)
 	MediaCommentType string                   `json:"media_comment_type" url:"media_comment_type,omitempty"` //  (Optional) . Must be one of audio, video
 	FileIDs          []string                 `json:"file_ids" url:"file_ids,omitempty"`                     //  (Optional)
-	AssignmentID     int64                    `json:"assignment_id" url:"assignment_id,omitempty"`           //  (Optional)
+	AssignmentID     canvasapi.Opt[int64]     `json:"assignment_id" url:"assignment_id,omitempty"`           //  (Optional)
 }
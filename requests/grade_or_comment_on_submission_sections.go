@@ -1,13 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -111,29 +110,29 @@ import (
 //
 type GradeOrCommentOnSubmissionSections struct {
 	Path struct {
-		SectionID    string `json:"section_id" url:"section_id,omitempty"`       //  (Required)
-		AssignmentID string `json:"assignment_id" url:"assignment_id,omitempty"` //  (Required)
-		UserID       string `json:"user_id" url:"user_id,omitempty"`             //  (Required)
+		SectionID    canvasapi.ID `json:"section_id" url:"section_id,omitempty"`       //  (Required)
+		AssignmentID canvasapi.ID `json:"assignment_id" url:"assignment_id,omitempty"` //  (Required)
+		UserID       canvasapi.ID `json:"user_id" url:"user_id,omitempty"`             //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		Comment struct {
-			TextComment      string   `json:"text_comment" url:"text_comment,omitempty"`             //  (Optional)
-			GroupComment     bool     `json:"group_comment" url:"group_comment,omitempty"`           //  (Optional)
-			MediaCommentID   string   `json:"media_comment_id" url:"media_comment_id,omitempty"`     //  (Optional)
-			MediaCommentType string   `json:"media_comment_type" url:"media_comment_type,omitempty"` //  (Optional) . Must be one of audio, video
-			FileIDs          []string `json:"file_ids" url:"file_ids,omitempty"`                     //  (Optional)
+			TextComment      canvasapi.Opt[string] `json:"text_comment" url:"text_comment,omitempty"`             //  (Optional)
+			GroupComment     canvasapi.Opt[bool]   `json:"group_comment" url:"group_comment,omitempty"`           //  (Optional)
+			MediaCommentID   canvasapi.Opt[string] `json:"media_comment_id" url:"media_comment_id,omitempty"`     //  (Optional)
+			MediaCommentType canvasapi.Opt[string] `json:"media_comment_type" url:"media_comment_type,omitempty"` //  (Optional) . Must be one of audio, video
+			FileIDs          []string              `json:"file_ids" url:"file_ids,omitempty"`                     //  (Optional)
 		} `json:"comment" url:"comment,omitempty"`
 
 		Include struct {
-			Visibility string `json:"visibility" url:"visibility,omitempty"` //  (Optional)
+			Visibility canvasapi.Opt[string] `json:"visibility" url:"visibility,omitempty"` //  (Optional)
 		} `json:"include" url:"include,omitempty"`
 
 		Submission struct {
-			PostedGrade         string `json:"posted_grade" url:"posted_grade,omitempty"`                   //  (Optional)
-			Excuse              bool   `json:"excuse" url:"excuse,omitempty"`                               //  (Optional)
-			LatePolicyStatus    string `json:"late_policy_status" url:"late_policy_status,omitempty"`       //  (Optional)
-			SecondsLateOverride int64  `json:"seconds_late_override" url:"seconds_late_override,omitempty"` //  (Optional)
+			PostedGrade         canvasapi.Opt[string] `json:"posted_grade" url:"posted_grade,omitempty"`                   //  (Optional)
+			Excuse              canvasapi.Opt[bool]   `json:"excuse" url:"excuse,omitempty"`                               //  (Optional)
+			LatePolicyStatus    canvasapi.Opt[string] `json:"late_policy_status" url:"late_policy_status,omitempty"`       //  (Optional)
+			SecondsLateOverride canvasapi.Opt[int64]  `json:"seconds_late_override" url:"seconds_late_override,omitempty"` //  (Optional)
 		} `json:"submission" url:"submission,omitempty"`
 
 		RubricAssessment *models.RubricAssessment `json:"rubric_assessment" url:"rubric_assessment,omitempty"` //  (Optional)
@@ -146,9 +145,9 @@ func (t *GradeOrCommentOnSubmissionSections) GetMethod() string {
 
 func (t *GradeOrCommentOnSubmissionSections) GetURLPath() string {
 	path := "sections/{section_id}/assignments/{assignment_id}/submissions/{user_id}"
-	path = strings.ReplaceAll(path, "{section_id}", fmt.Sprintf("%v", t.Path.SectionID))
-	path = strings.ReplaceAll(path, "{assignment_id}", fmt.Sprintf("%v", t.Path.AssignmentID))
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", t.Path.UserID))
+	path = strings.ReplaceAll(path, "{section_id}", t.Path.SectionID.Escaped())
+	path = strings.ReplaceAll(path, "{assignment_id}", t.Path.AssignmentID.Escaped())
+	path = strings.ReplaceAll(path, "{user_id}", t.Path.UserID.Escaped())
 	return path
 }
 
@@ -157,42 +156,61 @@ func (t *GradeOrCommentOnSubmissionSections) GetQuery() (string, error) {
 }
 
 func (t *GradeOrCommentOnSubmissionSections) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *GradeOrCommentOnSubmissionSections) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *GradeOrCommentOnSubmissionSections) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.SectionID == "" {
-		errs = append(errs, "'Path.SectionID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.SectionID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.AssignmentID == "" {
-		errs = append(errs, "'Path.AssignmentID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AssignmentID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.UserID == "" {
-		errs = append(errs, "'Path.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.UserID", Rule: canvasapi.RuleRequired})
 	}
-	if t.Form.Comment.MediaCommentType != "" && !string_utils.Include([]string{"audio", "video"}, t.Form.Comment.MediaCommentType) {
-		errs = append(errs, "Comment must be one of audio, video")
+	if t.Form.Comment.MediaCommentType.Value() != "" && !string_utils.Include([]string{"audio", "video"}, t.Form.Comment.MediaCommentType.Value()) {
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Comment.MediaCommentType", Rule: canvasapi.RuleOneOf, Allowed: []string{"audio", "video"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *GradeOrCommentOnSubmissionSections) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *GradeOrCommentOnSubmissionSections) Do(c *canvasapi.Canvas) (*models.Submission, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GradeOrCommentOnSubmissionSections) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Submission, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.Submission{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
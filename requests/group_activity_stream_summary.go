@@ -1,11 +1,14 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // GroupActivityStreamSummary Returns a summary of the current user's group-specific activity stream.
@@ -19,7 +22,7 @@ import (
 //
 type GroupActivityStreamSummary struct {
 	Path struct {
-		GroupID string `json:"group_id" url:"group_id,omitempty"` //  (Required)
+		GroupID canvasapi.ID `json:"group_id" url:"group_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -29,7 +32,7 @@ func (t *GroupActivityStreamSummary) GetMethod() string {
 
 func (t *GroupActivityStreamSummary) GetURLPath() string {
 	path := "groups/{group_id}/activity_stream/summary"
-	path = strings.ReplaceAll(path, "{group_id}", fmt.Sprintf("%v", t.Path.GroupID))
+	path = strings.ReplaceAll(path, "{group_id}", t.Path.GroupID.Escaped())
 	return path
 }
 
@@ -46,21 +49,40 @@ func (t *GroupActivityStreamSummary) GetJSON() ([]byte, error) {
 }
 
 func (t *GroupActivityStreamSummary) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.GroupID == "" {
-		errs = append(errs, "'Path.GroupID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.GroupID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *GroupActivityStreamSummary) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *GroupActivityStreamSummary) Do(c *canvasapi.Canvas) ([]*models.StreamSummary, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GroupActivityStreamSummary) DoContext(ctx context.Context, c *canvasapi.Canvas) ([]*models.StreamSummary, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := []*models.StreamSummary{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
 }
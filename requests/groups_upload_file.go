@@ -1,11 +1,15 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
+	"github.com/atomicjolt/string_utils"
 )
 
 // GroupsUploadFile Upload a file to the group.
@@ -22,10 +26,29 @@ import (
 // Path Parameters:
 // # Path.GroupID (Required) ID
 //
+// Form Parameters:
+// # Form.Name (Optional) The filename of the file. Any UTF-8 name is allowed. Path components such as `/` and `\` will be treated as part of the filename, not a path to a sub-folder.
+// # Form.Size (Optional) The size of the file, in bytes. This field is recommended, as it will let you find out if there's a quota issue before uploading the raw file.
+// # Form.ContentType (Optional) The content type of the file. If not given, it will be guessed based on the file extension.
+// # Form.ParentFolderID (Optional) The id of the folder to store the file in. This is ignored if parent_folder_path is given.
+// # Form.ParentFolderPath (Optional) The path of the folder to store the file in. The path separator is the forward slash `/`, never a back slash. The folder will be created if it does not already exist.
+// # Form.OnDuplicate (Optional) . Must be one of overwrite, renameHow to handle duplicate filenames. If `overwrite`, then this file upload will overwrite any other file in the folder with the same name. If `rename`, then this file will be renamed if another file in the folder exists with the given name.
+// # Form.Url (Optional) The URL to download the file from. Canvas fetches the file itself and returns a progress object instead of an upload_url.
+//
 type GroupsUploadFile struct {
 	Path struct {
-		GroupID string `json:"group_id" url:"group_id,omitempty"` //  (Required)
+		GroupID canvasapi.ID `json:"group_id" url:"group_id,omitempty"` //  (Required)
 	} `json:"path"`
+
+	Form struct {
+		Name             string               `json:"name" url:"name,omitempty"`                             //  (Optional)
+		Size             canvasapi.Opt[int64] `json:"size" url:"size,omitempty"`                             //  (Optional)
+		ContentType      string               `json:"content_type" url:"content_type,omitempty"`             //  (Optional)
+		ParentFolderID   string               `json:"parent_folder_id" url:"parent_folder_id,omitempty"`     //  (Optional)
+		ParentFolderPath string               `json:"parent_folder_path" url:"parent_folder_path,omitempty"` //  (Optional)
+		OnDuplicate      string               `json:"on_duplicate" url:"on_duplicate,omitempty"`             //  (Optional)
+		Url              string               `json:"url" url:"url,omitempty"`                               //  (Optional)
+	} `json:"form"`
 }
 
 func (t *GroupsUploadFile) GetMethod() string {
@@ -34,7 +57,7 @@ func (t *GroupsUploadFile) GetMethod() string {
 
 func (t *GroupsUploadFile) GetURLPath() string {
 	path := "groups/{group_id}/files"
-	path = strings.ReplaceAll(path, "{group_id}", fmt.Sprintf("%v", t.Path.GroupID))
+	path = strings.ReplaceAll(path, "{group_id}", t.Path.GroupID.Escaped())
 	return path
 }
 
@@ -43,29 +66,55 @@ func (t *GroupsUploadFile) GetQuery() (string, error) {
 }
 
 func (t *GroupsUploadFile) GetBody() (url.Values, error) {
-	return nil, nil
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *GroupsUploadFile) GetJSON() ([]byte, error) {
-	return nil, nil
+	j, err := canvasapi.EncodeJSON(t.Form)
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
 }
 
 func (t *GroupsUploadFile) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.GroupID == "" {
-		errs = append(errs, "'Path.GroupID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.GroupID", Rule: canvasapi.RuleRequired})
+	}
+	if t.Form.OnDuplicate != "" && !string_utils.Include([]string{"overwrite", "rename"}, t.Form.OnDuplicate) {
+		errs = append(errs, canvasapi.FieldError{Field: "Form.OnDuplicate", Rule: canvasapi.RuleOneOf, Allowed: []string{"overwrite", "rename"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *GroupsUploadFile) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *GroupsUploadFile) Do(c *canvasapi.Canvas) (*models.UploadParams, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *GroupsUploadFile) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.UploadParams, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.UploadParams{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,6 +1,9 @@
 package requests
 
 import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 
 	"github.com/atomicjolt/canvasapi"
@@ -17,7 +20,7 @@ func (t *HideAllStreamItems) GetMethod() string {
 }
 
 func (t *HideAllStreamItems) GetURLPath() string {
-	return ""
+	return "users/self/activity_stream"
 }
 
 func (t *HideAllStreamItems) GetQuery() (string, error) {
@@ -36,11 +39,21 @@ func (t *HideAllStreamItems) HasErrors() error {
 	return nil
 }
 
-func (t *HideAllStreamItems) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *HideAllStreamItems) Do(c *canvasapi.Canvas) (json.RawMessage, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *HideAllStreamItems) DoContext(ctx context.Context, c *canvasapi.Canvas) (json.RawMessage, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(body), nil
 }
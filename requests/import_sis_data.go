@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -121,26 +119,26 @@ import (
 //
 type ImportSISData struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		ImportType                    string `json:"import_type" url:"import_type,omitempty"`                                             //  (Optional)
-		Attachment                    string `json:"attachment" url:"attachment,omitempty"`                                               //  (Optional)
-		Extension                     string `json:"extension" url:"extension,omitempty"`                                                 //  (Optional)
-		BatchMode                     bool   `json:"batch_mode" url:"batch_mode,omitempty"`                                               //  (Optional)
-		BatchModeTermID               string `json:"batch_mode_term_id" url:"batch_mode_term_id,omitempty"`                               //  (Optional)
-		MultiTermBatchMode            bool   `json:"multi_term_batch_mode" url:"multi_term_batch_mode,omitempty"`                         //  (Optional)
-		SkipDeletes                   bool   `json:"skip_deletes" url:"skip_deletes,omitempty"`                                           //  (Optional)
-		OverrideSISStickiness         bool   `json:"override_sis_stickiness" url:"override_sis_stickiness,omitempty"`                     //  (Optional)
-		AddSISStickiness              bool   `json:"add_sis_stickiness" url:"add_sis_stickiness,omitempty"`                               //  (Optional)
-		ClearSISStickiness            bool   `json:"clear_sis_stickiness" url:"clear_sis_stickiness,omitempty"`                           //  (Optional)
-		DiffingDataSetIDentifier      string `json:"diffing_data_set_identifier" url:"diffing_data_set_identifier,omitempty"`             //  (Optional)
-		DiffingRemasterDataSet        bool   `json:"diffing_remaster_data_set" url:"diffing_remaster_data_set,omitempty"`                 //  (Optional)
-		DiffingDropStatus             string `json:"diffing_drop_status" url:"diffing_drop_status,omitempty"`                             //  (Optional) . Must be one of deleted, completed, inactive
-		BatchModeEnrollmentDropStatus string `json:"batch_mode_enrollment_drop_status" url:"batch_mode_enrollment_drop_status,omitempty"` //  (Optional) . Must be one of deleted, completed, inactive
-		ChangeThreshold               int64  `json:"change_threshold" url:"change_threshold,omitempty"`                                   //  (Optional)
-		DiffRowCountThreshold         int64  `json:"diff_row_count_threshold" url:"diff_row_count_threshold,omitempty"`                   //  (Optional)
+		ImportType                    string               `json:"import_type" url:"import_type,omitempty"`                                             //  (Optional)
+		Attachment                    *canvasapi.File      `json:"-" url:"-"`                                                                           //  (Optional)
+		Extension                     string               `json:"extension" url:"extension,omitempty"`                                                 //  (Optional)
+		BatchMode                     canvasapi.Opt[bool]  `json:"batch_mode" url:"batch_mode,omitempty"`                                               //  (Optional)
+		BatchModeTermID               string               `json:"batch_mode_term_id" url:"batch_mode_term_id,omitempty"`                               //  (Optional)
+		MultiTermBatchMode            canvasapi.Opt[bool]  `json:"multi_term_batch_mode" url:"multi_term_batch_mode,omitempty"`                         //  (Optional)
+		SkipDeletes                   canvasapi.Opt[bool]  `json:"skip_deletes" url:"skip_deletes,omitempty"`                                           //  (Optional)
+		OverrideSISStickiness         canvasapi.Opt[bool]  `json:"override_sis_stickiness" url:"override_sis_stickiness,omitempty"`                     //  (Optional)
+		AddSISStickiness              canvasapi.Opt[bool]  `json:"add_sis_stickiness" url:"add_sis_stickiness,omitempty"`                               //  (Optional)
+		ClearSISStickiness            canvasapi.Opt[bool]  `json:"clear_sis_stickiness" url:"clear_sis_stickiness,omitempty"`                           //  (Optional)
+		DiffingDataSetIDentifier      string               `json:"diffing_data_set_identifier" url:"diffing_data_set_identifier,omitempty"`             //  (Optional)
+		DiffingRemasterDataSet        canvasapi.Opt[bool]  `json:"diffing_remaster_data_set" url:"diffing_remaster_data_set,omitempty"`                 //  (Optional)
+		DiffingDropStatus             string               `json:"diffing_drop_status" url:"diffing_drop_status,omitempty"`                             //  (Optional) . Must be one of deleted, completed, inactive
+		BatchModeEnrollmentDropStatus string               `json:"batch_mode_enrollment_drop_status" url:"batch_mode_enrollment_drop_status,omitempty"` //  (Optional) . Must be one of deleted, completed, inactive
+		ChangeThreshold               canvasapi.Opt[int64] `json:"change_threshold" url:"change_threshold,omitempty"`                                   //  (Optional)
+		DiffRowCountThreshold         canvasapi.Opt[int64] `json:"diff_row_count_threshold" url:"diff_row_count_threshold,omitempty"`                   //  (Optional)
 	} `json:"form"`
 }
 
@@ -150,7 +148,7 @@ func (t *ImportSISData) GetMethod() string {
 
 func (t *ImportSISData) GetURLPath() string {
 	path := "accounts/{account_id}/sis_imports"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	return path
 }
 
@@ -159,36 +157,52 @@ func (t *ImportSISData) GetQuery() (string, error) {
 }
 
 func (t *ImportSISData) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *ImportSISData) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
+// GetMultipart posts Form.Attachment as a multipart/form-data field named attachment.
+func (t *ImportSISData) GetMultipart() ([]byte, string, error) {
+	if t.Form.Attachment == nil {
+		return nil, "", nil
+	}
+	values, err := canvasapi.EncodeValues(t.Form)
+	if err != nil {
+		return nil, "", err
+	}
+	return canvasapi.MultipartBody(values, "attachment", *t.Form.Attachment)
+}
+
 func (t *ImportSISData) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.DiffingDropStatus != "" && !string_utils.Include([]string{"deleted", "completed", "inactive"}, t.Form.DiffingDropStatus) {
-		errs = append(errs, "DiffingDropStatus must be one of deleted, completed, inactive")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.DiffingDropStatus", Rule: canvasapi.RuleOneOf, Allowed: []string{"deleted", "completed", "inactive"}})
 	}
 	if t.Form.BatchModeEnrollmentDropStatus != "" && !string_utils.Include([]string{"deleted", "completed", "inactive"}, t.Form.BatchModeEnrollmentDropStatus) {
-		errs = append(errs, "BatchModeEnrollmentDropStatus must be one of deleted, completed, inactive")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.BatchModeEnrollmentDropStatus", Rule: canvasapi.RuleOneOf, Allowed: []string{"deleted", "completed", "inactive"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ImportSISData) Do(c *canvasapi.Canvas) (*models.SISImport, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ImportSISData) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.SISImport, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -203,6 +217,10 @@ func (t *ImportSISData) Do(c *canvasapi.Canvas) (*models.SISImport, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -27,11 +25,11 @@ import (
 //
 type IndexOfActiveGlobalNotificationForUser struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		IncludePast bool `json:"include_past" url:"include_past,omitempty"` //  (Optional)
+		IncludePast canvasapi.Opt[bool] `json:"include_past" url:"include_past,omitempty"` //  (Optional)
 	} `json:"query"`
 }
 
@@ -41,12 +39,12 @@ func (t *IndexOfActiveGlobalNotificationForUser) GetMethod() string {
 
 func (t *IndexOfActiveGlobalNotificationForUser) GetURLPath() string {
 	path := "accounts/{account_id}/account_notifications"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	return path
 }
 
 func (t *IndexOfActiveGlobalNotificationForUser) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -62,23 +60,27 @@ func (t *IndexOfActiveGlobalNotificationForUser) GetJSON() ([]byte, error) {
 }
 
 func (t *IndexOfActiveGlobalNotificationForUser) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *IndexOfActiveGlobalNotificationForUser) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.AccountNotification, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *IndexOfActiveGlobalNotificationForUser) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.AccountNotification, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -98,6 +100,10 @@ func (t *IndexOfActiveGlobalNotificationForUser) Do(c *canvasapi.Canvas, next *u
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
@@ -1,6 +1,7 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -21,8 +22,8 @@ import (
 //
 type IndexOfReports struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
-		Report    string `json:"report" url:"report,omitempty"`         //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		Report    string       `json:"report" url:"report,omitempty"`         //  (Required)
 	} `json:"path"`
 }
 
@@ -32,7 +33,7 @@ func (t *IndexOfReports) GetMethod() string {
 
 func (t *IndexOfReports) GetURLPath() string {
 	path := "accounts/{account_id}/reports/{report}"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	path = strings.ReplaceAll(path, "{report}", fmt.Sprintf("%v", t.Path.Report))
 	return path
 }
@@ -50,26 +51,30 @@ func (t *IndexOfReports) GetJSON() ([]byte, error) {
 }
 
 func (t *IndexOfReports) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.Report == "" {
-		errs = append(errs, "'Path.Report' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Report", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *IndexOfReports) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Report, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *IndexOfReports) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.Report, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -89,6 +94,10 @@ func (t *IndexOfReports) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Repor
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
@@ -1,7 +1,9 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
@@ -19,8 +21,8 @@ import (
 //
 type LeaveGroupMemberships struct {
 	Path struct {
-		GroupID      string `json:"group_id" url:"group_id,omitempty"`           //  (Required)
-		MembershipID string `json:"membership_id" url:"membership_id,omitempty"` //  (Required)
+		GroupID      canvasapi.ID `json:"group_id" url:"group_id,omitempty"`           //  (Required)
+		MembershipID canvasapi.ID `json:"membership_id" url:"membership_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -30,8 +32,8 @@ func (t *LeaveGroupMemberships) GetMethod() string {
 
 func (t *LeaveGroupMemberships) GetURLPath() string {
 	path := "groups/{group_id}/memberships/{membership_id}"
-	path = strings.ReplaceAll(path, "{group_id}", fmt.Sprintf("%v", t.Path.GroupID))
-	path = strings.ReplaceAll(path, "{membership_id}", fmt.Sprintf("%v", t.Path.MembershipID))
+	path = strings.ReplaceAll(path, "{group_id}", t.Path.GroupID.Escaped())
+	path = strings.ReplaceAll(path, "{membership_id}", t.Path.MembershipID.Escaped())
 	return path
 }
 
@@ -48,24 +50,34 @@ func (t *LeaveGroupMemberships) GetJSON() ([]byte, error) {
 }
 
 func (t *LeaveGroupMemberships) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.GroupID == "" {
-		errs = append(errs, "'Path.GroupID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.GroupID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.MembershipID == "" {
-		errs = append(errs, "'Path.MembershipID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.MembershipID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *LeaveGroupMemberships) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *LeaveGroupMemberships) Do(c *canvasapi.Canvas) (json.RawMessage, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *LeaveGroupMemberships) DoContext(ctx context.Context, c *canvasapi.Canvas) (json.RawMessage, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(body), nil
 }
@@ -1,14 +1,11 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
-	"strings"
-
-	"github.com/google/go-querystring/query"
 
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
@@ -38,11 +35,11 @@ func (t *ListAccounts) GetMethod() string {
 }
 
 func (t *ListAccounts) GetURLPath() string {
-	return ""
+	return "accounts"
 }
 
 func (t *ListAccounts) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -58,25 +55,29 @@ func (t *ListAccounts) GetJSON() ([]byte, error) {
 }
 
 func (t *ListAccounts) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	for _, v := range t.Query.Include {
 		if v != "" && !string_utils.Include([]string{"lti_guid", "registration_settings", "services"}, v) {
-			errs = append(errs, "Include must be one of lti_guid, registration_settings, services")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.Include", Rule: canvasapi.RuleOneOf, Allowed: []string{"lti_guid", "registration_settings", "services"}})
 		}
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ListAccounts) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Account, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListAccounts) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.Account, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -96,6 +97,10 @@ func (t *ListAccounts) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Account
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
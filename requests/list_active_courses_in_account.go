@@ -1,16 +1,14 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -65,29 +63,29 @@ import (
 //
 type ListActiveCoursesInAccount struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		WithEnrollments           bool      `json:"with_enrollments" url:"with_enrollments,omitempty"`                       //  (Optional)
-		EnrollmentType            []string  `json:"enrollment_type" url:"enrollment_type,omitempty"`                         //  (Optional) . Must be one of teacher, student, ta, observer, designer
-		Published                 bool      `json:"published" url:"published,omitempty"`                                     //  (Optional)
-		Completed                 bool      `json:"completed" url:"completed,omitempty"`                                     //  (Optional)
-		Blueprint                 bool      `json:"blueprint" url:"blueprint,omitempty"`                                     //  (Optional)
-		BlueprintAssociated       bool      `json:"blueprint_associated" url:"blueprint_associated,omitempty"`               //  (Optional)
-		ByTeachers                []string  `json:"by_teachers" url:"by_teachers,omitempty"`                                 //  (Optional)
-		BySubaccounts             []string  `json:"by_subaccounts" url:"by_subaccounts,omitempty"`                           //  (Optional)
-		HideEnrollmentlessCourses bool      `json:"hide_enrollmentless_courses" url:"hide_enrollmentless_courses,omitempty"` //  (Optional)
-		State                     []string  `json:"state" url:"state,omitempty"`                                             //  (Optional) . Must be one of created, claimed, available, completed, deleted, all
-		EnrollmentTermID          int64     `json:"enrollment_term_id" url:"enrollment_term_id,omitempty"`                   //  (Optional)
-		SearchTerm                string    `json:"search_term" url:"search_term,omitempty"`                                 //  (Optional)
-		Include                   []string  `json:"include" url:"include,omitempty"`                                         //  (Optional) . Must be one of syllabus_body, term, course_progress, storage_quota_used_mb, total_students, teachers, account_name, concluded
-		Sort                      string    `json:"sort" url:"sort,omitempty"`                                               //  (Optional) . Must be one of course_name, sis_course_id, teacher, account_name
-		Order                     string    `json:"order" url:"order,omitempty"`                                             //  (Optional) . Must be one of asc, desc
-		SearchBy                  string    `json:"search_by" url:"search_by,omitempty"`                                     //  (Optional) . Must be one of course, teacher
-		StartsBefore              time.Time `json:"starts_before" url:"starts_before,omitempty"`                             //  (Optional)
-		EndsAfter                 time.Time `json:"ends_after" url:"ends_after,omitempty"`                                   //  (Optional)
-		Homeroom                  bool      `json:"homeroom" url:"homeroom,omitempty"`                                       //  (Optional)
+		WithEnrollments           canvasapi.Opt[bool]  `json:"with_enrollments" url:"with_enrollments,omitempty"`                       //  (Optional)
+		EnrollmentType            []string             `json:"enrollment_type" url:"enrollment_type,omitempty"`                         //  (Optional) . Must be one of teacher, student, ta, observer, designer
+		Published                 canvasapi.Opt[bool]  `json:"published" url:"published,omitempty"`                                     //  (Optional)
+		Completed                 canvasapi.Opt[bool]  `json:"completed" url:"completed,omitempty"`                                     //  (Optional)
+		Blueprint                 canvasapi.Opt[bool]  `json:"blueprint" url:"blueprint,omitempty"`                                     //  (Optional)
+		BlueprintAssociated       canvasapi.Opt[bool]  `json:"blueprint_associated" url:"blueprint_associated,omitempty"`               //  (Optional)
+		ByTeachers                []string             `json:"by_teachers" url:"by_teachers,omitempty"`                                 //  (Optional)
+		BySubaccounts             []string             `json:"by_subaccounts" url:"by_subaccounts,omitempty"`                           //  (Optional)
+		HideEnrollmentlessCourses canvasapi.Opt[bool]  `json:"hide_enrollmentless_courses" url:"hide_enrollmentless_courses,omitempty"` //  (Optional)
+		State                     []string             `json:"state" url:"state,omitempty"`                                             //  (Optional) . Must be one of created, claimed, available, completed, deleted, all
+		EnrollmentTermID          canvasapi.Opt[int64] `json:"enrollment_term_id" url:"enrollment_term_id,omitempty"`                   //  (Optional)
+		SearchTerm                string               `json:"search_term" url:"search_term,omitempty"`                                 //  (Optional)
+		Include                   []string             `json:"include" url:"include,omitempty"`                                         //  (Optional) . Must be one of syllabus_body, term, course_progress, storage_quota_used_mb, total_students, teachers, account_name, concluded
+		Sort                      string               `json:"sort" url:"sort,omitempty"`                                               //  (Optional) . Must be one of course_name, sis_course_id, teacher, account_name
+		Order                     string               `json:"order" url:"order,omitempty"`                                             //  (Optional) . Must be one of asc, desc
+		SearchBy                  string               `json:"search_by" url:"search_by,omitempty"`                                     //  (Optional) . Must be one of course, teacher
+		StartsBefore              time.Time            `json:"starts_before" url:"starts_before,omitempty"`                             //  (Optional)
+		EndsAfter                 time.Time            `json:"ends_after" url:"ends_after,omitempty"`                                   //  (Optional)
+		Homeroom                  canvasapi.Opt[bool]  `json:"homeroom" url:"homeroom,omitempty"`                                       //  (Optional)
 	} `json:"query"`
 }
 
@@ -97,12 +95,12 @@ func (t *ListActiveCoursesInAccount) GetMethod() string {
 
 func (t *ListActiveCoursesInAccount) GetURLPath() string {
 	path := "accounts/{account_id}/courses"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	return path
 }
 
 func (t *ListActiveCoursesInAccount) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -118,47 +116,51 @@ func (t *ListActiveCoursesInAccount) GetJSON() ([]byte, error) {
 }
 
 func (t *ListActiveCoursesInAccount) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	for _, v := range t.Query.EnrollmentType {
 		if v != "" && !string_utils.Include([]string{"teacher", "student", "ta", "observer", "designer"}, v) {
-			errs = append(errs, "EnrollmentType must be one of teacher, student, ta, observer, designer")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.EnrollmentType", Rule: canvasapi.RuleOneOf, Allowed: []string{"teacher", "student", "ta", "observer", "designer"}})
 		}
 	}
 	for _, v := range t.Query.State {
 		if v != "" && !string_utils.Include([]string{"created", "claimed", "available", "completed", "deleted", "all"}, v) {
-			errs = append(errs, "State must be one of created, claimed, available, completed, deleted, all")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.State", Rule: canvasapi.RuleOneOf, Allowed: []string{"created", "claimed", "available", "completed", "deleted", "all"}})
 		}
 	}
 	for _, v := range t.Query.Include {
 		if v != "" && !string_utils.Include([]string{"syllabus_body", "term", "course_progress", "storage_quota_used_mb", "total_students", "teachers", "account_name", "concluded"}, v) {
-			errs = append(errs, "Include must be one of syllabus_body, term, course_progress, storage_quota_used_mb, total_students, teachers, account_name, concluded")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.Include", Rule: canvasapi.RuleOneOf, Allowed: []string{"syllabus_body", "term", "course_progress", "storage_quota_used_mb", "total_students", "teachers", "account_name", "concluded"}})
 		}
 	}
 	if t.Query.Sort != "" && !string_utils.Include([]string{"course_name", "sis_course_id", "teacher", "account_name"}, t.Query.Sort) {
-		errs = append(errs, "Sort must be one of course_name, sis_course_id, teacher, account_name")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.Sort", Rule: canvasapi.RuleOneOf, Allowed: []string{"course_name", "sis_course_id", "teacher", "account_name"}})
 	}
 	if t.Query.Order != "" && !string_utils.Include([]string{"asc", "desc"}, t.Query.Order) {
-		errs = append(errs, "Order must be one of asc, desc")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.Order", Rule: canvasapi.RuleOneOf, Allowed: []string{"asc", "desc"}})
 	}
 	if t.Query.SearchBy != "" && !string_utils.Include([]string{"course", "teacher"}, t.Query.SearchBy) {
-		errs = append(errs, "SearchBy must be one of course, teacher")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.SearchBy", Rule: canvasapi.RuleOneOf, Allowed: []string{"course", "teacher"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ListActiveCoursesInAccount) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Course, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListActiveCoursesInAccount) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.Course, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -178,6 +180,10 @@ func (t *ListActiveCoursesInAccount) Do(c *canvasapi.Canvas, next *url.URL) ([]*
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
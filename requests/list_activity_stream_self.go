@@ -1,11 +1,14 @@
 package requests
 
 import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
 	"net/url"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // ListActivityStreamSelf Returns the current user's global activity stream, paginated.
@@ -114,7 +117,7 @@ import (
 //
 type ListActivityStreamSelf struct {
 	Query struct {
-		OnlyActiveCourses bool `json:"only_active_courses" url:"only_active_courses,omitempty"` //  (Optional)
+		OnlyActiveCourses canvasapi.Opt[bool] `json:"only_active_courses" url:"only_active_courses,omitempty"` //  (Optional)
 	} `json:"query"`
 }
 
@@ -123,11 +126,11 @@ func (t *ListActivityStreamSelf) GetMethod() string {
 }
 
 func (t *ListActivityStreamSelf) GetURLPath() string {
-	return ""
+	return "users/self/activity_stream"
 }
 
 func (t *ListActivityStreamSelf) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -146,11 +149,42 @@ func (t *ListActivityStreamSelf) HasErrors() error {
 	return nil
 }
 
-func (t *ListActivityStreamSelf) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *ListActivityStreamSelf) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.StreamItem, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListActivityStreamSelf) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.StreamItem, *canvasapi.PagedResource, error) {
+	var err error
+	var response *http.Response
+	if next != nil {
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
+	} else {
+		response, err = c.SendRequestContext(ctx, t)
+	}
+
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	ret := []*models.StreamItem{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ret, pagedResource, nil
 }
@@ -1,11 +1,14 @@
 package requests
 
 import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
 	"net/url"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // ListAllCourses A paginated list of all courses visible in the public index
@@ -20,9 +23,9 @@ import (
 //
 type ListAllCourses struct {
 	Query struct {
-		Search             string `json:"search" url:"search,omitempty"`                             //  (Optional)
-		PublicOnly         bool   `json:"public_only" url:"public_only,omitempty"`                   //  (Optional)
-		OpenEnrollmentOnly bool   `json:"open_enrollment_only" url:"open_enrollment_only,omitempty"` //  (Optional)
+		Search             string              `json:"search" url:"search,omitempty"`                             //  (Optional)
+		PublicOnly         canvasapi.Opt[bool] `json:"public_only" url:"public_only,omitempty"`                   //  (Optional)
+		OpenEnrollmentOnly canvasapi.Opt[bool] `json:"open_enrollment_only" url:"open_enrollment_only,omitempty"` //  (Optional)
 	} `json:"query"`
 }
 
@@ -31,11 +34,11 @@ func (t *ListAllCourses) GetMethod() string {
 }
 
 func (t *ListAllCourses) GetURLPath() string {
-	return ""
+	return "search/all_courses"
 }
 
 func (t *ListAllCourses) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -54,11 +57,42 @@ func (t *ListAllCourses) HasErrors() error {
 	return nil
 }
 
-func (t *ListAllCourses) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *ListAllCourses) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Course, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListAllCourses) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.Course, *canvasapi.PagedResource, error) {
+	var err error
+	var response *http.Response
+	if next != nil {
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
+	} else {
+		response, err = c.SendRequestContext(ctx, t)
+	}
+
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	ret := []*models.Course{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ret, pagedResource, nil
 }
@@ -1,9 +1,13 @@
 package requests
 
 import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // ListAllWebhookSubscriptionForToolProxy This endpoint returns a paginated list with a default limit of 100 items per result set.
@@ -22,7 +26,7 @@ func (t *ListAllWebhookSubscriptionForToolProxy) GetMethod() string {
 }
 
 func (t *ListAllWebhookSubscriptionForToolProxy) GetURLPath() string {
-	return ""
+	return "/lti/subscriptions"
 }
 
 func (t *ListAllWebhookSubscriptionForToolProxy) GetQuery() (string, error) {
@@ -41,11 +45,30 @@ func (t *ListAllWebhookSubscriptionForToolProxy) HasErrors() error {
 	return nil
 }
 
-func (t *ListAllWebhookSubscriptionForToolProxy) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *ListAllWebhookSubscriptionForToolProxy) Do(c *canvasapi.Canvas) ([]*models.WebhookSubscription, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ListAllWebhookSubscriptionForToolProxy) DoContext(ctx context.Context, c *canvasapi.Canvas) ([]*models.WebhookSubscription, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := []*models.WebhookSubscription{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
 }
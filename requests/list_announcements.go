@@ -1,16 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
-	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -52,12 +49,12 @@ import (
 //
 type ListAnnouncements struct {
 	Query struct {
-		ContextCodes []string  `json:"context_codes" url:"context_codes,omitempty"` //  (Required)
-		StartDate    time.Time `json:"start_date" url:"start_date,omitempty"`       //  (Optional)
-		EndDate      time.Time `json:"end_date" url:"end_date,omitempty"`           //  (Optional)
-		ActiveOnly   bool      `json:"active_only" url:"active_only,omitempty"`     //  (Optional)
-		LatestOnly   bool      `json:"latest_only" url:"latest_only,omitempty"`     //  (Optional)
-		Include      []string  `json:"include" url:"include,omitempty"`             //  (Optional)
+		ContextCodes []string            `json:"context_codes" url:"context_codes,omitempty"` //  (Required)
+		StartDate    time.Time           `json:"start_date" url:"start_date,omitempty"`       //  (Optional)
+		EndDate      time.Time           `json:"end_date" url:"end_date,omitempty"`           //  (Optional)
+		ActiveOnly   canvasapi.Opt[bool] `json:"active_only" url:"active_only,omitempty"`     //  (Optional)
+		LatestOnly   canvasapi.Opt[bool] `json:"latest_only" url:"latest_only,omitempty"`     //  (Optional)
+		Include      []string            `json:"include" url:"include,omitempty"`             //  (Optional)
 	} `json:"query"`
 }
 
@@ -66,11 +63,11 @@ func (t *ListAnnouncements) GetMethod() string {
 }
 
 func (t *ListAnnouncements) GetURLPath() string {
-	return ""
+	return "announcements"
 }
 
 func (t *ListAnnouncements) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -86,23 +83,27 @@ func (t *ListAnnouncements) GetJSON() ([]byte, error) {
 }
 
 func (t *ListAnnouncements) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Query.ContextCodes == nil {
-		errs = append(errs, "'Query.ContextCodes' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.ContextCodes", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ListAnnouncements) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.DiscussionTopic, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListAnnouncements) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.DiscussionTopic, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -122,6 +123,10 @@ func (t *ListAnnouncements) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Di
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
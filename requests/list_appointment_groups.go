@@ -1,13 +1,14 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
 	"net/url"
-	"strings"
-
-	"github.com/google/go-querystring/query"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -30,10 +31,10 @@ import (
 //
 type ListAppointmentGroups struct {
 	Query struct {
-		Scope                   string   `json:"scope" url:"scope,omitempty"`                                         //  (Optional) . Must be one of reservable, manageable
-		ContextCodes            []string `json:"context_codes" url:"context_codes,omitempty"`                         //  (Optional)
-		IncludePastAppointments bool     `json:"include_past_appointments" url:"include_past_appointments,omitempty"` //  (Optional)
-		Include                 []string `json:"include" url:"include,omitempty"`                                     //  (Optional) . Must be one of appointments, child_events, participant_count, reserved_times, all_context_codes
+		Scope                   string              `json:"scope" url:"scope,omitempty"`                                         //  (Optional) . Must be one of reservable, manageable
+		ContextCodes            []string            `json:"context_codes" url:"context_codes,omitempty"`                         //  (Optional)
+		IncludePastAppointments canvasapi.Opt[bool] `json:"include_past_appointments" url:"include_past_appointments,omitempty"` //  (Optional)
+		Include                 []string            `json:"include" url:"include,omitempty"`                                     //  (Optional) . Must be one of appointments, child_events, participant_count, reserved_times, all_context_codes
 	} `json:"query"`
 }
 
@@ -42,11 +43,11 @@ func (t *ListAppointmentGroups) GetMethod() string {
 }
 
 func (t *ListAppointmentGroups) GetURLPath() string {
-	return ""
+	return "appointment_groups"
 }
 
 func (t *ListAppointmentGroups) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -62,26 +63,57 @@ func (t *ListAppointmentGroups) GetJSON() ([]byte, error) {
 }
 
 func (t *ListAppointmentGroups) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Query.Scope != "" && !string_utils.Include([]string{"reservable", "manageable"}, t.Query.Scope) {
-		errs = append(errs, "Scope must be one of reservable, manageable")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.Scope", Rule: canvasapi.RuleOneOf, Allowed: []string{"reservable", "manageable"}})
 	}
 	for _, v := range t.Query.Include {
 		if v != "" && !string_utils.Include([]string{"appointments", "child_events", "participant_count", "reserved_times", "all_context_codes"}, v) {
-			errs = append(errs, "Include must be one of appointments, child_events, participant_count, reserved_times, all_context_codes")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.Include", Rule: canvasapi.RuleOneOf, Allowed: []string{"appointments", "child_events", "participant_count", "reserved_times", "all_context_codes"}})
 		}
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *ListAppointmentGroups) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *ListAppointmentGroups) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.AppointmentGroup, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListAppointmentGroups) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.AppointmentGroup, *canvasapi.PagedResource, error) {
+	var err error
+	var response *http.Response
+	if next != nil {
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
+	} else {
+		response, err = c.SendRequestContext(ctx, t)
+	}
+
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	ret := []*models.AppointmentGroup{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ret, pagedResource, nil
 }
@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -43,16 +41,16 @@ import (
 //
 type ListAssignmentGroups struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		Include                          []string `json:"include" url:"include,omitempty"`                                                         //  (Optional) . Must be one of assignments, discussion_topic, all_dates, assignment_visibility, overrides, submission, observed_users, can_edit, score_statistics
-		AssignmentIDs                    []string `json:"assignment_ids" url:"assignment_ids,omitempty"`                                           //  (Optional)
-		ExcludeAssignmentSubmissionTypes []string `json:"exclude_assignment_submission_types" url:"exclude_assignment_submission_types,omitempty"` //  (Optional) . Must be one of online_quiz, discussion_topic, wiki_page, external_tool
-		OverrideAssignmentDates          bool     `json:"override_assignment_dates" url:"override_assignment_dates,omitempty"`                     //  (Optional)
-		GradingPeriodID                  int64    `json:"grading_period_id" url:"grading_period_id,omitempty"`                                     //  (Optional)
-		ScopeAssignmentsToStudent        bool     `json:"scope_assignments_to_student" url:"scope_assignments_to_student,omitempty"`               //  (Optional)
+		Include                          []string             `json:"include" url:"include,omitempty"`                                                         //  (Optional) . Must be one of assignments, discussion_topic, all_dates, assignment_visibility, overrides, submission, observed_users, can_edit, score_statistics
+		AssignmentIDs                    []string             `json:"assignment_ids" url:"assignment_ids,omitempty"`                                           //  (Optional)
+		ExcludeAssignmentSubmissionTypes []string             `json:"exclude_assignment_submission_types" url:"exclude_assignment_submission_types,omitempty"` //  (Optional) . Must be one of online_quiz, discussion_topic, wiki_page, external_tool
+		OverrideAssignmentDates          canvasapi.Opt[bool]  `json:"override_assignment_dates" url:"override_assignment_dates,omitempty"`                     //  (Optional)
+		GradingPeriodID                  canvasapi.Opt[int64] `json:"grading_period_id" url:"grading_period_id,omitempty"`                                     //  (Optional)
+		ScopeAssignmentsToStudent        canvasapi.Opt[bool]  `json:"scope_assignments_to_student" url:"scope_assignments_to_student,omitempty"`               //  (Optional)
 	} `json:"query"`
 }
 
@@ -62,12 +60,12 @@ func (t *ListAssignmentGroups) GetMethod() string {
 
 func (t *ListAssignmentGroups) GetURLPath() string {
 	path := "courses/{course_id}/assignment_groups"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
 func (t *ListAssignmentGroups) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -83,33 +81,37 @@ func (t *ListAssignmentGroups) GetJSON() ([]byte, error) {
 }
 
 func (t *ListAssignmentGroups) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	for _, v := range t.Query.Include {
 		if v != "" && !string_utils.Include([]string{"assignments", "discussion_topic", "all_dates", "assignment_visibility", "overrides", "submission", "observed_users", "can_edit", "score_statistics"}, v) {
-			errs = append(errs, "Include must be one of assignments, discussion_topic, all_dates, assignment_visibility, overrides, submission, observed_users, can_edit, score_statistics")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.Include", Rule: canvasapi.RuleOneOf, Allowed: []string{"assignments", "discussion_topic", "all_dates", "assignment_visibility", "overrides", "submission", "observed_users", "can_edit", "score_statistics"}})
 		}
 	}
 	for _, v := range t.Query.ExcludeAssignmentSubmissionTypes {
 		if v != "" && !string_utils.Include([]string{"online_quiz", "discussion_topic", "wiki_page", "external_tool"}, v) {
-			errs = append(errs, "ExcludeAssignmentSubmissionTypes must be one of online_quiz, discussion_topic, wiki_page, external_tool")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.ExcludeAssignmentSubmissionTypes", Rule: canvasapi.RuleOneOf, Allowed: []string{"online_quiz", "discussion_topic", "wiki_page", "external_tool"}})
 		}
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ListAssignmentGroups) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.AssignmentGroup, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListAssignmentGroups) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.AssignmentGroup, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -129,6 +131,10 @@ func (t *ListAssignmentGroups) Do(c *canvasapi.Canvas, next *url.URL) ([]*models
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -41,19 +39,19 @@ import (
 //
 type ListAssignmentsAssignmentGroups struct {
 	Path struct {
-		CourseID          string `json:"course_id" url:"course_id,omitempty"`                     //  (Required)
-		AssignmentGroupID string `json:"assignment_group_id" url:"assignment_group_id,omitempty"` //  (Required)
+		CourseID          canvasapi.ID `json:"course_id" url:"course_id,omitempty"`                     //  (Required)
+		AssignmentGroupID canvasapi.ID `json:"assignment_group_id" url:"assignment_group_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		Include                    []string `json:"include" url:"include,omitempty"`                                               //  (Optional) . Must be one of submission, assignment_visibility, all_dates, overrides, observed_users, can_edit, score_statistics
-		SearchTerm                 string   `json:"search_term" url:"search_term,omitempty"`                                       //  (Optional)
-		OverrideAssignmentDates    bool     `json:"override_assignment_dates" url:"override_assignment_dates,omitempty"`           //  (Optional)
-		NeedsGradingCountBySection bool     `json:"needs_grading_count_by_section" url:"needs_grading_count_by_section,omitempty"` //  (Optional)
-		Bucket                     string   `json:"bucket" url:"bucket,omitempty"`                                                 //  (Optional) . Must be one of past, overdue, undated, ungraded, unsubmitted, upcoming, future
-		AssignmentIDs              []string `json:"assignment_ids" url:"assignment_ids,omitempty"`                                 //  (Optional)
-		OrderBy                    string   `json:"order_by" url:"order_by,omitempty"`                                             //  (Optional) . Must be one of position, name, due_at
-		PostToSIS                  bool     `json:"post_to_sis" url:"post_to_sis,omitempty"`                                       //  (Optional)
+		Include                    []string            `json:"include" url:"include,omitempty"`                                               //  (Optional) . Must be one of submission, assignment_visibility, all_dates, overrides, observed_users, can_edit, score_statistics
+		SearchTerm                 string              `json:"search_term" url:"search_term,omitempty"`                                       //  (Optional)
+		OverrideAssignmentDates    canvasapi.Opt[bool] `json:"override_assignment_dates" url:"override_assignment_dates,omitempty"`           //  (Optional)
+		NeedsGradingCountBySection canvasapi.Opt[bool] `json:"needs_grading_count_by_section" url:"needs_grading_count_by_section,omitempty"` //  (Optional)
+		Bucket                     string              `json:"bucket" url:"bucket,omitempty"`                                                 //  (Optional) . Must be one of past, overdue, undated, ungraded, unsubmitted, upcoming, future
+		AssignmentIDs              []string            `json:"assignment_ids" url:"assignment_ids,omitempty"`                                 //  (Optional)
+		OrderBy                    string              `json:"order_by" url:"order_by,omitempty"`                                             //  (Optional) . Must be one of position, name, due_at
+		PostToSIS                  canvasapi.Opt[bool] `json:"post_to_sis" url:"post_to_sis,omitempty"`                                       //  (Optional)
 	} `json:"query"`
 }
 
@@ -63,13 +61,13 @@ func (t *ListAssignmentsAssignmentGroups) GetMethod() string {
 
 func (t *ListAssignmentsAssignmentGroups) GetURLPath() string {
 	path := "courses/{course_id}/assignment_groups/{assignment_group_id}/assignments"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{assignment_group_id}", fmt.Sprintf("%v", t.Path.AssignmentGroupID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{assignment_group_id}", t.Path.AssignmentGroupID.Escaped())
 	return path
 }
 
 func (t *ListAssignmentsAssignmentGroups) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -85,37 +83,41 @@ func (t *ListAssignmentsAssignmentGroups) GetJSON() ([]byte, error) {
 }
 
 func (t *ListAssignmentsAssignmentGroups) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.AssignmentGroupID == "" {
-		errs = append(errs, "'Path.AssignmentGroupID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AssignmentGroupID", Rule: canvasapi.RuleRequired})
 	}
 	for _, v := range t.Query.Include {
 		if v != "" && !string_utils.Include([]string{"submission", "assignment_visibility", "all_dates", "overrides", "observed_users", "can_edit", "score_statistics"}, v) {
-			errs = append(errs, "Include must be one of submission, assignment_visibility, all_dates, overrides, observed_users, can_edit, score_statistics")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.Include", Rule: canvasapi.RuleOneOf, Allowed: []string{"submission", "assignment_visibility", "all_dates", "overrides", "observed_users", "can_edit", "score_statistics"}})
 		}
 	}
 	if t.Query.Bucket != "" && !string_utils.Include([]string{"past", "overdue", "undated", "ungraded", "unsubmitted", "upcoming", "future"}, t.Query.Bucket) {
-		errs = append(errs, "Bucket must be one of past, overdue, undated, ungraded, unsubmitted, upcoming, future")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.Bucket", Rule: canvasapi.RuleOneOf, Allowed: []string{"past", "overdue", "undated", "ungraded", "unsubmitted", "upcoming", "future"}})
 	}
 	if t.Query.OrderBy != "" && !string_utils.Include([]string{"position", "name", "due_at"}, t.Query.OrderBy) {
-		errs = append(errs, "OrderBy must be one of position, name, due_at")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.OrderBy", Rule: canvasapi.RuleOneOf, Allowed: []string{"position", "name", "due_at"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ListAssignmentsAssignmentGroups) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Assignment, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListAssignmentsAssignmentGroups) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.Assignment, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -135,6 +137,10 @@ func (t *ListAssignmentsAssignmentGroups) Do(c *canvasapi.Canvas, next *url.URL)
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
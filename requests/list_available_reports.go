@@ -1,11 +1,14 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // ListAvailableReports Returns a paginated list of reports for the current context.
@@ -16,7 +19,7 @@ import (
 //
 type ListAvailableReports struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -26,7 +29,7 @@ func (t *ListAvailableReports) GetMethod() string {
 
 func (t *ListAvailableReports) GetURLPath() string {
 	path := "accounts/{account_id}/reports"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	return path
 }
 
@@ -43,21 +46,40 @@ func (t *ListAvailableReports) GetJSON() ([]byte, error) {
 }
 
 func (t *ListAvailableReports) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *ListAvailableReports) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *ListAvailableReports) Do(c *canvasapi.Canvas) ([]*models.AvailableReport, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ListAvailableReports) DoContext(ctx context.Context, c *canvasapi.Canvas) ([]*models.AvailableReport, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := []*models.AvailableReport{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
 }
@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -24,8 +24,8 @@ import (
 //
 type ListBlueprintImports struct {
 	Path struct {
-		CourseID       string `json:"course_id" url:"course_id,omitempty"`             //  (Required)
-		SubscriptionID string `json:"subscription_id" url:"subscription_id,omitempty"` //  (Required)
+		CourseID       canvasapi.ID `json:"course_id" url:"course_id,omitempty"`             //  (Required)
+		SubscriptionID canvasapi.ID `json:"subscription_id" url:"subscription_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -35,8 +35,8 @@ func (t *ListBlueprintImports) GetMethod() string {
 
 func (t *ListBlueprintImports) GetURLPath() string {
 	path := "courses/{course_id}/blueprint_subscriptions/{subscription_id}/migrations"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{subscription_id}", fmt.Sprintf("%v", t.Path.SubscriptionID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{subscription_id}", t.Path.SubscriptionID.Escaped())
 	return path
 }
 
@@ -53,26 +53,30 @@ func (t *ListBlueprintImports) GetJSON() ([]byte, error) {
 }
 
 func (t *ListBlueprintImports) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.SubscriptionID == "" {
-		errs = append(errs, "'Path.SubscriptionID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.SubscriptionID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ListBlueprintImports) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.BlueprintMigration, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListBlueprintImports) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.BlueprintMigration, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -92,6 +96,10 @@ func (t *ListBlueprintImports) Do(c *canvasapi.Canvas, next *url.URL) ([]*models
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
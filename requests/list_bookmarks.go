@@ -1,6 +1,7 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
@@ -21,7 +22,7 @@ func (t *ListBookmarks) GetMethod() string {
 }
 
 func (t *ListBookmarks) GetURLPath() string {
-	return ""
+	return "users/self/bookmarks"
 }
 
 func (t *ListBookmarks) GetQuery() (string, error) {
@@ -41,12 +42,16 @@ func (t *ListBookmarks) HasErrors() error {
 }
 
 func (t *ListBookmarks) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Bookmark, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListBookmarks) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.Bookmark, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -66,6 +71,10 @@ func (t *ListBookmarks) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Bookma
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
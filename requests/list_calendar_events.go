@@ -1,16 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
-	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -43,14 +40,14 @@ import (
 //
 type ListCalendarEvents struct {
 	Query struct {
-		Type           string    `json:"type" url:"type,omitempty"`                       //  (Optional) . Must be one of event, assignment
-		StartDate      time.Time `json:"start_date" url:"start_date,omitempty"`           //  (Optional)
-		EndDate        time.Time `json:"end_date" url:"end_date,omitempty"`               //  (Optional)
-		Undated        bool      `json:"undated" url:"undated,omitempty"`                 //  (Optional)
-		AllEvents      bool      `json:"all_events" url:"all_events,omitempty"`           //  (Optional)
-		ContextCodes   []string  `json:"context_codes" url:"context_codes,omitempty"`     //  (Optional)
-		Excludes       []string  `json:"excludes" url:"excludes,omitempty"`               //  (Optional)
-		ImportantDates bool      `json:"important_dates" url:"important_dates,omitempty"` //  (Optional)
+		Type           string              `json:"type" url:"type,omitempty"`                       //  (Optional) . Must be one of event, assignment
+		StartDate      time.Time           `json:"start_date" url:"start_date,omitempty"`           //  (Optional)
+		EndDate        time.Time           `json:"end_date" url:"end_date,omitempty"`               //  (Optional)
+		Undated        canvasapi.Opt[bool] `json:"undated" url:"undated,omitempty"`                 //  (Optional)
+		AllEvents      canvasapi.Opt[bool] `json:"all_events" url:"all_events,omitempty"`           //  (Optional)
+		ContextCodes   []string            `json:"context_codes" url:"context_codes,omitempty"`     //  (Optional)
+		Excludes       []string            `json:"excludes" url:"excludes,omitempty"`               //  (Optional)
+		ImportantDates canvasapi.Opt[bool] `json:"important_dates" url:"important_dates,omitempty"` //  (Optional)
 	} `json:"query"`
 }
 
@@ -59,11 +56,11 @@ func (t *ListCalendarEvents) GetMethod() string {
 }
 
 func (t *ListCalendarEvents) GetURLPath() string {
-	return ""
+	return "calendar_events"
 }
 
 func (t *ListCalendarEvents) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -79,23 +76,27 @@ func (t *ListCalendarEvents) GetJSON() ([]byte, error) {
 }
 
 func (t *ListCalendarEvents) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Query.Type != "" && !string_utils.Include([]string{"event", "assignment"}, t.Query.Type) {
-		errs = append(errs, "Type must be one of event, assignment")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.Type", Rule: canvasapi.RuleOneOf, Allowed: []string{"event", "assignment"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ListCalendarEvents) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.CalendarEvent, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListCalendarEvents) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.CalendarEvent, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -115,6 +116,10 @@ func (t *ListCalendarEvents) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.C
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
@@ -1,16 +1,14 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -52,20 +50,20 @@ import (
 //
 type ListCalendarEventsForUser struct {
 	Path struct {
-		UserID string `json:"user_id" url:"user_id,omitempty"` //  (Required)
+		UserID canvasapi.ID `json:"user_id" url:"user_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		Type                   string    `json:"type" url:"type,omitempty"`                                         //  (Optional) . Must be one of event, assignment
-		StartDate              time.Time `json:"start_date" url:"start_date,omitempty"`                             //  (Optional)
-		EndDate                time.Time `json:"end_date" url:"end_date,omitempty"`                                 //  (Optional)
-		Undated                bool      `json:"undated" url:"undated,omitempty"`                                   //  (Optional)
-		AllEvents              bool      `json:"all_events" url:"all_events,omitempty"`                             //  (Optional)
-		ContextCodes           []string  `json:"context_codes" url:"context_codes,omitempty"`                       //  (Optional)
-		Excludes               []string  `json:"excludes" url:"excludes,omitempty"`                                 //  (Optional)
-		SubmissionTypes        []string  `json:"submission_types" url:"submission_types,omitempty"`                 //  (Optional)
-		ExcludeSubmissionTypes []string  `json:"exclude_submission_types" url:"exclude_submission_types,omitempty"` //  (Optional)
-		ImportantDates         bool      `json:"important_dates" url:"important_dates,omitempty"`                   //  (Optional)
+		Type                   string              `json:"type" url:"type,omitempty"`                                         //  (Optional) . Must be one of event, assignment
+		StartDate              time.Time           `json:"start_date" url:"start_date,omitempty"`                             //  (Optional)
+		EndDate                time.Time           `json:"end_date" url:"end_date,omitempty"`                                 //  (Optional)
+		Undated                canvasapi.Opt[bool] `json:"undated" url:"undated,omitempty"`                                   //  (Optional)
+		AllEvents              canvasapi.Opt[bool] `json:"all_events" url:"all_events,omitempty"`                             //  (Optional)
+		ContextCodes           []string            `json:"context_codes" url:"context_codes,omitempty"`                       //  (Optional)
+		Excludes               []string            `json:"excludes" url:"excludes,omitempty"`                                 //  (Optional)
+		SubmissionTypes        []string            `json:"submission_types" url:"submission_types,omitempty"`                 //  (Optional)
+		ExcludeSubmissionTypes []string            `json:"exclude_submission_types" url:"exclude_submission_types,omitempty"` //  (Optional)
+		ImportantDates         canvasapi.Opt[bool] `json:"important_dates" url:"important_dates,omitempty"`                   //  (Optional)
 	} `json:"query"`
 }
 
@@ -75,12 +73,12 @@ func (t *ListCalendarEventsForUser) GetMethod() string {
 
 func (t *ListCalendarEventsForUser) GetURLPath() string {
 	path := "users/{user_id}/calendar_events"
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", t.Path.UserID))
+	path = strings.ReplaceAll(path, "{user_id}", t.Path.UserID.Escaped())
 	return path
 }
 
 func (t *ListCalendarEventsForUser) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -96,26 +94,30 @@ func (t *ListCalendarEventsForUser) GetJSON() ([]byte, error) {
 }
 
 func (t *ListCalendarEventsForUser) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.UserID == "" {
-		errs = append(errs, "'Path.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Query.Type != "" && !string_utils.Include([]string{"event", "assignment"}, t.Query.Type) {
-		errs = append(errs, "Type must be one of event, assignment")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.Type", Rule: canvasapi.RuleOneOf, Allowed: []string{"event", "assignment"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ListCalendarEventsForUser) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.CalendarEvent, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListCalendarEventsForUser) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.CalendarEvent, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -135,6 +137,10 @@ func (t *ListCalendarEventsForUser) Do(c *canvasapi.Canvas, next *url.URL) ([]*m
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
@@ -1,9 +1,13 @@
 package requests
 
 import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // ListClosedPollSessions A paginated list of all closed poll sessions available to the current user.
@@ -17,7 +21,7 @@ func (t *ListClosedPollSessions) GetMethod() string {
 }
 
 func (t *ListClosedPollSessions) GetURLPath() string {
-	return ""
+	return "poll_sessions/closed"
 }
 
 func (t *ListClosedPollSessions) GetQuery() (string, error) {
@@ -36,11 +40,30 @@ func (t *ListClosedPollSessions) HasErrors() error {
 	return nil
 }
 
-func (t *ListClosedPollSessions) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *ListClosedPollSessions) Do(c *canvasapi.Canvas) (*models.PollSessionsResponse, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ListClosedPollSessions) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.PollSessionsResponse, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.PollSessionsResponse{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,13 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -35,11 +34,11 @@ func (t *ListConferencesForCurrentUser) GetMethod() string {
 }
 
 func (t *ListConferencesForCurrentUser) GetURLPath() string {
-	return ""
+	return "conferences"
 }
 
 func (t *ListConferencesForCurrentUser) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -59,12 +58,16 @@ func (t *ListConferencesForCurrentUser) HasErrors() error {
 }
 
 func (t *ListConferencesForCurrentUser) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Conference, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListConferencesForCurrentUser) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.Conference, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -84,6 +87,10 @@ func (t *ListConferencesForCurrentUser) Do(c *canvasapi.Canvas, next *url.URL) (
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
@@ -1,14 +1,11 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
-	"strings"
-
-	"github.com/google/go-querystring/query"
 
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
@@ -42,12 +39,12 @@ import (
 //
 type ListConversations struct {
 	Query struct {
-		Scope                     string   `json:"scope" url:"scope,omitempty"`                                               //  (Optional) . Must be one of unread, starred, archived
-		Filter                    []string `json:"filter" url:"filter,omitempty"`                                             //  (Optional)
-		FilterMode                string   `json:"filter_mode" url:"filter_mode,omitempty"`                                   //  (Optional) . Must be one of and, or, default or
-		InterleaveSubmissions     bool     `json:"interleave_submissions" url:"interleave_submissions,omitempty"`             //  (Optional)
-		IncludeAllConversationIDs bool     `json:"include_all_conversation_ids" url:"include_all_conversation_ids,omitempty"` //  (Optional)
-		Include                   []string `json:"include" url:"include,omitempty"`                                           //  (Optional) . Must be one of participant_avatars
+		Scope                     string              `json:"scope" url:"scope,omitempty"`                                               //  (Optional) . Must be one of unread, starred, archived
+		Filter                    []string            `json:"filter" url:"filter,omitempty"`                                             //  (Optional)
+		FilterMode                string              `json:"filter_mode" url:"filter_mode,omitempty"`                                   //  (Optional) . Must be one of and, or, default or
+		InterleaveSubmissions     canvasapi.Opt[bool] `json:"interleave_submissions" url:"interleave_submissions,omitempty"`             //  (Optional)
+		IncludeAllConversationIDs canvasapi.Opt[bool] `json:"include_all_conversation_ids" url:"include_all_conversation_ids,omitempty"` //  (Optional)
+		Include                   []string            `json:"include" url:"include,omitempty"`                                           //  (Optional) . Must be one of participant_avatars
 	} `json:"query"`
 }
 
@@ -56,11 +53,11 @@ func (t *ListConversations) GetMethod() string {
 }
 
 func (t *ListConversations) GetURLPath() string {
-	return ""
+	return "conversations"
 }
 
 func (t *ListConversations) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -76,31 +73,35 @@ func (t *ListConversations) GetJSON() ([]byte, error) {
 }
 
 func (t *ListConversations) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Query.Scope != "" && !string_utils.Include([]string{"unread", "starred", "archived"}, t.Query.Scope) {
-		errs = append(errs, "Scope must be one of unread, starred, archived")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.Scope", Rule: canvasapi.RuleOneOf, Allowed: []string{"unread", "starred", "archived"}})
 	}
 	if t.Query.FilterMode != "" && !string_utils.Include([]string{"and", "or", "default or"}, t.Query.FilterMode) {
-		errs = append(errs, "FilterMode must be one of and, or, default or")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.FilterMode", Rule: canvasapi.RuleOneOf, Allowed: []string{"and", "or", "default or"}})
 	}
 	for _, v := range t.Query.Include {
 		if v != "" && !string_utils.Include([]string{"participant_avatars"}, v) {
-			errs = append(errs, "Include must be one of participant_avatars")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.Include", Rule: canvasapi.RuleOneOf, Allowed: []string{"participant_avatars"}})
 		}
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ListConversations) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Conversation, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListConversations) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.Conversation, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -120,6 +121,10 @@ func (t *ListConversations) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Co
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
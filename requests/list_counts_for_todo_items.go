@@ -1,11 +1,10 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
-	"strings"
-
-	"github.com/google/go-querystring/query"
 
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
@@ -33,11 +32,11 @@ func (t *ListCountsForTodoItems) GetMethod() string {
 }
 
 func (t *ListCountsForTodoItems) GetURLPath() string {
-	return ""
+	return "users/self/todo_item_count"
 }
 
 func (t *ListCountsForTodoItems) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -53,23 +52,33 @@ func (t *ListCountsForTodoItems) GetJSON() ([]byte, error) {
 }
 
 func (t *ListCountsForTodoItems) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	for _, v := range t.Query.Include {
 		if v != "" && !string_utils.Include([]string{"ungraded_quizzes"}, v) {
-			errs = append(errs, "Include must be one of ungraded_quizzes")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.Include", Rule: canvasapi.RuleOneOf, Allowed: []string{"ungraded_quizzes"}})
 		}
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *ListCountsForTodoItems) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *ListCountsForTodoItems) Do(c *canvasapi.Canvas) (json.RawMessage, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ListCountsForTodoItems) DoContext(ctx context.Context, c *canvasapi.Canvas) (json.RawMessage, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(body), nil
 }
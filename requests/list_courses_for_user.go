@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -107,14 +105,14 @@ import (
 //
 type ListCoursesForUser struct {
 	Path struct {
-		UserID string `json:"user_id" url:"user_id,omitempty"` //  (Required)
+		UserID canvasapi.ID `json:"user_id" url:"user_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		Include         []string `json:"include" url:"include,omitempty"`                   //  (Optional) . Must be one of needs_grading_count, syllabus_body, public_description, total_scores, current_grading_period_scores, grading_periods, term, account, course_progress, sections, storage_quota_used_mb, total_students, passback_status, favorites, teachers, observed_users, course_image, concluded
-		State           []string `json:"state" url:"state,omitempty"`                       //  (Optional) . Must be one of unpublished, available, completed, deleted
-		EnrollmentState string   `json:"enrollment_state" url:"enrollment_state,omitempty"` //  (Optional) . Must be one of active, invited_or_pending, completed
-		Homeroom        bool     `json:"homeroom" url:"homeroom,omitempty"`                 //  (Optional)
+		Include         []string            `json:"include" url:"include,omitempty"`                   //  (Optional) . Must be one of needs_grading_count, syllabus_body, public_description, total_scores, current_grading_period_scores, grading_periods, term, account, course_progress, sections, storage_quota_used_mb, total_students, passback_status, favorites, teachers, observed_users, course_image, concluded
+		State           []string            `json:"state" url:"state,omitempty"`                       //  (Optional) . Must be one of unpublished, available, completed, deleted
+		EnrollmentState string              `json:"enrollment_state" url:"enrollment_state,omitempty"` //  (Optional) . Must be one of active, invited_or_pending, completed
+		Homeroom        canvasapi.Opt[bool] `json:"homeroom" url:"homeroom,omitempty"`                 //  (Optional)
 	} `json:"query"`
 }
 
@@ -124,12 +122,12 @@ func (t *ListCoursesForUser) GetMethod() string {
 
 func (t *ListCoursesForUser) GetURLPath() string {
 	path := "users/{user_id}/courses"
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", t.Path.UserID))
+	path = strings.ReplaceAll(path, "{user_id}", t.Path.UserID.Escaped())
 	return path
 }
 
 func (t *ListCoursesForUser) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -145,36 +143,40 @@ func (t *ListCoursesForUser) GetJSON() ([]byte, error) {
 }
 
 func (t *ListCoursesForUser) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.UserID == "" {
-		errs = append(errs, "'Path.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.UserID", Rule: canvasapi.RuleRequired})
 	}
 	for _, v := range t.Query.Include {
 		if v != "" && !string_utils.Include([]string{"needs_grading_count", "syllabus_body", "public_description", "total_scores", "current_grading_period_scores", "grading_periods", "term", "account", "course_progress", "sections", "storage_quota_used_mb", "total_students", "passback_status", "favorites", "teachers", "observed_users", "course_image", "concluded"}, v) {
-			errs = append(errs, "Include must be one of needs_grading_count, syllabus_body, public_description, total_scores, current_grading_period_scores, grading_periods, term, account, course_progress, sections, storage_quota_used_mb, total_students, passback_status, favorites, teachers, observed_users, course_image, concluded")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.Include", Rule: canvasapi.RuleOneOf, Allowed: []string{"needs_grading_count", "syllabus_body", "public_description", "total_scores", "current_grading_period_scores", "grading_periods", "term", "account", "course_progress", "sections", "storage_quota_used_mb", "total_students", "passback_status", "favorites", "teachers", "observed_users", "course_image", "concluded"}})
 		}
 	}
 	for _, v := range t.Query.State {
 		if v != "" && !string_utils.Include([]string{"unpublished", "available", "completed", "deleted"}, v) {
-			errs = append(errs, "State must be one of unpublished, available, completed, deleted")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.State", Rule: canvasapi.RuleOneOf, Allowed: []string{"unpublished", "available", "completed", "deleted"}})
 		}
 	}
 	if t.Query.EnrollmentState != "" && !string_utils.Include([]string{"active", "invited_or_pending", "completed"}, t.Query.EnrollmentState) {
-		errs = append(errs, "EnrollmentState must be one of active, invited_or_pending, completed")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.EnrollmentState", Rule: canvasapi.RuleOneOf, Allowed: []string{"active", "invited_or_pending", "completed"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ListCoursesForUser) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Course, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListCoursesForUser) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.Course, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -194,6 +196,10 @@ func (t *ListCoursesForUser) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.C
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
@@ -1,6 +1,7 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
@@ -22,7 +23,7 @@ func (t *ListCoursesWithTheirLatestEpubExport) GetMethod() string {
 }
 
 func (t *ListCoursesWithTheirLatestEpubExport) GetURLPath() string {
-	return ""
+	return "epub_exports"
 }
 
 func (t *ListCoursesWithTheirLatestEpubExport) GetQuery() (string, error) {
@@ -42,12 +43,16 @@ func (t *ListCoursesWithTheirLatestEpubExport) HasErrors() error {
 }
 
 func (t *ListCoursesWithTheirLatestEpubExport) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.CourseEpubExport, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListCoursesWithTheirLatestEpubExport) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.CourseEpubExport, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -67,6 +72,10 @@ func (t *ListCoursesWithTheirLatestEpubExport) Do(c *canvasapi.Canvas, next *url
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
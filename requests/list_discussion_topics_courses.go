@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -46,17 +44,17 @@ import (
 //
 type ListDiscussionTopicsCourses struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		Include                          []string `json:"include" url:"include,omitempty"`                                                           //  (Optional) . Must be one of all_dates, sections, sections_user_count, overrides
-		OrderBy                          string   `json:"order_by" url:"order_by,omitempty"`                                                         //  (Optional) . Must be one of position, recent_activity, title
-		Scope                            string   `json:"scope" url:"scope,omitempty"`                                                               //  (Optional) . Must be one of locked, unlocked, pinned, unpinned
-		OnlyAnnouncements                bool     `json:"only_announcements" url:"only_announcements,omitempty"`                                     //  (Optional)
-		FilterBy                         string   `json:"filter_by" url:"filter_by,omitempty"`                                                       //  (Optional) . Must be one of all, unread
-		SearchTerm                       string   `json:"search_term" url:"search_term,omitempty"`                                                   //  (Optional)
-		ExcludeContextModuleLockedTopics bool     `json:"exclude_context_module_locked_topics" url:"exclude_context_module_locked_topics,omitempty"` //  (Optional)
+		Include                          []string            `json:"include" url:"include,omitempty"`                                                           //  (Optional) . Must be one of all_dates, sections, sections_user_count, overrides
+		OrderBy                          string              `json:"order_by" url:"order_by,omitempty"`                                                         //  (Optional) . Must be one of position, recent_activity, title
+		Scope                            string              `json:"scope" url:"scope,omitempty"`                                                               //  (Optional) . Must be one of locked, unlocked, pinned, unpinned
+		OnlyAnnouncements                canvasapi.Opt[bool] `json:"only_announcements" url:"only_announcements,omitempty"`                                     //  (Optional)
+		FilterBy                         string              `json:"filter_by" url:"filter_by,omitempty"`                                                       //  (Optional) . Must be one of all, unread
+		SearchTerm                       string              `json:"search_term" url:"search_term,omitempty"`                                                   //  (Optional)
+		ExcludeContextModuleLockedTopics canvasapi.Opt[bool] `json:"exclude_context_module_locked_topics" url:"exclude_context_module_locked_topics,omitempty"` //  (Optional)
 	} `json:"query"`
 }
 
@@ -66,12 +64,12 @@ func (t *ListDiscussionTopicsCourses) GetMethod() string {
 
 func (t *ListDiscussionTopicsCourses) GetURLPath() string {
 	path := "courses/{course_id}/discussion_topics"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
 func (t *ListDiscussionTopicsCourses) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -87,37 +85,41 @@ func (t *ListDiscussionTopicsCourses) GetJSON() ([]byte, error) {
 }
 
 func (t *ListDiscussionTopicsCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	for _, v := range t.Query.Include {
 		if v != "" && !string_utils.Include([]string{"all_dates", "sections", "sections_user_count", "overrides"}, v) {
-			errs = append(errs, "Include must be one of all_dates, sections, sections_user_count, overrides")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.Include", Rule: canvasapi.RuleOneOf, Allowed: []string{"all_dates", "sections", "sections_user_count", "overrides"}})
 		}
 	}
 	if t.Query.OrderBy != "" && !string_utils.Include([]string{"position", "recent_activity", "title"}, t.Query.OrderBy) {
-		errs = append(errs, "OrderBy must be one of position, recent_activity, title")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.OrderBy", Rule: canvasapi.RuleOneOf, Allowed: []string{"position", "recent_activity", "title"}})
 	}
 	if t.Query.Scope != "" && !string_utils.Include([]string{"locked", "unlocked", "pinned", "unpinned"}, t.Query.Scope) {
-		errs = append(errs, "Scope must be one of locked, unlocked, pinned, unpinned")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.Scope", Rule: canvasapi.RuleOneOf, Allowed: []string{"locked", "unlocked", "pinned", "unpinned"}})
 	}
 	if t.Query.FilterBy != "" && !string_utils.Include([]string{"all", "unread"}, t.Query.FilterBy) {
-		errs = append(errs, "FilterBy must be one of all, unread")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.FilterBy", Rule: canvasapi.RuleOneOf, Allowed: []string{"all", "unread"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ListDiscussionTopicsCourses) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.DiscussionTopic, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListDiscussionTopicsCourses) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.DiscussionTopic, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -137,6 +139,10 @@ func (t *ListDiscussionTopicsCourses) Do(c *canvasapi.Canvas, next *url.URL) ([]
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
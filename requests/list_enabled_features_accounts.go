@@ -1,7 +1,9 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
@@ -17,7 +19,7 @@ import (
 //
 type ListEnabledFeaturesAccounts struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -27,7 +29,7 @@ func (t *ListEnabledFeaturesAccounts) GetMethod() string {
 
 func (t *ListEnabledFeaturesAccounts) GetURLPath() string {
 	path := "accounts/{account_id}/features/enabled"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	return path
 }
 
@@ -44,21 +46,36 @@ func (t *ListEnabledFeaturesAccounts) GetJSON() ([]byte, error) {
 }
 
 func (t *ListEnabledFeaturesAccounts) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *ListEnabledFeaturesAccounts) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *ListEnabledFeaturesAccounts) Do(c *canvasapi.Canvas) (*[]string, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ListEnabledFeaturesAccounts) DoContext(ctx context.Context, c *canvasapi.Canvas) (*[]string, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := []string{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
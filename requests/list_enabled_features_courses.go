@@ -1,7 +1,9 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
@@ -17,7 +19,7 @@ import (
 //
 type ListEnabledFeaturesCourses struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -27,7 +29,7 @@ func (t *ListEnabledFeaturesCourses) GetMethod() string {
 
 func (t *ListEnabledFeaturesCourses) GetURLPath() string {
 	path := "courses/{course_id}/features/enabled"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
@@ -44,21 +46,36 @@ func (t *ListEnabledFeaturesCourses) GetJSON() ([]byte, error) {
 }
 
 func (t *ListEnabledFeaturesCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *ListEnabledFeaturesCourses) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *ListEnabledFeaturesCourses) Do(c *canvasapi.Canvas) (*[]string, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ListEnabledFeaturesCourses) DoContext(ctx context.Context, c *canvasapi.Canvas) (*[]string, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := []string{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,7 +1,9 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
@@ -17,7 +19,7 @@ import (
 //
 type ListEnabledFeaturesUsers struct {
 	Path struct {
-		UserID string `json:"user_id" url:"user_id,omitempty"` //  (Required)
+		UserID canvasapi.ID `json:"user_id" url:"user_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -27,7 +29,7 @@ func (t *ListEnabledFeaturesUsers) GetMethod() string {
 
 func (t *ListEnabledFeaturesUsers) GetURLPath() string {
 	path := "users/{user_id}/features/enabled"
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", t.Path.UserID))
+	path = strings.ReplaceAll(path, "{user_id}", t.Path.UserID.Escaped())
 	return path
 }
 
@@ -44,21 +46,36 @@ func (t *ListEnabledFeaturesUsers) GetJSON() ([]byte, error) {
 }
 
 func (t *ListEnabledFeaturesUsers) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.UserID == "" {
-		errs = append(errs, "'Path.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *ListEnabledFeaturesUsers) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *ListEnabledFeaturesUsers) Do(c *canvasapi.Canvas) (*[]string, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ListEnabledFeaturesUsers) DoContext(ctx context.Context, c *canvasapi.Canvas) (*[]string, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := []string{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -29,7 +27,7 @@ import (
 //
 type ListEnrollmentTerms struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
@@ -44,12 +42,12 @@ func (t *ListEnrollmentTerms) GetMethod() string {
 
 func (t *ListEnrollmentTerms) GetURLPath() string {
 	path := "accounts/{account_id}/terms"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	return path
 }
 
 func (t *ListEnrollmentTerms) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -65,28 +63,32 @@ func (t *ListEnrollmentTerms) GetJSON() ([]byte, error) {
 }
 
 func (t *ListEnrollmentTerms) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	for _, v := range t.Query.WorkflowState {
 		if v != "" && !string_utils.Include([]string{"active", "deleted", "all"}, v) {
-			errs = append(errs, "WorkflowState must be one of active, deleted, all")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.WorkflowState", Rule: canvasapi.RuleOneOf, Allowed: []string{"active", "deleted", "all"}})
 		}
 	}
 	for _, v := range t.Query.Include {
 		if v != "" && !string_utils.Include([]string{"overrides"}, v) {
-			errs = append(errs, "Include must be one of overrides")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.Include", Rule: canvasapi.RuleOneOf, Allowed: []string{"overrides"}})
 		}
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ListEnrollmentTerms) Do(c *canvasapi.Canvas) (*models.EnrollmentTermsList, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ListEnrollmentTerms) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.EnrollmentTermsList, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -101,6 +103,10 @@ func (t *ListEnrollmentTerms) Do(c *canvasapi.Canvas) (*models.EnrollmentTermsLi
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -74,22 +72,22 @@ import (
 //
 type ListEnrollmentsSections struct {
 	Path struct {
-		SectionID string `json:"section_id" url:"section_id,omitempty"` //  (Required)
+		SectionID canvasapi.ID `json:"section_id" url:"section_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		Type             []string `json:"type" url:"type,omitempty"`                             //  (Optional)
-		Role             []string `json:"role" url:"role,omitempty"`                             //  (Optional)
-		State            []string `json:"state" url:"state,omitempty"`                           //  (Optional) . Must be one of active, invited, creation_pending, deleted, rejected, completed, inactive, current_and_invited, current_and_future, current_and_concluded
-		Include          []string `json:"include" url:"include,omitempty"`                       //  (Optional) . Must be one of avatar_url, group_ids, locked, observed_users, can_be_removed, uuid, current_points
-		UserID           string   `json:"user_id" url:"user_id,omitempty"`                       //  (Optional)
-		GradingPeriodID  int64    `json:"grading_period_id" url:"grading_period_id,omitempty"`   //  (Optional)
-		EnrollmentTermID int64    `json:"enrollment_term_id" url:"enrollment_term_id,omitempty"` //  (Optional)
-		SISAccountID     []string `json:"sis_account_id" url:"sis_account_id,omitempty"`         //  (Optional)
-		SISCourseID      []string `json:"sis_course_id" url:"sis_course_id,omitempty"`           //  (Optional)
-		SISSectionID     []string `json:"sis_section_id" url:"sis_section_id,omitempty"`         //  (Optional)
-		SISUserID        []string `json:"sis_user_id" url:"sis_user_id,omitempty"`               //  (Optional)
-		CreatedForSISID  []string `json:"created_for_sis_id" url:"created_for_sis_id,omitempty"` //  (Optional)
+		Type             []string             `json:"type" url:"type,omitempty"`                             //  (Optional)
+		Role             []string             `json:"role" url:"role,omitempty"`                             //  (Optional)
+		State            []string             `json:"state" url:"state,omitempty"`                           //  (Optional) . Must be one of active, invited, creation_pending, deleted, rejected, completed, inactive, current_and_invited, current_and_future, current_and_concluded
+		Include          []string             `json:"include" url:"include,omitempty"`                       //  (Optional) . Must be one of avatar_url, group_ids, locked, observed_users, can_be_removed, uuid, current_points
+		UserID           string               `json:"user_id" url:"user_id,omitempty"`                       //  (Optional)
+		GradingPeriodID  canvasapi.Opt[int64] `json:"grading_period_id" url:"grading_period_id,omitempty"`   //  (Optional)
+		EnrollmentTermID canvasapi.Opt[int64] `json:"enrollment_term_id" url:"enrollment_term_id,omitempty"` //  (Optional)
+		SISAccountID     []string             `json:"sis_account_id" url:"sis_account_id,omitempty"`         //  (Optional)
+		SISCourseID      []string             `json:"sis_course_id" url:"sis_course_id,omitempty"`           //  (Optional)
+		SISSectionID     []string             `json:"sis_section_id" url:"sis_section_id,omitempty"`         //  (Optional)
+		SISUserID        []string             `json:"sis_user_id" url:"sis_user_id,omitempty"`               //  (Optional)
+		CreatedForSISID  []string             `json:"created_for_sis_id" url:"created_for_sis_id,omitempty"` //  (Optional)
 	} `json:"query"`
 }
 
@@ -99,12 +97,12 @@ func (t *ListEnrollmentsSections) GetMethod() string {
 
 func (t *ListEnrollmentsSections) GetURLPath() string {
 	path := "sections/{section_id}/enrollments"
-	path = strings.ReplaceAll(path, "{section_id}", fmt.Sprintf("%v", t.Path.SectionID))
+	path = strings.ReplaceAll(path, "{section_id}", t.Path.SectionID.Escaped())
 	return path
 }
 
 func (t *ListEnrollmentsSections) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -120,33 +118,37 @@ func (t *ListEnrollmentsSections) GetJSON() ([]byte, error) {
 }
 
 func (t *ListEnrollmentsSections) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.SectionID == "" {
-		errs = append(errs, "'Path.SectionID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.SectionID", Rule: canvasapi.RuleRequired})
 	}
 	for _, v := range t.Query.State {
 		if v != "" && !string_utils.Include([]string{"active", "invited", "creation_pending", "deleted", "rejected", "completed", "inactive", "current_and_invited", "current_and_future", "current_and_concluded"}, v) {
-			errs = append(errs, "State must be one of active, invited, creation_pending, deleted, rejected, completed, inactive, current_and_invited, current_and_future, current_and_concluded")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.State", Rule: canvasapi.RuleOneOf, Allowed: []string{"active", "invited", "creation_pending", "deleted", "rejected", "completed", "inactive", "current_and_invited", "current_and_future", "current_and_concluded"}})
 		}
 	}
 	for _, v := range t.Query.Include {
 		if v != "" && !string_utils.Include([]string{"avatar_url", "group_ids", "locked", "observed_users", "can_be_removed", "uuid", "current_points"}, v) {
-			errs = append(errs, "Include must be one of avatar_url, group_ids, locked, observed_users, can_be_removed, uuid, current_points")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.Include", Rule: canvasapi.RuleOneOf, Allowed: []string{"avatar_url", "group_ids", "locked", "observed_users", "can_be_removed", "uuid", "current_points"}})
 		}
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ListEnrollmentsSections) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Enrollment, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListEnrollmentsSections) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.Enrollment, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -166,6 +168,10 @@ func (t *ListEnrollmentsSections) Do(c *canvasapi.Canvas, next *url.URL) ([]*mod
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
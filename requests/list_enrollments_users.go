@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -73,21 +71,21 @@ import (
 //
 type ListEnrollmentsUsers struct {
 	Path struct {
-		UserID string `json:"user_id" url:"user_id,omitempty"` //  (Required)
+		UserID canvasapi.ID `json:"user_id" url:"user_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		Type             []string `json:"type" url:"type,omitempty"`                             //  (Optional)
-		Role             []string `json:"role" url:"role,omitempty"`                             //  (Optional)
-		State            []string `json:"state" url:"state,omitempty"`                           //  (Optional) . Must be one of active, invited, creation_pending, deleted, rejected, completed, inactive, current_and_invited, current_and_future, current_and_concluded
-		Include          []string `json:"include" url:"include,omitempty"`                       //  (Optional) . Must be one of avatar_url, group_ids, locked, observed_users, can_be_removed, uuid, current_points
-		GradingPeriodID  int64    `json:"grading_period_id" url:"grading_period_id,omitempty"`   //  (Optional)
-		EnrollmentTermID int64    `json:"enrollment_term_id" url:"enrollment_term_id,omitempty"` //  (Optional)
-		SISAccountID     []string `json:"sis_account_id" url:"sis_account_id,omitempty"`         //  (Optional)
-		SISCourseID      []string `json:"sis_course_id" url:"sis_course_id,omitempty"`           //  (Optional)
-		SISSectionID     []string `json:"sis_section_id" url:"sis_section_id,omitempty"`         //  (Optional)
-		SISUserID        []string `json:"sis_user_id" url:"sis_user_id,omitempty"`               //  (Optional)
-		CreatedForSISID  []string `json:"created_for_sis_id" url:"created_for_sis_id,omitempty"` //  (Optional)
+		Type             []string             `json:"type" url:"type,omitempty"`                             //  (Optional)
+		Role             []string             `json:"role" url:"role,omitempty"`                             //  (Optional)
+		State            []string             `json:"state" url:"state,omitempty"`                           //  (Optional) . Must be one of active, invited, creation_pending, deleted, rejected, completed, inactive, current_and_invited, current_and_future, current_and_concluded
+		Include          []string             `json:"include" url:"include,omitempty"`                       //  (Optional) . Must be one of avatar_url, group_ids, locked, observed_users, can_be_removed, uuid, current_points
+		GradingPeriodID  canvasapi.Opt[int64] `json:"grading_period_id" url:"grading_period_id,omitempty"`   //  (Optional)
+		EnrollmentTermID canvasapi.Opt[int64] `json:"enrollment_term_id" url:"enrollment_term_id,omitempty"` //  (Optional)
+		SISAccountID     []string             `json:"sis_account_id" url:"sis_account_id,omitempty"`         //  (Optional)
+		SISCourseID      []string             `json:"sis_course_id" url:"sis_course_id,omitempty"`           //  (Optional)
+		SISSectionID     []string             `json:"sis_section_id" url:"sis_section_id,omitempty"`         //  (Optional)
+		SISUserID        []string             `json:"sis_user_id" url:"sis_user_id,omitempty"`               //  (Optional)
+		CreatedForSISID  []string             `json:"created_for_sis_id" url:"created_for_sis_id,omitempty"` //  (Optional)
 	} `json:"query"`
 }
 
@@ -97,12 +95,12 @@ func (t *ListEnrollmentsUsers) GetMethod() string {
 
 func (t *ListEnrollmentsUsers) GetURLPath() string {
 	path := "users/{user_id}/enrollments"
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", t.Path.UserID))
+	path = strings.ReplaceAll(path, "{user_id}", t.Path.UserID.Escaped())
 	return path
 }
 
 func (t *ListEnrollmentsUsers) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -118,33 +116,37 @@ func (t *ListEnrollmentsUsers) GetJSON() ([]byte, error) {
 }
 
 func (t *ListEnrollmentsUsers) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	for _, v := range t.Query.State {
 		if v != "" && !string_utils.Include([]string{"active", "invited", "creation_pending", "deleted", "rejected", "completed", "inactive", "current_and_invited", "current_and_future", "current_and_concluded"}, v) {
-			errs = append(errs, "State must be one of active, invited, creation_pending, deleted, rejected, completed, inactive, current_and_invited, current_and_future, current_and_concluded")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.State", Rule: canvasapi.RuleOneOf, Allowed: []string{"active", "invited", "creation_pending", "deleted", "rejected", "completed", "inactive", "current_and_invited", "current_and_future", "current_and_concluded"}})
 		}
 	}
 	for _, v := range t.Query.Include {
 		if v != "" && !string_utils.Include([]string{"avatar_url", "group_ids", "locked", "observed_users", "can_be_removed", "uuid", "current_points"}, v) {
-			errs = append(errs, "Include must be one of avatar_url, group_ids, locked, observed_users, can_be_removed, uuid, current_points")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.Include", Rule: canvasapi.RuleOneOf, Allowed: []string{"avatar_url", "group_ids", "locked", "observed_users", "can_be_removed", "uuid", "current_points"}})
 		}
 	}
 	if t.Path.UserID == "" {
-		errs = append(errs, "'Path.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ListEnrollmentsUsers) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Enrollment, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListEnrollmentsUsers) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.Enrollment, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -164,6 +166,10 @@ func (t *ListEnrollmentsUsers) Do(c *canvasapi.Canvas, next *url.URL) ([]*models
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
@@ -1,6 +1,9 @@
 package requests
 
 import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 
 	"github.com/atomicjolt/canvasapi"
@@ -19,7 +22,7 @@ func (t *ListEnvironmentFeatures) GetMethod() string {
 }
 
 func (t *ListEnvironmentFeatures) GetURLPath() string {
-	return ""
+	return "features/environment"
 }
 
 func (t *ListEnvironmentFeatures) GetQuery() (string, error) {
@@ -38,11 +41,26 @@ func (t *ListEnvironmentFeatures) HasErrors() error {
 	return nil
 }
 
-func (t *ListEnvironmentFeatures) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *ListEnvironmentFeatures) Do(c *canvasapi.Canvas) (map[string]bool, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ListEnvironmentFeatures) DoContext(ctx context.Context, c *canvasapi.Canvas) (map[string]bool, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := map[string]bool{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
 }
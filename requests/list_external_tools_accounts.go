@@ -1,13 +1,15 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // ListExternalToolsAccounts Returns the paginated list of external tools for the current context.
@@ -24,13 +26,13 @@ import (
 //
 type ListExternalToolsAccounts struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		SearchTerm     string `json:"search_term" url:"search_term,omitempty"`         //  (Optional)
-		Selectable     bool   `json:"selectable" url:"selectable,omitempty"`           //  (Optional)
-		IncludeParents bool   `json:"include_parents" url:"include_parents,omitempty"` //  (Optional)
+		SearchTerm     string              `json:"search_term" url:"search_term,omitempty"`         //  (Optional)
+		Selectable     canvasapi.Opt[bool] `json:"selectable" url:"selectable,omitempty"`           //  (Optional)
+		IncludeParents canvasapi.Opt[bool] `json:"include_parents" url:"include_parents,omitempty"` //  (Optional)
 	} `json:"query"`
 }
 
@@ -40,12 +42,12 @@ func (t *ListExternalToolsAccounts) GetMethod() string {
 
 func (t *ListExternalToolsAccounts) GetURLPath() string {
 	path := "accounts/{account_id}/external_tools"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	return path
 }
 
 func (t *ListExternalToolsAccounts) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -61,21 +63,52 @@ func (t *ListExternalToolsAccounts) GetJSON() ([]byte, error) {
 }
 
 func (t *ListExternalToolsAccounts) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *ListExternalToolsAccounts) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *ListExternalToolsAccounts) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.ExternalTool, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListExternalToolsAccounts) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.ExternalTool, *canvasapi.PagedResource, error) {
+	var err error
+	var response *http.Response
+	if next != nil {
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
+	} else {
+		response, err = c.SendRequestContext(ctx, t)
+	}
+
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	ret := []*models.ExternalTool{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ret, pagedResource, nil
 }
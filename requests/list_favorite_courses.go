@@ -1,13 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -23,7 +22,7 @@ import (
 //
 type ListFavoriteCourses struct {
 	Query struct {
-		ExcludeBlueprintCourses bool `json:"exclude_blueprint_courses" url:"exclude_blueprint_courses,omitempty"` //  (Optional)
+		ExcludeBlueprintCourses canvasapi.Opt[bool] `json:"exclude_blueprint_courses" url:"exclude_blueprint_courses,omitempty"` //  (Optional)
 	} `json:"query"`
 }
 
@@ -32,11 +31,11 @@ func (t *ListFavoriteCourses) GetMethod() string {
 }
 
 func (t *ListFavoriteCourses) GetURLPath() string {
-	return ""
+	return "users/self/favorites/courses"
 }
 
 func (t *ListFavoriteCourses) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -56,12 +55,16 @@ func (t *ListFavoriteCourses) HasErrors() error {
 }
 
 func (t *ListFavoriteCourses) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Course, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListFavoriteCourses) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.Course, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -81,6 +84,10 @@ func (t *ListFavoriteCourses) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
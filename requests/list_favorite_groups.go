@@ -1,6 +1,7 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
@@ -22,7 +23,7 @@ func (t *ListFavoriteGroups) GetMethod() string {
 }
 
 func (t *ListFavoriteGroups) GetURLPath() string {
-	return ""
+	return "users/self/favorites/groups"
 }
 
 func (t *ListFavoriteGroups) GetQuery() (string, error) {
@@ -42,12 +43,16 @@ func (t *ListFavoriteGroups) HasErrors() error {
 }
 
 func (t *ListFavoriteGroups) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Group, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListFavoriteGroups) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.Group, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -67,6 +72,10 @@ func (t *ListFavoriteGroups) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.G
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
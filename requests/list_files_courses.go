@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -41,7 +39,7 @@ import (
 //
 type ListFilesCourses struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
@@ -61,12 +59,12 @@ func (t *ListFilesCourses) GetMethod() string {
 
 func (t *ListFilesCourses) GetURLPath() string {
 	path := "courses/{course_id}/files"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
 func (t *ListFilesCourses) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -82,34 +80,38 @@ func (t *ListFilesCourses) GetJSON() ([]byte, error) {
 }
 
 func (t *ListFilesCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	for _, v := range t.Query.Include {
 		if v != "" && !string_utils.Include([]string{"user"}, v) {
-			errs = append(errs, "Include must be one of user")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.Include", Rule: canvasapi.RuleOneOf, Allowed: []string{"user"}})
 		}
 	}
 	if t.Query.Sort != "" && !string_utils.Include([]string{"name", "size", "created_at", "updated_at", "content_type", "user"}, t.Query.Sort) {
-		errs = append(errs, "Sort must be one of name, size, created_at, updated_at, content_type, user")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.Sort", Rule: canvasapi.RuleOneOf, Allowed: []string{"name", "size", "created_at", "updated_at", "content_type", "user"}})
 	}
 	if t.Query.Order != "" && !string_utils.Include([]string{"asc", "desc"}, t.Query.Order) {
-		errs = append(errs, "Order must be one of asc, desc")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.Order", Rule: canvasapi.RuleOneOf, Allowed: []string{"asc", "desc"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ListFilesCourses) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.File, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListFilesCourses) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.File, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -129,6 +131,10 @@ func (t *ListFilesCourses) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Fil
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
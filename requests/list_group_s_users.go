@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -30,13 +28,13 @@ import (
 //
 type ListGroupSUsers struct {
 	Path struct {
-		GroupID string `json:"group_id" url:"group_id,omitempty"` //  (Required)
+		GroupID canvasapi.ID `json:"group_id" url:"group_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		SearchTerm      string   `json:"search_term" url:"search_term,omitempty"`           //  (Optional)
-		Include         []string `json:"include" url:"include,omitempty"`                   //  (Optional) . Must be one of avatar_url
-		ExcludeInactive bool     `json:"exclude_inactive" url:"exclude_inactive,omitempty"` //  (Optional)
+		SearchTerm      string              `json:"search_term" url:"search_term,omitempty"`           //  (Optional)
+		Include         []string            `json:"include" url:"include,omitempty"`                   //  (Optional) . Must be one of avatar_url
+		ExcludeInactive canvasapi.Opt[bool] `json:"exclude_inactive" url:"exclude_inactive,omitempty"` //  (Optional)
 	} `json:"query"`
 }
 
@@ -46,12 +44,12 @@ func (t *ListGroupSUsers) GetMethod() string {
 
 func (t *ListGroupSUsers) GetURLPath() string {
 	path := "groups/{group_id}/users"
-	path = strings.ReplaceAll(path, "{group_id}", fmt.Sprintf("%v", t.Path.GroupID))
+	path = strings.ReplaceAll(path, "{group_id}", t.Path.GroupID.Escaped())
 	return path
 }
 
 func (t *ListGroupSUsers) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -67,28 +65,32 @@ func (t *ListGroupSUsers) GetJSON() ([]byte, error) {
 }
 
 func (t *ListGroupSUsers) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.GroupID == "" {
-		errs = append(errs, "'Path.GroupID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.GroupID", Rule: canvasapi.RuleRequired})
 	}
 	for _, v := range t.Query.Include {
 		if v != "" && !string_utils.Include([]string{"avatar_url"}, v) {
-			errs = append(errs, "Include must be one of avatar_url")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.Include", Rule: canvasapi.RuleOneOf, Allowed: []string{"avatar_url"}})
 		}
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ListGroupSUsers) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.User, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListGroupSUsers) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.User, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -108,6 +110,10 @@ func (t *ListGroupSUsers) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.User
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
@@ -1,15 +1,14 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -77,8 +76,8 @@ import (
 //
 type ListItemsForSelectiveImportCourses struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		ID       string `json:"id" url:"id,omitempty"`               //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		ID       canvasapi.ID `json:"id" url:"id,omitempty"`               //  (Required)
 	} `json:"path"`
 
 	Query struct {
@@ -92,13 +91,13 @@ func (t *ListItemsForSelectiveImportCourses) GetMethod() string {
 
 func (t *ListItemsForSelectiveImportCourses) GetURLPath() string {
 	path := "courses/{course_id}/content_migrations/{id}/selective_data"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
 func (t *ListItemsForSelectiveImportCourses) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -114,24 +113,28 @@ func (t *ListItemsForSelectiveImportCourses) GetJSON() ([]byte, error) {
 }
 
 func (t *ListItemsForSelectiveImportCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Query.Type != "" && !string_utils.Include([]string{"context_modules", "assignments", "quizzes", "assessment_question_banks", "discussion_topics", "wiki_pages", "context_external_tools", "tool_profiles", "announcements", "calendar_events", "rubrics", "groups", "learning_outcomes", "attachments"}, t.Query.Type) {
-		errs = append(errs, "Type must be one of context_modules, assignments, quizzes, assessment_question_banks, discussion_topics, wiki_pages, context_external_tools, tool_profiles, announcements, calendar_events, rubrics, groups, learning_outcomes, attachments")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.Type", Rule: canvasapi.RuleOneOf, Allowed: []string{"context_modules", "assignments", "quizzes", "assessment_question_banks", "discussion_topics", "wiki_pages", "context_external_tools", "tool_profiles", "announcements", "calendar_events", "rubrics", "groups", "learning_outcomes", "attachments"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *ListItemsForSelectiveImportCourses) Do(c *canvasapi.Canvas) (*[]string, error) {
-	response, err := c.SendRequest(t)
+func (t *ListItemsForSelectiveImportCourses) Do(c *canvasapi.Canvas) ([]*models.SelectiveImportItem, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ListItemsForSelectiveImportCourses) DoContext(ctx context.Context, c *canvasapi.Canvas) ([]*models.SelectiveImportItem, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -141,11 +144,11 @@ func (t *ListItemsForSelectiveImportCourses) Do(c *canvasapi.Canvas) (*[]string,
 	if err != nil {
 		return nil, err
 	}
-	ret := []string{}
+	ret := []*models.SelectiveImportItem{}
 	err = json.Unmarshal(body, &ret)
 	if err != nil {
 		return nil, err
 	}
 
-	return &ret, nil
+	return ret, nil
 }
@@ -1,14 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
+	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -27,7 +26,7 @@ import (
 //
 type ListLineItems struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
@@ -44,12 +43,12 @@ func (t *ListLineItems) GetMethod() string {
 
 func (t *ListLineItems) GetURLPath() string {
 	path := "/lti/courses/{course_id}/line_items"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
 func (t *ListLineItems) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -64,33 +63,59 @@ func (t *ListLineItems) GetJSON() ([]byte, error) {
 	return nil, nil
 }
 
+// MediaTypes returns the IMS media types of this LTI Advantage service.
+func (t *ListLineItems) MediaTypes() (string, string) {
+	return "", "application/vnd.ims.lis.v2.lineitemcontainer+json"
+}
+
 func (t *ListLineItems) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *ListLineItems) Do(c *canvasapi.Canvas) (*models.LineItem, error) {
-	response, err := c.SendRequest(t)
+func (t *ListLineItems) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.LineItem, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListLineItems) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.LineItem, *canvasapi.PagedResource, error) {
+	var err error
+	var response *http.Response
+	if next != nil {
+		_, accept := t.MediaTypes()
+		response, err = c.SendAcceptContext(ctx, next, t.GetMethod(), nil, "", accept)
+	} else {
+		response, err = c.SendRequestContext(ctx, t)
+	}
+
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	body, err := ioutil.ReadAll(response.Body)
 	response.Body.Close()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	ret := models.LineItem{}
+	ret := []*models.LineItem{}
 	err = json.Unmarshal(body, &ret)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return &ret, nil
+	return ret, pagedResource, nil
 }
@@ -1,12 +1,12 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 )
 
@@ -22,12 +22,12 @@ import (
 //
 type ListLiveAssessmentResults struct {
 	Path struct {
-		CourseID     string `json:"course_id" url:"course_id,omitempty"`         //  (Required)
-		AssessmentID string `json:"assessment_id" url:"assessment_id,omitempty"` //  (Required)
+		CourseID     canvasapi.ID `json:"course_id" url:"course_id,omitempty"`         //  (Required)
+		AssessmentID canvasapi.ID `json:"assessment_id" url:"assessment_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		UserID int64 `json:"user_id" url:"user_id,omitempty"` //  (Optional)
+		UserID canvasapi.Opt[int64] `json:"user_id" url:"user_id,omitempty"` //  (Optional)
 	} `json:"query"`
 }
 
@@ -37,13 +37,13 @@ func (t *ListLiveAssessmentResults) GetMethod() string {
 
 func (t *ListLiveAssessmentResults) GetURLPath() string {
 	path := "courses/{course_id}/live_assessments/{assessment_id}/results"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{assessment_id}", fmt.Sprintf("%v", t.Path.AssessmentID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{assessment_id}", t.Path.AssessmentID.Escaped())
 	return path
 }
 
 func (t *ListLiveAssessmentResults) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -59,24 +59,34 @@ func (t *ListLiveAssessmentResults) GetJSON() ([]byte, error) {
 }
 
 func (t *ListLiveAssessmentResults) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.AssessmentID == "" {
-		errs = append(errs, "'Path.AssessmentID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AssessmentID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *ListLiveAssessmentResults) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *ListLiveAssessmentResults) Do(c *canvasapi.Canvas) (json.RawMessage, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ListLiveAssessmentResults) DoContext(ctx context.Context, c *canvasapi.Canvas) (json.RawMessage, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(body), nil
 }
@@ -1,14 +1,11 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
-	"strings"
-
-	"github.com/google/go-querystring/query"
 
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
@@ -47,11 +44,11 @@ func (t *ListMediaObjectsMediaObjects) GetMethod() string {
 }
 
 func (t *ListMediaObjectsMediaObjects) GetURLPath() string {
-	return ""
+	return "media_objects"
 }
 
 func (t *ListMediaObjectsMediaObjects) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -67,31 +64,35 @@ func (t *ListMediaObjectsMediaObjects) GetJSON() ([]byte, error) {
 }
 
 func (t *ListMediaObjectsMediaObjects) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Query.Sort != "" && !string_utils.Include([]string{"title", "created_at"}, t.Query.Sort) {
-		errs = append(errs, "Sort must be one of title, created_at")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.Sort", Rule: canvasapi.RuleOneOf, Allowed: []string{"title", "created_at"}})
 	}
 	if t.Query.Order != "" && !string_utils.Include([]string{"asc", "desc"}, t.Query.Order) {
-		errs = append(errs, "Order must be one of asc, desc")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.Order", Rule: canvasapi.RuleOneOf, Allowed: []string{"asc", "desc"}})
 	}
 	for _, v := range t.Query.Exclude {
 		if v != "" && !string_utils.Include([]string{"sources", "tracks"}, v) {
-			errs = append(errs, "Exclude must be one of sources, tracks")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.Exclude", Rule: canvasapi.RuleOneOf, Allowed: []string{"sources", "tracks"}})
 		}
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ListMediaObjectsMediaObjects) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.MediaObject, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListMediaObjectsMediaObjects) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.MediaObject, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -111,6 +112,10 @@ func (t *ListMediaObjectsMediaObjects) Do(c *canvasapi.Canvas, next *url.URL) ([
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
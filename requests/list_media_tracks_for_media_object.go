@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -28,7 +26,7 @@ import (
 //
 type ListMediaTracksForMediaObject struct {
 	Path struct {
-		MediaObjectID string `json:"media_object_id" url:"media_object_id,omitempty"` //  (Required)
+		MediaObjectID canvasapi.ID `json:"media_object_id" url:"media_object_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
@@ -42,12 +40,12 @@ func (t *ListMediaTracksForMediaObject) GetMethod() string {
 
 func (t *ListMediaTracksForMediaObject) GetURLPath() string {
 	path := "media_objects/{media_object_id}/media_tracks"
-	path = strings.ReplaceAll(path, "{media_object_id}", fmt.Sprintf("%v", t.Path.MediaObjectID))
+	path = strings.ReplaceAll(path, "{media_object_id}", t.Path.MediaObjectID.Escaped())
 	return path
 }
 
 func (t *ListMediaTracksForMediaObject) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -63,28 +61,32 @@ func (t *ListMediaTracksForMediaObject) GetJSON() ([]byte, error) {
 }
 
 func (t *ListMediaTracksForMediaObject) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.MediaObjectID == "" {
-		errs = append(errs, "'Path.MediaObjectID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.MediaObjectID", Rule: canvasapi.RuleRequired})
 	}
 	for _, v := range t.Query.Include {
 		if v != "" && !string_utils.Include([]string{"content", "webvtt_content", "updated_at", "created_at"}, v) {
-			errs = append(errs, "Include must be one of content, webvtt_content, updated_at, created_at")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.Include", Rule: canvasapi.RuleOneOf, Allowed: []string{"content", "webvtt_content", "updated_at", "created_at"}})
 		}
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ListMediaTracksForMediaObject) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.MediaTrack, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListMediaTracksForMediaObject) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.MediaTrack, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -104,6 +106,10 @@ func (t *ListMediaTracksForMediaObject) Do(c *canvasapi.Canvas, next *url.URL) (
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
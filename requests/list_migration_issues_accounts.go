@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -21,8 +21,8 @@ import (
 //
 type ListMigrationIssuesAccounts struct {
 	Path struct {
-		AccountID          string `json:"account_id" url:"account_id,omitempty"`                     //  (Required)
-		ContentMigrationID string `json:"content_migration_id" url:"content_migration_id,omitempty"` //  (Required)
+		AccountID          canvasapi.ID `json:"account_id" url:"account_id,omitempty"`                     //  (Required)
+		ContentMigrationID canvasapi.ID `json:"content_migration_id" url:"content_migration_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -32,8 +32,8 @@ func (t *ListMigrationIssuesAccounts) GetMethod() string {
 
 func (t *ListMigrationIssuesAccounts) GetURLPath() string {
 	path := "accounts/{account_id}/content_migrations/{content_migration_id}/migration_issues"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
-	path = strings.ReplaceAll(path, "{content_migration_id}", fmt.Sprintf("%v", t.Path.ContentMigrationID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
+	path = strings.ReplaceAll(path, "{content_migration_id}", t.Path.ContentMigrationID.Escaped())
 	return path
 }
 
@@ -50,26 +50,30 @@ func (t *ListMigrationIssuesAccounts) GetJSON() ([]byte, error) {
 }
 
 func (t *ListMigrationIssuesAccounts) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ContentMigrationID == "" {
-		errs = append(errs, "'Path.ContentMigrationID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ContentMigrationID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ListMigrationIssuesAccounts) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.MigrationIssue, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListMigrationIssuesAccounts) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.MigrationIssue, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -89,6 +93,10 @@ func (t *ListMigrationIssuesAccounts) Do(c *canvasapi.Canvas, next *url.URL) ([]
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -32,7 +30,7 @@ import (
 //
 type ListMissingSubmissions struct {
 	Path struct {
-		UserID string `json:"user_id" url:"user_id,omitempty"` //  (Required)
+		UserID canvasapi.ID `json:"user_id" url:"user_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
@@ -48,12 +46,12 @@ func (t *ListMissingSubmissions) GetMethod() string {
 
 func (t *ListMissingSubmissions) GetURLPath() string {
 	path := "users/{user_id}/missing_submissions"
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", t.Path.UserID))
+	path = strings.ReplaceAll(path, "{user_id}", t.Path.UserID.Escaped())
 	return path
 }
 
 func (t *ListMissingSubmissions) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -69,33 +67,37 @@ func (t *ListMissingSubmissions) GetJSON() ([]byte, error) {
 }
 
 func (t *ListMissingSubmissions) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.UserID == "" {
-		errs = append(errs, "'Path.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.UserID", Rule: canvasapi.RuleRequired})
 	}
 	for _, v := range t.Query.Include {
 		if v != "" && !string_utils.Include([]string{"planner_overrides", "course"}, v) {
-			errs = append(errs, "Include must be one of planner_overrides, course")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.Include", Rule: canvasapi.RuleOneOf, Allowed: []string{"planner_overrides", "course"}})
 		}
 	}
 	for _, v := range t.Query.Filter {
 		if v != "" && !string_utils.Include([]string{"submittable"}, v) {
-			errs = append(errs, "Filter must be one of submittable")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.Filter", Rule: canvasapi.RuleOneOf, Allowed: []string{"submittable"}})
 		}
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ListMissingSubmissions) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Assignment, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListMissingSubmissions) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.Assignment, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -115,6 +117,10 @@ func (t *ListMissingSubmissions) Do(c *canvasapi.Canvas, next *url.URL) ([]*mode
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -32,8 +30,8 @@ import (
 //
 type ListModuleItems struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		ModuleID string `json:"module_id" url:"module_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		ModuleID canvasapi.ID `json:"module_id" url:"module_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
@@ -49,13 +47,13 @@ func (t *ListModuleItems) GetMethod() string {
 
 func (t *ListModuleItems) GetURLPath() string {
 	path := "courses/{course_id}/modules/{module_id}/items"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{module_id}", fmt.Sprintf("%v", t.Path.ModuleID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{module_id}", t.Path.ModuleID.Escaped())
 	return path
 }
 
 func (t *ListModuleItems) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -71,31 +69,35 @@ func (t *ListModuleItems) GetJSON() ([]byte, error) {
 }
 
 func (t *ListModuleItems) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ModuleID == "" {
-		errs = append(errs, "'Path.ModuleID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ModuleID", Rule: canvasapi.RuleRequired})
 	}
 	for _, v := range t.Query.Include {
 		if v != "" && !string_utils.Include([]string{"content_details"}, v) {
-			errs = append(errs, "Include must be one of content_details")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.Include", Rule: canvasapi.RuleOneOf, Allowed: []string{"content_details"}})
 		}
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ListModuleItems) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.ModuleItem, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListModuleItems) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.ModuleItem, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -115,6 +117,10 @@ func (t *ListModuleItems) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Modu
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
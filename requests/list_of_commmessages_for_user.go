@@ -1,16 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
-	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -38,11 +35,11 @@ func (t *ListOfCommmessagesForUser) GetMethod() string {
 }
 
 func (t *ListOfCommmessagesForUser) GetURLPath() string {
-	return ""
+	return "comm_messages"
 }
 
 func (t *ListOfCommmessagesForUser) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -58,23 +55,27 @@ func (t *ListOfCommmessagesForUser) GetJSON() ([]byte, error) {
 }
 
 func (t *ListOfCommmessagesForUser) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Query.UserID == "" {
-		errs = append(errs, "'Query.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ListOfCommmessagesForUser) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.CommMessage, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListOfCommmessagesForUser) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.CommMessage, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -94,6 +95,10 @@ func (t *ListOfCommmessagesForUser) Do(c *canvasapi.Canvas, next *url.URL) ([]*m
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
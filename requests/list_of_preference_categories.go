@@ -1,7 +1,9 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
@@ -17,8 +19,8 @@ import (
 //
 type ListOfPreferenceCategories struct {
 	Path struct {
-		UserID                 string `json:"user_id" url:"user_id,omitempty"`                                   //  (Required)
-		CommunicationChannelID string `json:"communication_channel_id" url:"communication_channel_id,omitempty"` //  (Required)
+		UserID                 canvasapi.ID `json:"user_id" url:"user_id,omitempty"`                                   //  (Required)
+		CommunicationChannelID canvasapi.ID `json:"communication_channel_id" url:"communication_channel_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -28,8 +30,8 @@ func (t *ListOfPreferenceCategories) GetMethod() string {
 
 func (t *ListOfPreferenceCategories) GetURLPath() string {
 	path := "users/{user_id}/communication_channels/{communication_channel_id}/notification_preference_categories"
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", t.Path.UserID))
-	path = strings.ReplaceAll(path, "{communication_channel_id}", fmt.Sprintf("%v", t.Path.CommunicationChannelID))
+	path = strings.ReplaceAll(path, "{user_id}", t.Path.UserID.Escaped())
+	path = strings.ReplaceAll(path, "{communication_channel_id}", t.Path.CommunicationChannelID.Escaped())
 	return path
 }
 
@@ -46,24 +48,34 @@ func (t *ListOfPreferenceCategories) GetJSON() ([]byte, error) {
 }
 
 func (t *ListOfPreferenceCategories) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.UserID == "" {
-		errs = append(errs, "'Path.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.CommunicationChannelID == "" {
-		errs = append(errs, "'Path.CommunicationChannelID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CommunicationChannelID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *ListOfPreferenceCategories) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *ListOfPreferenceCategories) Do(c *canvasapi.Canvas) (json.RawMessage, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ListOfPreferenceCategories) DoContext(ctx context.Context, c *canvasapi.Canvas) (json.RawMessage, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(body), nil
 }
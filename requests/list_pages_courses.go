@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -30,14 +28,14 @@ import (
 //
 type ListPagesCourses struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		Sort       string `json:"sort" url:"sort,omitempty"`               //  (Optional) . Must be one of title, created_at, updated_at
-		Order      string `json:"order" url:"order,omitempty"`             //  (Optional) . Must be one of asc, desc
-		SearchTerm string `json:"search_term" url:"search_term,omitempty"` //  (Optional)
-		Published  bool   `json:"published" url:"published,omitempty"`     //  (Optional)
+		Sort       string              `json:"sort" url:"sort,omitempty"`               //  (Optional) . Must be one of title, created_at, updated_at
+		Order      string              `json:"order" url:"order,omitempty"`             //  (Optional) . Must be one of asc, desc
+		SearchTerm string              `json:"search_term" url:"search_term,omitempty"` //  (Optional)
+		Published  canvasapi.Opt[bool] `json:"published" url:"published,omitempty"`     //  (Optional)
 	} `json:"query"`
 }
 
@@ -47,12 +45,12 @@ func (t *ListPagesCourses) GetMethod() string {
 
 func (t *ListPagesCourses) GetURLPath() string {
 	path := "courses/{course_id}/pages"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
 func (t *ListPagesCourses) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -68,29 +66,33 @@ func (t *ListPagesCourses) GetJSON() ([]byte, error) {
 }
 
 func (t *ListPagesCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Query.Sort != "" && !string_utils.Include([]string{"title", "created_at", "updated_at"}, t.Query.Sort) {
-		errs = append(errs, "Sort must be one of title, created_at, updated_at")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.Sort", Rule: canvasapi.RuleOneOf, Allowed: []string{"title", "created_at", "updated_at"}})
 	}
 	if t.Query.Order != "" && !string_utils.Include([]string{"asc", "desc"}, t.Query.Order) {
-		errs = append(errs, "Order must be one of asc, desc")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.Order", Rule: canvasapi.RuleOneOf, Allowed: []string{"asc", "desc"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ListPagesCourses) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Page, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListPagesCourses) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.Page, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -110,6 +112,10 @@ func (t *ListPagesCourses) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Pag
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
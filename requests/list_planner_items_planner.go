@@ -1,13 +1,13 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
 	"net/url"
-	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
 )
@@ -46,11 +46,11 @@ func (t *ListPlannerItemsPlanner) GetMethod() string {
 }
 
 func (t *ListPlannerItemsPlanner) GetURLPath() string {
-	return ""
+	return "planner/items"
 }
 
 func (t *ListPlannerItemsPlanner) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -66,21 +66,48 @@ func (t *ListPlannerItemsPlanner) GetJSON() ([]byte, error) {
 }
 
 func (t *ListPlannerItemsPlanner) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Query.Filter != "" && !string_utils.Include([]string{"new_activity"}, t.Query.Filter) {
-		errs = append(errs, "Filter must be one of new_activity")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.Filter", Rule: canvasapi.RuleOneOf, Allowed: []string{"new_activity"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *ListPlannerItemsPlanner) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *ListPlannerItemsPlanner) Do(c *canvasapi.Canvas, next *url.URL) ([]json.RawMessage, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListPlannerItemsPlanner) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]json.RawMessage, *canvasapi.PagedResource, error) {
+	var err error
+	var response *http.Response
+	if next != nil {
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
+	} else {
+		response, err = c.SendRequestContext(ctx, t)
+	}
+
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	ret := []json.RawMessage{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ret, pagedResource, nil
 }
@@ -1,14 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -47,11 +46,11 @@ func (t *ListPlannerNotes) GetMethod() string {
 }
 
 func (t *ListPlannerNotes) GetURLPath() string {
-	return ""
+	return "planner_notes"
 }
 
 func (t *ListPlannerNotes) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -71,12 +70,16 @@ func (t *ListPlannerNotes) HasErrors() error {
 }
 
 func (t *ListPlannerNotes) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.PlannerNote, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListPlannerNotes) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.PlannerNote, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -96,6 +99,10 @@ func (t *ListPlannerNotes) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Pla
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
@@ -1,6 +1,7 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
@@ -21,7 +22,7 @@ func (t *ListPlannerOverrides) GetMethod() string {
 }
 
 func (t *ListPlannerOverrides) GetURLPath() string {
-	return ""
+	return "planner/overrides"
 }
 
 func (t *ListPlannerOverrides) GetQuery() (string, error) {
@@ -41,12 +42,16 @@ func (t *ListPlannerOverrides) HasErrors() error {
 }
 
 func (t *ListPlannerOverrides) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.PlannerOverride, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListPlannerOverrides) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.PlannerOverride, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -66,6 +71,10 @@ func (t *ListPlannerOverrides) Do(c *canvasapi.Canvas, next *url.URL) ([]*models
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
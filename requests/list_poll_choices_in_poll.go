@@ -1,11 +1,14 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // ListPollChoicesInPoll Returns the paginated list of PollChoices in this poll.
@@ -16,7 +19,7 @@ import (
 //
 type ListPollChoicesInPoll struct {
 	Path struct {
-		PollID string `json:"poll_id" url:"poll_id,omitempty"` //  (Required)
+		PollID canvasapi.ID `json:"poll_id" url:"poll_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -26,7 +29,7 @@ func (t *ListPollChoicesInPoll) GetMethod() string {
 
 func (t *ListPollChoicesInPoll) GetURLPath() string {
 	path := "polls/{poll_id}/poll_choices"
-	path = strings.ReplaceAll(path, "{poll_id}", fmt.Sprintf("%v", t.Path.PollID))
+	path = strings.ReplaceAll(path, "{poll_id}", t.Path.PollID.Escaped())
 	return path
 }
 
@@ -43,21 +46,40 @@ func (t *ListPollChoicesInPoll) GetJSON() ([]byte, error) {
 }
 
 func (t *ListPollChoicesInPoll) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.PollID == "" {
-		errs = append(errs, "'Path.PollID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.PollID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *ListPollChoicesInPoll) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *ListPollChoicesInPoll) Do(c *canvasapi.Canvas) (*models.PollChoicesResponse, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ListPollChoicesInPoll) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.PollChoicesResponse, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.PollChoicesResponse{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,9 +1,13 @@
 package requests
 
 import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // ListPolls Returns the paginated list of polls for the current user.
@@ -17,7 +21,7 @@ func (t *ListPolls) GetMethod() string {
 }
 
 func (t *ListPolls) GetURLPath() string {
-	return ""
+	return "polls"
 }
 
 func (t *ListPolls) GetQuery() (string, error) {
@@ -36,11 +40,30 @@ func (t *ListPolls) HasErrors() error {
 	return nil
 }
 
-func (t *ListPolls) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *ListPolls) Do(c *canvasapi.Canvas) (*models.PollsResponse, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ListPolls) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.PollsResponse, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.PollsResponse{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -21,8 +21,8 @@ import (
 //
 type ListPreferencesCommunicationChannelID struct {
 	Path struct {
-		UserID                 string `json:"user_id" url:"user_id,omitempty"`                                   //  (Required)
-		CommunicationChannelID string `json:"communication_channel_id" url:"communication_channel_id,omitempty"` //  (Required)
+		UserID                 canvasapi.ID `json:"user_id" url:"user_id,omitempty"`                                   //  (Required)
+		CommunicationChannelID canvasapi.ID `json:"communication_channel_id" url:"communication_channel_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -32,8 +32,8 @@ func (t *ListPreferencesCommunicationChannelID) GetMethod() string {
 
 func (t *ListPreferencesCommunicationChannelID) GetURLPath() string {
 	path := "users/{user_id}/communication_channels/{communication_channel_id}/notification_preferences"
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", t.Path.UserID))
-	path = strings.ReplaceAll(path, "{communication_channel_id}", fmt.Sprintf("%v", t.Path.CommunicationChannelID))
+	path = strings.ReplaceAll(path, "{user_id}", t.Path.UserID.Escaped())
+	path = strings.ReplaceAll(path, "{communication_channel_id}", t.Path.CommunicationChannelID.Escaped())
 	return path
 }
 
@@ -50,26 +50,30 @@ func (t *ListPreferencesCommunicationChannelID) GetJSON() ([]byte, error) {
 }
 
 func (t *ListPreferencesCommunicationChannelID) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.UserID == "" {
-		errs = append(errs, "'Path.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.CommunicationChannelID == "" {
-		errs = append(errs, "'Path.CommunicationChannelID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CommunicationChannelID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ListPreferencesCommunicationChannelID) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.NotificationPreference, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListPreferencesCommunicationChannelID) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.NotificationPreference, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -89,6 +93,10 @@ func (t *ListPreferencesCommunicationChannelID) Do(c *canvasapi.Canvas, next *ur
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
@@ -1,6 +1,7 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -22,9 +23,9 @@ import (
 //
 type ListPreferencesType struct {
 	Path struct {
-		UserID  string `json:"user_id" url:"user_id,omitempty"` //  (Required)
-		Type    string `json:"type" url:"type,omitempty"`       //  (Required)
-		Address string `json:"address" url:"address,omitempty"` //  (Required)
+		UserID  canvasapi.ID `json:"user_id" url:"user_id,omitempty"` //  (Required)
+		Type    string       `json:"type" url:"type,omitempty"`       //  (Required)
+		Address string       `json:"address" url:"address,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -34,7 +35,7 @@ func (t *ListPreferencesType) GetMethod() string {
 
 func (t *ListPreferencesType) GetURLPath() string {
 	path := "users/{user_id}/communication_channels/{type}/{address}/notification_preferences"
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", t.Path.UserID))
+	path = strings.ReplaceAll(path, "{user_id}", t.Path.UserID.Escaped())
 	path = strings.ReplaceAll(path, "{type}", fmt.Sprintf("%v", t.Path.Type))
 	path = strings.ReplaceAll(path, "{address}", fmt.Sprintf("%v", t.Path.Address))
 	return path
@@ -53,29 +54,33 @@ func (t *ListPreferencesType) GetJSON() ([]byte, error) {
 }
 
 func (t *ListPreferencesType) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.UserID == "" {
-		errs = append(errs, "'Path.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.Type == "" {
-		errs = append(errs, "'Path.Type' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Type", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.Address == "" {
-		errs = append(errs, "'Path.Address' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Address", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ListPreferencesType) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.NotificationPreference, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListPreferencesType) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.NotificationPreference, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -95,6 +100,10 @@ func (t *ListPreferencesType) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -32,13 +30,13 @@ import (
 //
 type ListQuestionsInQuizOrSubmission struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		QuizID   string `json:"quiz_id" url:"quiz_id,omitempty"`     //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		QuizID   canvasapi.ID `json:"quiz_id" url:"quiz_id,omitempty"`     //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		QuizSubmissionID      int64 `json:"quiz_submission_id" url:"quiz_submission_id,omitempty"`           //  (Optional)
-		QuizSubmissionAttempt int64 `json:"quiz_submission_attempt" url:"quiz_submission_attempt,omitempty"` //  (Optional)
+		QuizSubmissionID      canvasapi.Opt[int64] `json:"quiz_submission_id" url:"quiz_submission_id,omitempty"`           //  (Optional)
+		QuizSubmissionAttempt canvasapi.Opt[int64] `json:"quiz_submission_attempt" url:"quiz_submission_attempt,omitempty"` //  (Optional)
 	} `json:"query"`
 }
 
@@ -48,13 +46,13 @@ func (t *ListQuestionsInQuizOrSubmission) GetMethod() string {
 
 func (t *ListQuestionsInQuizOrSubmission) GetURLPath() string {
 	path := "courses/{course_id}/quizzes/{quiz_id}/questions"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{quiz_id}", fmt.Sprintf("%v", t.Path.QuizID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{quiz_id}", t.Path.QuizID.Escaped())
 	return path
 }
 
 func (t *ListQuestionsInQuizOrSubmission) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -70,26 +68,30 @@ func (t *ListQuestionsInQuizOrSubmission) GetJSON() ([]byte, error) {
 }
 
 func (t *ListQuestionsInQuizOrSubmission) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.QuizID == "" {
-		errs = append(errs, "'Path.QuizID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.QuizID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ListQuestionsInQuizOrSubmission) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.QuizQuestion, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListQuestionsInQuizOrSubmission) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.QuizQuestion, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -109,6 +111,10 @@ func (t *ListQuestionsInQuizOrSubmission) Do(c *canvasapi.Canvas, next *url.URL)
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
@@ -1,6 +1,7 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -21,8 +22,8 @@ import (
 //
 type ListRevisionsCourses struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		Url      string `json:"url" url:"url,omitempty"`             //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		Url      string       `json:"url" url:"url,omitempty"`             //  (Required)
 	} `json:"path"`
 }
 
@@ -32,7 +33,7 @@ func (t *ListRevisionsCourses) GetMethod() string {
 
 func (t *ListRevisionsCourses) GetURLPath() string {
 	path := "courses/{course_id}/pages/{url}/revisions"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	path = strings.ReplaceAll(path, "{url}", fmt.Sprintf("%v", t.Path.Url))
 	return path
 }
@@ -50,26 +51,30 @@ func (t *ListRevisionsCourses) GetJSON() ([]byte, error) {
 }
 
 func (t *ListRevisionsCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.Url == "" {
-		errs = append(errs, "'Path.Url' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Url", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ListRevisionsCourses) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.PageRevision, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListRevisionsCourses) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.PageRevision, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -89,6 +94,10 @@ func (t *ListRevisionsCourses) Do(c *canvasapi.Canvas, next *url.URL) ([]*models
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
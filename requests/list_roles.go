@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -29,12 +27,12 @@ import (
 //
 type ListRoles struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		State         []string `json:"state" url:"state,omitempty"`                   //  (Optional) . Must be one of active, inactive
-		ShowInherited bool     `json:"show_inherited" url:"show_inherited,omitempty"` //  (Optional)
+		State         []string            `json:"state" url:"state,omitempty"`                   //  (Optional) . Must be one of active, inactive
+		ShowInherited canvasapi.Opt[bool] `json:"show_inherited" url:"show_inherited,omitempty"` //  (Optional)
 	} `json:"query"`
 }
 
@@ -44,12 +42,12 @@ func (t *ListRoles) GetMethod() string {
 
 func (t *ListRoles) GetURLPath() string {
 	path := "accounts/{account_id}/roles"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	return path
 }
 
 func (t *ListRoles) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -65,28 +63,32 @@ func (t *ListRoles) GetJSON() ([]byte, error) {
 }
 
 func (t *ListRoles) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	for _, v := range t.Query.State {
 		if v != "" && !string_utils.Include([]string{"active", "inactive"}, v) {
-			errs = append(errs, "State must be one of active, inactive")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.State", Rule: canvasapi.RuleOneOf, Allowed: []string{"active", "inactive"}})
 		}
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ListRoles) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Role, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListRoles) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.Role, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -106,6 +108,10 @@ func (t *ListRoles) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Role, *can
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
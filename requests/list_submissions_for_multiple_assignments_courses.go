@@ -1,13 +1,14 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
 	"net/url"
 	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/string_utils"
 )
@@ -58,23 +59,23 @@ import (
 //
 type ListSubmissionsForMultipleAssignmentsCourses struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		StudentIDs       []string  `json:"student_ids" url:"student_ids,omitempty"`                 //  (Optional)
-		AssignmentIDs    []string  `json:"assignment_ids" url:"assignment_ids,omitempty"`           //  (Optional)
-		Grouped          bool      `json:"grouped" url:"grouped,omitempty"`                         //  (Optional)
-		PostToSIS        bool      `json:"post_to_sis" url:"post_to_sis,omitempty"`                 //  (Optional)
-		SubmittedSince   time.Time `json:"submitted_since" url:"submitted_since,omitempty"`         //  (Optional)
-		GradedSince      time.Time `json:"graded_since" url:"graded_since,omitempty"`               //  (Optional)
-		GradingPeriodID  int64     `json:"grading_period_id" url:"grading_period_id,omitempty"`     //  (Optional)
-		WorkflowState    string    `json:"workflow_state" url:"workflow_state,omitempty"`           //  (Optional) . Must be one of submitted, unsubmitted, graded, pending_review
-		EnrollmentState  string    `json:"enrollment_state" url:"enrollment_state,omitempty"`       //  (Optional) . Must be one of active, concluded
-		StateBasedOnDate bool      `json:"state_based_on_date" url:"state_based_on_date,omitempty"` //  (Optional)
-		Order            string    `json:"order" url:"order,omitempty"`                             //  (Optional) . Must be one of id, graded_at
-		OrderDirection   string    `json:"order_direction" url:"order_direction,omitempty"`         //  (Optional) . Must be one of ascending, descending
-		Include          []string  `json:"include" url:"include,omitempty"`                         //  (Optional) . Must be one of submission_history, submission_comments, rubric_assessment, assignment, total_scores, visibility, course, user
+		StudentIDs       []string             `json:"student_ids" url:"student_ids,omitempty"`                 //  (Optional)
+		AssignmentIDs    []string             `json:"assignment_ids" url:"assignment_ids,omitempty"`           //  (Optional)
+		Grouped          canvasapi.Opt[bool]  `json:"grouped" url:"grouped,omitempty"`                         //  (Optional)
+		PostToSIS        canvasapi.Opt[bool]  `json:"post_to_sis" url:"post_to_sis,omitempty"`                 //  (Optional)
+		SubmittedSince   time.Time            `json:"submitted_since" url:"submitted_since,omitempty"`         //  (Optional)
+		GradedSince      time.Time            `json:"graded_since" url:"graded_since,omitempty"`               //  (Optional)
+		GradingPeriodID  canvasapi.Opt[int64] `json:"grading_period_id" url:"grading_period_id,omitempty"`     //  (Optional)
+		WorkflowState    string               `json:"workflow_state" url:"workflow_state,omitempty"`           //  (Optional) . Must be one of submitted, unsubmitted, graded, pending_review
+		EnrollmentState  string               `json:"enrollment_state" url:"enrollment_state,omitempty"`       //  (Optional) . Must be one of active, concluded
+		StateBasedOnDate canvasapi.Opt[bool]  `json:"state_based_on_date" url:"state_based_on_date,omitempty"` //  (Optional)
+		Order            string               `json:"order" url:"order,omitempty"`                             //  (Optional) . Must be one of id, graded_at
+		OrderDirection   string               `json:"order_direction" url:"order_direction,omitempty"`         //  (Optional) . Must be one of ascending, descending
+		Include          []string             `json:"include" url:"include,omitempty"`                         //  (Optional) . Must be one of submission_history, submission_comments, rubric_assessment, assignment, total_scores, visibility, course, user
 	} `json:"query"`
 }
 
@@ -84,12 +85,12 @@ func (t *ListSubmissionsForMultipleAssignmentsCourses) GetMethod() string {
 
 func (t *ListSubmissionsForMultipleAssignmentsCourses) GetURLPath() string {
 	path := "courses/{course_id}/students/submissions"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
 func (t *ListSubmissionsForMultipleAssignmentsCourses) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -105,38 +106,65 @@ func (t *ListSubmissionsForMultipleAssignmentsCourses) GetJSON() ([]byte, error)
 }
 
 func (t *ListSubmissionsForMultipleAssignmentsCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Query.WorkflowState != "" && !string_utils.Include([]string{"submitted", "unsubmitted", "graded", "pending_review"}, t.Query.WorkflowState) {
-		errs = append(errs, "WorkflowState must be one of submitted, unsubmitted, graded, pending_review")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.WorkflowState", Rule: canvasapi.RuleOneOf, Allowed: []string{"submitted", "unsubmitted", "graded", "pending_review"}})
 	}
 	if t.Query.EnrollmentState != "" && !string_utils.Include([]string{"active", "concluded"}, t.Query.EnrollmentState) {
-		errs = append(errs, "EnrollmentState must be one of active, concluded")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.EnrollmentState", Rule: canvasapi.RuleOneOf, Allowed: []string{"active", "concluded"}})
 	}
 	if t.Query.Order != "" && !string_utils.Include([]string{"id", "graded_at"}, t.Query.Order) {
-		errs = append(errs, "Order must be one of id, graded_at")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.Order", Rule: canvasapi.RuleOneOf, Allowed: []string{"id", "graded_at"}})
 	}
 	if t.Query.OrderDirection != "" && !string_utils.Include([]string{"ascending", "descending"}, t.Query.OrderDirection) {
-		errs = append(errs, "OrderDirection must be one of ascending, descending")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.OrderDirection", Rule: canvasapi.RuleOneOf, Allowed: []string{"ascending", "descending"}})
 	}
 	for _, v := range t.Query.Include {
 		if v != "" && !string_utils.Include([]string{"submission_history", "submission_comments", "rubric_assessment", "assignment", "total_scores", "visibility", "course", "user"}, v) {
-			errs = append(errs, "Include must be one of submission_history, submission_comments, rubric_assessment, assignment, total_scores, visibility, course, user")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.Include", Rule: canvasapi.RuleOneOf, Allowed: []string{"submission_history", "submission_comments", "rubric_assessment", "assignment", "total_scores", "visibility", "course", "user"}})
 		}
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *ListSubmissionsForMultipleAssignmentsCourses) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *ListSubmissionsForMultipleAssignmentsCourses) Do(c *canvasapi.Canvas, next *url.URL) ([]json.RawMessage, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListSubmissionsForMultipleAssignmentsCourses) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]json.RawMessage, *canvasapi.PagedResource, error) {
+	var err error
+	var response *http.Response
+	if next != nil {
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
+	} else {
+		response, err = c.SendRequestContext(ctx, t)
+	}
+
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	ret := []json.RawMessage{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ret, pagedResource, nil
 }
@@ -1,13 +1,14 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
 	"net/url"
-	"strings"
-
-	"github.com/google/go-querystring/query"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -38,11 +39,11 @@ func (t *ListTodoItems) GetMethod() string {
 }
 
 func (t *ListTodoItems) GetURLPath() string {
-	return ""
+	return "users/self/todo"
 }
 
 func (t *ListTodoItems) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -58,23 +59,54 @@ func (t *ListTodoItems) GetJSON() ([]byte, error) {
 }
 
 func (t *ListTodoItems) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	for _, v := range t.Query.Include {
 		if v != "" && !string_utils.Include([]string{"ungraded_quizzes"}, v) {
-			errs = append(errs, "Include must be one of ungraded_quizzes")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.Include", Rule: canvasapi.RuleOneOf, Allowed: []string{"ungraded_quizzes"}})
 		}
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *ListTodoItems) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *ListTodoItems) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.TodoItem, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListTodoItems) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.TodoItem, *canvasapi.PagedResource, error) {
+	var err error
+	var response *http.Response
+	if next != nil {
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
+	} else {
+		response, err = c.SendRequestContext(ctx, t)
+	}
+
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	ret := []*models.TodoItem{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ret, pagedResource, nil
 }
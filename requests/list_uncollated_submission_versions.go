@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -35,13 +33,13 @@ import (
 //
 type ListUncollatedSubmissionVersions struct {
 	Path struct {
-		CourseID int64 `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		AssignmentID int64 `json:"assignment_id" url:"assignment_id,omitempty"` //  (Optional)
-		UserID       int64 `json:"user_id" url:"user_id,omitempty"`             //  (Optional)
-		Ascending    bool  `json:"ascending" url:"ascending,omitempty"`         //  (Optional)
+		AssignmentID canvasapi.Opt[int64] `json:"assignment_id" url:"assignment_id,omitempty"` //  (Optional)
+		UserID       canvasapi.Opt[int64] `json:"user_id" url:"user_id,omitempty"`             //  (Optional)
+		Ascending    canvasapi.Opt[bool]  `json:"ascending" url:"ascending,omitempty"`         //  (Optional)
 	} `json:"query"`
 }
 
@@ -51,12 +49,12 @@ func (t *ListUncollatedSubmissionVersions) GetMethod() string {
 
 func (t *ListUncollatedSubmissionVersions) GetURLPath() string {
 	path := "courses/{course_id}/gradebook_history/feed"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
 func (t *ListUncollatedSubmissionVersions) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -72,16 +70,27 @@ func (t *ListUncollatedSubmissionVersions) GetJSON() ([]byte, error) {
 }
 
 func (t *ListUncollatedSubmissionVersions) HasErrors() error {
+	errs := []canvasapi.FieldError{}
+	if t.Path.CourseID == "" {
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
+	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
+	}
 	return nil
 }
 
 func (t *ListUncollatedSubmissionVersions) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.SubmissionVersion, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListUncollatedSubmissionVersions) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.SubmissionVersion, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -101,6 +110,10 @@ func (t *ListUncollatedSubmissionVersions) Do(c *canvasapi.Canvas, next *url.URL
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
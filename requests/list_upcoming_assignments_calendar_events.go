@@ -1,9 +1,13 @@
 package requests
 
 import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // ListUpcomingAssignmentsCalendarEvents A paginated list of the current user's upcoming events.
@@ -17,7 +21,7 @@ func (t *ListUpcomingAssignmentsCalendarEvents) GetMethod() string {
 }
 
 func (t *ListUpcomingAssignmentsCalendarEvents) GetURLPath() string {
-	return ""
+	return "users/self/upcoming_events"
 }
 
 func (t *ListUpcomingAssignmentsCalendarEvents) GetQuery() (string, error) {
@@ -36,11 +40,30 @@ func (t *ListUpcomingAssignmentsCalendarEvents) HasErrors() error {
 	return nil
 }
 
-func (t *ListUpcomingAssignmentsCalendarEvents) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *ListUpcomingAssignmentsCalendarEvents) Do(c *canvasapi.Canvas) ([]*models.CalendarEvent, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ListUpcomingAssignmentsCalendarEvents) DoContext(ctx context.Context, c *canvasapi.Canvas) ([]*models.CalendarEvent, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := []*models.CalendarEvent{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
 }
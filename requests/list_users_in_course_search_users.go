@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -68,19 +66,19 @@ import (
 //
 type ListUsersInCourseSearchUsers struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		SearchTerm       string   `json:"search_term" url:"search_term,omitempty"`               //  (Optional)
-		Sort             string   `json:"sort" url:"sort,omitempty"`                             //  (Optional) . Must be one of username, last_login, email, sis_id
-		EnrollmentType   []string `json:"enrollment_type" url:"enrollment_type,omitempty"`       //  (Optional) . Must be one of teacher, student, student_view, ta, observer, designer
-		EnrollmentRole   string   `json:"enrollment_role" url:"enrollment_role,omitempty"`       //  (Optional)
-		EnrollmentRoleID int64    `json:"enrollment_role_id" url:"enrollment_role_id,omitempty"` //  (Optional)
-		Include          []string `json:"include" url:"include,omitempty"`                       //  (Optional) . Must be one of enrollments, locked, avatar_url, test_student, bio, custom_links, current_grading_period_scores, uuid
-		UserID           string   `json:"user_id" url:"user_id,omitempty"`                       //  (Optional)
-		UserIDs          []string `json:"user_ids" url:"user_ids,omitempty"`                     //  (Optional)
-		EnrollmentState  []string `json:"enrollment_state" url:"enrollment_state,omitempty"`     //  (Optional) . Must be one of active, invited, rejected, completed, inactive
+		SearchTerm       string               `json:"search_term" url:"search_term,omitempty"`               //  (Optional)
+		Sort             string               `json:"sort" url:"sort,omitempty"`                             //  (Optional) . Must be one of username, last_login, email, sis_id
+		EnrollmentType   []string             `json:"enrollment_type" url:"enrollment_type,omitempty"`       //  (Optional) . Must be one of teacher, student, student_view, ta, observer, designer
+		EnrollmentRole   string               `json:"enrollment_role" url:"enrollment_role,omitempty"`       //  (Optional)
+		EnrollmentRoleID canvasapi.Opt[int64] `json:"enrollment_role_id" url:"enrollment_role_id,omitempty"` //  (Optional)
+		Include          []string             `json:"include" url:"include,omitempty"`                       //  (Optional) . Must be one of enrollments, locked, avatar_url, test_student, bio, custom_links, current_grading_period_scores, uuid
+		UserID           string               `json:"user_id" url:"user_id,omitempty"`                       //  (Optional)
+		UserIDs          []string             `json:"user_ids" url:"user_ids,omitempty"`                     //  (Optional)
+		EnrollmentState  []string             `json:"enrollment_state" url:"enrollment_state,omitempty"`     //  (Optional) . Must be one of active, invited, rejected, completed, inactive
 	} `json:"query"`
 }
 
@@ -90,12 +88,12 @@ func (t *ListUsersInCourseSearchUsers) GetMethod() string {
 
 func (t *ListUsersInCourseSearchUsers) GetURLPath() string {
 	path := "courses/{course_id}/search_users"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
 func (t *ListUsersInCourseSearchUsers) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -111,41 +109,45 @@ func (t *ListUsersInCourseSearchUsers) GetJSON() ([]byte, error) {
 }
 
 func (t *ListUsersInCourseSearchUsers) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Query.Sort != "" && !string_utils.Include([]string{"username", "last_login", "email", "sis_id"}, t.Query.Sort) {
-		errs = append(errs, "Sort must be one of username, last_login, email, sis_id")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.Sort", Rule: canvasapi.RuleOneOf, Allowed: []string{"username", "last_login", "email", "sis_id"}})
 	}
 	for _, v := range t.Query.EnrollmentType {
 		if v != "" && !string_utils.Include([]string{"teacher", "student", "student_view", "ta", "observer", "designer"}, v) {
-			errs = append(errs, "EnrollmentType must be one of teacher, student, student_view, ta, observer, designer")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.EnrollmentType", Rule: canvasapi.RuleOneOf, Allowed: []string{"teacher", "student", "student_view", "ta", "observer", "designer"}})
 		}
 	}
 	for _, v := range t.Query.Include {
 		if v != "" && !string_utils.Include([]string{"enrollments", "locked", "avatar_url", "test_student", "bio", "custom_links", "current_grading_period_scores", "uuid"}, v) {
-			errs = append(errs, "Include must be one of enrollments, locked, avatar_url, test_student, bio, custom_links, current_grading_period_scores, uuid")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.Include", Rule: canvasapi.RuleOneOf, Allowed: []string{"enrollments", "locked", "avatar_url", "test_student", "bio", "custom_links", "current_grading_period_scores", "uuid"}})
 		}
 	}
 	for _, v := range t.Query.EnrollmentState {
 		if v != "" && !string_utils.Include([]string{"active", "invited", "rejected", "completed", "inactive"}, v) {
-			errs = append(errs, "EnrollmentState must be one of active, invited, rejected, completed, inactive")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.EnrollmentState", Rule: canvasapi.RuleOneOf, Allowed: []string{"active", "invited", "rejected", "completed", "inactive"}})
 		}
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ListUsersInCourseSearchUsers) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.User, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListUsersInCourseSearchUsers) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.User, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -165,6 +167,10 @@ func (t *ListUsersInCourseSearchUsers) Do(c *canvasapi.Canvas, next *url.URL) ([
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
@@ -1,14 +1,11 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
-	"strings"
-
-	"github.com/google/go-querystring/query"
 
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
@@ -131,13 +128,13 @@ import (
 //
 type ListYourCourses struct {
 	Query struct {
-		EnrollmentType          string   `json:"enrollment_type" url:"enrollment_type,omitempty"`                     //  (Optional) . Must be one of teacher, student, ta, observer, designer
-		EnrollmentRole          string   `json:"enrollment_role" url:"enrollment_role,omitempty"`                     //  (Optional)
-		EnrollmentRoleID        int64    `json:"enrollment_role_id" url:"enrollment_role_id,omitempty"`               //  (Optional)
-		EnrollmentState         string   `json:"enrollment_state" url:"enrollment_state,omitempty"`                   //  (Optional) . Must be one of active, invited_or_pending, completed
-		ExcludeBlueprintCourses bool     `json:"exclude_blueprint_courses" url:"exclude_blueprint_courses,omitempty"` //  (Optional)
-		Include                 []string `json:"include" url:"include,omitempty"`                                     //  (Optional) . Must be one of needs_grading_count, syllabus_body, public_description, total_scores, current_grading_period_scores, grading_periods, term, account, course_progress, sections, storage_quota_used_mb, total_students, passback_status, favorites, teachers, observed_users, course_image, concluded
-		State                   []string `json:"state" url:"state,omitempty"`                                         //  (Optional) . Must be one of unpublished, available, completed, deleted
+		EnrollmentType          string               `json:"enrollment_type" url:"enrollment_type,omitempty"`                     //  (Optional) . Must be one of teacher, student, ta, observer, designer
+		EnrollmentRole          string               `json:"enrollment_role" url:"enrollment_role,omitempty"`                     //  (Optional)
+		EnrollmentRoleID        canvasapi.Opt[int64] `json:"enrollment_role_id" url:"enrollment_role_id,omitempty"`               //  (Optional)
+		EnrollmentState         string               `json:"enrollment_state" url:"enrollment_state,omitempty"`                   //  (Optional) . Must be one of active, invited_or_pending, completed
+		ExcludeBlueprintCourses canvasapi.Opt[bool]  `json:"exclude_blueprint_courses" url:"exclude_blueprint_courses,omitempty"` //  (Optional)
+		Include                 []string             `json:"include" url:"include,omitempty"`                                     //  (Optional) . Must be one of needs_grading_count, syllabus_body, public_description, total_scores, current_grading_period_scores, grading_periods, term, account, course_progress, sections, storage_quota_used_mb, total_students, passback_status, favorites, teachers, observed_users, course_image, concluded
+		State                   []string             `json:"state" url:"state,omitempty"`                                         //  (Optional) . Must be one of unpublished, available, completed, deleted
 	} `json:"query"`
 }
 
@@ -146,11 +143,11 @@ func (t *ListYourCourses) GetMethod() string {
 }
 
 func (t *ListYourCourses) GetURLPath() string {
-	return ""
+	return "courses"
 }
 
 func (t *ListYourCourses) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -166,36 +163,40 @@ func (t *ListYourCourses) GetJSON() ([]byte, error) {
 }
 
 func (t *ListYourCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Query.EnrollmentType != "" && !string_utils.Include([]string{"teacher", "student", "ta", "observer", "designer"}, t.Query.EnrollmentType) {
-		errs = append(errs, "EnrollmentType must be one of teacher, student, ta, observer, designer")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.EnrollmentType", Rule: canvasapi.RuleOneOf, Allowed: []string{"teacher", "student", "ta", "observer", "designer"}})
 	}
 	if t.Query.EnrollmentState != "" && !string_utils.Include([]string{"active", "invited_or_pending", "completed"}, t.Query.EnrollmentState) {
-		errs = append(errs, "EnrollmentState must be one of active, invited_or_pending, completed")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.EnrollmentState", Rule: canvasapi.RuleOneOf, Allowed: []string{"active", "invited_or_pending", "completed"}})
 	}
 	for _, v := range t.Query.Include {
 		if v != "" && !string_utils.Include([]string{"needs_grading_count", "syllabus_body", "public_description", "total_scores", "current_grading_period_scores", "grading_periods", "term", "account", "course_progress", "sections", "storage_quota_used_mb", "total_students", "passback_status", "favorites", "teachers", "observed_users", "course_image", "concluded"}, v) {
-			errs = append(errs, "Include must be one of needs_grading_count, syllabus_body, public_description, total_scores, current_grading_period_scores, grading_periods, term, account, course_progress, sections, storage_quota_used_mb, total_students, passback_status, favorites, teachers, observed_users, course_image, concluded")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.Include", Rule: canvasapi.RuleOneOf, Allowed: []string{"needs_grading_count", "syllabus_body", "public_description", "total_scores", "current_grading_period_scores", "grading_periods", "term", "account", "course_progress", "sections", "storage_quota_used_mb", "total_students", "passback_status", "favorites", "teachers", "observed_users", "course_image", "concluded"}})
 		}
 	}
 	for _, v := range t.Query.State {
 		if v != "" && !string_utils.Include([]string{"unpublished", "available", "completed", "deleted"}, v) {
-			errs = append(errs, "State must be one of unpublished, available, completed, deleted")
+			errs = append(errs, canvasapi.FieldError{Field: "Query.State", Rule: canvasapi.RuleOneOf, Allowed: []string{"unpublished", "available", "completed", "deleted"}})
 		}
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ListYourCourses) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Course, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListYourCourses) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.Course, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -215,6 +216,10 @@ func (t *ListYourCourses) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Cour
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
@@ -1,6 +1,7 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -23,10 +24,10 @@ import (
 //
 type ListsSubmissions struct {
 	Path struct {
-		CourseID     int64  `json:"course_id" url:"course_id,omitempty"`         //  (Required)
-		Date         string `json:"date" url:"date,omitempty"`                   //  (Required)
-		GraderID     int64  `json:"grader_id" url:"grader_id,omitempty"`         //  (Required)
-		AssignmentID int64  `json:"assignment_id" url:"assignment_id,omitempty"` //  (Required)
+		CourseID     canvasapi.ID `json:"course_id" url:"course_id,omitempty"`         //  (Required)
+		Date         string       `json:"date" url:"date,omitempty"`                   //  (Required)
+		GraderID     canvasapi.ID `json:"grader_id" url:"grader_id,omitempty"`         //  (Required)
+		AssignmentID canvasapi.ID `json:"assignment_id" url:"assignment_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -36,10 +37,10 @@ func (t *ListsSubmissions) GetMethod() string {
 
 func (t *ListsSubmissions) GetURLPath() string {
 	path := "courses/{course_id}/gradebook_history/{date}/graders/{grader_id}/assignments/{assignment_id}/submissions"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	path = strings.ReplaceAll(path, "{date}", fmt.Sprintf("%v", t.Path.Date))
-	path = strings.ReplaceAll(path, "{grader_id}", fmt.Sprintf("%v", t.Path.GraderID))
-	path = strings.ReplaceAll(path, "{assignment_id}", fmt.Sprintf("%v", t.Path.AssignmentID))
+	path = strings.ReplaceAll(path, "{grader_id}", t.Path.GraderID.Escaped())
+	path = strings.ReplaceAll(path, "{assignment_id}", t.Path.AssignmentID.Escaped())
 	return path
 }
 
@@ -56,23 +57,36 @@ func (t *ListsSubmissions) GetJSON() ([]byte, error) {
 }
 
 func (t *ListsSubmissions) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
+	if t.Path.CourseID == "" {
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
+	}
+	if t.Path.GraderID == "" {
+		errs = append(errs, canvasapi.FieldError{Field: "Path.GraderID", Rule: canvasapi.RuleRequired})
+	}
+	if t.Path.AssignmentID == "" {
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AssignmentID", Rule: canvasapi.RuleRequired})
+	}
 	if t.Path.Date == "" {
-		errs = append(errs, "'Path.Date' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Date", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ListsSubmissions) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.SubmissionHistory, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ListsSubmissions) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.SubmissionHistory, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -92,6 +106,10 @@ func (t *ListsSubmissions) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Sub
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
@@ -1,13 +1,14 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // LoadCustomData Load custom user data.
@@ -32,7 +33,7 @@ import (
 //
 type LoadCustomData struct {
 	Path struct {
-		UserID string `json:"user_id" url:"user_id,omitempty"` //  (Required)
+		UserID canvasapi.ID `json:"user_id" url:"user_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
@@ -46,12 +47,12 @@ func (t *LoadCustomData) GetMethod() string {
 
 func (t *LoadCustomData) GetURLPath() string {
 	path := "users/{user_id}/custom_data"
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", t.Path.UserID))
+	path = strings.ReplaceAll(path, "{user_id}", t.Path.UserID.Escaped())
 	return path
 }
 
 func (t *LoadCustomData) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -67,24 +68,43 @@ func (t *LoadCustomData) GetJSON() ([]byte, error) {
 }
 
 func (t *LoadCustomData) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.UserID == "" {
-		errs = append(errs, "'Path.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Query.Ns == "" {
-		errs = append(errs, "'Query.Ns' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.Ns", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *LoadCustomData) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *LoadCustomData) Do(c *canvasapi.Canvas) (*models.CustomData, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *LoadCustomData) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.CustomData, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.CustomData{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,14 +1,14 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // LockOrUnlockCurrentCspSettingsForSubAccountsAndCourses Can only be set if CSP is explicitly enabled or disabled on this account (i.e. "inherited" is false).
@@ -22,11 +22,11 @@ import (
 //
 type LockOrUnlockCurrentCspSettingsForSubAccountsAndCourses struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		SettingsLocked bool `json:"settings_locked" url:"settings_locked,omitempty"` //  (Required)
+		SettingsLocked canvasapi.Opt[bool] `json:"settings_locked" url:"settings_locked,omitempty"` //  (Required)
 	} `json:"form"`
 }
 
@@ -36,7 +36,7 @@ func (t *LockOrUnlockCurrentCspSettingsForSubAccountsAndCourses) GetMethod() str
 
 func (t *LockOrUnlockCurrentCspSettingsForSubAccountsAndCourses) GetURLPath() string {
 	path := "accounts/{account_id}/csp_settings/lock"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	return path
 }
 
@@ -45,33 +45,55 @@ func (t *LockOrUnlockCurrentCspSettingsForSubAccountsAndCourses) GetQuery() (str
 }
 
 func (t *LockOrUnlockCurrentCspSettingsForSubAccountsAndCourses) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *LockOrUnlockCurrentCspSettingsForSubAccountsAndCourses) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *LockOrUnlockCurrentCspSettingsForSubAccountsAndCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
+	}
+	if !t.Form.SettingsLocked.IsSet() {
+		errs = append(errs, canvasapi.FieldError{Field: "Form.SettingsLocked", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *LockOrUnlockCurrentCspSettingsForSubAccountsAndCourses) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *LockOrUnlockCurrentCspSettingsForSubAccountsAndCourses) Do(c *canvasapi.Canvas) (*models.CSPSettings, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *LockOrUnlockCurrentCspSettingsForSubAccountsAndCourses) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.CSPSettings, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.CSPSettings{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -30,14 +28,14 @@ import (
 //
 type MakeAccountAdmin struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		UserID           int64  `json:"user_id" url:"user_id,omitempty"`                     //  (Required)
-		Role             string `json:"role" url:"role,omitempty"`                           //  (Optional)
-		RoleID           int64  `json:"role_id" url:"role_id,omitempty"`                     //  (Optional)
-		SendConfirmation bool   `json:"send_confirmation" url:"send_confirmation,omitempty"` //  (Optional)
+		UserID           int64                `json:"user_id" url:"user_id,omitempty"`                     //  (Required)
+		Role             string               `json:"role" url:"role,omitempty"`                           //  (Optional)
+		RoleID           canvasapi.Opt[int64] `json:"role_id" url:"role_id,omitempty"`                     //  (Optional)
+		SendConfirmation canvasapi.Opt[bool]  `json:"send_confirmation" url:"send_confirmation,omitempty"` //  (Optional)
 	} `json:"form"`
 }
 
@@ -47,7 +45,7 @@ func (t *MakeAccountAdmin) GetMethod() string {
 
 func (t *MakeAccountAdmin) GetURLPath() string {
 	path := "accounts/{account_id}/admins"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	return path
 }
 
@@ -56,30 +54,34 @@ func (t *MakeAccountAdmin) GetQuery() (string, error) {
 }
 
 func (t *MakeAccountAdmin) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *MakeAccountAdmin) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *MakeAccountAdmin) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *MakeAccountAdmin) Do(c *canvasapi.Canvas) (*models.Admin, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *MakeAccountAdmin) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Admin, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -94,6 +96,10 @@ func (t *MakeAccountAdmin) Do(c *canvasapi.Canvas) (*models.Admin, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
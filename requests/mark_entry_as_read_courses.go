@@ -1,13 +1,10 @@
 package requests
 
 import (
-	"encoding/json"
-	"fmt"
+	"context"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 )
 
@@ -29,13 +26,13 @@ import (
 //
 type MarkEntryAsReadCourses struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		TopicID  string `json:"topic_id" url:"topic_id,omitempty"`   //  (Required)
-		EntryID  string `json:"entry_id" url:"entry_id,omitempty"`   //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		TopicID  canvasapi.ID `json:"topic_id" url:"topic_id,omitempty"`   //  (Required)
+		EntryID  canvasapi.ID `json:"entry_id" url:"entry_id,omitempty"`   //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		ForcedReadState bool `json:"forced_read_state" url:"forced_read_state,omitempty"` //  (Optional)
+		ForcedReadState canvasapi.Opt[bool] `json:"forced_read_state" url:"forced_read_state,omitempty"` //  (Optional)
 	} `json:"form"`
 }
 
@@ -45,9 +42,9 @@ func (t *MarkEntryAsReadCourses) GetMethod() string {
 
 func (t *MarkEntryAsReadCourses) GetURLPath() string {
 	path := "courses/{course_id}/discussion_topics/{topic_id}/entries/{entry_id}/read"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{topic_id}", fmt.Sprintf("%v", t.Path.TopicID))
-	path = strings.ReplaceAll(path, "{entry_id}", fmt.Sprintf("%v", t.Path.EntryID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{topic_id}", t.Path.TopicID.Escaped())
+	path = strings.ReplaceAll(path, "{entry_id}", t.Path.EntryID.Escaped())
 	return path
 }
 
@@ -56,39 +53,44 @@ func (t *MarkEntryAsReadCourses) GetQuery() (string, error) {
 }
 
 func (t *MarkEntryAsReadCourses) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *MarkEntryAsReadCourses) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *MarkEntryAsReadCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.TopicID == "" {
-		errs = append(errs, "'Path.TopicID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.TopicID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.EntryID == "" {
-		errs = append(errs, "'Path.EntryID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.EntryID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *MarkEntryAsReadCourses) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *MarkEntryAsReadCourses) DoContext(ctx context.Context, c *canvasapi.Canvas) error {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return err
 	}
+	response.Body.Close()
 
 	return nil
 }
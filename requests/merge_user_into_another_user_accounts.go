@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -77,9 +77,9 @@ import (
 //
 type MergeUserIntoAnotherUserAccounts struct {
 	Path struct {
-		ID                   string `json:"id" url:"id,omitempty"`                                         //  (Required)
-		DestinationAccountID string `json:"destination_account_id" url:"destination_account_id,omitempty"` //  (Required)
-		DestinationUserID    string `json:"destination_user_id" url:"destination_user_id,omitempty"`       //  (Required)
+		ID                   canvasapi.ID `json:"id" url:"id,omitempty"`                                         //  (Required)
+		DestinationAccountID canvasapi.ID `json:"destination_account_id" url:"destination_account_id,omitempty"` //  (Required)
+		DestinationUserID    canvasapi.ID `json:"destination_user_id" url:"destination_user_id,omitempty"`       //  (Required)
 	} `json:"path"`
 }
 
@@ -89,9 +89,9 @@ func (t *MergeUserIntoAnotherUserAccounts) GetMethod() string {
 
 func (t *MergeUserIntoAnotherUserAccounts) GetURLPath() string {
 	path := "users/{id}/merge_into/accounts/{destination_account_id}/users/{destination_user_id}"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
-	path = strings.ReplaceAll(path, "{destination_account_id}", fmt.Sprintf("%v", t.Path.DestinationAccountID))
-	path = strings.ReplaceAll(path, "{destination_user_id}", fmt.Sprintf("%v", t.Path.DestinationUserID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
+	path = strings.ReplaceAll(path, "{destination_account_id}", t.Path.DestinationAccountID.Escaped())
+	path = strings.ReplaceAll(path, "{destination_user_id}", t.Path.DestinationUserID.Escaped())
 	return path
 }
 
@@ -108,24 +108,28 @@ func (t *MergeUserIntoAnotherUserAccounts) GetJSON() ([]byte, error) {
 }
 
 func (t *MergeUserIntoAnotherUserAccounts) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.DestinationAccountID == "" {
-		errs = append(errs, "'Path.DestinationAccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.DestinationAccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.DestinationUserID == "" {
-		errs = append(errs, "'Path.DestinationUserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.DestinationUserID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *MergeUserIntoAnotherUserAccounts) Do(c *canvasapi.Canvas) (*models.User, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *MergeUserIntoAnotherUserAccounts) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.User, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -140,6 +144,10 @@ func (t *MergeUserIntoAnotherUserAccounts) Do(c *canvasapi.Canvas) (*models.User
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
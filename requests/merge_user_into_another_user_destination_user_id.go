@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -76,8 +76,8 @@ import (
 //
 type MergeUserIntoAnotherUserDestinationUserID struct {
 	Path struct {
-		ID                string `json:"id" url:"id,omitempty"`                                   //  (Required)
-		DestinationUserID string `json:"destination_user_id" url:"destination_user_id,omitempty"` //  (Required)
+		ID                canvasapi.ID `json:"id" url:"id,omitempty"`                                   //  (Required)
+		DestinationUserID canvasapi.ID `json:"destination_user_id" url:"destination_user_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -87,8 +87,8 @@ func (t *MergeUserIntoAnotherUserDestinationUserID) GetMethod() string {
 
 func (t *MergeUserIntoAnotherUserDestinationUserID) GetURLPath() string {
 	path := "users/{id}/merge_into/{destination_user_id}"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
-	path = strings.ReplaceAll(path, "{destination_user_id}", fmt.Sprintf("%v", t.Path.DestinationUserID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
+	path = strings.ReplaceAll(path, "{destination_user_id}", t.Path.DestinationUserID.Escaped())
 	return path
 }
 
@@ -105,21 +105,25 @@ func (t *MergeUserIntoAnotherUserDestinationUserID) GetJSON() ([]byte, error) {
 }
 
 func (t *MergeUserIntoAnotherUserDestinationUserID) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.DestinationUserID == "" {
-		errs = append(errs, "'Path.DestinationUserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.DestinationUserID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *MergeUserIntoAnotherUserDestinationUserID) Do(c *canvasapi.Canvas) (*models.User, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *MergeUserIntoAnotherUserDestinationUserID) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.User, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -134,6 +138,10 @@ func (t *MergeUserIntoAnotherUserDestinationUserID) Do(c *canvasapi.Canvas) (*mo
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
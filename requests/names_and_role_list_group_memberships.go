@@ -1,14 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
+	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -32,7 +31,7 @@ import (
 //
 type NamesAndRoleListGroupMemberships struct {
 	Path struct {
-		GroupID string `json:"group_id" url:"group_id,omitempty"` //  (Required)
+		GroupID canvasapi.ID `json:"group_id" url:"group_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
@@ -48,12 +47,12 @@ func (t *NamesAndRoleListGroupMemberships) GetMethod() string {
 
 func (t *NamesAndRoleListGroupMemberships) GetURLPath() string {
 	path := "/lti/groups/{group_id}/names_and_roles"
-	path = strings.ReplaceAll(path, "{group_id}", fmt.Sprintf("%v", t.Path.GroupID))
+	path = strings.ReplaceAll(path, "{group_id}", t.Path.GroupID.Escaped())
 	return path
 }
 
 func (t *NamesAndRoleListGroupMemberships) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -68,33 +67,59 @@ func (t *NamesAndRoleListGroupMemberships) GetJSON() ([]byte, error) {
 	return nil, nil
 }
 
+// MediaTypes returns the IMS media types of this LTI Advantage service.
+func (t *NamesAndRoleListGroupMemberships) MediaTypes() (string, string) {
+	return "", "application/vnd.ims.lti-nrps.v2.membershipcontainer+json"
+}
+
 func (t *NamesAndRoleListGroupMemberships) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.GroupID == "" {
-		errs = append(errs, "'Path.GroupID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.GroupID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *NamesAndRoleListGroupMemberships) Do(c *canvasapi.Canvas) (*models.NamesAndRoleMemberships, error) {
-	response, err := c.SendRequest(t)
+func (t *NamesAndRoleListGroupMemberships) Do(c *canvasapi.Canvas, next *url.URL) (*models.NamesAndRoleMemberships, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *NamesAndRoleListGroupMemberships) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) (*models.NamesAndRoleMemberships, *canvasapi.PagedResource, error) {
+	var err error
+	var response *http.Response
+	if next != nil {
+		_, accept := t.MediaTypes()
+		response, err = c.SendAcceptContext(ctx, next, t.GetMethod(), nil, "", accept)
+	} else {
+		response, err = c.SendRequestContext(ctx, t)
+	}
+
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	body, err := ioutil.ReadAll(response.Body)
 	response.Body.Close()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	ret := models.NamesAndRoleMemberships{}
 	err = json.Unmarshal(body, &ret)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return &ret, nil
+	return &ret, pagedResource, nil
 }
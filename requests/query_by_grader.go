@@ -1,16 +1,14 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -27,7 +25,7 @@ import (
 //
 type QueryByGrader struct {
 	Path struct {
-		GraderID string `json:"grader_id" url:"grader_id,omitempty"` //  (Required)
+		GraderID canvasapi.ID `json:"grader_id" url:"grader_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
@@ -42,12 +40,12 @@ func (t *QueryByGrader) GetMethod() string {
 
 func (t *QueryByGrader) GetURLPath() string {
 	path := "audit/grade_change/graders/{grader_id}"
-	path = strings.ReplaceAll(path, "{grader_id}", fmt.Sprintf("%v", t.Path.GraderID))
+	path = strings.ReplaceAll(path, "{grader_id}", t.Path.GraderID.Escaped())
 	return path
 }
 
 func (t *QueryByGrader) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -63,28 +61,29 @@ func (t *QueryByGrader) GetJSON() ([]byte, error) {
 }
 
 func (t *QueryByGrader) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.GraderID == "" {
-		errs = append(errs, "'Path.GraderID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.GraderID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *QueryByGrader) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.GradeChangeEvent, *canvasapi.PagedResource, error) {
+func (t *QueryByGrader) Do(c *canvasapi.Canvas, next *url.URL) (*models.GradeChangeEventsResponse, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *QueryByGrader) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) (*models.GradeChangeEventsResponse, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
-	if err != nil {
-		return nil, nil, err
-	}
 	if err != nil {
 		return nil, nil, err
 	}
@@ -94,16 +93,20 @@ func (t *QueryByGrader) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.GradeC
 	if err != nil {
 		return nil, nil, err
 	}
-	ret := []*models.GradeChangeEvent{}
+	ret := models.GradeChangeEventsResponse{}
 	err = json.Unmarshal(body, &ret)
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	return ret, pagedResource, nil
+	return &ret, pagedResource, nil
 }
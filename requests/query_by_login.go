@@ -1,14 +1,16 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
 	"net/url"
 	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // QueryByLogin List authentication events for a given login.
@@ -24,7 +26,7 @@ import (
 //
 type QueryByLogin struct {
 	Path struct {
-		LoginID string `json:"login_id" url:"login_id,omitempty"` //  (Required)
+		LoginID canvasapi.ID `json:"login_id" url:"login_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
@@ -39,12 +41,12 @@ func (t *QueryByLogin) GetMethod() string {
 
 func (t *QueryByLogin) GetURLPath() string {
 	path := "audit/authentication/logins/{login_id}"
-	path = strings.ReplaceAll(path, "{login_id}", fmt.Sprintf("%v", t.Path.LoginID))
+	path = strings.ReplaceAll(path, "{login_id}", t.Path.LoginID.Escaped())
 	return path
 }
 
 func (t *QueryByLogin) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -60,21 +62,52 @@ func (t *QueryByLogin) GetJSON() ([]byte, error) {
 }
 
 func (t *QueryByLogin) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.LoginID == "" {
-		errs = append(errs, "'Path.LoginID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.LoginID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *QueryByLogin) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *QueryByLogin) Do(c *canvasapi.Canvas, next *url.URL) (*models.AuthenticationEventsResponse, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *QueryByLogin) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) (*models.AuthenticationEventsResponse, *canvasapi.PagedResource, error) {
+	var err error
+	var response *http.Response
+	if next != nil {
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
+	} else {
+		response, err = c.SendRequestContext(ctx, t)
+	}
+
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	ret := models.AuthenticationEventsResponse{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &ret, pagedResource, nil
 }
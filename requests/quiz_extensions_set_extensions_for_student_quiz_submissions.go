@@ -1,14 +1,14 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // QuizExtensionsSetExtensionsForStudentQuizSubmissions <b>Responses</b>
@@ -39,8 +39,8 @@ import (
 //
 type QuizExtensionsSetExtensionsForStudentQuizSubmissions struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		QuizID   string `json:"quiz_id" url:"quiz_id,omitempty"`     //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		QuizID   canvasapi.ID `json:"quiz_id" url:"quiz_id,omitempty"`     //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -61,8 +61,8 @@ func (t *QuizExtensionsSetExtensionsForStudentQuizSubmissions) GetMethod() strin
 
 func (t *QuizExtensionsSetExtensionsForStudentQuizSubmissions) GetURLPath() string {
 	path := "courses/{course_id}/quizzes/{quiz_id}/extensions"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{quiz_id}", fmt.Sprintf("%v", t.Path.QuizID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{quiz_id}", t.Path.QuizID.Escaped())
 	return path
 }
 
@@ -71,39 +71,58 @@ func (t *QuizExtensionsSetExtensionsForStudentQuizSubmissions) GetQuery() (strin
 }
 
 func (t *QuizExtensionsSetExtensionsForStudentQuizSubmissions) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *QuizExtensionsSetExtensionsForStudentQuizSubmissions) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *QuizExtensionsSetExtensionsForStudentQuizSubmissions) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.QuizID == "" {
-		errs = append(errs, "'Path.QuizID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.QuizID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.QuizExtensions.UserID == nil {
-		errs = append(errs, "'Form.QuizExtensions.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.QuizExtensions.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *QuizExtensionsSetExtensionsForStudentQuizSubmissions) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *QuizExtensionsSetExtensionsForStudentQuizSubmissions) Do(c *canvasapi.Canvas) (*models.QuizExtensionsResponse, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *QuizExtensionsSetExtensionsForStudentQuizSubmissions) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.QuizExtensionsResponse, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.QuizExtensionsResponse{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,14 +1,14 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // QuizSubmissionFilesUploadFile Associate a new quiz submission file
@@ -26,16 +26,20 @@ import (
 // Form Parameters:
 // # Form.Name (Optional) The name of the quiz submission file
 // # Form.OnDuplicate (Optional) How to handle duplicate names
+// # Form.Size (Optional) The size of the file, in bytes.
+// # Form.ContentType (Optional) The content type of the file. If not given, it will be guessed based on the file extension.
 //
 type QuizSubmissionFilesUploadFile struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		QuizID   string `json:"quiz_id" url:"quiz_id,omitempty"`     //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		QuizID   canvasapi.ID `json:"quiz_id" url:"quiz_id,omitempty"`     //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		Name        string `json:"name" url:"name,omitempty"`                 //  (Optional)
-		OnDuplicate string `json:"on_duplicate" url:"on_duplicate,omitempty"` //  (Optional)
+		Name        string               `json:"name" url:"name,omitempty"`                 //  (Optional)
+		OnDuplicate string               `json:"on_duplicate" url:"on_duplicate,omitempty"` //  (Optional)
+		Size        canvasapi.Opt[int64] `json:"size" url:"size,omitempty"`                 //  (Optional)
+		ContentType string               `json:"content_type" url:"content_type,omitempty"` //  (Optional)
 	} `json:"form"`
 }
 
@@ -45,8 +49,8 @@ func (t *QuizSubmissionFilesUploadFile) GetMethod() string {
 
 func (t *QuizSubmissionFilesUploadFile) GetURLPath() string {
 	path := "courses/{course_id}/quizzes/{quiz_id}/submissions/self/files"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{quiz_id}", fmt.Sprintf("%v", t.Path.QuizID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{quiz_id}", t.Path.QuizID.Escaped())
 	return path
 }
 
@@ -55,36 +59,55 @@ func (t *QuizSubmissionFilesUploadFile) GetQuery() (string, error) {
 }
 
 func (t *QuizSubmissionFilesUploadFile) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *QuizSubmissionFilesUploadFile) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *QuizSubmissionFilesUploadFile) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.QuizID == "" {
-		errs = append(errs, "'Path.QuizID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.QuizID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *QuizSubmissionFilesUploadFile) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *QuizSubmissionFilesUploadFile) Do(c *canvasapi.Canvas) (*models.UploadParams, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *QuizSubmissionFilesUploadFile) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.UploadParams, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.UploadParams{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
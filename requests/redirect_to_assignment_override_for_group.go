@@ -1,11 +1,14 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // RedirectToAssignmentOverrideForGroup Responds with a redirect to the override for the given group, if any
@@ -18,8 +21,8 @@ import (
 //
 type RedirectToAssignmentOverrideForGroup struct {
 	Path struct {
-		GroupID      string `json:"group_id" url:"group_id,omitempty"`           //  (Required)
-		AssignmentID string `json:"assignment_id" url:"assignment_id,omitempty"` //  (Required)
+		GroupID      canvasapi.ID `json:"group_id" url:"group_id,omitempty"`           //  (Required)
+		AssignmentID canvasapi.ID `json:"assignment_id" url:"assignment_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -29,8 +32,8 @@ func (t *RedirectToAssignmentOverrideForGroup) GetMethod() string {
 
 func (t *RedirectToAssignmentOverrideForGroup) GetURLPath() string {
 	path := "groups/{group_id}/assignments/{assignment_id}/override"
-	path = strings.ReplaceAll(path, "{group_id}", fmt.Sprintf("%v", t.Path.GroupID))
-	path = strings.ReplaceAll(path, "{assignment_id}", fmt.Sprintf("%v", t.Path.AssignmentID))
+	path = strings.ReplaceAll(path, "{group_id}", t.Path.GroupID.Escaped())
+	path = strings.ReplaceAll(path, "{assignment_id}", t.Path.AssignmentID.Escaped())
 	return path
 }
 
@@ -47,24 +50,43 @@ func (t *RedirectToAssignmentOverrideForGroup) GetJSON() ([]byte, error) {
 }
 
 func (t *RedirectToAssignmentOverrideForGroup) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.GroupID == "" {
-		errs = append(errs, "'Path.GroupID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.GroupID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.AssignmentID == "" {
-		errs = append(errs, "'Path.AssignmentID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AssignmentID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *RedirectToAssignmentOverrideForGroup) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *RedirectToAssignmentOverrideForGroup) Do(c *canvasapi.Canvas) (*models.AssignmentOverride, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *RedirectToAssignmentOverrideForGroup) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.AssignmentOverride, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.AssignmentOverride{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
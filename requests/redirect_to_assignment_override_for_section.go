@@ -1,11 +1,14 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // RedirectToAssignmentOverrideForSection Responds with a redirect to the override for the given section, if any
@@ -18,8 +21,8 @@ import (
 //
 type RedirectToAssignmentOverrideForSection struct {
 	Path struct {
-		CourseSectionID string `json:"course_section_id" url:"course_section_id,omitempty"` //  (Required)
-		AssignmentID    string `json:"assignment_id" url:"assignment_id,omitempty"`         //  (Required)
+		CourseSectionID canvasapi.ID `json:"course_section_id" url:"course_section_id,omitempty"` //  (Required)
+		AssignmentID    canvasapi.ID `json:"assignment_id" url:"assignment_id,omitempty"`         //  (Required)
 	} `json:"path"`
 }
 
@@ -29,8 +32,8 @@ func (t *RedirectToAssignmentOverrideForSection) GetMethod() string {
 
 func (t *RedirectToAssignmentOverrideForSection) GetURLPath() string {
 	path := "sections/{course_section_id}/assignments/{assignment_id}/override"
-	path = strings.ReplaceAll(path, "{course_section_id}", fmt.Sprintf("%v", t.Path.CourseSectionID))
-	path = strings.ReplaceAll(path, "{assignment_id}", fmt.Sprintf("%v", t.Path.AssignmentID))
+	path = strings.ReplaceAll(path, "{course_section_id}", t.Path.CourseSectionID.Escaped())
+	path = strings.ReplaceAll(path, "{assignment_id}", t.Path.AssignmentID.Escaped())
 	return path
 }
 
@@ -47,24 +50,43 @@ func (t *RedirectToAssignmentOverrideForSection) GetJSON() ([]byte, error) {
 }
 
 func (t *RedirectToAssignmentOverrideForSection) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseSectionID == "" {
-		errs = append(errs, "'Path.CourseSectionID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseSectionID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.AssignmentID == "" {
-		errs = append(errs, "'Path.AssignmentID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AssignmentID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *RedirectToAssignmentOverrideForSection) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *RedirectToAssignmentOverrideForSection) Do(c *canvasapi.Canvas) (*models.AssignmentOverride, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *RedirectToAssignmentOverrideForSection) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.AssignmentOverride, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.AssignmentOverride{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,11 +1,14 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // RedirectToRootOutcomeGroupForContextAccounts Convenience redirect to find the root outcome group for a particular
@@ -17,7 +20,7 @@ import (
 //
 type RedirectToRootOutcomeGroupForContextAccounts struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -27,7 +30,7 @@ func (t *RedirectToRootOutcomeGroupForContextAccounts) GetMethod() string {
 
 func (t *RedirectToRootOutcomeGroupForContextAccounts) GetURLPath() string {
 	path := "accounts/{account_id}/root_outcome_group"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	return path
 }
 
@@ -44,21 +47,40 @@ func (t *RedirectToRootOutcomeGroupForContextAccounts) GetJSON() ([]byte, error)
 }
 
 func (t *RedirectToRootOutcomeGroupForContextAccounts) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *RedirectToRootOutcomeGroupForContextAccounts) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *RedirectToRootOutcomeGroupForContextAccounts) Do(c *canvasapi.Canvas) (*models.OutcomeGroup, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *RedirectToRootOutcomeGroupForContextAccounts) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.OutcomeGroup, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.OutcomeGroup{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
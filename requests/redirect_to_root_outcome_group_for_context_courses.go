@@ -1,11 +1,14 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // RedirectToRootOutcomeGroupForContextCourses Convenience redirect to find the root outcome group for a particular
@@ -17,7 +20,7 @@ import (
 //
 type RedirectToRootOutcomeGroupForContextCourses struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -27,7 +30,7 @@ func (t *RedirectToRootOutcomeGroupForContextCourses) GetMethod() string {
 
 func (t *RedirectToRootOutcomeGroupForContextCourses) GetURLPath() string {
 	path := "courses/{course_id}/root_outcome_group"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
@@ -44,21 +47,40 @@ func (t *RedirectToRootOutcomeGroupForContextCourses) GetJSON() ([]byte, error)
 }
 
 func (t *RedirectToRootOutcomeGroupForContextCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *RedirectToRootOutcomeGroupForContextCourses) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *RedirectToRootOutcomeGroupForContextCourses) Do(c *canvasapi.Canvas) (*models.OutcomeGroup, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *RedirectToRootOutcomeGroupForContextCourses) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.OutcomeGroup, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.OutcomeGroup{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
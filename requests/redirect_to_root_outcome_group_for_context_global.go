@@ -1,9 +1,13 @@
 package requests
 
 import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // RedirectToRootOutcomeGroupForContextGlobal Convenience redirect to find the root outcome group for a particular
@@ -18,7 +22,7 @@ func (t *RedirectToRootOutcomeGroupForContextGlobal) GetMethod() string {
 }
 
 func (t *RedirectToRootOutcomeGroupForContextGlobal) GetURLPath() string {
-	return ""
+	return "global/root_outcome_group"
 }
 
 func (t *RedirectToRootOutcomeGroupForContextGlobal) GetQuery() (string, error) {
@@ -37,11 +41,30 @@ func (t *RedirectToRootOutcomeGroupForContextGlobal) HasErrors() error {
 	return nil
 }
 
-func (t *RedirectToRootOutcomeGroupForContextGlobal) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *RedirectToRootOutcomeGroupForContextGlobal) Do(c *canvasapi.Canvas) (*models.OutcomeGroup, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *RedirectToRootOutcomeGroupForContextGlobal) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.OutcomeGroup, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.OutcomeGroup{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
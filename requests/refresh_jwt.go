@@ -1,13 +1,10 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
-	"strings"
-
-	"github.com/google/go-querystring/query"
 
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
@@ -34,7 +31,7 @@ func (t *RefreshJwt) GetMethod() string {
 }
 
 func (t *RefreshJwt) GetURLPath() string {
-	return ""
+	return "jwts/refresh"
 }
 
 func (t *RefreshJwt) GetQuery() (string, error) {
@@ -42,30 +39,34 @@ func (t *RefreshJwt) GetQuery() (string, error) {
 }
 
 func (t *RefreshJwt) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *RefreshJwt) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *RefreshJwt) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Form.Jwt == "" {
-		errs = append(errs, "'Form.Jwt' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Jwt", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *RefreshJwt) Do(c *canvasapi.Canvas) (*models.JWT, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *RefreshJwt) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.JWT, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -80,6 +81,10 @@ func (t *RefreshJwt) Do(c *canvasapi.Canvas) (*models.JWT, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
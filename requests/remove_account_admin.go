@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -28,13 +26,13 @@ import (
 //
 type RemoveAccountAdmin struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
-		UserID    string `json:"user_id" url:"user_id,omitempty"`       //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		UserID    canvasapi.ID `json:"user_id" url:"user_id,omitempty"`       //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		Role   string `json:"role" url:"role,omitempty"`       //  (Optional)
-		RoleID int64  `json:"role_id" url:"role_id,omitempty"` //  (Optional)
+		Role   string               `json:"role" url:"role,omitempty"`       //  (Optional)
+		RoleID canvasapi.Opt[int64] `json:"role_id" url:"role_id,omitempty"` //  (Optional)
 	} `json:"query"`
 }
 
@@ -44,13 +42,13 @@ func (t *RemoveAccountAdmin) GetMethod() string {
 
 func (t *RemoveAccountAdmin) GetURLPath() string {
 	path := "accounts/{account_id}/admins/{user_id}"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", t.Path.UserID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
+	path = strings.ReplaceAll(path, "{user_id}", t.Path.UserID.Escaped())
 	return path
 }
 
 func (t *RemoveAccountAdmin) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -66,21 +64,25 @@ func (t *RemoveAccountAdmin) GetJSON() ([]byte, error) {
 }
 
 func (t *RemoveAccountAdmin) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.UserID == "" {
-		errs = append(errs, "'Path.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *RemoveAccountAdmin) Do(c *canvasapi.Canvas) (*models.Admin, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *RemoveAccountAdmin) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Admin, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -95,6 +97,10 @@ func (t *RemoveAccountAdmin) Do(c *canvasapi.Canvas) (*models.Admin, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,6 +1,7 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -23,8 +24,8 @@ import (
 //
 type RemoveFeatureFlagAccounts struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
-		Feature   string `json:"feature" url:"feature,omitempty"`       //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		Feature   string       `json:"feature" url:"feature,omitempty"`       //  (Required)
 	} `json:"path"`
 }
 
@@ -34,7 +35,7 @@ func (t *RemoveFeatureFlagAccounts) GetMethod() string {
 
 func (t *RemoveFeatureFlagAccounts) GetURLPath() string {
 	path := "accounts/{account_id}/features/flags/{feature}"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	path = strings.ReplaceAll(path, "{feature}", fmt.Sprintf("%v", t.Path.Feature))
 	return path
 }
@@ -52,21 +53,25 @@ func (t *RemoveFeatureFlagAccounts) GetJSON() ([]byte, error) {
 }
 
 func (t *RemoveFeatureFlagAccounts) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.Feature == "" {
-		errs = append(errs, "'Path.Feature' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Feature", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *RemoveFeatureFlagAccounts) Do(c *canvasapi.Canvas) (*models.FeatureFlag, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *RemoveFeatureFlagAccounts) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.FeatureFlag, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -81,6 +86,10 @@ func (t *RemoveFeatureFlagAccounts) Do(c *canvasapi.Canvas) (*models.FeatureFlag
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
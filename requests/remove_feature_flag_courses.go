@@ -1,6 +1,7 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -23,8 +24,8 @@ import (
 //
 type RemoveFeatureFlagCourses struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		Feature  string `json:"feature" url:"feature,omitempty"`     //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		Feature  string       `json:"feature" url:"feature,omitempty"`     //  (Required)
 	} `json:"path"`
 }
 
@@ -34,7 +35,7 @@ func (t *RemoveFeatureFlagCourses) GetMethod() string {
 
 func (t *RemoveFeatureFlagCourses) GetURLPath() string {
 	path := "courses/{course_id}/features/flags/{feature}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	path = strings.ReplaceAll(path, "{feature}", fmt.Sprintf("%v", t.Path.Feature))
 	return path
 }
@@ -52,21 +53,25 @@ func (t *RemoveFeatureFlagCourses) GetJSON() ([]byte, error) {
 }
 
 func (t *RemoveFeatureFlagCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.Feature == "" {
-		errs = append(errs, "'Path.Feature' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Feature", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *RemoveFeatureFlagCourses) Do(c *canvasapi.Canvas) (*models.FeatureFlag, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *RemoveFeatureFlagCourses) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.FeatureFlag, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -81,6 +86,10 @@ func (t *RemoveFeatureFlagCourses) Do(c *canvasapi.Canvas) (*models.FeatureFlag,
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,6 +1,7 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -23,8 +24,8 @@ import (
 //
 type RemoveFeatureFlagUsers struct {
 	Path struct {
-		UserID  string `json:"user_id" url:"user_id,omitempty"` //  (Required)
-		Feature string `json:"feature" url:"feature,omitempty"` //  (Required)
+		UserID  canvasapi.ID `json:"user_id" url:"user_id,omitempty"` //  (Required)
+		Feature string       `json:"feature" url:"feature,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -34,7 +35,7 @@ func (t *RemoveFeatureFlagUsers) GetMethod() string {
 
 func (t *RemoveFeatureFlagUsers) GetURLPath() string {
 	path := "users/{user_id}/features/flags/{feature}"
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", t.Path.UserID))
+	path = strings.ReplaceAll(path, "{user_id}", t.Path.UserID.Escaped())
 	path = strings.ReplaceAll(path, "{feature}", fmt.Sprintf("%v", t.Path.Feature))
 	return path
 }
@@ -52,21 +53,25 @@ func (t *RemoveFeatureFlagUsers) GetJSON() ([]byte, error) {
 }
 
 func (t *RemoveFeatureFlagUsers) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.UserID == "" {
-		errs = append(errs, "'Path.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.Feature == "" {
-		errs = append(errs, "'Path.Feature' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Feature", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *RemoveFeatureFlagUsers) Do(c *canvasapi.Canvas) (*models.FeatureFlag, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *RemoveFeatureFlagUsers) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.FeatureFlag, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -81,6 +86,10 @@ func (t *RemoveFeatureFlagUsers) Do(c *canvasapi.Canvas) (*models.FeatureFlag, e
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -25,12 +23,12 @@ import (
 //
 type RemoveObservee struct {
 	Path struct {
-		UserID     string `json:"user_id" url:"user_id,omitempty"`         //  (Required)
-		ObserveeID string `json:"observee_id" url:"observee_id,omitempty"` //  (Required)
+		UserID     canvasapi.ID `json:"user_id" url:"user_id,omitempty"`         //  (Required)
+		ObserveeID canvasapi.ID `json:"observee_id" url:"observee_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		RootAccountID int64 `json:"root_account_id" url:"root_account_id,omitempty"` //  (Optional)
+		RootAccountID canvasapi.Opt[int64] `json:"root_account_id" url:"root_account_id,omitempty"` //  (Optional)
 	} `json:"query"`
 }
 
@@ -40,13 +38,13 @@ func (t *RemoveObservee) GetMethod() string {
 
 func (t *RemoveObservee) GetURLPath() string {
 	path := "users/{user_id}/observees/{observee_id}"
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", t.Path.UserID))
-	path = strings.ReplaceAll(path, "{observee_id}", fmt.Sprintf("%v", t.Path.ObserveeID))
+	path = strings.ReplaceAll(path, "{user_id}", t.Path.UserID.Escaped())
+	path = strings.ReplaceAll(path, "{observee_id}", t.Path.ObserveeID.Escaped())
 	return path
 }
 
 func (t *RemoveObservee) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -62,21 +60,25 @@ func (t *RemoveObservee) GetJSON() ([]byte, error) {
 }
 
 func (t *RemoveObservee) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.UserID == "" {
-		errs = append(errs, "'Path.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ObserveeID == "" {
-		errs = append(errs, "'Path.ObserveeID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ObserveeID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *RemoveObservee) Do(c *canvasapi.Canvas) (*models.User, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *RemoveObservee) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.User, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -91,6 +93,10 @@ func (t *RemoveObservee) Do(c *canvasapi.Canvas) (*models.User, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
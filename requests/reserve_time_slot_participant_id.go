@@ -1,14 +1,14 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // ReserveTimeSlotParticipantID Reserves a particular time slot and return the new reservation
@@ -26,13 +26,13 @@ import (
 //
 type ReserveTimeSlotParticipantID struct {
 	Path struct {
-		ID            string `json:"id" url:"id,omitempty"`                         //  (Required)
-		ParticipantID string `json:"participant_id" url:"participant_id,omitempty"` //  (Required)
+		ID            canvasapi.ID `json:"id" url:"id,omitempty"`                         //  (Required)
+		ParticipantID canvasapi.ID `json:"participant_id" url:"participant_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		Comments       string `json:"comments" url:"comments,omitempty"`               //  (Optional)
-		CancelExisting bool   `json:"cancel_existing" url:"cancel_existing,omitempty"` //  (Optional)
+		Comments       string              `json:"comments" url:"comments,omitempty"`               //  (Optional)
+		CancelExisting canvasapi.Opt[bool] `json:"cancel_existing" url:"cancel_existing,omitempty"` //  (Optional)
 	} `json:"form"`
 }
 
@@ -42,8 +42,8 @@ func (t *ReserveTimeSlotParticipantID) GetMethod() string {
 
 func (t *ReserveTimeSlotParticipantID) GetURLPath() string {
 	path := "calendar_events/{id}/reservations/{participant_id}"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
-	path = strings.ReplaceAll(path, "{participant_id}", fmt.Sprintf("%v", t.Path.ParticipantID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
+	path = strings.ReplaceAll(path, "{participant_id}", t.Path.ParticipantID.Escaped())
 	return path
 }
 
@@ -52,36 +52,55 @@ func (t *ReserveTimeSlotParticipantID) GetQuery() (string, error) {
 }
 
 func (t *ReserveTimeSlotParticipantID) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *ReserveTimeSlotParticipantID) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *ReserveTimeSlotParticipantID) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ParticipantID == "" {
-		errs = append(errs, "'Path.ParticipantID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ParticipantID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *ReserveTimeSlotParticipantID) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *ReserveTimeSlotParticipantID) Do(c *canvasapi.Canvas) (*models.CalendarEvent, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ReserveTimeSlotParticipantID) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.CalendarEvent, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.CalendarEvent{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,6 +1,9 @@
 package requests
 
 import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 
 	"github.com/atomicjolt/canvasapi"
@@ -18,7 +21,7 @@ func (t *ResetGroupFavorites) GetMethod() string {
 }
 
 func (t *ResetGroupFavorites) GetURLPath() string {
-	return ""
+	return "users/self/favorites/groups"
 }
 
 func (t *ResetGroupFavorites) GetQuery() (string, error) {
@@ -37,11 +40,21 @@ func (t *ResetGroupFavorites) HasErrors() error {
 	return nil
 }
 
-func (t *ResetGroupFavorites) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *ResetGroupFavorites) Do(c *canvasapi.Canvas) (json.RawMessage, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ResetGroupFavorites) DoContext(ctx context.Context, c *canvasapi.Canvas) (json.RawMessage, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(body), nil
 }
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -37,14 +35,14 @@ import (
 //
 type RestoreWorkflowStatesOfSISImportedItems struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
-		ID        string `json:"id" url:"id,omitempty"`                 //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		ID        canvasapi.ID `json:"id" url:"id,omitempty"`                 //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		BatchMode      bool `json:"batch_mode" url:"batch_mode,omitempty"`           //  (Optional)
-		UndeleteOnly   bool `json:"undelete_only" url:"undelete_only,omitempty"`     //  (Optional)
-		UnconcludeOnly bool `json:"unconclude_only" url:"unconclude_only,omitempty"` //  (Optional)
+		BatchMode      canvasapi.Opt[bool] `json:"batch_mode" url:"batch_mode,omitempty"`           //  (Optional)
+		UndeleteOnly   canvasapi.Opt[bool] `json:"undelete_only" url:"undelete_only,omitempty"`     //  (Optional)
+		UnconcludeOnly canvasapi.Opt[bool] `json:"unconclude_only" url:"unconclude_only,omitempty"` //  (Optional)
 	} `json:"form"`
 }
 
@@ -54,8 +52,8 @@ func (t *RestoreWorkflowStatesOfSISImportedItems) GetMethod() string {
 
 func (t *RestoreWorkflowStatesOfSISImportedItems) GetURLPath() string {
 	path := "accounts/{account_id}/sis_imports/{id}/restore_states"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -64,33 +62,37 @@ func (t *RestoreWorkflowStatesOfSISImportedItems) GetQuery() (string, error) {
 }
 
 func (t *RestoreWorkflowStatesOfSISImportedItems) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *RestoreWorkflowStatesOfSISImportedItems) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *RestoreWorkflowStatesOfSISImportedItems) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *RestoreWorkflowStatesOfSISImportedItems) Do(c *canvasapi.Canvas) (*models.Progress, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *RestoreWorkflowStatesOfSISImportedItems) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Progress, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -105,6 +107,10 @@ func (t *RestoreWorkflowStatesOfSISImportedItems) Do(c *canvasapi.Canvas) (*mode
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
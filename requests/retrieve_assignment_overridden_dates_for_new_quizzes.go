@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -26,11 +24,11 @@ import (
 //
 type RetrieveAssignmentOverriddenDatesForNewQuizzes struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		QuizAssignmentOverrides map[string]RetrieveAssignmentOverriddenDatesForNewQuizzesQuizAssignmentOverrides
+		QuizAssignmentOverrides map[string]RetrieveAssignmentOverriddenDatesForNewQuizzesQuizAssignmentOverrides `json:"quiz_assignment_overrides" url:"quiz_assignment_overrides,omitempty"` //  (Optional)
 	} `json:"query"`
 }
 
@@ -40,12 +38,12 @@ func (t *RetrieveAssignmentOverriddenDatesForNewQuizzes) GetMethod() string {
 
 func (t *RetrieveAssignmentOverriddenDatesForNewQuizzes) GetURLPath() string {
 	path := "courses/{course_id}/new_quizzes/assignment_overrides"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
 func (t *RetrieveAssignmentOverriddenDatesForNewQuizzes) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -61,18 +59,22 @@ func (t *RetrieveAssignmentOverriddenDatesForNewQuizzes) GetJSON() ([]byte, erro
 }
 
 func (t *RetrieveAssignmentOverriddenDatesForNewQuizzes) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *RetrieveAssignmentOverriddenDatesForNewQuizzes) Do(c *canvasapi.Canvas) (*models.QuizAssignmentOverrideSetContainer, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *RetrieveAssignmentOverriddenDatesForNewQuizzes) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.QuizAssignmentOverrideSetContainer, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -87,6 +89,10 @@ func (t *RetrieveAssignmentOverriddenDatesForNewQuizzes) Do(c *canvasapi.Canvas)
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
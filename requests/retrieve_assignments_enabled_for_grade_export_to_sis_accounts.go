@@ -1,14 +1,16 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
 	"net/url"
 	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -49,14 +51,14 @@ import (
 //
 type RetrieveAssignmentsEnabledForGradeExportToSISAccounts struct {
 	Path struct {
-		AccountID int64 `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		CourseID     int64     `json:"course_id" url:"course_id,omitempty"`         //  (Optional)
-		StartsBefore time.Time `json:"starts_before" url:"starts_before,omitempty"` //  (Optional)
-		EndsAfter    time.Time `json:"ends_after" url:"ends_after,omitempty"`       //  (Optional)
-		Include      string    `json:"include" url:"include,omitempty"`             //  (Optional) . Must be one of student_overrides
+		CourseID     canvasapi.Opt[int64] `json:"course_id" url:"course_id,omitempty"`         //  (Optional)
+		StartsBefore time.Time            `json:"starts_before" url:"starts_before,omitempty"` //  (Optional)
+		EndsAfter    time.Time            `json:"ends_after" url:"ends_after,omitempty"`       //  (Optional)
+		Include      string               `json:"include" url:"include,omitempty"`             //  (Optional) . Must be one of student_overrides
 	} `json:"query"`
 }
 
@@ -66,12 +68,12 @@ func (t *RetrieveAssignmentsEnabledForGradeExportToSISAccounts) GetMethod() stri
 
 func (t *RetrieveAssignmentsEnabledForGradeExportToSISAccounts) GetURLPath() string {
 	path := "/sis/accounts/{account_id}/assignments"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	return path
 }
 
 func (t *RetrieveAssignmentsEnabledForGradeExportToSISAccounts) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -87,21 +89,55 @@ func (t *RetrieveAssignmentsEnabledForGradeExportToSISAccounts) GetJSON() ([]byt
 }
 
 func (t *RetrieveAssignmentsEnabledForGradeExportToSISAccounts) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
+	if t.Path.AccountID == "" {
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
+	}
 	if t.Query.Include != "" && !string_utils.Include([]string{"student_overrides"}, t.Query.Include) {
-		errs = append(errs, "Include must be one of student_overrides")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.Include", Rule: canvasapi.RuleOneOf, Allowed: []string{"student_overrides"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *RetrieveAssignmentsEnabledForGradeExportToSISAccounts) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *RetrieveAssignmentsEnabledForGradeExportToSISAccounts) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.SISAssignment, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *RetrieveAssignmentsEnabledForGradeExportToSISAccounts) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.SISAssignment, *canvasapi.PagedResource, error) {
+	var err error
+	var response *http.Response
+	if next != nil {
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
+	} else {
+		response, err = c.SendRequestContext(ctx, t)
+	}
+
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	ret := []*models.SISAssignment{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ret, pagedResource, nil
 }
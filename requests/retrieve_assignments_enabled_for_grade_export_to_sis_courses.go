@@ -1,14 +1,16 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
 	"net/url"
 	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -49,14 +51,14 @@ import (
 //
 type RetrieveAssignmentsEnabledForGradeExportToSISCourses struct {
 	Path struct {
-		CourseID int64 `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		AccountID    int64     `json:"account_id" url:"account_id,omitempty"`       //  (Optional)
-		StartsBefore time.Time `json:"starts_before" url:"starts_before,omitempty"` //  (Optional)
-		EndsAfter    time.Time `json:"ends_after" url:"ends_after,omitempty"`       //  (Optional)
-		Include      string    `json:"include" url:"include,omitempty"`             //  (Optional) . Must be one of student_overrides
+		AccountID    canvasapi.Opt[int64] `json:"account_id" url:"account_id,omitempty"`       //  (Optional)
+		StartsBefore time.Time            `json:"starts_before" url:"starts_before,omitempty"` //  (Optional)
+		EndsAfter    time.Time            `json:"ends_after" url:"ends_after,omitempty"`       //  (Optional)
+		Include      string               `json:"include" url:"include,omitempty"`             //  (Optional) . Must be one of student_overrides
 	} `json:"query"`
 }
 
@@ -66,12 +68,12 @@ func (t *RetrieveAssignmentsEnabledForGradeExportToSISCourses) GetMethod() strin
 
 func (t *RetrieveAssignmentsEnabledForGradeExportToSISCourses) GetURLPath() string {
 	path := "/sis/courses/{course_id}/assignments"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
 func (t *RetrieveAssignmentsEnabledForGradeExportToSISCourses) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -87,21 +89,55 @@ func (t *RetrieveAssignmentsEnabledForGradeExportToSISCourses) GetJSON() ([]byte
 }
 
 func (t *RetrieveAssignmentsEnabledForGradeExportToSISCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
+	if t.Path.CourseID == "" {
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
+	}
 	if t.Query.Include != "" && !string_utils.Include([]string{"student_overrides"}, t.Query.Include) {
-		errs = append(errs, "Include must be one of student_overrides")
+		errs = append(errs, canvasapi.FieldError{Field: "Query.Include", Rule: canvasapi.RuleOneOf, Allowed: []string{"student_overrides"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *RetrieveAssignmentsEnabledForGradeExportToSISCourses) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *RetrieveAssignmentsEnabledForGradeExportToSISCourses) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.SISAssignment, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *RetrieveAssignmentsEnabledForGradeExportToSISCourses) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.SISAssignment, *canvasapi.PagedResource, error) {
+	var err error
+	var response *http.Response
+	if next != nil {
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
+	} else {
+		response, err = c.SendRequestContext(ctx, t)
+	}
+
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	ret := []*models.SISAssignment{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ret, pagedResource, nil
 }
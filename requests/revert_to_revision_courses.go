@@ -1,6 +1,7 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -23,9 +24,9 @@ import (
 //
 type RevertToRevisionCourses struct {
 	Path struct {
-		CourseID   string `json:"course_id" url:"course_id,omitempty"`     //  (Required)
-		Url        string `json:"url" url:"url,omitempty"`                 //  (Required)
-		RevisionID int64  `json:"revision_id" url:"revision_id,omitempty"` //  (Required)
+		CourseID   canvasapi.ID `json:"course_id" url:"course_id,omitempty"`     //  (Required)
+		Url        string       `json:"url" url:"url,omitempty"`                 //  (Required)
+		RevisionID canvasapi.ID `json:"revision_id" url:"revision_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -35,9 +36,9 @@ func (t *RevertToRevisionCourses) GetMethod() string {
 
 func (t *RevertToRevisionCourses) GetURLPath() string {
 	path := "courses/{course_id}/pages/{url}/revisions/{revision_id}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	path = strings.ReplaceAll(path, "{url}", fmt.Sprintf("%v", t.Path.Url))
-	path = strings.ReplaceAll(path, "{revision_id}", fmt.Sprintf("%v", t.Path.RevisionID))
+	path = strings.ReplaceAll(path, "{revision_id}", t.Path.RevisionID.Escaped())
 	return path
 }
 
@@ -54,21 +55,28 @@ func (t *RevertToRevisionCourses) GetJSON() ([]byte, error) {
 }
 
 func (t *RevertToRevisionCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
+	if t.Path.RevisionID == "" {
+		errs = append(errs, canvasapi.FieldError{Field: "Path.RevisionID", Rule: canvasapi.RuleRequired})
+	}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.Url == "" {
-		errs = append(errs, "'Path.Url' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Url", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *RevertToRevisionCourses) Do(c *canvasapi.Canvas) (*models.PageRevision, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *RevertToRevisionCourses) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.PageRevision, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -83,6 +91,10 @@ func (t *RevertToRevisionCourses) Do(c *canvasapi.Canvas) (*models.PageRevision,
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
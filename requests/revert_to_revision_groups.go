@@ -1,6 +1,7 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -23,9 +24,9 @@ import (
 //
 type RevertToRevisionGroups struct {
 	Path struct {
-		GroupID    string `json:"group_id" url:"group_id,omitempty"`       //  (Required)
-		Url        string `json:"url" url:"url,omitempty"`                 //  (Required)
-		RevisionID int64  `json:"revision_id" url:"revision_id,omitempty"` //  (Required)
+		GroupID    canvasapi.ID `json:"group_id" url:"group_id,omitempty"`       //  (Required)
+		Url        string       `json:"url" url:"url,omitempty"`                 //  (Required)
+		RevisionID canvasapi.ID `json:"revision_id" url:"revision_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -35,9 +36,9 @@ func (t *RevertToRevisionGroups) GetMethod() string {
 
 func (t *RevertToRevisionGroups) GetURLPath() string {
 	path := "groups/{group_id}/pages/{url}/revisions/{revision_id}"
-	path = strings.ReplaceAll(path, "{group_id}", fmt.Sprintf("%v", t.Path.GroupID))
+	path = strings.ReplaceAll(path, "{group_id}", t.Path.GroupID.Escaped())
 	path = strings.ReplaceAll(path, "{url}", fmt.Sprintf("%v", t.Path.Url))
-	path = strings.ReplaceAll(path, "{revision_id}", fmt.Sprintf("%v", t.Path.RevisionID))
+	path = strings.ReplaceAll(path, "{revision_id}", t.Path.RevisionID.Escaped())
 	return path
 }
 
@@ -54,21 +55,28 @@ func (t *RevertToRevisionGroups) GetJSON() ([]byte, error) {
 }
 
 func (t *RevertToRevisionGroups) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
+	if t.Path.RevisionID == "" {
+		errs = append(errs, canvasapi.FieldError{Field: "Path.RevisionID", Rule: canvasapi.RuleRequired})
+	}
 	if t.Path.GroupID == "" {
-		errs = append(errs, "'Path.GroupID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.GroupID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.Url == "" {
-		errs = append(errs, "'Path.Url' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Url", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *RevertToRevisionGroups) Do(c *canvasapi.Canvas) (*models.PageRevision, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *RevertToRevisionGroups) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.PageRevision, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -83,6 +91,10 @@ func (t *RevertToRevisionGroups) Do(c *canvasapi.Canvas) (*models.PageRevision,
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
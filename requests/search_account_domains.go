@@ -1,10 +1,11 @@
 package requests
 
 import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 )
 
@@ -21,10 +22,10 @@ import (
 //
 type SearchAccountDomains struct {
 	Query struct {
-		Name      string  `json:"name" url:"name,omitempty"`           //  (Optional)
-		Domain    string  `json:"domain" url:"domain,omitempty"`       //  (Optional)
-		Latitude  float64 `json:"latitude" url:"latitude,omitempty"`   //  (Optional)
-		Longitude float64 `json:"longitude" url:"longitude,omitempty"` //  (Optional)
+		Name      string                 `json:"name" url:"name,omitempty"`           //  (Optional)
+		Domain    string                 `json:"domain" url:"domain,omitempty"`       //  (Optional)
+		Latitude  canvasapi.Opt[float64] `json:"latitude" url:"latitude,omitempty"`   //  (Optional)
+		Longitude canvasapi.Opt[float64] `json:"longitude" url:"longitude,omitempty"` //  (Optional)
 	} `json:"query"`
 }
 
@@ -33,11 +34,11 @@ func (t *SearchAccountDomains) GetMethod() string {
 }
 
 func (t *SearchAccountDomains) GetURLPath() string {
-	return ""
+	return "accounts/search"
 }
 
 func (t *SearchAccountDomains) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -56,11 +57,21 @@ func (t *SearchAccountDomains) HasErrors() error {
 	return nil
 }
 
-func (t *SearchAccountDomains) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *SearchAccountDomains) Do(c *canvasapi.Canvas) (json.RawMessage, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *SearchAccountDomains) DoContext(ctx context.Context, c *canvasapi.Canvas) (json.RawMessage, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(body), nil
 }
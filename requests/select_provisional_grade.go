@@ -1,7 +1,9 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
@@ -19,9 +21,9 @@ import (
 //
 type SelectProvisionalGrade struct {
 	Path struct {
-		CourseID           string `json:"course_id" url:"course_id,omitempty"`                       //  (Required)
-		AssignmentID       string `json:"assignment_id" url:"assignment_id,omitempty"`               //  (Required)
-		ProvisionalGradeID string `json:"provisional_grade_id" url:"provisional_grade_id,omitempty"` //  (Required)
+		CourseID           canvasapi.ID `json:"course_id" url:"course_id,omitempty"`                       //  (Required)
+		AssignmentID       canvasapi.ID `json:"assignment_id" url:"assignment_id,omitempty"`               //  (Required)
+		ProvisionalGradeID canvasapi.ID `json:"provisional_grade_id" url:"provisional_grade_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -31,9 +33,9 @@ func (t *SelectProvisionalGrade) GetMethod() string {
 
 func (t *SelectProvisionalGrade) GetURLPath() string {
 	path := "courses/{course_id}/assignments/{assignment_id}/provisional_grades/{provisional_grade_id}/select"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{assignment_id}", fmt.Sprintf("%v", t.Path.AssignmentID))
-	path = strings.ReplaceAll(path, "{provisional_grade_id}", fmt.Sprintf("%v", t.Path.ProvisionalGradeID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{assignment_id}", t.Path.AssignmentID.Escaped())
+	path = strings.ReplaceAll(path, "{provisional_grade_id}", t.Path.ProvisionalGradeID.Escaped())
 	return path
 }
 
@@ -50,27 +52,37 @@ func (t *SelectProvisionalGrade) GetJSON() ([]byte, error) {
 }
 
 func (t *SelectProvisionalGrade) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.AssignmentID == "" {
-		errs = append(errs, "'Path.AssignmentID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AssignmentID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ProvisionalGradeID == "" {
-		errs = append(errs, "'Path.ProvisionalGradeID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ProvisionalGradeID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *SelectProvisionalGrade) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *SelectProvisionalGrade) Do(c *canvasapi.Canvas) (json.RawMessage, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *SelectProvisionalGrade) DoContext(ctx context.Context, c *canvasapi.Canvas) (json.RawMessage, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(body), nil
 }
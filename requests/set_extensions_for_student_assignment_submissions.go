@@ -1,14 +1,14 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // SetExtensionsForStudentAssignmentSubmissions <b>Responses</b>
@@ -29,8 +29,8 @@ import (
 //
 type SetExtensionsForStudentAssignmentSubmissions struct {
 	Path struct {
-		CourseID     string `json:"course_id" url:"course_id,omitempty"`         //  (Required)
-		AssignmentID string `json:"assignment_id" url:"assignment_id,omitempty"` //  (Required)
+		CourseID     canvasapi.ID `json:"course_id" url:"course_id,omitempty"`         //  (Required)
+		AssignmentID canvasapi.ID `json:"assignment_id" url:"assignment_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -47,8 +47,8 @@ func (t *SetExtensionsForStudentAssignmentSubmissions) GetMethod() string {
 
 func (t *SetExtensionsForStudentAssignmentSubmissions) GetURLPath() string {
 	path := "courses/{course_id}/assignments/{assignment_id}/extensions"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{assignment_id}", fmt.Sprintf("%v", t.Path.AssignmentID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{assignment_id}", t.Path.AssignmentID.Escaped())
 	return path
 }
 
@@ -57,42 +57,61 @@ func (t *SetExtensionsForStudentAssignmentSubmissions) GetQuery() (string, error
 }
 
 func (t *SetExtensionsForStudentAssignmentSubmissions) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *SetExtensionsForStudentAssignmentSubmissions) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *SetExtensionsForStudentAssignmentSubmissions) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.AssignmentID == "" {
-		errs = append(errs, "'Path.AssignmentID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AssignmentID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.AssignmentExtensions.UserID == nil {
-		errs = append(errs, "'Form.AssignmentExtensions.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.AssignmentExtensions.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.AssignmentExtensions.ExtraAttempts == nil {
-		errs = append(errs, "'Form.AssignmentExtensions.ExtraAttempts' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.AssignmentExtensions.ExtraAttempts", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *SetExtensionsForStudentAssignmentSubmissions) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *SetExtensionsForStudentAssignmentSubmissions) Do(c *canvasapi.Canvas) (*models.AssignmentExtensionsResponse, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *SetExtensionsForStudentAssignmentSubmissions) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.AssignmentExtensionsResponse, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.AssignmentExtensionsResponse{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
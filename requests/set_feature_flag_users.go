@@ -1,14 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -30,12 +29,12 @@ import (
 //
 type SetFeatureFlagUsers struct {
 	Path struct {
-		UserID  string `json:"user_id" url:"user_id,omitempty"` //  (Required)
-		Feature string `json:"feature" url:"feature,omitempty"` //  (Required)
+		UserID  canvasapi.ID `json:"user_id" url:"user_id,omitempty"` //  (Required)
+		Feature string       `json:"feature" url:"feature,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		State string `json:"state" url:"state,omitempty"` //  (Optional) . Must be one of off, allowed, on
+		State canvasapi.Opt[string] `json:"state" url:"state,omitempty"` //  (Optional) . Must be one of off, allowed, on
 	} `json:"form"`
 }
 
@@ -45,7 +44,7 @@ func (t *SetFeatureFlagUsers) GetMethod() string {
 
 func (t *SetFeatureFlagUsers) GetURLPath() string {
 	path := "users/{user_id}/features/flags/{feature}"
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", t.Path.UserID))
+	path = strings.ReplaceAll(path, "{user_id}", t.Path.UserID.Escaped())
 	path = strings.ReplaceAll(path, "{feature}", fmt.Sprintf("%v", t.Path.Feature))
 	return path
 }
@@ -55,36 +54,40 @@ func (t *SetFeatureFlagUsers) GetQuery() (string, error) {
 }
 
 func (t *SetFeatureFlagUsers) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *SetFeatureFlagUsers) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *SetFeatureFlagUsers) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.UserID == "" {
-		errs = append(errs, "'Path.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.Feature == "" {
-		errs = append(errs, "'Path.Feature' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Feature", Rule: canvasapi.RuleRequired})
 	}
-	if t.Form.State != "" && !string_utils.Include([]string{"off", "allowed", "on"}, t.Form.State) {
-		errs = append(errs, "State must be one of off, allowed, on")
+	if t.Form.State.Value() != "" && !string_utils.Include([]string{"off", "allowed", "on"}, t.Form.State.Value()) {
+		errs = append(errs, canvasapi.FieldError{Field: "Form.State", Rule: canvasapi.RuleOneOf, Allowed: []string{"off", "allowed", "on"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *SetFeatureFlagUsers) Do(c *canvasapi.Canvas) (*models.FeatureFlag, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *SetFeatureFlagUsers) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.FeatureFlag, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -99,6 +102,10 @@ func (t *SetFeatureFlagUsers) Do(c *canvasapi.Canvas) (*models.FeatureFlag, erro
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
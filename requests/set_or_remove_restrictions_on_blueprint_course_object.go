@@ -1,13 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -29,14 +28,14 @@ import (
 //
 type SetOrRemoveRestrictionsOnBlueprintCourseObject struct {
 	Path struct {
-		CourseID   string `json:"course_id" url:"course_id,omitempty"`     //  (Required)
-		TemplateID string `json:"template_id" url:"template_id,omitempty"` //  (Required)
+		CourseID   canvasapi.ID `json:"course_id" url:"course_id,omitempty"`     //  (Required)
+		TemplateID canvasapi.ID `json:"template_id" url:"template_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		ContentType  string                       `json:"content_type" url:"content_type,omitempty"` //  (Optional) . Must be one of assignment, attachment, discussion_topic, external_tool, quiz, wiki_page
-		ContentID    int64                        `json:"content_id" url:"content_id,omitempty"`     //  (Optional)
-		Restricted   bool                         `json:"restricted" url:"restricted,omitempty"`     //  (Optional)
+		ContentType  canvasapi.Opt[string]        `json:"content_type" url:"content_type,omitempty"` //  (Optional) . Must be one of assignment, attachment, discussion_topic, external_tool, quiz, wiki_page
+		ContentID    canvasapi.Opt[int64]         `json:"content_id" url:"content_id,omitempty"`     //  (Optional)
+		Restricted   canvasapi.Opt[bool]          `json:"restricted" url:"restricted,omitempty"`     //  (Optional)
 		Restrictions *models.BlueprintRestriction `json:"restrictions" url:"restrictions,omitempty"` //  (Optional)
 	} `json:"form"`
 }
@@ -47,8 +46,8 @@ func (t *SetOrRemoveRestrictionsOnBlueprintCourseObject) GetMethod() string {
 
 func (t *SetOrRemoveRestrictionsOnBlueprintCourseObject) GetURLPath() string {
 	path := "courses/{course_id}/blueprint_templates/{template_id}/restrict_item"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{template_id}", fmt.Sprintf("%v", t.Path.TemplateID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{template_id}", t.Path.TemplateID.Escaped())
 	return path
 }
 
@@ -57,39 +56,54 @@ func (t *SetOrRemoveRestrictionsOnBlueprintCourseObject) GetQuery() (string, err
 }
 
 func (t *SetOrRemoveRestrictionsOnBlueprintCourseObject) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *SetOrRemoveRestrictionsOnBlueprintCourseObject) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *SetOrRemoveRestrictionsOnBlueprintCourseObject) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.TemplateID == "" {
-		errs = append(errs, "'Path.TemplateID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.TemplateID", Rule: canvasapi.RuleRequired})
 	}
-	if t.Form.ContentType != "" && !string_utils.Include([]string{"assignment", "attachment", "discussion_topic", "external_tool", "quiz", "wiki_page"}, t.Form.ContentType) {
-		errs = append(errs, "ContentType must be one of assignment, attachment, discussion_topic, external_tool, quiz, wiki_page")
+	if t.Form.ContentType.Value() != "" && !string_utils.Include([]string{"assignment", "attachment", "discussion_topic", "external_tool", "quiz", "wiki_page"}, t.Form.ContentType.Value()) {
+		errs = append(errs, canvasapi.FieldError{Field: "Form.ContentType", Rule: canvasapi.RuleOneOf, Allowed: []string{"assignment", "attachment", "discussion_topic", "external_tool", "quiz", "wiki_page"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *SetOrRemoveRestrictionsOnBlueprintCourseObject) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *SetOrRemoveRestrictionsOnBlueprintCourseObject) Do(c *canvasapi.Canvas) (*canvasapi.SuccessResponse, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *SetOrRemoveRestrictionsOnBlueprintCourseObject) DoContext(ctx context.Context, c *canvasapi.Canvas) (*canvasapi.SuccessResponse, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := canvasapi.SuccessResponse{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
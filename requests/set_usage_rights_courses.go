@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -31,17 +29,17 @@ import (
 //
 type SetUsageRightsCourses struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		FileIDs     []string `json:"file_ids" url:"file_ids,omitempty"`     //  (Required)
-		FolderIDs   []string `json:"folder_ids" url:"folder_ids,omitempty"` //  (Optional)
-		Publish     bool     `json:"publish" url:"publish,omitempty"`       //  (Optional)
+		FileIDs     []string            `json:"file_ids" url:"file_ids,omitempty"`     //  (Required)
+		FolderIDs   []string            `json:"folder_ids" url:"folder_ids,omitempty"` //  (Optional)
+		Publish     canvasapi.Opt[bool] `json:"publish" url:"publish,omitempty"`       //  (Optional)
 		UsageRights struct {
-			UseJustification string `json:"use_justification" url:"use_justification,omitempty"` //  (Required) . Must be one of own_copyright, used_by_permission, fair_use, public_domain, creative_commons
-			LegalCopyright   string `json:"legal_copyright" url:"legal_copyright,omitempty"`     //  (Optional)
-			License          string `json:"license" url:"license,omitempty"`                     //  (Optional)
+			UseJustification string                `json:"use_justification" url:"use_justification,omitempty"` //  (Required) . Must be one of own_copyright, used_by_permission, fair_use, public_domain, creative_commons
+			LegalCopyright   canvasapi.Opt[string] `json:"legal_copyright" url:"legal_copyright,omitempty"`     //  (Optional)
+			License          canvasapi.Opt[string] `json:"license" url:"license,omitempty"`                     //  (Optional)
 		} `json:"usage_rights" url:"usage_rights,omitempty"`
 	} `json:"form"`
 }
@@ -52,7 +50,7 @@ func (t *SetUsageRightsCourses) GetMethod() string {
 
 func (t *SetUsageRightsCourses) GetURLPath() string {
 	path := "courses/{course_id}/usage_rights"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
@@ -61,39 +59,43 @@ func (t *SetUsageRightsCourses) GetQuery() (string, error) {
 }
 
 func (t *SetUsageRightsCourses) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *SetUsageRightsCourses) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *SetUsageRightsCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.FileIDs == nil {
-		errs = append(errs, "'Form.FileIDs' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.FileIDs", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.UsageRights.UseJustification == "" {
-		errs = append(errs, "'Form.UsageRights.UseJustification' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.UsageRights.UseJustification", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.UsageRights.UseJustification != "" && !string_utils.Include([]string{"own_copyright", "used_by_permission", "fair_use", "public_domain", "creative_commons"}, t.Form.UsageRights.UseJustification) {
-		errs = append(errs, "UsageRights must be one of own_copyright, used_by_permission, fair_use, public_domain, creative_commons")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.UsageRights.UseJustification", Rule: canvasapi.RuleOneOf, Allowed: []string{"own_copyright", "used_by_permission", "fair_use", "public_domain", "creative_commons"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *SetUsageRightsCourses) Do(c *canvasapi.Canvas) (*models.UsageRights, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *SetUsageRightsCourses) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.UsageRights, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -108,6 +110,10 @@ func (t *SetUsageRightsCourses) Do(c *canvasapi.Canvas) (*models.UsageRights, er
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
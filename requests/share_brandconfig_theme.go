@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -26,7 +24,7 @@ import (
 //
 type ShareBrandconfigTheme struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -43,7 +41,7 @@ func (t *ShareBrandconfigTheme) GetMethod() string {
 
 func (t *ShareBrandconfigTheme) GetURLPath() string {
 	path := "accounts/{account_id}/shared_brand_configs"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	return path
 }
 
@@ -52,36 +50,40 @@ func (t *ShareBrandconfigTheme) GetQuery() (string, error) {
 }
 
 func (t *ShareBrandconfigTheme) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *ShareBrandconfigTheme) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *ShareBrandconfigTheme) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.SharedBrandConfig.Name == "" {
-		errs = append(errs, "'Form.SharedBrandConfig.Name' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.SharedBrandConfig.Name", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.SharedBrandConfig.BrandConfigMd5 == "" {
-		errs = append(errs, "'Form.SharedBrandConfig.BrandConfigMd5' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.SharedBrandConfig.BrandConfigMd5", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ShareBrandconfigTheme) Do(c *canvasapi.Canvas) (*models.SharedBrandConfig, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ShareBrandconfigTheme) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.SharedBrandConfig, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -96,6 +98,10 @@ func (t *ShareBrandconfigTheme) Do(c *canvasapi.Canvas) (*models.SharedBrandConf
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
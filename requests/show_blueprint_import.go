@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -22,9 +22,9 @@ import (
 //
 type ShowBlueprintImport struct {
 	Path struct {
-		CourseID       string `json:"course_id" url:"course_id,omitempty"`             //  (Required)
-		SubscriptionID string `json:"subscription_id" url:"subscription_id,omitempty"` //  (Required)
-		ID             string `json:"id" url:"id,omitempty"`                           //  (Required)
+		CourseID       canvasapi.ID `json:"course_id" url:"course_id,omitempty"`             //  (Required)
+		SubscriptionID canvasapi.ID `json:"subscription_id" url:"subscription_id,omitempty"` //  (Required)
+		ID             canvasapi.ID `json:"id" url:"id,omitempty"`                           //  (Required)
 	} `json:"path"`
 }
 
@@ -34,9 +34,9 @@ func (t *ShowBlueprintImport) GetMethod() string {
 
 func (t *ShowBlueprintImport) GetURLPath() string {
 	path := "courses/{course_id}/blueprint_subscriptions/{subscription_id}/migrations/{id}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{subscription_id}", fmt.Sprintf("%v", t.Path.SubscriptionID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{subscription_id}", t.Path.SubscriptionID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -53,24 +53,28 @@ func (t *ShowBlueprintImport) GetJSON() ([]byte, error) {
 }
 
 func (t *ShowBlueprintImport) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.SubscriptionID == "" {
-		errs = append(errs, "'Path.SubscriptionID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.SubscriptionID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ShowBlueprintImport) Do(c *canvasapi.Canvas) (*models.BlueprintMigration, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ShowBlueprintImport) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.BlueprintMigration, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -85,6 +89,10 @@ func (t *ShowBlueprintImport) Do(c *canvasapi.Canvas) (*models.BlueprintMigratio
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,9 +1,10 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
+	"net/http"
 	"net/url"
 	"strings"
 
@@ -24,8 +25,8 @@ import (
 //
 type ShowCollectionOfResults struct {
 	Path struct {
-		CourseID   string `json:"course_id" url:"course_id,omitempty"`       //  (Required)
-		LineItemID string `json:"line_item_id" url:"line_item_id,omitempty"` //  (Required)
+		CourseID   canvasapi.ID `json:"course_id" url:"course_id,omitempty"`       //  (Required)
+		LineItemID canvasapi.ID `json:"line_item_id" url:"line_item_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -35,8 +36,8 @@ func (t *ShowCollectionOfResults) GetMethod() string {
 
 func (t *ShowCollectionOfResults) GetURLPath() string {
 	path := "/lti/courses/{course_id}/line_items/{line_item_id}/results"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{line_item_id}", fmt.Sprintf("%v", t.Path.LineItemID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{line_item_id}", t.Path.LineItemID.Escaped())
 	return path
 }
 
@@ -52,36 +53,62 @@ func (t *ShowCollectionOfResults) GetJSON() ([]byte, error) {
 	return nil, nil
 }
 
+// MediaTypes returns the IMS media types of this LTI Advantage service.
+func (t *ShowCollectionOfResults) MediaTypes() (string, string) {
+	return "", "application/vnd.ims.lis.v2.resultcontainer+json"
+}
+
 func (t *ShowCollectionOfResults) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.LineItemID == "" {
-		errs = append(errs, "'Path.LineItemID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.LineItemID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *ShowCollectionOfResults) Do(c *canvasapi.Canvas) (*models.Result, error) {
-	response, err := c.SendRequest(t)
+func (t *ShowCollectionOfResults) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Result, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *ShowCollectionOfResults) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.Result, *canvasapi.PagedResource, error) {
+	var err error
+	var response *http.Response
+	if next != nil {
+		_, accept := t.MediaTypes()
+		response, err = c.SendAcceptContext(ctx, next, t.GetMethod(), nil, "", accept)
+	} else {
+		response, err = c.SendRequestContext(ctx, t)
+	}
+
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	body, err := ioutil.ReadAll(response.Body)
 	response.Body.Close()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	ret := models.Result{}
+	ret := []*models.Result{}
 	err = json.Unmarshal(body, &ret)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return &ret, nil
+	return ret, pagedResource, nil
 }
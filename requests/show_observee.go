@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -22,8 +22,8 @@ import (
 //
 type ShowObservee struct {
 	Path struct {
-		UserID     string `json:"user_id" url:"user_id,omitempty"`         //  (Required)
-		ObserveeID string `json:"observee_id" url:"observee_id,omitempty"` //  (Required)
+		UserID     canvasapi.ID `json:"user_id" url:"user_id,omitempty"`         //  (Required)
+		ObserveeID canvasapi.ID `json:"observee_id" url:"observee_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -33,8 +33,8 @@ func (t *ShowObservee) GetMethod() string {
 
 func (t *ShowObservee) GetURLPath() string {
 	path := "users/{user_id}/observees/{observee_id}"
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", t.Path.UserID))
-	path = strings.ReplaceAll(path, "{observee_id}", fmt.Sprintf("%v", t.Path.ObserveeID))
+	path = strings.ReplaceAll(path, "{user_id}", t.Path.UserID.Escaped())
+	path = strings.ReplaceAll(path, "{observee_id}", t.Path.ObserveeID.Escaped())
 	return path
 }
 
@@ -51,21 +51,25 @@ func (t *ShowObservee) GetJSON() ([]byte, error) {
 }
 
 func (t *ShowObservee) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.UserID == "" {
-		errs = append(errs, "'Path.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ObserveeID == "" {
-		errs = append(errs, "'Path.ObserveeID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ObserveeID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ShowObservee) Do(c *canvasapi.Canvas) (*models.User, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ShowObservee) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.User, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -80,6 +84,10 @@ func (t *ShowObservee) Do(c *canvasapi.Canvas) (*models.User, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
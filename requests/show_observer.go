@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -22,8 +22,8 @@ import (
 //
 type ShowObserver struct {
 	Path struct {
-		UserID     string `json:"user_id" url:"user_id,omitempty"`         //  (Required)
-		ObserverID string `json:"observer_id" url:"observer_id,omitempty"` //  (Required)
+		UserID     canvasapi.ID `json:"user_id" url:"user_id,omitempty"`         //  (Required)
+		ObserverID canvasapi.ID `json:"observer_id" url:"observer_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -33,8 +33,8 @@ func (t *ShowObserver) GetMethod() string {
 
 func (t *ShowObserver) GetURLPath() string {
 	path := "users/{user_id}/observers/{observer_id}"
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", t.Path.UserID))
-	path = strings.ReplaceAll(path, "{observer_id}", fmt.Sprintf("%v", t.Path.ObserverID))
+	path = strings.ReplaceAll(path, "{user_id}", t.Path.UserID.Escaped())
+	path = strings.ReplaceAll(path, "{observer_id}", t.Path.ObserverID.Escaped())
 	return path
 }
 
@@ -51,21 +51,25 @@ func (t *ShowObserver) GetJSON() ([]byte, error) {
 }
 
 func (t *ShowObserver) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.UserID == "" {
-		errs = append(errs, "'Path.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ObserverID == "" {
-		errs = append(errs, "'Path.ObserverID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ObserverID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ShowObserver) Do(c *canvasapi.Canvas) (*models.User, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ShowObserver) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.User, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -80,6 +84,10 @@ func (t *ShowObserver) Do(c *canvasapi.Canvas) (*models.User, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -20,8 +20,8 @@ import (
 //
 type ShowOriginalityReportFiles struct {
 	Path struct {
-		AssignmentID string `json:"assignment_id" url:"assignment_id,omitempty"` //  (Required)
-		FileID       string `json:"file_id" url:"file_id,omitempty"`             //  (Required)
+		AssignmentID canvasapi.ID `json:"assignment_id" url:"assignment_id,omitempty"` //  (Required)
+		FileID       canvasapi.ID `json:"file_id" url:"file_id,omitempty"`             //  (Required)
 	} `json:"path"`
 }
 
@@ -31,8 +31,8 @@ func (t *ShowOriginalityReportFiles) GetMethod() string {
 
 func (t *ShowOriginalityReportFiles) GetURLPath() string {
 	path := "/lti/assignments/{assignment_id}/files/{file_id}/originality_report"
-	path = strings.ReplaceAll(path, "{assignment_id}", fmt.Sprintf("%v", t.Path.AssignmentID))
-	path = strings.ReplaceAll(path, "{file_id}", fmt.Sprintf("%v", t.Path.FileID))
+	path = strings.ReplaceAll(path, "{assignment_id}", t.Path.AssignmentID.Escaped())
+	path = strings.ReplaceAll(path, "{file_id}", t.Path.FileID.Escaped())
 	return path
 }
 
@@ -49,21 +49,25 @@ func (t *ShowOriginalityReportFiles) GetJSON() ([]byte, error) {
 }
 
 func (t *ShowOriginalityReportFiles) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AssignmentID == "" {
-		errs = append(errs, "'Path.AssignmentID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AssignmentID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.FileID == "" {
-		errs = append(errs, "'Path.FileID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.FileID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ShowOriginalityReportFiles) Do(c *canvasapi.Canvas) (*models.OriginalityReport, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ShowOriginalityReportFiles) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.OriginalityReport, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -78,6 +82,10 @@ func (t *ShowOriginalityReportFiles) Do(c *canvasapi.Canvas) (*models.Originalit
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
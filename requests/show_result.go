@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -21,9 +21,9 @@ import (
 //
 type ShowResult struct {
 	Path struct {
-		CourseID   string `json:"course_id" url:"course_id,omitempty"`       //  (Required)
-		LineItemID string `json:"line_item_id" url:"line_item_id,omitempty"` //  (Required)
-		ID         string `json:"id" url:"id,omitempty"`                     //  (Required)
+		CourseID   canvasapi.ID `json:"course_id" url:"course_id,omitempty"`       //  (Required)
+		LineItemID canvasapi.ID `json:"line_item_id" url:"line_item_id,omitempty"` //  (Required)
+		ID         canvasapi.ID `json:"id" url:"id,omitempty"`                     //  (Required)
 	} `json:"path"`
 }
 
@@ -33,9 +33,9 @@ func (t *ShowResult) GetMethod() string {
 
 func (t *ShowResult) GetURLPath() string {
 	path := "/lti/courses/{course_id}/line_items/{line_item_id}/results/{id}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{line_item_id}", fmt.Sprintf("%v", t.Path.LineItemID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{line_item_id}", t.Path.LineItemID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -51,25 +51,34 @@ func (t *ShowResult) GetJSON() ([]byte, error) {
 	return nil, nil
 }
 
+// MediaTypes returns the IMS media types of this LTI Advantage service.
+func (t *ShowResult) MediaTypes() (string, string) {
+	return "", "application/vnd.ims.lis.v2.resultcontainer+json"
+}
+
 func (t *ShowResult) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.LineItemID == "" {
-		errs = append(errs, "'Path.LineItemID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.LineItemID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ShowResult) Do(c *canvasapi.Canvas) (*models.Result, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ShowResult) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Result, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -84,6 +93,10 @@ func (t *ShowResult) Do(c *canvasapi.Canvas) (*models.Result, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
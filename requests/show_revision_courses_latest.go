@@ -1,14 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -26,12 +25,12 @@ import (
 //
 type ShowRevisionCoursesLatest struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		Url      string `json:"url" url:"url,omitempty"`             //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		Url      string       `json:"url" url:"url,omitempty"`             //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		Summary bool `json:"summary" url:"summary,omitempty"` //  (Optional)
+		Summary canvasapi.Opt[bool] `json:"summary" url:"summary,omitempty"` //  (Optional)
 	} `json:"query"`
 }
 
@@ -41,13 +40,13 @@ func (t *ShowRevisionCoursesLatest) GetMethod() string {
 
 func (t *ShowRevisionCoursesLatest) GetURLPath() string {
 	path := "courses/{course_id}/pages/{url}/revisions/latest"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	path = strings.ReplaceAll(path, "{url}", fmt.Sprintf("%v", t.Path.Url))
 	return path
 }
 
 func (t *ShowRevisionCoursesLatest) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -63,21 +62,25 @@ func (t *ShowRevisionCoursesLatest) GetJSON() ([]byte, error) {
 }
 
 func (t *ShowRevisionCoursesLatest) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.Url == "" {
-		errs = append(errs, "'Path.Url' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Url", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ShowRevisionCoursesLatest) Do(c *canvasapi.Canvas) (*models.PageRevision, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ShowRevisionCoursesLatest) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.PageRevision, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -92,6 +95,10 @@ func (t *ShowRevisionCoursesLatest) Do(c *canvasapi.Canvas) (*models.PageRevisio
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
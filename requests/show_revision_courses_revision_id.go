@@ -1,14 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -27,13 +26,13 @@ import (
 //
 type ShowRevisionCoursesRevisionID struct {
 	Path struct {
-		CourseID   string `json:"course_id" url:"course_id,omitempty"`     //  (Required)
-		Url        string `json:"url" url:"url,omitempty"`                 //  (Required)
-		RevisionID string `json:"revision_id" url:"revision_id,omitempty"` //  (Required)
+		CourseID   canvasapi.ID `json:"course_id" url:"course_id,omitempty"`     //  (Required)
+		Url        string       `json:"url" url:"url,omitempty"`                 //  (Required)
+		RevisionID canvasapi.ID `json:"revision_id" url:"revision_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		Summary bool `json:"summary" url:"summary,omitempty"` //  (Optional)
+		Summary canvasapi.Opt[bool] `json:"summary" url:"summary,omitempty"` //  (Optional)
 	} `json:"query"`
 }
 
@@ -43,14 +42,14 @@ func (t *ShowRevisionCoursesRevisionID) GetMethod() string {
 
 func (t *ShowRevisionCoursesRevisionID) GetURLPath() string {
 	path := "courses/{course_id}/pages/{url}/revisions/{revision_id}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	path = strings.ReplaceAll(path, "{url}", fmt.Sprintf("%v", t.Path.Url))
-	path = strings.ReplaceAll(path, "{revision_id}", fmt.Sprintf("%v", t.Path.RevisionID))
+	path = strings.ReplaceAll(path, "{revision_id}", t.Path.RevisionID.Escaped())
 	return path
 }
 
 func (t *ShowRevisionCoursesRevisionID) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -66,24 +65,28 @@ func (t *ShowRevisionCoursesRevisionID) GetJSON() ([]byte, error) {
 }
 
 func (t *ShowRevisionCoursesRevisionID) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.Url == "" {
-		errs = append(errs, "'Path.Url' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Url", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.RevisionID == "" {
-		errs = append(errs, "'Path.RevisionID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.RevisionID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ShowRevisionCoursesRevisionID) Do(c *canvasapi.Canvas) (*models.PageRevision, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ShowRevisionCoursesRevisionID) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.PageRevision, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -98,6 +101,10 @@ func (t *ShowRevisionCoursesRevisionID) Do(c *canvasapi.Canvas) (*models.PageRev
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,14 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -26,12 +25,12 @@ import (
 //
 type ShowRevisionGroupsLatest struct {
 	Path struct {
-		GroupID string `json:"group_id" url:"group_id,omitempty"` //  (Required)
-		Url     string `json:"url" url:"url,omitempty"`           //  (Required)
+		GroupID canvasapi.ID `json:"group_id" url:"group_id,omitempty"` //  (Required)
+		Url     string       `json:"url" url:"url,omitempty"`           //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		Summary bool `json:"summary" url:"summary,omitempty"` //  (Optional)
+		Summary canvasapi.Opt[bool] `json:"summary" url:"summary,omitempty"` //  (Optional)
 	} `json:"query"`
 }
 
@@ -41,13 +40,13 @@ func (t *ShowRevisionGroupsLatest) GetMethod() string {
 
 func (t *ShowRevisionGroupsLatest) GetURLPath() string {
 	path := "groups/{group_id}/pages/{url}/revisions/latest"
-	path = strings.ReplaceAll(path, "{group_id}", fmt.Sprintf("%v", t.Path.GroupID))
+	path = strings.ReplaceAll(path, "{group_id}", t.Path.GroupID.Escaped())
 	path = strings.ReplaceAll(path, "{url}", fmt.Sprintf("%v", t.Path.Url))
 	return path
 }
 
 func (t *ShowRevisionGroupsLatest) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -63,21 +62,25 @@ func (t *ShowRevisionGroupsLatest) GetJSON() ([]byte, error) {
 }
 
 func (t *ShowRevisionGroupsLatest) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.GroupID == "" {
-		errs = append(errs, "'Path.GroupID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.GroupID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.Url == "" {
-		errs = append(errs, "'Path.Url' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Url", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ShowRevisionGroupsLatest) Do(c *canvasapi.Canvas) (*models.PageRevision, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ShowRevisionGroupsLatest) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.PageRevision, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -92,6 +95,10 @@ func (t *ShowRevisionGroupsLatest) Do(c *canvasapi.Canvas) (*models.PageRevision
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,14 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -27,13 +26,13 @@ import (
 //
 type ShowRevisionGroupsRevisionID struct {
 	Path struct {
-		GroupID    string `json:"group_id" url:"group_id,omitempty"`       //  (Required)
-		Url        string `json:"url" url:"url,omitempty"`                 //  (Required)
-		RevisionID string `json:"revision_id" url:"revision_id,omitempty"` //  (Required)
+		GroupID    canvasapi.ID `json:"group_id" url:"group_id,omitempty"`       //  (Required)
+		Url        string       `json:"url" url:"url,omitempty"`                 //  (Required)
+		RevisionID canvasapi.ID `json:"revision_id" url:"revision_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		Summary bool `json:"summary" url:"summary,omitempty"` //  (Optional)
+		Summary canvasapi.Opt[bool] `json:"summary" url:"summary,omitempty"` //  (Optional)
 	} `json:"query"`
 }
 
@@ -43,14 +42,14 @@ func (t *ShowRevisionGroupsRevisionID) GetMethod() string {
 
 func (t *ShowRevisionGroupsRevisionID) GetURLPath() string {
 	path := "groups/{group_id}/pages/{url}/revisions/{revision_id}"
-	path = strings.ReplaceAll(path, "{group_id}", fmt.Sprintf("%v", t.Path.GroupID))
+	path = strings.ReplaceAll(path, "{group_id}", t.Path.GroupID.Escaped())
 	path = strings.ReplaceAll(path, "{url}", fmt.Sprintf("%v", t.Path.Url))
-	path = strings.ReplaceAll(path, "{revision_id}", fmt.Sprintf("%v", t.Path.RevisionID))
+	path = strings.ReplaceAll(path, "{revision_id}", t.Path.RevisionID.Escaped())
 	return path
 }
 
 func (t *ShowRevisionGroupsRevisionID) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -66,24 +65,28 @@ func (t *ShowRevisionGroupsRevisionID) GetJSON() ([]byte, error) {
 }
 
 func (t *ShowRevisionGroupsRevisionID) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.GroupID == "" {
-		errs = append(errs, "'Path.GroupID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.GroupID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.Url == "" {
-		errs = append(errs, "'Path.Url' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Url", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.RevisionID == "" {
-		errs = append(errs, "'Path.RevisionID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.RevisionID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ShowRevisionGroupsRevisionID) Do(c *canvasapi.Canvas) (*models.PageRevision, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ShowRevisionGroupsRevisionID) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.PageRevision, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -98,6 +101,10 @@ func (t *ShowRevisionGroupsRevisionID) Do(c *canvasapi.Canvas) (*models.PageRevi
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
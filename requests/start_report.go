@@ -1,14 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -29,6 +28,8 @@ import (
 //    of available parameters for each report, see {api:AccountReportsController#available_reports List Available Reports}.
 //    A few example parameters have been provided below. Note that the example
 //    parameters provided below may not be valid for every report.
+//    Set it to a map of parameter names to values, or to one of the parameter
+//    structs of the reports package.
 // # Form.Parameters.CourseID (Optional) The id of the course to report on.
 //    Note: this parameter has been listed to serve as an example and may not be
 //    valid for every report.
@@ -38,12 +39,12 @@ import (
 //
 type StartReport struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
-		Report    string `json:"report" url:"report,omitempty"`         //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		Report    string       `json:"report" url:"report,omitempty"`         //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		Parameters string `json:"parameters" url:"parameters,omitempty"` //  (Optional)
+		Parameters interface{} `json:"parameters" url:"parameters,omitempty"` //  (Optional)
 	} `json:"form"`
 }
 
@@ -53,7 +54,7 @@ func (t *StartReport) GetMethod() string {
 
 func (t *StartReport) GetURLPath() string {
 	path := "accounts/{account_id}/reports/{report}"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	path = strings.ReplaceAll(path, "{report}", fmt.Sprintf("%v", t.Path.Report))
 	return path
 }
@@ -63,33 +64,37 @@ func (t *StartReport) GetQuery() (string, error) {
 }
 
 func (t *StartReport) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *StartReport) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *StartReport) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.Report == "" {
-		errs = append(errs, "'Path.Report' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Report", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *StartReport) Do(c *canvasapi.Canvas) (*models.Report, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *StartReport) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Report, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -104,6 +109,10 @@ func (t *StartReport) Do(c *canvasapi.Canvas) (*models.Report, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,14 +1,14 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // StoreCustomData Store arbitrary user data as JSON.
@@ -170,7 +170,7 @@ import (
 //
 type StoreCustomData struct {
 	Path struct {
-		UserID string `json:"user_id" url:"user_id,omitempty"` //  (Required)
+		UserID canvasapi.ID `json:"user_id" url:"user_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -185,7 +185,7 @@ func (t *StoreCustomData) GetMethod() string {
 
 func (t *StoreCustomData) GetURLPath() string {
 	path := "users/{user_id}/custom_data"
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", t.Path.UserID))
+	path = strings.ReplaceAll(path, "{user_id}", t.Path.UserID.Escaped())
 	return path
 }
 
@@ -194,39 +194,58 @@ func (t *StoreCustomData) GetQuery() (string, error) {
 }
 
 func (t *StoreCustomData) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *StoreCustomData) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *StoreCustomData) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.UserID == "" {
-		errs = append(errs, "'Path.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Ns == "" {
-		errs = append(errs, "'Form.Ns' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Ns", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Data == nil {
-		errs = append(errs, "'Form.Data' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Data", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *StoreCustomData) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *StoreCustomData) Do(c *canvasapi.Canvas) (*models.CustomData, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *StoreCustomData) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.CustomData, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.CustomData{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,11 +1,14 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // SubmissionCommentsUploadFile Upload a file to attach to a submission comment
@@ -22,12 +25,23 @@ import (
 // # Path.AssignmentID (Required) ID
 // # Path.UserID (Required) ID
 //
+// Form Parameters:
+// # Form.Name (Optional) The filename of the file. Any UTF-8 name is allowed. Path components such as `/` and `\` will be treated as part of the filename, not a path to a sub-folder.
+// # Form.Size (Optional) The size of the file, in bytes. This field is recommended, as it will let you find out if there's a quota issue before uploading the raw file.
+// # Form.ContentType (Optional) The content type of the file. If not given, it will be guessed based on the file extension.
+//
 type SubmissionCommentsUploadFile struct {
 	Path struct {
-		CourseID     string `json:"course_id" url:"course_id,omitempty"`         //  (Required)
-		AssignmentID string `json:"assignment_id" url:"assignment_id,omitempty"` //  (Required)
-		UserID       string `json:"user_id" url:"user_id,omitempty"`             //  (Required)
+		CourseID     canvasapi.ID `json:"course_id" url:"course_id,omitempty"`         //  (Required)
+		AssignmentID canvasapi.ID `json:"assignment_id" url:"assignment_id,omitempty"` //  (Required)
+		UserID       canvasapi.ID `json:"user_id" url:"user_id,omitempty"`             //  (Required)
 	} `json:"path"`
+
+	Form struct {
+		Name        string               `json:"name" url:"name,omitempty"`                 //  (Optional)
+		Size        canvasapi.Opt[int64] `json:"size" url:"size,omitempty"`                 //  (Optional)
+		ContentType string               `json:"content_type" url:"content_type,omitempty"` //  (Optional)
+	} `json:"form"`
 }
 
 func (t *SubmissionCommentsUploadFile) GetMethod() string {
@@ -36,9 +50,9 @@ func (t *SubmissionCommentsUploadFile) GetMethod() string {
 
 func (t *SubmissionCommentsUploadFile) GetURLPath() string {
 	path := "courses/{course_id}/assignments/{assignment_id}/submissions/{user_id}/comments/files"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{assignment_id}", fmt.Sprintf("%v", t.Path.AssignmentID))
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", t.Path.UserID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{assignment_id}", t.Path.AssignmentID.Escaped())
+	path = strings.ReplaceAll(path, "{user_id}", t.Path.UserID.Escaped())
 	return path
 }
 
@@ -47,35 +61,58 @@ func (t *SubmissionCommentsUploadFile) GetQuery() (string, error) {
 }
 
 func (t *SubmissionCommentsUploadFile) GetBody() (url.Values, error) {
-	return nil, nil
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *SubmissionCommentsUploadFile) GetJSON() ([]byte, error) {
-	return nil, nil
+	j, err := canvasapi.EncodeJSON(t.Form)
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
 }
 
 func (t *SubmissionCommentsUploadFile) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.AssignmentID == "" {
-		errs = append(errs, "'Path.AssignmentID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AssignmentID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.UserID == "" {
-		errs = append(errs, "'Path.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *SubmissionCommentsUploadFile) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *SubmissionCommentsUploadFile) Do(c *canvasapi.Canvas) (*models.UploadParams, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *SubmissionCommentsUploadFile) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.UploadParams, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.UploadParams{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
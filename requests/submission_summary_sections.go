@@ -1,13 +1,14 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // SubmissionSummarySections Returns the number of submissions for the given assignment based on gradeable students
@@ -23,12 +24,12 @@ import (
 //
 type SubmissionSummarySections struct {
 	Path struct {
-		SectionID    string `json:"section_id" url:"section_id,omitempty"`       //  (Required)
-		AssignmentID string `json:"assignment_id" url:"assignment_id,omitempty"` //  (Required)
+		SectionID    canvasapi.ID `json:"section_id" url:"section_id,omitempty"`       //  (Required)
+		AssignmentID canvasapi.ID `json:"assignment_id" url:"assignment_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		Grouped bool `json:"grouped" url:"grouped,omitempty"` //  (Optional)
+		Grouped canvasapi.Opt[bool] `json:"grouped" url:"grouped,omitempty"` //  (Optional)
 	} `json:"query"`
 }
 
@@ -38,13 +39,13 @@ func (t *SubmissionSummarySections) GetMethod() string {
 
 func (t *SubmissionSummarySections) GetURLPath() string {
 	path := "sections/{section_id}/assignments/{assignment_id}/submission_summary"
-	path = strings.ReplaceAll(path, "{section_id}", fmt.Sprintf("%v", t.Path.SectionID))
-	path = strings.ReplaceAll(path, "{assignment_id}", fmt.Sprintf("%v", t.Path.AssignmentID))
+	path = strings.ReplaceAll(path, "{section_id}", t.Path.SectionID.Escaped())
+	path = strings.ReplaceAll(path, "{assignment_id}", t.Path.AssignmentID.Escaped())
 	return path
 }
 
 func (t *SubmissionSummarySections) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -60,24 +61,43 @@ func (t *SubmissionSummarySections) GetJSON() ([]byte, error) {
 }
 
 func (t *SubmissionSummarySections) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.SectionID == "" {
-		errs = append(errs, "'Path.SectionID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.SectionID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.AssignmentID == "" {
-		errs = append(errs, "'Path.AssignmentID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AssignmentID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *SubmissionSummarySections) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *SubmissionSummarySections) Do(c *canvasapi.Canvas) (*models.SubmissionSummary, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *SubmissionSummarySections) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.SubmissionSummary, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.SubmissionSummary{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
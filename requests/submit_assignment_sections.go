@@ -1,15 +1,15 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -67,8 +67,8 @@ import (
 //
 type SubmitAssignmentSections struct {
 	Path struct {
-		SectionID    string `json:"section_id" url:"section_id,omitempty"`       //  (Required)
-		AssignmentID string `json:"assignment_id" url:"assignment_id,omitempty"` //  (Required)
+		SectionID    canvasapi.ID `json:"section_id" url:"section_id,omitempty"`       //  (Required)
+		AssignmentID canvasapi.ID `json:"assignment_id" url:"assignment_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -77,15 +77,15 @@ type SubmitAssignmentSections struct {
 		} `json:"comment" url:"comment,omitempty"`
 
 		Submission struct {
-			SubmissionType          string    `json:"submission_type" url:"submission_type,omitempty"`                     //  (Required) . Must be one of online_text_entry, online_url, online_upload, media_recording, basic_lti_launch, student_annotation
-			Body                    string    `json:"body" url:"body,omitempty"`                                           //  (Optional)
-			Url                     string    `json:"url" url:"url,omitempty"`                                             //  (Optional)
-			FileIDs                 []string  `json:"file_ids" url:"file_ids,omitempty"`                                   //  (Optional)
-			MediaCommentID          string    `json:"media_comment_id" url:"media_comment_id,omitempty"`                   //  (Optional)
-			MediaCommentType        string    `json:"media_comment_type" url:"media_comment_type,omitempty"`               //  (Optional) . Must be one of audio, video
-			UserID                  int64     `json:"user_id" url:"user_id,omitempty"`                                     //  (Optional)
-			AnnotatableAttachmentID int64     `json:"annotatable_attachment_id" url:"annotatable_attachment_id,omitempty"` //  (Optional)
-			SubmittedAt             time.Time `json:"submitted_at" url:"submitted_at,omitempty"`                           //  (Optional)
+			SubmissionType          string               `json:"submission_type" url:"submission_type,omitempty"`                     //  (Required) . Must be one of online_text_entry, online_url, online_upload, media_recording, basic_lti_launch, student_annotation
+			Body                    string               `json:"body" url:"body,omitempty"`                                           //  (Optional)
+			Url                     string               `json:"url" url:"url,omitempty"`                                             //  (Optional)
+			FileIDs                 []string             `json:"file_ids" url:"file_ids,omitempty"`                                   //  (Optional)
+			MediaCommentID          string               `json:"media_comment_id" url:"media_comment_id,omitempty"`                   //  (Optional)
+			MediaCommentType        string               `json:"media_comment_type" url:"media_comment_type,omitempty"`               //  (Optional) . Must be one of audio, video
+			UserID                  canvasapi.Opt[int64] `json:"user_id" url:"user_id,omitempty"`                                     //  (Optional)
+			AnnotatableAttachmentID canvasapi.Opt[int64] `json:"annotatable_attachment_id" url:"annotatable_attachment_id,omitempty"` //  (Optional)
+			SubmittedAt             time.Time            `json:"submitted_at" url:"submitted_at,omitempty"`                           //  (Optional)
 		} `json:"submission" url:"submission,omitempty"`
 	} `json:"form"`
 }
@@ -96,8 +96,8 @@ func (t *SubmitAssignmentSections) GetMethod() string {
 
 func (t *SubmitAssignmentSections) GetURLPath() string {
 	path := "sections/{section_id}/assignments/{assignment_id}/submissions"
-	path = strings.ReplaceAll(path, "{section_id}", fmt.Sprintf("%v", t.Path.SectionID))
-	path = strings.ReplaceAll(path, "{assignment_id}", fmt.Sprintf("%v", t.Path.AssignmentID))
+	path = strings.ReplaceAll(path, "{section_id}", t.Path.SectionID.Escaped())
+	path = strings.ReplaceAll(path, "{assignment_id}", t.Path.AssignmentID.Escaped())
 	return path
 }
 
@@ -106,45 +106,64 @@ func (t *SubmitAssignmentSections) GetQuery() (string, error) {
 }
 
 func (t *SubmitAssignmentSections) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *SubmitAssignmentSections) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *SubmitAssignmentSections) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.SectionID == "" {
-		errs = append(errs, "'Path.SectionID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.SectionID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.AssignmentID == "" {
-		errs = append(errs, "'Path.AssignmentID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AssignmentID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Submission.SubmissionType == "" {
-		errs = append(errs, "'Form.Submission.SubmissionType' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Submission.SubmissionType", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Submission.SubmissionType != "" && !string_utils.Include([]string{"online_text_entry", "online_url", "online_upload", "media_recording", "basic_lti_launch", "student_annotation"}, t.Form.Submission.SubmissionType) {
-		errs = append(errs, "Submission must be one of online_text_entry, online_url, online_upload, media_recording, basic_lti_launch, student_annotation")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Submission.SubmissionType", Rule: canvasapi.RuleOneOf, Allowed: []string{"online_text_entry", "online_url", "online_upload", "media_recording", "basic_lti_launch", "student_annotation"}})
 	}
 	if t.Form.Submission.MediaCommentType != "" && !string_utils.Include([]string{"audio", "video"}, t.Form.Submission.MediaCommentType) {
-		errs = append(errs, "Submission must be one of audio, video")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Submission.MediaCommentType", Rule: canvasapi.RuleOneOf, Allowed: []string{"audio", "video"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *SubmitAssignmentSections) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *SubmitAssignmentSections) Do(c *canvasapi.Canvas) (*models.Submission, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *SubmitAssignmentSections) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Submission, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.Submission{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -24,9 +24,9 @@ import (
 //
 type UnlinkOutcomeAccounts struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
-		ID        string `json:"id" url:"id,omitempty"`                 //  (Required)
-		OutcomeID string `json:"outcome_id" url:"outcome_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		ID        canvasapi.ID `json:"id" url:"id,omitempty"`                 //  (Required)
+		OutcomeID canvasapi.ID `json:"outcome_id" url:"outcome_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -36,9 +36,9 @@ func (t *UnlinkOutcomeAccounts) GetMethod() string {
 
 func (t *UnlinkOutcomeAccounts) GetURLPath() string {
 	path := "accounts/{account_id}/outcome_groups/{id}/outcomes/{outcome_id}"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
-	path = strings.ReplaceAll(path, "{outcome_id}", fmt.Sprintf("%v", t.Path.OutcomeID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
+	path = strings.ReplaceAll(path, "{outcome_id}", t.Path.OutcomeID.Escaped())
 	return path
 }
 
@@ -55,24 +55,28 @@ func (t *UnlinkOutcomeAccounts) GetJSON() ([]byte, error) {
 }
 
 func (t *UnlinkOutcomeAccounts) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.OutcomeID == "" {
-		errs = append(errs, "'Path.OutcomeID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.OutcomeID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *UnlinkOutcomeAccounts) Do(c *canvasapi.Canvas) (*models.OutcomeLink, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UnlinkOutcomeAccounts) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.OutcomeLink, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -87,6 +91,10 @@ func (t *UnlinkOutcomeAccounts) Do(c *canvasapi.Canvas) (*models.OutcomeLink, er
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -24,9 +24,9 @@ import (
 //
 type UnlinkOutcomeCourses struct {
 	Path struct {
-		CourseID  string `json:"course_id" url:"course_id,omitempty"`   //  (Required)
-		ID        string `json:"id" url:"id,omitempty"`                 //  (Required)
-		OutcomeID string `json:"outcome_id" url:"outcome_id,omitempty"` //  (Required)
+		CourseID  canvasapi.ID `json:"course_id" url:"course_id,omitempty"`   //  (Required)
+		ID        canvasapi.ID `json:"id" url:"id,omitempty"`                 //  (Required)
+		OutcomeID canvasapi.ID `json:"outcome_id" url:"outcome_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -36,9 +36,9 @@ func (t *UnlinkOutcomeCourses) GetMethod() string {
 
 func (t *UnlinkOutcomeCourses) GetURLPath() string {
 	path := "courses/{course_id}/outcome_groups/{id}/outcomes/{outcome_id}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
-	path = strings.ReplaceAll(path, "{outcome_id}", fmt.Sprintf("%v", t.Path.OutcomeID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
+	path = strings.ReplaceAll(path, "{outcome_id}", t.Path.OutcomeID.Escaped())
 	return path
 }
 
@@ -55,24 +55,28 @@ func (t *UnlinkOutcomeCourses) GetJSON() ([]byte, error) {
 }
 
 func (t *UnlinkOutcomeCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.OutcomeID == "" {
-		errs = append(errs, "'Path.OutcomeID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.OutcomeID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *UnlinkOutcomeCourses) Do(c *canvasapi.Canvas) (*models.OutcomeLink, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UnlinkOutcomeCourses) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.OutcomeLink, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -87,6 +91,10 @@ func (t *UnlinkOutcomeCourses) Do(c *canvasapi.Canvas) (*models.OutcomeLink, err
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
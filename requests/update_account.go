@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -60,62 +58,62 @@ import (
 //
 type UpdateAccount struct {
 	Path struct {
-		ID string `json:"id" url:"id,omitempty"` //  (Required)
+		ID canvasapi.ID `json:"id" url:"id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		Account struct {
-			Name                       string `json:"name" url:"name,omitempty"`                                                     //  (Optional)
-			SISAccountID               string `json:"sis_account_id" url:"sis_account_id,omitempty"`                                 //  (Optional)
-			DefaultTimeZone            string `json:"default_time_zone" url:"default_time_zone,omitempty"`                           //  (Optional)
-			DefaultStorageQuotaMb      int64  `json:"default_storage_quota_mb" url:"default_storage_quota_mb,omitempty"`             //  (Optional)
-			DefaultUserStorageQuotaMb  int64  `json:"default_user_storage_quota_mb" url:"default_user_storage_quota_mb,omitempty"`   //  (Optional)
-			DefaultGroupStorageQuotaMb int64  `json:"default_group_storage_quota_mb" url:"default_group_storage_quota_mb,omitempty"` //  (Optional)
-			CourseTemplateID           int64  `json:"course_template_id" url:"course_template_id,omitempty"`                         //  (Optional)
+			Name                       canvasapi.Opt[string] `json:"name" url:"name,omitempty"`                                                     //  (Optional)
+			SISAccountID               canvasapi.Opt[string] `json:"sis_account_id" url:"sis_account_id,omitempty"`                                 //  (Optional)
+			DefaultTimeZone            canvasapi.Opt[string] `json:"default_time_zone" url:"default_time_zone,omitempty"`                           //  (Optional)
+			DefaultStorageQuotaMb      canvasapi.Opt[int64]  `json:"default_storage_quota_mb" url:"default_storage_quota_mb,omitempty"`             //  (Optional)
+			DefaultUserStorageQuotaMb  canvasapi.Opt[int64]  `json:"default_user_storage_quota_mb" url:"default_user_storage_quota_mb,omitempty"`   //  (Optional)
+			DefaultGroupStorageQuotaMb canvasapi.Opt[int64]  `json:"default_group_storage_quota_mb" url:"default_group_storage_quota_mb,omitempty"` //  (Optional)
+			CourseTemplateID           canvasapi.Opt[int64]  `json:"course_template_id" url:"course_template_id,omitempty"`                         //  (Optional)
 			Settings                   struct {
 				RestrictStudentPastView struct {
-					Value  bool `json:"value" url:"value,omitempty"`   //  (Optional)
-					Locked bool `json:"locked" url:"locked,omitempty"` //  (Optional)
+					Value  canvasapi.Opt[bool] `json:"value" url:"value,omitempty"`   //  (Optional)
+					Locked canvasapi.Opt[bool] `json:"locked" url:"locked,omitempty"` //  (Optional)
 				} `json:"restrict_student_past_view" url:"restrict_student_past_view,omitempty"`
 
 				RestrictStudentFutureView struct {
-					Value  bool `json:"value" url:"value,omitempty"`   //  (Optional)
-					Locked bool `json:"locked" url:"locked,omitempty"` //  (Optional)
+					Value  canvasapi.Opt[bool] `json:"value" url:"value,omitempty"`   //  (Optional)
+					Locked canvasapi.Opt[bool] `json:"locked" url:"locked,omitempty"` //  (Optional)
 				} `json:"restrict_student_future_view" url:"restrict_student_future_view,omitempty"`
 
-				MicrosoftSyncEnabled        bool   `json:"microsoft_sync_enabled" url:"microsoft_sync_enabled,omitempty"`                 //  (Optional)
-				MicrosoftSyncTenant         string `json:"microsoft_sync_tenant" url:"microsoft_sync_tenant,omitempty"`                   //  (Optional)
-				MicrosoftSyncLoginAttribute string `json:"microsoft_sync_login_attribute" url:"microsoft_sync_login_attribute,omitempty"` //  (Optional)
+				MicrosoftSyncEnabled        canvasapi.Opt[bool]   `json:"microsoft_sync_enabled" url:"microsoft_sync_enabled,omitempty"`                 //  (Optional)
+				MicrosoftSyncTenant         canvasapi.Opt[string] `json:"microsoft_sync_tenant" url:"microsoft_sync_tenant,omitempty"`                   //  (Optional)
+				MicrosoftSyncLoginAttribute canvasapi.Opt[string] `json:"microsoft_sync_login_attribute" url:"microsoft_sync_login_attribute,omitempty"` //  (Optional)
 				LockAllAnnouncements        struct {
-					Value  bool `json:"value" url:"value,omitempty"`   //  (Optional)
-					Locked bool `json:"locked" url:"locked,omitempty"` //  (Optional)
+					Value  canvasapi.Opt[bool] `json:"value" url:"value,omitempty"`   //  (Optional)
+					Locked canvasapi.Opt[bool] `json:"locked" url:"locked,omitempty"` //  (Optional)
 				} `json:"lock_all_announcements" url:"lock_all_announcements,omitempty"`
 
 				UsageRightsRequired struct {
-					Value  bool `json:"value" url:"value,omitempty"`   //  (Optional)
-					Locked bool `json:"locked" url:"locked,omitempty"` //  (Optional)
+					Value  canvasapi.Opt[bool] `json:"value" url:"value,omitempty"`   //  (Optional)
+					Locked canvasapi.Opt[bool] `json:"locked" url:"locked,omitempty"` //  (Optional)
 				} `json:"usage_rights_required" url:"usage_rights_required,omitempty"`
 
 				RestrictStudentFutureListing struct {
-					Value  bool `json:"value" url:"value,omitempty"`   //  (Optional)
-					Locked bool `json:"locked" url:"locked,omitempty"` //  (Optional)
+					Value  canvasapi.Opt[bool] `json:"value" url:"value,omitempty"`   //  (Optional)
+					Locked canvasapi.Opt[bool] `json:"locked" url:"locked,omitempty"` //  (Optional)
 				} `json:"restrict_student_future_listing" url:"restrict_student_future_listing,omitempty"`
 
 				LockOutcomeProficiency struct {
-					Value bool `json:"value" url:"value,omitempty"` //  (Optional)
+					Value canvasapi.Opt[bool] `json:"value" url:"value,omitempty"` //  (Optional)
 				} `json:"lock_outcome_proficiency" url:"lock_outcome_proficiency,omitempty"`
 
 				LockProficiencyCalculation struct {
-					Value bool `json:"value" url:"value,omitempty"` //  (Optional)
+					Value canvasapi.Opt[bool] `json:"value" url:"value,omitempty"` //  (Optional)
 				} `json:"lock_proficiency_calculation" url:"lock_proficiency_calculation,omitempty"`
 			} `json:"settings" url:"settings,omitempty"`
 
 			LockOutcomeProficiency struct {
-				Locked bool `json:"locked" url:"locked,omitempty"` //  (Optional)
+				Locked canvasapi.Opt[bool] `json:"locked" url:"locked,omitempty"` //  (Optional)
 			} `json:"lock_outcome_proficiency" url:"lock_outcome_proficiency,omitempty"`
 
 			LockProficiencyCalculation struct {
-				Locked bool `json:"locked" url:"locked,omitempty"` //  (Optional)
+				Locked canvasapi.Opt[bool] `json:"locked" url:"locked,omitempty"` //  (Optional)
 			} `json:"lock_proficiency_calculation" url:"lock_proficiency_calculation,omitempty"`
 
 			Services map[string](interface{}) `json:"services" url:"services,omitempty"` //  (Optional)
@@ -129,7 +127,7 @@ func (t *UpdateAccount) GetMethod() string {
 
 func (t *UpdateAccount) GetURLPath() string {
 	path := "accounts/{id}"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -138,30 +136,34 @@ func (t *UpdateAccount) GetQuery() (string, error) {
 }
 
 func (t *UpdateAccount) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateAccount) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdateAccount) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *UpdateAccount) Do(c *canvasapi.Canvas) (*models.Account, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateAccount) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Account, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -176,6 +178,10 @@ func (t *UpdateAccount) Do(c *canvasapi.Canvas) (*models.Account, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
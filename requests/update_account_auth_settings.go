@@ -1,8 +1,8 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -28,7 +28,7 @@ import (
 //
 type UpdateAccountAuthSettings struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
 	} `json:"path"`
 }
 
@@ -38,7 +38,7 @@ func (t *UpdateAccountAuthSettings) GetMethod() string {
 
 func (t *UpdateAccountAuthSettings) GetURLPath() string {
 	path := "accounts/{account_id}/sso_settings"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	return path
 }
 
@@ -55,18 +55,22 @@ func (t *UpdateAccountAuthSettings) GetJSON() ([]byte, error) {
 }
 
 func (t *UpdateAccountAuthSettings) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *UpdateAccountAuthSettings) Do(c *canvasapi.Canvas) (*models.SSOSettings, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateAccountAuthSettings) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.SSOSettings, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -81,6 +85,10 @@ func (t *UpdateAccountAuthSettings) Do(c *canvasapi.Canvas) (*models.SSOSettings
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,14 +1,14 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -48,26 +48,26 @@ import (
 //
 type UpdateAppointmentGroup struct {
 	Path struct {
-		ID string `json:"id" url:"id,omitempty"` //  (Required)
+		ID canvasapi.ID `json:"id" url:"id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		AppointmentGroup struct {
-			ContextCodes                  []string `json:"context_codes" url:"context_codes,omitempty"`                                       //  (Required)
-			SubContextCodes               []string `json:"sub_context_codes" url:"sub_context_codes,omitempty"`                               //  (Optional)
-			Title                         string   `json:"title" url:"title,omitempty"`                                                       //  (Optional)
-			Description                   string   `json:"description" url:"description,omitempty"`                                           //  (Optional)
-			LocationName                  string   `json:"location_name" url:"location_name,omitempty"`                                       //  (Optional)
-			LocationAddress               string   `json:"location_address" url:"location_address,omitempty"`                                 //  (Optional)
-			Publish                       bool     `json:"publish" url:"publish,omitempty"`                                                   //  (Optional)
-			ParticipantsPerAppointment    int64    `json:"participants_per_appointment" url:"participants_per_appointment,omitempty"`         //  (Optional)
-			MinAppointmentsPerParticipant int64    `json:"min_appointments_per_participant" url:"min_appointments_per_participant,omitempty"` //  (Optional)
-			MaxAppointmentsPerParticipant int64    `json:"max_appointments_per_participant" url:"max_appointments_per_participant,omitempty"` //  (Optional)
+			ContextCodes                  []string              `json:"context_codes" url:"context_codes,omitempty"`                                       //  (Required)
+			SubContextCodes               []string              `json:"sub_context_codes" url:"sub_context_codes,omitempty"`                               //  (Optional)
+			Title                         canvasapi.Opt[string] `json:"title" url:"title,omitempty"`                                                       //  (Optional)
+			Description                   canvasapi.Opt[string] `json:"description" url:"description,omitempty"`                                           //  (Optional)
+			LocationName                  canvasapi.Opt[string] `json:"location_name" url:"location_name,omitempty"`                                       //  (Optional)
+			LocationAddress               canvasapi.Opt[string] `json:"location_address" url:"location_address,omitempty"`                                 //  (Optional)
+			Publish                       canvasapi.Opt[bool]   `json:"publish" url:"publish,omitempty"`                                                   //  (Optional)
+			ParticipantsPerAppointment    canvasapi.Opt[int64]  `json:"participants_per_appointment" url:"participants_per_appointment,omitempty"`         //  (Optional)
+			MinAppointmentsPerParticipant canvasapi.Opt[int64]  `json:"min_appointments_per_participant" url:"min_appointments_per_participant,omitempty"` //  (Optional)
+			MaxAppointmentsPerParticipant canvasapi.Opt[int64]  `json:"max_appointments_per_participant" url:"max_appointments_per_participant,omitempty"` //  (Optional)
 			NewAppointments               struct {
 				X []string `json:"x" url:"x,omitempty"` //  (Optional)
 			} `json:"new_appointments" url:"new_appointments,omitempty"`
 
-			ParticipantVisibility string `json:"participant_visibility" url:"participant_visibility,omitempty"` //  (Optional) . Must be one of private, protected
+			ParticipantVisibility canvasapi.Opt[string] `json:"participant_visibility" url:"participant_visibility,omitempty"` //  (Optional) . Must be one of private, protected
 		} `json:"appointment_group" url:"appointment_group,omitempty"`
 	} `json:"form"`
 }
@@ -78,7 +78,7 @@ func (t *UpdateAppointmentGroup) GetMethod() string {
 
 func (t *UpdateAppointmentGroup) GetURLPath() string {
 	path := "appointment_groups/{id}"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -87,39 +87,58 @@ func (t *UpdateAppointmentGroup) GetQuery() (string, error) {
 }
 
 func (t *UpdateAppointmentGroup) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateAppointmentGroup) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdateAppointmentGroup) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.AppointmentGroup.ContextCodes == nil {
-		errs = append(errs, "'Form.AppointmentGroup.ContextCodes' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.AppointmentGroup.ContextCodes", Rule: canvasapi.RuleRequired})
 	}
-	if t.Form.AppointmentGroup.ParticipantVisibility != "" && !string_utils.Include([]string{"private", "protected"}, t.Form.AppointmentGroup.ParticipantVisibility) {
-		errs = append(errs, "AppointmentGroup must be one of private, protected")
+	if t.Form.AppointmentGroup.ParticipantVisibility.Value() != "" && !string_utils.Include([]string{"private", "protected"}, t.Form.AppointmentGroup.ParticipantVisibility.Value()) {
+		errs = append(errs, canvasapi.FieldError{Field: "Form.AppointmentGroup.ParticipantVisibility", Rule: canvasapi.RuleOneOf, Allowed: []string{"private", "protected"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *UpdateAppointmentGroup) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *UpdateAppointmentGroup) Do(c *canvasapi.Canvas) (*models.AppointmentGroup, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateAppointmentGroup) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.AppointmentGroup, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.AppointmentGroup{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
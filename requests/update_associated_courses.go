@@ -1,13 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 )
 
@@ -28,13 +27,13 @@ import (
 //
 type UpdateAssociatedCourses struct {
 	Path struct {
-		CourseID   string `json:"course_id" url:"course_id,omitempty"`     //  (Required)
-		TemplateID string `json:"template_id" url:"template_id,omitempty"` //  (Required)
+		CourseID   canvasapi.ID `json:"course_id" url:"course_id,omitempty"`     //  (Required)
+		TemplateID canvasapi.ID `json:"template_id" url:"template_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		CourseIDsToAdd    string `json:"course_ids_to_add" url:"course_ids_to_add,omitempty"`       //  (Optional)
-		CourseIDsToRemove string `json:"course_ids_to_remove" url:"course_ids_to_remove,omitempty"` //  (Optional)
+		CourseIDsToAdd    canvasapi.Opt[string] `json:"course_ids_to_add" url:"course_ids_to_add,omitempty"`       //  (Optional)
+		CourseIDsToRemove canvasapi.Opt[string] `json:"course_ids_to_remove" url:"course_ids_to_remove,omitempty"` //  (Optional)
 	} `json:"form"`
 }
 
@@ -44,8 +43,8 @@ func (t *UpdateAssociatedCourses) GetMethod() string {
 
 func (t *UpdateAssociatedCourses) GetURLPath() string {
 	path := "courses/{course_id}/blueprint_templates/{template_id}/update_associations"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{template_id}", fmt.Sprintf("%v", t.Path.TemplateID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{template_id}", t.Path.TemplateID.Escaped())
 	return path
 }
 
@@ -54,36 +53,51 @@ func (t *UpdateAssociatedCourses) GetQuery() (string, error) {
 }
 
 func (t *UpdateAssociatedCourses) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateAssociatedCourses) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdateAssociatedCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.TemplateID == "" {
-		errs = append(errs, "'Path.TemplateID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.TemplateID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *UpdateAssociatedCourses) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *UpdateAssociatedCourses) Do(c *canvasapi.Canvas) (*canvasapi.SuccessResponse, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateAssociatedCourses) DoContext(ctx context.Context, c *canvasapi.Canvas) (*canvasapi.SuccessResponse, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := canvasapi.SuccessResponse{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -27,14 +25,14 @@ import (
 //
 type UpdateBookmark struct {
 	Path struct {
-		ID string `json:"id" url:"id,omitempty"` //  (Required)
+		ID canvasapi.ID `json:"id" url:"id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		Name     string `json:"name" url:"name,omitempty"`         //  (Optional)
-		Url      string `json:"url" url:"url,omitempty"`           //  (Optional)
-		Position int64  `json:"position" url:"position,omitempty"` //  (Optional)
-		Data     string `json:"data" url:"data,omitempty"`         //  (Optional)
+		Name     canvasapi.Opt[string] `json:"name" url:"name,omitempty"`         //  (Optional)
+		Url      canvasapi.Opt[string] `json:"url" url:"url,omitempty"`           //  (Optional)
+		Position canvasapi.Opt[int64]  `json:"position" url:"position,omitempty"` //  (Optional)
+		Data     canvasapi.Opt[string] `json:"data" url:"data,omitempty"`         //  (Optional)
 	} `json:"form"`
 }
 
@@ -44,7 +42,7 @@ func (t *UpdateBookmark) GetMethod() string {
 
 func (t *UpdateBookmark) GetURLPath() string {
 	path := "users/self/bookmarks/{id}"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -53,30 +51,34 @@ func (t *UpdateBookmark) GetQuery() (string, error) {
 }
 
 func (t *UpdateBookmark) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateBookmark) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdateBookmark) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *UpdateBookmark) Do(c *canvasapi.Canvas) (*models.Folder, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateBookmark) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Folder, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -91,6 +93,10 @@ func (t *UpdateBookmark) Do(c *canvasapi.Canvas) (*models.Folder, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,15 +1,15 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // UpdateCalendarEvent Update and return a calendar event
@@ -39,20 +39,20 @@ import (
 //
 type UpdateCalendarEvent struct {
 	Path struct {
-		ID string `json:"id" url:"id,omitempty"` //  (Required)
+		ID canvasapi.ID `json:"id" url:"id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		CalendarEvent struct {
-			ContextCode     string                                       `json:"context_code" url:"context_code,omitempty"`         //  (Optional)
-			Title           string                                       `json:"title" url:"title,omitempty"`                       //  (Optional)
-			Description     string                                       `json:"description" url:"description,omitempty"`           //  (Optional)
-			StartAt         time.Time                                    `json:"start_at" url:"start_at,omitempty"`                 //  (Optional)
-			EndAt           time.Time                                    `json:"end_at" url:"end_at,omitempty"`                     //  (Optional)
-			LocationName    string                                       `json:"location_name" url:"location_name,omitempty"`       //  (Optional)
-			LocationAddress string                                       `json:"location_address" url:"location_address,omitempty"` //  (Optional)
-			TimeZoneEdited  string                                       `json:"time_zone_edited" url:"time_zone_edited,omitempty"` //  (Optional)
-			AllDay          bool                                         `json:"all_day" url:"all_day,omitempty"`                   //  (Optional)
+			ContextCode     canvasapi.Opt[string]                        `json:"context_code" url:"context_code,omitempty"`         //  (Optional)
+			Title           canvasapi.Opt[string]                        `json:"title" url:"title,omitempty"`                       //  (Optional)
+			Description     canvasapi.Opt[string]                        `json:"description" url:"description,omitempty"`           //  (Optional)
+			StartAt         canvasapi.Opt[time.Time]                     `json:"start_at" url:"start_at,omitempty"`                 //  (Optional)
+			EndAt           canvasapi.Opt[time.Time]                     `json:"end_at" url:"end_at,omitempty"`                     //  (Optional)
+			LocationName    canvasapi.Opt[string]                        `json:"location_name" url:"location_name,omitempty"`       //  (Optional)
+			LocationAddress canvasapi.Opt[string]                        `json:"location_address" url:"location_address,omitempty"` //  (Optional)
+			TimeZoneEdited  canvasapi.Opt[string]                        `json:"time_zone_edited" url:"time_zone_edited,omitempty"` //  (Optional)
+			AllDay          canvasapi.Opt[bool]                          `json:"all_day" url:"all_day,omitempty"`                   //  (Optional)
 			ChildEventData  map[string]UpdateCalendarEventChildEventData `json:"child_event_data" url:"child_event_data,omitempty"` //  (Optional)
 		} `json:"calendar_event" url:"calendar_event,omitempty"`
 	} `json:"form"`
@@ -64,7 +64,7 @@ func (t *UpdateCalendarEvent) GetMethod() string {
 
 func (t *UpdateCalendarEvent) GetURLPath() string {
 	path := "calendar_events/{id}"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -73,35 +73,54 @@ func (t *UpdateCalendarEvent) GetQuery() (string, error) {
 }
 
 func (t *UpdateCalendarEvent) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateCalendarEvent) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdateCalendarEvent) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *UpdateCalendarEvent) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *UpdateCalendarEvent) Do(c *canvasapi.Canvas) (*models.CalendarEvent, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateCalendarEvent) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.CalendarEvent, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.CalendarEvent{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
 
 type UpdateCalendarEventChildEventData struct {
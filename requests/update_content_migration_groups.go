@@ -1,14 +1,16 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
+	"github.com/atomicjolt/string_utils"
 )
 
 // UpdateContentMigrationGroups Update a content migration. Takes same arguments as {api:ContentMigrationsController#create create} except that you
@@ -23,11 +25,92 @@ import (
 // # Path.GroupID (Required) ID
 // # Path.ID (Required) ID
 //
+// Form Parameters:
+// # Form.PreAttachment.Name (Optional) Required if uploading a file. This is the first step in uploading a file
+//    to the content migration. See the {file:file_uploads.html File Upload
+//    Documentation} for details on the file upload workflow.
+// # Form.PreAttachment.Size (Optional) The size of the file in bytes. See {file:file_uploads.html File Upload
+//    Documentation}
+// # Form.PreAttachment.ContentType (Optional) The content type of the file. Canvas guesses it from the name
+//    when it is not given.
+// # Form.Settings.FileUrl (Optional) A URL to download the file from. Must not require authentication.
+// # Form.Settings.ContentExportID (Optional) The id of a ContentExport to import. This allows you to import content previously exported from Canvas
+//    without needing to download and re-upload it.
+// # Form.Settings.SourceCourseID (Optional) The course to copy from for a course copy migration. (required if doing
+//    course copy)
+// # Form.Settings.FolderID (Optional) The folder to unzip the .zip file into for a zip_file_import.
+// # Form.Settings.OverwriteQuizzes (Optional) Whether to overwrite quizzes with the same identifiers between content
+//    packages.
+// # Form.Settings.QuestionBankID (Optional) The existing question bank ID to import questions into if not specified in
+//    the content package.
+// # Form.Settings.QuestionBankName (Optional) The question bank to import questions into if not specified in the content
+//    package, if both bank id and name are set, id will take precedence.
+// # Form.Settings.InsertIntoModuleID (Optional) The id of a module in the target course. This will add all imported items
+//    (that can be added to a module) to the given module.
+// # Form.Settings.InsertIntoModuleType (Optional) . Must be one of assignment, discussion_topic, file, page, quizIf provided (and +insert_into_module_id+ is supplied),
+//    only add objects of the specified type to the module.
+// # Form.Settings.InsertIntoModulePosition (Optional) The (1-based) position to insert the imported items into the course
+//    (if +insert_into_module_id+ is supplied). If this parameter
+//    is omitted, items will be added to the end of the module.
+// # Form.Settings.MoveToAssignmentGroupID (Optional) The id of an assignment group in the target course. If provided, all
+//    imported assignments will be moved to the given assignment group.
+// # Form.DateShiftOptions.ShiftDates (Optional) Whether to shift dates in the copied course
+// # Form.DateShiftOptions.OldStartDate (Optional) The original start date of the source content/course
+// # Form.DateShiftOptions.OldEndDate (Optional) The original end date of the source content/course
+// # Form.DateShiftOptions.NewStartDate (Optional) The new start date for the content/course
+// # Form.DateShiftOptions.NewEndDate (Optional) The new end date for the source content/course
+// # Form.DateShiftOptions (Optional) Move anything scheduled for day 'X' to the specified day. (0-Sunday,
+//    1-Monday, 2-Tuesday, 3-Wednesday, 4-Thursday, 5-Friday, 6-Saturday)
+// # Form.DateShiftOptions.RemoveDates (Optional) Whether to remove dates in the copied course. Cannot be used
+//    in conjunction with *shift_dates*.
+// # Form.Copy (Optional) The items to import into a migration that is waiting_for_select. The keys are
+//    the object types of the +property+ of the items listed by the
+//    {api:ContentMigrationsController#content_list List items endpoint}, such as
+//    copy[assignments][id_i310cba275dc3f4aa8a3306bbbe380979]=1, and the values
+//    are maps of item ids to 1. Use copy[all_assignments]=1 to import every item of a type.
+//
 type UpdateContentMigrationGroups struct {
 	Path struct {
-		GroupID string `json:"group_id" url:"group_id,omitempty"` //  (Required)
-		ID      string `json:"id" url:"id,omitempty"`             //  (Required)
+		GroupID canvasapi.ID `json:"group_id" url:"group_id,omitempty"` //  (Required)
+		ID      canvasapi.ID `json:"id" url:"id,omitempty"`             //  (Required)
 	} `json:"path"`
+
+	Form struct {
+		PreAttachment struct {
+			Name        canvasapi.Opt[string] `json:"name" url:"name,omitempty"`                 //  (Optional)
+			Size        canvasapi.Opt[int64]  `json:"size" url:"size,omitempty"`                 //  (Optional)
+			ContentType canvasapi.Opt[string] `json:"content_type" url:"content_type,omitempty"` //  (Optional)
+		} `json:"pre_attachment" url:"pre_attachment,omitempty"`
+
+		Settings struct {
+			FileUrl                  canvasapi.Opt[string] `json:"file_url" url:"file_url,omitempty"`                                       //  (Optional)
+			ContentExportID          canvasapi.Opt[string] `json:"content_export_id" url:"content_export_id,omitempty"`                     //  (Optional)
+			SourceCourseID           canvasapi.Opt[string] `json:"source_course_id" url:"source_course_id,omitempty"`                       //  (Optional)
+			FolderID                 canvasapi.Opt[string] `json:"folder_id" url:"folder_id,omitempty"`                                     //  (Optional)
+			OverwriteQuizzes         canvasapi.Opt[bool]   `json:"overwrite_quizzes" url:"overwrite_quizzes,omitempty"`                     //  (Optional)
+			QuestionBankID           canvasapi.Opt[int64]  `json:"question_bank_id" url:"question_bank_id,omitempty"`                       //  (Optional)
+			QuestionBankName         canvasapi.Opt[string] `json:"question_bank_name" url:"question_bank_name,omitempty"`                   //  (Optional)
+			InsertIntoModuleID       canvasapi.Opt[int64]  `json:"insert_into_module_id" url:"insert_into_module_id,omitempty"`             //  (Optional)
+			InsertIntoModuleType     canvasapi.Opt[string] `json:"insert_into_module_type" url:"insert_into_module_type,omitempty"`         //  (Optional) . Must be one of assignment, discussion_topic, file, page, quiz
+			InsertIntoModulePosition canvasapi.Opt[int64]  `json:"insert_into_module_position" url:"insert_into_module_position,omitempty"` //  (Optional)
+			MoveToAssignmentGroupID  canvasapi.Opt[int64]  `json:"move_to_assignment_group_id" url:"move_to_assignment_group_id,omitempty"` //  (Optional)
+		} `json:"settings" url:"settings,omitempty"`
+
+		DateShiftOptions struct {
+			ShiftDates       canvasapi.Opt[bool]      `json:"shift_dates" url:"shift_dates,omitempty"`       //  (Optional)
+			OldStartDate     canvasapi.Opt[time.Time] `json:"old_start_date" url:"old_start_date,omitempty"` //  (Optional)
+			OldEndDate       canvasapi.Opt[time.Time] `json:"old_end_date" url:"old_end_date,omitempty"`     //  (Optional)
+			NewStartDate     canvasapi.Opt[time.Time] `json:"new_start_date" url:"new_start_date,omitempty"` //  (Optional)
+			NewEndDate       canvasapi.Opt[time.Time] `json:"new_end_date" url:"new_end_date,omitempty"`     //  (Optional)
+			DaySubstitutions struct {
+				X canvasapi.Opt[int64] `json:"x" url:"x,omitempty"` //  (Optional)
+			} `json:"day_substitutions" url:"day_substitutions,omitempty"`
+
+			RemoveDates canvasapi.Opt[bool] `json:"remove_dates" url:"remove_dates,omitempty"` //  (Optional)
+		} `json:"date_shift_options" url:"date_shift_options,omitempty"`
+
+		Copy map[string](interface{}) `json:"copy" url:"copy,omitempty"` //  (Optional)
+	} `json:"form"`
 }
 
 func (t *UpdateContentMigrationGroups) GetMethod() string {
@@ -36,8 +119,8 @@ func (t *UpdateContentMigrationGroups) GetMethod() string {
 
 func (t *UpdateContentMigrationGroups) GetURLPath() string {
 	path := "groups/{group_id}/content_migrations/{id}"
-	path = strings.ReplaceAll(path, "{group_id}", fmt.Sprintf("%v", t.Path.GroupID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{group_id}", t.Path.GroupID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -46,29 +129,40 @@ func (t *UpdateContentMigrationGroups) GetQuery() (string, error) {
 }
 
 func (t *UpdateContentMigrationGroups) GetBody() (url.Values, error) {
-	return nil, nil
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateContentMigrationGroups) GetJSON() ([]byte, error) {
-	return nil, nil
+	j, err := canvasapi.EncodeJSON(t.Form)
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
 }
 
 func (t *UpdateContentMigrationGroups) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.GroupID == "" {
-		errs = append(errs, "'Path.GroupID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.GroupID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
+	}
+	if t.Form.Settings.InsertIntoModuleType.Value() != "" && !string_utils.Include([]string{"assignment", "discussion_topic", "file", "page", "quiz"}, t.Form.Settings.InsertIntoModuleType.Value()) {
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Settings.InsertIntoModuleType", Rule: canvasapi.RuleOneOf, Allowed: []string{"assignment", "discussion_topic", "file", "page", "quiz"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *UpdateContentMigrationGroups) Do(c *canvasapi.Canvas) (*models.ContentMigration, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateContentMigrationGroups) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.ContentMigration, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -83,6 +177,10 @@ func (t *UpdateContentMigrationGroups) Do(c *canvasapi.Canvas) (*models.ContentM
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
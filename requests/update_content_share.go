@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -26,12 +24,12 @@ import (
 //
 type UpdateContentShare struct {
 	Path struct {
-		UserID string `json:"user_id" url:"user_id,omitempty"` //  (Required)
-		ID     string `json:"id" url:"id,omitempty"`           //  (Required)
+		UserID canvasapi.ID `json:"user_id" url:"user_id,omitempty"` //  (Required)
+		ID     canvasapi.ID `json:"id" url:"id,omitempty"`           //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		ReadState string `json:"read_state" url:"read_state,omitempty"` //  (Optional) . Must be one of read, unread
+		ReadState canvasapi.Opt[string] `json:"read_state" url:"read_state,omitempty"` //  (Optional) . Must be one of read, unread
 	} `json:"form"`
 }
 
@@ -41,8 +39,8 @@ func (t *UpdateContentShare) GetMethod() string {
 
 func (t *UpdateContentShare) GetURLPath() string {
 	path := "users/{user_id}/content_shares/{id}"
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", t.Path.UserID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{user_id}", t.Path.UserID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -51,36 +49,40 @@ func (t *UpdateContentShare) GetQuery() (string, error) {
 }
 
 func (t *UpdateContentShare) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateContentShare) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdateContentShare) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.UserID == "" {
-		errs = append(errs, "'Path.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.UserID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
-	if t.Form.ReadState != "" && !string_utils.Include([]string{"read", "unread"}, t.Form.ReadState) {
-		errs = append(errs, "ReadState must be one of read, unread")
+	if t.Form.ReadState.Value() != "" && !string_utils.Include([]string{"read", "unread"}, t.Form.ReadState.Value()) {
+		errs = append(errs, canvasapi.FieldError{Field: "Form.ReadState", Rule: canvasapi.RuleOneOf, Allowed: []string{"read", "unread"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *UpdateContentShare) Do(c *canvasapi.Canvas) (*models.ContentShare, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateContentShare) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.ContentShare, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -95,6 +97,10 @@ func (t *UpdateContentShare) Do(c *canvasapi.Canvas) (*models.ContentShare, erro
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,14 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -123,57 +122,57 @@ import (
 //
 type UpdateCourse struct {
 	Path struct {
-		ID string `json:"id" url:"id,omitempty"` //  (Required)
+		ID canvasapi.ID `json:"id" url:"id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		Course struct {
-			AccountID                            int64                          `json:"account_id" url:"account_id,omitempty"`                                                               //  (Optional)
-			Name                                 string                         `json:"name" url:"name,omitempty"`                                                                           //  (Optional)
-			CourseCode                           string                         `json:"course_code" url:"course_code,omitempty"`                                                             //  (Optional)
-			StartAt                              time.Time                      `json:"start_at" url:"start_at,omitempty"`                                                                   //  (Optional)
-			EndAt                                time.Time                      `json:"end_at" url:"end_at,omitempty"`                                                                       //  (Optional)
-			License                              string                         `json:"license" url:"license,omitempty"`                                                                     //  (Optional)
-			IsPublic                             bool                           `json:"is_public" url:"is_public,omitempty"`                                                                 //  (Optional)
-			IsPublicToAuthUsers                  bool                           `json:"is_public_to_auth_users" url:"is_public_to_auth_users,omitempty"`                                     //  (Optional)
-			PublicSyllabus                       bool                           `json:"public_syllabus" url:"public_syllabus,omitempty"`                                                     //  (Optional)
-			PublicSyllabusToAuth                 bool                           `json:"public_syllabus_to_auth" url:"public_syllabus_to_auth,omitempty"`                                     //  (Optional)
-			PublicDescription                    string                         `json:"public_description" url:"public_description,omitempty"`                                               //  (Optional)
-			AllowStudentWikiEdits                bool                           `json:"allow_student_wiki_edits" url:"allow_student_wiki_edits,omitempty"`                                   //  (Optional)
-			AllowWikiComments                    bool                           `json:"allow_wiki_comments" url:"allow_wiki_comments,omitempty"`                                             //  (Optional)
-			AllowStudentForumAttachments         bool                           `json:"allow_student_forum_attachments" url:"allow_student_forum_attachments,omitempty"`                     //  (Optional)
-			OpenEnrollment                       bool                           `json:"open_enrollment" url:"open_enrollment,omitempty"`                                                     //  (Optional)
-			SelfEnrollment                       bool                           `json:"self_enrollment" url:"self_enrollment,omitempty"`                                                     //  (Optional)
-			RestrictEnrollmentsToCourseDates     bool                           `json:"restrict_enrollments_to_course_dates" url:"restrict_enrollments_to_course_dates,omitempty"`           //  (Optional)
-			TermID                               int64                          `json:"term_id" url:"term_id,omitempty"`                                                                     //  (Optional)
-			SISCourseID                          string                         `json:"sis_course_id" url:"sis_course_id,omitempty"`                                                         //  (Optional)
-			IntegrationID                        string                         `json:"integration_id" url:"integration_id,omitempty"`                                                       //  (Optional)
-			HideFinalGrades                      bool                           `json:"hide_final_grades" url:"hide_final_grades,omitempty"`                                                 //  (Optional)
-			TimeZone                             string                         `json:"time_zone" url:"time_zone,omitempty"`                                                                 //  (Optional)
-			ApplyAssignmentGroupWeights          bool                           `json:"apply_assignment_group_weights" url:"apply_assignment_group_weights,omitempty"`                       //  (Optional)
-			StorageQuotaMb                       int64                          `json:"storage_quota_mb" url:"storage_quota_mb,omitempty"`                                                   //  (Optional)
-			Event                                string                         `json:"event" url:"event,omitempty"`                                                                         //  (Optional) . Must be one of claim, offer, conclude, delete, undelete
-			DefaultView                          string                         `json:"default_view" url:"default_view,omitempty"`                                                           //  (Optional) . Must be one of feed, wiki, modules, syllabus, assignments
-			SyllabusBody                         string                         `json:"syllabus_body" url:"syllabus_body,omitempty"`                                                         //  (Optional)
-			SyllabusCourseSummary                bool                           `json:"syllabus_course_summary" url:"syllabus_course_summary,omitempty"`                                     //  (Optional)
-			GradingStandardID                    int64                          `json:"grading_standard_id" url:"grading_standard_id,omitempty"`                                             //  (Optional)
-			GradePassbackSetting                 string                         `json:"grade_passback_setting" url:"grade_passback_setting,omitempty"`                                       //  (Optional)
-			CourseFormat                         string                         `json:"course_format" url:"course_format,omitempty"`                                                         //  (Optional)
-			ImageID                              int64                          `json:"image_id" url:"image_id,omitempty"`                                                                   //  (Optional)
-			ImageUrl                             string                         `json:"image_url" url:"image_url,omitempty"`                                                                 //  (Optional)
-			RemoveImage                          bool                           `json:"remove_image" url:"remove_image,omitempty"`                                                           //  (Optional)
-			Blueprint                            bool                           `json:"blueprint" url:"blueprint,omitempty"`                                                                 //  (Optional)
+			AccountID                            canvasapi.Opt[int64]           `json:"account_id" url:"account_id,omitempty"`                                                               //  (Optional)
+			Name                                 canvasapi.Opt[string]          `json:"name" url:"name,omitempty"`                                                                           //  (Optional)
+			CourseCode                           canvasapi.Opt[string]          `json:"course_code" url:"course_code,omitempty"`                                                             //  (Optional)
+			StartAt                              canvasapi.Opt[time.Time]       `json:"start_at" url:"start_at,omitempty"`                                                                   //  (Optional)
+			EndAt                                canvasapi.Opt[time.Time]       `json:"end_at" url:"end_at,omitempty"`                                                                       //  (Optional)
+			License                              canvasapi.Opt[string]          `json:"license" url:"license,omitempty"`                                                                     //  (Optional)
+			IsPublic                             canvasapi.Opt[bool]            `json:"is_public" url:"is_public,omitempty"`                                                                 //  (Optional)
+			IsPublicToAuthUsers                  canvasapi.Opt[bool]            `json:"is_public_to_auth_users" url:"is_public_to_auth_users,omitempty"`                                     //  (Optional)
+			PublicSyllabus                       canvasapi.Opt[bool]            `json:"public_syllabus" url:"public_syllabus,omitempty"`                                                     //  (Optional)
+			PublicSyllabusToAuth                 canvasapi.Opt[bool]            `json:"public_syllabus_to_auth" url:"public_syllabus_to_auth,omitempty"`                                     //  (Optional)
+			PublicDescription                    canvasapi.Opt[string]          `json:"public_description" url:"public_description,omitempty"`                                               //  (Optional)
+			AllowStudentWikiEdits                canvasapi.Opt[bool]            `json:"allow_student_wiki_edits" url:"allow_student_wiki_edits,omitempty"`                                   //  (Optional)
+			AllowWikiComments                    canvasapi.Opt[bool]            `json:"allow_wiki_comments" url:"allow_wiki_comments,omitempty"`                                             //  (Optional)
+			AllowStudentForumAttachments         canvasapi.Opt[bool]            `json:"allow_student_forum_attachments" url:"allow_student_forum_attachments,omitempty"`                     //  (Optional)
+			OpenEnrollment                       canvasapi.Opt[bool]            `json:"open_enrollment" url:"open_enrollment,omitempty"`                                                     //  (Optional)
+			SelfEnrollment                       canvasapi.Opt[bool]            `json:"self_enrollment" url:"self_enrollment,omitempty"`                                                     //  (Optional)
+			RestrictEnrollmentsToCourseDates     canvasapi.Opt[bool]            `json:"restrict_enrollments_to_course_dates" url:"restrict_enrollments_to_course_dates,omitempty"`           //  (Optional)
+			TermID                               canvasapi.Opt[int64]           `json:"term_id" url:"term_id,omitempty"`                                                                     //  (Optional)
+			SISCourseID                          canvasapi.Opt[string]          `json:"sis_course_id" url:"sis_course_id,omitempty"`                                                         //  (Optional)
+			IntegrationID                        canvasapi.Opt[string]          `json:"integration_id" url:"integration_id,omitempty"`                                                       //  (Optional)
+			HideFinalGrades                      canvasapi.Opt[bool]            `json:"hide_final_grades" url:"hide_final_grades,omitempty"`                                                 //  (Optional)
+			TimeZone                             canvasapi.Opt[string]          `json:"time_zone" url:"time_zone,omitempty"`                                                                 //  (Optional)
+			ApplyAssignmentGroupWeights          canvasapi.Opt[bool]            `json:"apply_assignment_group_weights" url:"apply_assignment_group_weights,omitempty"`                       //  (Optional)
+			StorageQuotaMb                       canvasapi.Opt[int64]           `json:"storage_quota_mb" url:"storage_quota_mb,omitempty"`                                                   //  (Optional)
+			Event                                canvasapi.Opt[string]          `json:"event" url:"event,omitempty"`                                                                         //  (Optional) . Must be one of claim, offer, conclude, delete, undelete
+			DefaultView                          canvasapi.Opt[string]          `json:"default_view" url:"default_view,omitempty"`                                                           //  (Optional) . Must be one of feed, wiki, modules, syllabus, assignments
+			SyllabusBody                         canvasapi.Opt[string]          `json:"syllabus_body" url:"syllabus_body,omitempty"`                                                         //  (Optional)
+			SyllabusCourseSummary                canvasapi.Opt[bool]            `json:"syllabus_course_summary" url:"syllabus_course_summary,omitempty"`                                     //  (Optional)
+			GradingStandardID                    canvasapi.Opt[int64]           `json:"grading_standard_id" url:"grading_standard_id,omitempty"`                                             //  (Optional)
+			GradePassbackSetting                 canvasapi.Opt[string]          `json:"grade_passback_setting" url:"grade_passback_setting,omitempty"`                                       //  (Optional)
+			CourseFormat                         canvasapi.Opt[string]          `json:"course_format" url:"course_format,omitempty"`                                                         //  (Optional)
+			ImageID                              canvasapi.Opt[int64]           `json:"image_id" url:"image_id,omitempty"`                                                                   //  (Optional)
+			ImageUrl                             canvasapi.Opt[string]          `json:"image_url" url:"image_url,omitempty"`                                                                 //  (Optional)
+			RemoveImage                          canvasapi.Opt[bool]            `json:"remove_image" url:"remove_image,omitempty"`                                                           //  (Optional)
+			Blueprint                            canvasapi.Opt[bool]            `json:"blueprint" url:"blueprint,omitempty"`                                                                 //  (Optional)
 			BlueprintRestrictions                *models.BlueprintRestriction   `json:"blueprint_restrictions" url:"blueprint_restrictions,omitempty"`                                       //  (Optional)
-			UseBlueprintRestrictionsByObjectType bool                           `json:"use_blueprint_restrictions_by_object_type" url:"use_blueprint_restrictions_by_object_type,omitempty"` //  (Optional)
+			UseBlueprintRestrictionsByObjectType canvasapi.Opt[bool]            `json:"use_blueprint_restrictions_by_object_type" url:"use_blueprint_restrictions_by_object_type,omitempty"` //  (Optional)
 			BlueprintRestrictionsByObjectType    []*models.BlueprintRestriction `json:"blueprint_restrictions_by_object_type" url:"blueprint_restrictions_by_object_type,omitempty"`         //  (Optional)
-			HomeroomCourse                       bool                           `json:"homeroom_course" url:"homeroom_course,omitempty"`                                                     //  (Optional)
-			SyncEnrollmentsFromHomeroom          string                         `json:"sync_enrollments_from_homeroom" url:"sync_enrollments_from_homeroom,omitempty"`                       //  (Optional)
-			HomeroomCourseID                     string                         `json:"homeroom_course_id" url:"homeroom_course_id,omitempty"`                                               //  (Optional)
-			Template                             bool                           `json:"template" url:"template,omitempty"`                                                                   //  (Optional)
-			CourseColor                          string                         `json:"course_color" url:"course_color,omitempty"`                                                           //  (Optional)
+			HomeroomCourse                       canvasapi.Opt[bool]            `json:"homeroom_course" url:"homeroom_course,omitempty"`                                                     //  (Optional)
+			SyncEnrollmentsFromHomeroom          canvasapi.Opt[string]          `json:"sync_enrollments_from_homeroom" url:"sync_enrollments_from_homeroom,omitempty"`                       //  (Optional)
+			HomeroomCourseID                     canvasapi.Opt[string]          `json:"homeroom_course_id" url:"homeroom_course_id,omitempty"`                                               //  (Optional)
+			Template                             canvasapi.Opt[bool]            `json:"template" url:"template,omitempty"`                                                                   //  (Optional)
+			CourseColor                          canvasapi.Opt[string]          `json:"course_color" url:"course_color,omitempty"`                                                           //  (Optional)
 		} `json:"course" url:"course,omitempty"`
 
-		Offer bool `json:"offer" url:"offer,omitempty"` //  (Optional)
+		Offer canvasapi.Opt[bool] `json:"offer" url:"offer,omitempty"` //  (Optional)
 	} `json:"form"`
 }
 
@@ -183,7 +182,7 @@ func (t *UpdateCourse) GetMethod() string {
 
 func (t *UpdateCourse) GetURLPath() string {
 	path := "courses/{id}"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -192,39 +191,58 @@ func (t *UpdateCourse) GetQuery() (string, error) {
 }
 
 func (t *UpdateCourse) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateCourse) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdateCourse) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
-	if t.Form.Course.Event != "" && !string_utils.Include([]string{"claim", "offer", "conclude", "delete", "undelete"}, t.Form.Course.Event) {
-		errs = append(errs, "Course must be one of claim, offer, conclude, delete, undelete")
+	if t.Form.Course.Event.Value() != "" && !string_utils.Include([]string{"claim", "offer", "conclude", "delete", "undelete"}, t.Form.Course.Event.Value()) {
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Course.Event", Rule: canvasapi.RuleOneOf, Allowed: []string{"claim", "offer", "conclude", "delete", "undelete"}})
 	}
-	if t.Form.Course.DefaultView != "" && !string_utils.Include([]string{"feed", "wiki", "modules", "syllabus", "assignments"}, t.Form.Course.DefaultView) {
-		errs = append(errs, "Course must be one of feed, wiki, modules, syllabus, assignments")
+	if t.Form.Course.DefaultView.Value() != "" && !string_utils.Include([]string{"feed", "wiki", "modules", "syllabus", "assignments"}, t.Form.Course.DefaultView.Value()) {
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Course.DefaultView", Rule: canvasapi.RuleOneOf, Allowed: []string{"feed", "wiki", "modules", "syllabus", "assignments"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *UpdateCourse) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *UpdateCourse) Do(c *canvasapi.Canvas) (*models.Course, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateCourse) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Course, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.Course{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,13 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 )
 
@@ -37,25 +36,25 @@ import (
 //
 type UpdateCourseSettings struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		AllowStudentDiscussionTopics    bool  `json:"allow_student_discussion_topics" url:"allow_student_discussion_topics,omitempty"`           //  (Optional)
-		AllowStudentForumAttachments    bool  `json:"allow_student_forum_attachments" url:"allow_student_forum_attachments,omitempty"`           //  (Optional)
-		AllowStudentDiscussionEditing   bool  `json:"allow_student_discussion_editing" url:"allow_student_discussion_editing,omitempty"`         //  (Optional)
-		AllowStudentOrganizedGroups     bool  `json:"allow_student_organized_groups" url:"allow_student_organized_groups,omitempty"`             //  (Optional)
-		FilterSpeedGraderByStudentGroup bool  `json:"filter_speed_grader_by_student_group" url:"filter_speed_grader_by_student_group,omitempty"` //  (Optional)
-		HideFinalGrades                 bool  `json:"hide_final_grades" url:"hide_final_grades,omitempty"`                                       //  (Optional)
-		HideDistributionGraphs          bool  `json:"hide_distribution_graphs" url:"hide_distribution_graphs,omitempty"`                         //  (Optional)
-		HideSectionsOnCourseUsersPage   bool  `json:"hide_sections_on_course_users_page" url:"hide_sections_on_course_users_page,omitempty"`     //  (Optional)
-		LockAllAnnouncements            bool  `json:"lock_all_announcements" url:"lock_all_announcements,omitempty"`                             //  (Optional)
-		UsageRightsRequired             bool  `json:"usage_rights_required" url:"usage_rights_required,omitempty"`                               //  (Optional)
-		RestrictStudentPastView         bool  `json:"restrict_student_past_view" url:"restrict_student_past_view,omitempty"`                     //  (Optional)
-		RestrictStudentFutureView       bool  `json:"restrict_student_future_view" url:"restrict_student_future_view,omitempty"`                 //  (Optional)
-		ShowAnnouncementsOnHomePage     bool  `json:"show_announcements_on_home_page" url:"show_announcements_on_home_page,omitempty"`           //  (Optional)
-		HomePageAnnouncementLimit       int64 `json:"home_page_announcement_limit" url:"home_page_announcement_limit,omitempty"`                 //  (Optional)
-		SyllabusCourseSummary           bool  `json:"syllabus_course_summary" url:"syllabus_course_summary,omitempty"`                           //  (Optional)
+		AllowStudentDiscussionTopics    canvasapi.Opt[bool]  `json:"allow_student_discussion_topics" url:"allow_student_discussion_topics,omitempty"`           //  (Optional)
+		AllowStudentForumAttachments    canvasapi.Opt[bool]  `json:"allow_student_forum_attachments" url:"allow_student_forum_attachments,omitempty"`           //  (Optional)
+		AllowStudentDiscussionEditing   canvasapi.Opt[bool]  `json:"allow_student_discussion_editing" url:"allow_student_discussion_editing,omitempty"`         //  (Optional)
+		AllowStudentOrganizedGroups     canvasapi.Opt[bool]  `json:"allow_student_organized_groups" url:"allow_student_organized_groups,omitempty"`             //  (Optional)
+		FilterSpeedGraderByStudentGroup canvasapi.Opt[bool]  `json:"filter_speed_grader_by_student_group" url:"filter_speed_grader_by_student_group,omitempty"` //  (Optional)
+		HideFinalGrades                 canvasapi.Opt[bool]  `json:"hide_final_grades" url:"hide_final_grades,omitempty"`                                       //  (Optional)
+		HideDistributionGraphs          canvasapi.Opt[bool]  `json:"hide_distribution_graphs" url:"hide_distribution_graphs,omitempty"`                         //  (Optional)
+		HideSectionsOnCourseUsersPage   canvasapi.Opt[bool]  `json:"hide_sections_on_course_users_page" url:"hide_sections_on_course_users_page,omitempty"`     //  (Optional)
+		LockAllAnnouncements            canvasapi.Opt[bool]  `json:"lock_all_announcements" url:"lock_all_announcements,omitempty"`                             //  (Optional)
+		UsageRightsRequired             canvasapi.Opt[bool]  `json:"usage_rights_required" url:"usage_rights_required,omitempty"`                               //  (Optional)
+		RestrictStudentPastView         canvasapi.Opt[bool]  `json:"restrict_student_past_view" url:"restrict_student_past_view,omitempty"`                     //  (Optional)
+		RestrictStudentFutureView       canvasapi.Opt[bool]  `json:"restrict_student_future_view" url:"restrict_student_future_view,omitempty"`                 //  (Optional)
+		ShowAnnouncementsOnHomePage     canvasapi.Opt[bool]  `json:"show_announcements_on_home_page" url:"show_announcements_on_home_page,omitempty"`           //  (Optional)
+		HomePageAnnouncementLimit       canvasapi.Opt[int64] `json:"home_page_announcement_limit" url:"home_page_announcement_limit,omitempty"`                 //  (Optional)
+		SyllabusCourseSummary           canvasapi.Opt[bool]  `json:"syllabus_course_summary" url:"syllabus_course_summary,omitempty"`                           //  (Optional)
 	} `json:"form"`
 }
 
@@ -65,7 +64,7 @@ func (t *UpdateCourseSettings) GetMethod() string {
 
 func (t *UpdateCourseSettings) GetURLPath() string {
 	path := "courses/{course_id}/settings"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
@@ -74,33 +73,43 @@ func (t *UpdateCourseSettings) GetQuery() (string, error) {
 }
 
 func (t *UpdateCourseSettings) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateCourseSettings) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdateCourseSettings) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *UpdateCourseSettings) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *UpdateCourseSettings) Do(c *canvasapi.Canvas) (json.RawMessage, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateCourseSettings) DoContext(ctx context.Context, c *canvasapi.Canvas) (json.RawMessage, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(body), nil
 }
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -37,7 +35,7 @@ import (
 //
 type UpdateCourses struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -52,7 +50,7 @@ func (t *UpdateCourses) GetMethod() string {
 
 func (t *UpdateCourses) GetURLPath() string {
 	path := "accounts/{account_id}/courses"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
 	return path
 }
 
@@ -61,39 +59,43 @@ func (t *UpdateCourses) GetQuery() (string, error) {
 }
 
 func (t *UpdateCourses) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateCourses) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdateCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.CourseIDs == nil {
-		errs = append(errs, "'Form.CourseIDs' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.CourseIDs", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Event == "" {
-		errs = append(errs, "'Form.Event' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Event", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.Event != "" && !string_utils.Include([]string{"offer", "conclude", "delete", "undelete"}, t.Form.Event) {
-		errs = append(errs, "Event must be one of offer, conclude, delete, undelete")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Event", Rule: canvasapi.RuleOneOf, Allowed: []string{"offer", "conclude", "delete", "undelete"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *UpdateCourses) Do(c *canvasapi.Canvas) (*models.Progress, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateCourses) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Progress, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -108,6 +110,10 @@ func (t *UpdateCourses) Do(c *canvasapi.Canvas) (*models.Progress, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -36,16 +34,16 @@ import (
 //
 type UpdateCreateFrontPageCourses struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		WikiPage struct {
-			Title          string `json:"title" url:"title,omitempty"`                       //  (Optional)
-			Body           string `json:"body" url:"body,omitempty"`                         //  (Optional)
-			EditingRoles   string `json:"editing_roles" url:"editing_roles,omitempty"`       //  (Optional) . Must be one of teachers, students, members, public
-			NotifyOfUpdate bool   `json:"notify_of_update" url:"notify_of_update,omitempty"` //  (Optional)
-			Published      bool   `json:"published" url:"published,omitempty"`               //  (Optional)
+			Title          canvasapi.Opt[string] `json:"title" url:"title,omitempty"`                       //  (Optional)
+			Body           canvasapi.Opt[string] `json:"body" url:"body,omitempty"`                         //  (Optional)
+			EditingRoles   canvasapi.Opt[string] `json:"editing_roles" url:"editing_roles,omitempty"`       //  (Optional) . Must be one of teachers, students, members, public
+			NotifyOfUpdate canvasapi.Opt[bool]   `json:"notify_of_update" url:"notify_of_update,omitempty"` //  (Optional)
+			Published      canvasapi.Opt[bool]   `json:"published" url:"published,omitempty"`               //  (Optional)
 		} `json:"wiki_page" url:"wiki_page,omitempty"`
 	} `json:"form"`
 }
@@ -56,7 +54,7 @@ func (t *UpdateCreateFrontPageCourses) GetMethod() string {
 
 func (t *UpdateCreateFrontPageCourses) GetURLPath() string {
 	path := "courses/{course_id}/front_page"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	return path
 }
 
@@ -65,33 +63,37 @@ func (t *UpdateCreateFrontPageCourses) GetQuery() (string, error) {
 }
 
 func (t *UpdateCreateFrontPageCourses) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateCreateFrontPageCourses) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdateCreateFrontPageCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
-	if t.Form.WikiPage.EditingRoles != "" && !string_utils.Include([]string{"teachers", "students", "members", "public"}, t.Form.WikiPage.EditingRoles) {
-		errs = append(errs, "WikiPage must be one of teachers, students, members, public")
+	if t.Form.WikiPage.EditingRoles.Value() != "" && !string_utils.Include([]string{"teachers", "students", "members", "public"}, t.Form.WikiPage.EditingRoles.Value()) {
+		errs = append(errs, canvasapi.FieldError{Field: "Form.WikiPage.EditingRoles", Rule: canvasapi.RuleOneOf, Allowed: []string{"teachers", "students", "members", "public"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *UpdateCreateFrontPageCourses) Do(c *canvasapi.Canvas) (*models.Page, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateCreateFrontPageCourses) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Page, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -106,6 +108,10 @@ func (t *UpdateCreateFrontPageCourses) Do(c *canvasapi.Canvas) (*models.Page, er
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
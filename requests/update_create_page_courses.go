@@ -1,14 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -38,18 +37,18 @@ import (
 //
 type UpdateCreatePageCourses struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		Url      string `json:"url" url:"url,omitempty"`             //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		Url      string       `json:"url" url:"url,omitempty"`             //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		WikiPage struct {
-			Title          string `json:"title" url:"title,omitempty"`                       //  (Optional)
-			Body           string `json:"body" url:"body,omitempty"`                         //  (Optional)
-			EditingRoles   string `json:"editing_roles" url:"editing_roles,omitempty"`       //  (Optional) . Must be one of teachers, students, members, public
-			NotifyOfUpdate bool   `json:"notify_of_update" url:"notify_of_update,omitempty"` //  (Optional)
-			Published      bool   `json:"published" url:"published,omitempty"`               //  (Optional)
-			FrontPage      bool   `json:"front_page" url:"front_page,omitempty"`             //  (Optional)
+			Title          canvasapi.Opt[string] `json:"title" url:"title,omitempty"`                       //  (Optional)
+			Body           canvasapi.Opt[string] `json:"body" url:"body,omitempty"`                         //  (Optional)
+			EditingRoles   canvasapi.Opt[string] `json:"editing_roles" url:"editing_roles,omitempty"`       //  (Optional) . Must be one of teachers, students, members, public
+			NotifyOfUpdate canvasapi.Opt[bool]   `json:"notify_of_update" url:"notify_of_update,omitempty"` //  (Optional)
+			Published      canvasapi.Opt[bool]   `json:"published" url:"published,omitempty"`               //  (Optional)
+			FrontPage      canvasapi.Opt[bool]   `json:"front_page" url:"front_page,omitempty"`             //  (Optional)
 		} `json:"wiki_page" url:"wiki_page,omitempty"`
 	} `json:"form"`
 }
@@ -60,7 +59,7 @@ func (t *UpdateCreatePageCourses) GetMethod() string {
 
 func (t *UpdateCreatePageCourses) GetURLPath() string {
 	path := "courses/{course_id}/pages/{url}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
 	path = strings.ReplaceAll(path, "{url}", fmt.Sprintf("%v", t.Path.Url))
 	return path
 }
@@ -70,36 +69,40 @@ func (t *UpdateCreatePageCourses) GetQuery() (string, error) {
 }
 
 func (t *UpdateCreatePageCourses) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateCreatePageCourses) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdateCreatePageCourses) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.Url == "" {
-		errs = append(errs, "'Path.Url' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Url", Rule: canvasapi.RuleRequired})
 	}
-	if t.Form.WikiPage.EditingRoles != "" && !string_utils.Include([]string{"teachers", "students", "members", "public"}, t.Form.WikiPage.EditingRoles) {
-		errs = append(errs, "WikiPage must be one of teachers, students, members, public")
+	if t.Form.WikiPage.EditingRoles.Value() != "" && !string_utils.Include([]string{"teachers", "students", "members", "public"}, t.Form.WikiPage.EditingRoles.Value()) {
+		errs = append(errs, canvasapi.FieldError{Field: "Form.WikiPage.EditingRoles", Rule: canvasapi.RuleOneOf, Allowed: []string{"teachers", "students", "members", "public"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *UpdateCreatePageCourses) Do(c *canvasapi.Canvas) (*models.Page, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateCreatePageCourses) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Page, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -114,6 +117,10 @@ func (t *UpdateCreatePageCourses) Do(c *canvasapi.Canvas) (*models.Page, error)
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,14 +1,15 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // UpdateCustomColor Updates a custom color for a user for a given context.  This allows
@@ -29,12 +30,12 @@ import (
 //
 type UpdateCustomColor struct {
 	Path struct {
-		ID          string `json:"id" url:"id,omitempty"`                     //  (Required)
-		AssetString string `json:"asset_string" url:"asset_string,omitempty"` //  (Required)
+		ID          canvasapi.ID `json:"id" url:"id,omitempty"`                     //  (Required)
+		AssetString string       `json:"asset_string" url:"asset_string,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		Hexcode string `json:"hexcode" url:"hexcode,omitempty"` //  (Optional)
+		Hexcode canvasapi.Opt[string] `json:"hexcode" url:"hexcode,omitempty"` //  (Optional)
 	} `json:"form"`
 }
 
@@ -44,7 +45,7 @@ func (t *UpdateCustomColor) GetMethod() string {
 
 func (t *UpdateCustomColor) GetURLPath() string {
 	path := "users/{id}/colors/{asset_string}"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	path = strings.ReplaceAll(path, "{asset_string}", fmt.Sprintf("%v", t.Path.AssetString))
 	return path
 }
@@ -54,36 +55,55 @@ func (t *UpdateCustomColor) GetQuery() (string, error) {
 }
 
 func (t *UpdateCustomColor) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateCustomColor) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdateCustomColor) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.AssetString == "" {
-		errs = append(errs, "'Path.AssetString' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AssetString", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *UpdateCustomColor) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *UpdateCustomColor) Do(c *canvasapi.Canvas) (*models.CustomColor, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateCustomColor) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.CustomColor, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.CustomColor{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
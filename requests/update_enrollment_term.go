@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -35,20 +33,20 @@ import (
 //
 type UpdateEnrollmentTerm struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
-		ID        string `json:"id" url:"id,omitempty"`                 //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		ID        canvasapi.ID `json:"id" url:"id,omitempty"`                 //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		EnrollmentTerm struct {
-			Name      string    `json:"name" url:"name,omitempty"`               //  (Optional)
-			StartAt   time.Time `json:"start_at" url:"start_at,omitempty"`       //  (Optional)
-			EndAt     time.Time `json:"end_at" url:"end_at,omitempty"`           //  (Optional)
-			SISTermID string    `json:"sis_term_id" url:"sis_term_id,omitempty"` //  (Optional)
+			Name      canvasapi.Opt[string]    `json:"name" url:"name,omitempty"`               //  (Optional)
+			StartAt   canvasapi.Opt[time.Time] `json:"start_at" url:"start_at,omitempty"`       //  (Optional)
+			EndAt     canvasapi.Opt[time.Time] `json:"end_at" url:"end_at,omitempty"`           //  (Optional)
+			SISTermID canvasapi.Opt[string]    `json:"sis_term_id" url:"sis_term_id,omitempty"` //  (Optional)
 			Overrides struct {
 				EnrollmentType struct {
-					StartAt time.Time `json:"start_at" url:"start_at,omitempty"` //  (Optional)
-					EndAt   time.Time `json:"end_at" url:"end_at,omitempty"`     //  (Optional)
+					StartAt canvasapi.Opt[time.Time] `json:"start_at" url:"start_at,omitempty"` //  (Optional)
+					EndAt   canvasapi.Opt[time.Time] `json:"end_at" url:"end_at,omitempty"`     //  (Optional)
 				} `json:"enrollment_type" url:"enrollment_type,omitempty"`
 			} `json:"overrides" url:"overrides,omitempty"`
 		} `json:"enrollment_term" url:"enrollment_term,omitempty"`
@@ -61,8 +59,8 @@ func (t *UpdateEnrollmentTerm) GetMethod() string {
 
 func (t *UpdateEnrollmentTerm) GetURLPath() string {
 	path := "accounts/{account_id}/terms/{id}"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -71,33 +69,37 @@ func (t *UpdateEnrollmentTerm) GetQuery() (string, error) {
 }
 
 func (t *UpdateEnrollmentTerm) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateEnrollmentTerm) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdateEnrollmentTerm) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *UpdateEnrollmentTerm) Do(c *canvasapi.Canvas) (*models.EnrollmentTerm, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateEnrollmentTerm) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.EnrollmentTerm, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -112,6 +114,10 @@ func (t *UpdateEnrollmentTerm) Do(c *canvasapi.Canvas) (*models.EnrollmentTerm,
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
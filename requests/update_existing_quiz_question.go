@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -37,24 +35,24 @@ import (
 //
 type UpdateExistingQuizQuestion struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		QuizID   int64  `json:"quiz_id" url:"quiz_id,omitempty"`     //  (Required)
-		ID       int64  `json:"id" url:"id,omitempty"`               //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		QuizID   canvasapi.ID `json:"quiz_id" url:"quiz_id,omitempty"`     //  (Required)
+		ID       canvasapi.ID `json:"id" url:"id,omitempty"`               //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		Question struct {
-			QuestionName      string           `json:"question_name" url:"question_name,omitempty"`           //  (Optional)
-			QuestionText      string           `json:"question_text" url:"question_text,omitempty"`           //  (Optional)
-			QuizGroupID       int64            `json:"quiz_group_id" url:"quiz_group_id,omitempty"`           //  (Optional)
-			QuestionType      string           `json:"question_type" url:"question_type,omitempty"`           //  (Optional) . Must be one of calculated_question, essay_question, file_upload_question, fill_in_multiple_blanks_question, matching_question, multiple_answers_question, multiple_choice_question, multiple_dropdowns_question, numerical_question, short_answer_question, text_only_question, true_false_question
-			Position          int64            `json:"position" url:"position,omitempty"`                     //  (Optional)
-			PointsPossible    float64          `json:"points_possible" url:"points_possible,omitempty"`       //  (Optional)
-			CorrectComments   string           `json:"correct_comments" url:"correct_comments,omitempty"`     //  (Optional)
-			IncorrectComments string           `json:"incorrect_comments" url:"incorrect_comments,omitempty"` //  (Optional)
-			NeutralComments   string           `json:"neutral_comments" url:"neutral_comments,omitempty"`     //  (Optional)
-			TextAfterAnswers  string           `json:"text_after_answers" url:"text_after_answers,omitempty"` //  (Optional)
-			Answers           []*models.Answer `json:"answers" url:"answers,omitempty"`                       //  (Optional)
+			QuestionName      canvasapi.Opt[string]  `json:"question_name" url:"question_name,omitempty"`           //  (Optional)
+			QuestionText      canvasapi.Opt[string]  `json:"question_text" url:"question_text,omitempty"`           //  (Optional)
+			QuizGroupID       canvasapi.Opt[int64]   `json:"quiz_group_id" url:"quiz_group_id,omitempty"`           //  (Optional)
+			QuestionType      canvasapi.Opt[string]  `json:"question_type" url:"question_type,omitempty"`           //  (Optional) . Must be one of calculated_question, essay_question, file_upload_question, fill_in_multiple_blanks_question, matching_question, multiple_answers_question, multiple_choice_question, multiple_dropdowns_question, numerical_question, short_answer_question, text_only_question, true_false_question
+			Position          canvasapi.Opt[int64]   `json:"position" url:"position,omitempty"`                     //  (Optional)
+			PointsPossible    canvasapi.Opt[float64] `json:"points_possible" url:"points_possible,omitempty"`       //  (Optional)
+			CorrectComments   canvasapi.Opt[string]  `json:"correct_comments" url:"correct_comments,omitempty"`     //  (Optional)
+			IncorrectComments canvasapi.Opt[string]  `json:"incorrect_comments" url:"incorrect_comments,omitempty"` //  (Optional)
+			NeutralComments   canvasapi.Opt[string]  `json:"neutral_comments" url:"neutral_comments,omitempty"`     //  (Optional)
+			TextAfterAnswers  canvasapi.Opt[string]  `json:"text_after_answers" url:"text_after_answers,omitempty"` //  (Optional)
+			Answers           []*models.Answer       `json:"answers" url:"answers,omitempty"`                       //  (Optional)
 		} `json:"question" url:"question,omitempty"`
 	} `json:"form"`
 }
@@ -65,9 +63,9 @@ func (t *UpdateExistingQuizQuestion) GetMethod() string {
 
 func (t *UpdateExistingQuizQuestion) GetURLPath() string {
 	path := "courses/{course_id}/quizzes/{quiz_id}/questions/{id}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{quiz_id}", fmt.Sprintf("%v", t.Path.QuizID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{quiz_id}", t.Path.QuizID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -76,33 +74,43 @@ func (t *UpdateExistingQuizQuestion) GetQuery() (string, error) {
 }
 
 func (t *UpdateExistingQuizQuestion) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateExistingQuizQuestion) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdateExistingQuizQuestion) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
+	if t.Path.QuizID == "" {
+		errs = append(errs, canvasapi.FieldError{Field: "Path.QuizID", Rule: canvasapi.RuleRequired})
+	}
+	if t.Path.ID == "" {
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
+	}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
-	if t.Form.Question.QuestionType != "" && !string_utils.Include([]string{"calculated_question", "essay_question", "file_upload_question", "fill_in_multiple_blanks_question", "matching_question", "multiple_answers_question", "multiple_choice_question", "multiple_dropdowns_question", "numerical_question", "short_answer_question", "text_only_question", "true_false_question"}, t.Form.Question.QuestionType) {
-		errs = append(errs, "Question must be one of calculated_question, essay_question, file_upload_question, fill_in_multiple_blanks_question, matching_question, multiple_answers_question, multiple_choice_question, multiple_dropdowns_question, numerical_question, short_answer_question, text_only_question, true_false_question")
+	if t.Form.Question.QuestionType.Value() != "" && !string_utils.Include([]string{"calculated_question", "essay_question", "file_upload_question", "fill_in_multiple_blanks_question", "matching_question", "multiple_answers_question", "multiple_choice_question", "multiple_dropdowns_question", "numerical_question", "short_answer_question", "text_only_question", "true_false_question"}, t.Form.Question.QuestionType.Value()) {
+		errs = append(errs, canvasapi.FieldError{Field: "Form.Question.QuestionType", Rule: canvasapi.RuleOneOf, Allowed: []string{"calculated_question", "essay_question", "file_upload_question", "fill_in_multiple_blanks_question", "matching_question", "multiple_answers_question", "multiple_choice_question", "multiple_dropdowns_question", "numerical_question", "short_answer_question", "text_only_question", "true_false_question"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *UpdateExistingQuizQuestion) Do(c *canvasapi.Canvas) (*models.QuizQuestion, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateExistingQuizQuestion) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.QuizQuestion, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -117,6 +125,10 @@ func (t *UpdateExistingQuizQuestion) Do(c *canvasapi.Canvas) (*models.QuizQuesti
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
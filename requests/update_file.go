@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -39,17 +37,17 @@ import (
 //
 type UpdateFile struct {
 	Path struct {
-		ID string `json:"id" url:"id,omitempty"` //  (Required)
+		ID canvasapi.ID `json:"id" url:"id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		Name           string    `json:"name" url:"name,omitempty"`                         //  (Optional)
-		ParentFolderID string    `json:"parent_folder_id" url:"parent_folder_id,omitempty"` //  (Optional)
-		OnDuplicate    string    `json:"on_duplicate" url:"on_duplicate,omitempty"`         //  (Optional) . Must be one of overwrite, rename
-		LockAt         time.Time `json:"lock_at" url:"lock_at,omitempty"`                   //  (Optional)
-		UnlockAt       time.Time `json:"unlock_at" url:"unlock_at,omitempty"`               //  (Optional)
-		Locked         bool      `json:"locked" url:"locked,omitempty"`                     //  (Optional)
-		Hidden         bool      `json:"hidden" url:"hidden,omitempty"`                     //  (Optional)
+		Name           canvasapi.Opt[string]    `json:"name" url:"name,omitempty"`                         //  (Optional)
+		ParentFolderID canvasapi.Opt[string]    `json:"parent_folder_id" url:"parent_folder_id,omitempty"` //  (Optional)
+		OnDuplicate    canvasapi.Opt[string]    `json:"on_duplicate" url:"on_duplicate,omitempty"`         //  (Optional) . Must be one of overwrite, rename
+		LockAt         canvasapi.Opt[time.Time] `json:"lock_at" url:"lock_at,omitempty"`                   //  (Optional)
+		UnlockAt       canvasapi.Opt[time.Time] `json:"unlock_at" url:"unlock_at,omitempty"`               //  (Optional)
+		Locked         canvasapi.Opt[bool]      `json:"locked" url:"locked,omitempty"`                     //  (Optional)
+		Hidden         canvasapi.Opt[bool]      `json:"hidden" url:"hidden,omitempty"`                     //  (Optional)
 	} `json:"form"`
 }
 
@@ -59,7 +57,7 @@ func (t *UpdateFile) GetMethod() string {
 
 func (t *UpdateFile) GetURLPath() string {
 	path := "files/{id}"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -68,33 +66,37 @@ func (t *UpdateFile) GetQuery() (string, error) {
 }
 
 func (t *UpdateFile) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateFile) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdateFile) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
-	if t.Form.OnDuplicate != "" && !string_utils.Include([]string{"overwrite", "rename"}, t.Form.OnDuplicate) {
-		errs = append(errs, "OnDuplicate must be one of overwrite, rename")
+	if t.Form.OnDuplicate.Value() != "" && !string_utils.Include([]string{"overwrite", "rename"}, t.Form.OnDuplicate.Value()) {
+		errs = append(errs, canvasapi.FieldError{Field: "Form.OnDuplicate", Rule: canvasapi.RuleOneOf, Allowed: []string{"overwrite", "rename"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *UpdateFile) Do(c *canvasapi.Canvas) (*models.File, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateFile) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.File, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -109,6 +111,10 @@ func (t *UpdateFile) Do(c *canvasapi.Canvas) (*models.File, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -31,17 +29,17 @@ import (
 //
 type UpdateFolder struct {
 	Path struct {
-		ID string `json:"id" url:"id,omitempty"` //  (Required)
+		ID canvasapi.ID `json:"id" url:"id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		Name           string    `json:"name" url:"name,omitempty"`                         //  (Optional)
-		ParentFolderID string    `json:"parent_folder_id" url:"parent_folder_id,omitempty"` //  (Optional)
-		LockAt         time.Time `json:"lock_at" url:"lock_at,omitempty"`                   //  (Optional)
-		UnlockAt       time.Time `json:"unlock_at" url:"unlock_at,omitempty"`               //  (Optional)
-		Locked         bool      `json:"locked" url:"locked,omitempty"`                     //  (Optional)
-		Hidden         bool      `json:"hidden" url:"hidden,omitempty"`                     //  (Optional)
-		Position       int64     `json:"position" url:"position,omitempty"`                 //  (Optional)
+		Name           canvasapi.Opt[string]    `json:"name" url:"name,omitempty"`                         //  (Optional)
+		ParentFolderID canvasapi.Opt[string]    `json:"parent_folder_id" url:"parent_folder_id,omitempty"` //  (Optional)
+		LockAt         canvasapi.Opt[time.Time] `json:"lock_at" url:"lock_at,omitempty"`                   //  (Optional)
+		UnlockAt       canvasapi.Opt[time.Time] `json:"unlock_at" url:"unlock_at,omitempty"`               //  (Optional)
+		Locked         canvasapi.Opt[bool]      `json:"locked" url:"locked,omitempty"`                     //  (Optional)
+		Hidden         canvasapi.Opt[bool]      `json:"hidden" url:"hidden,omitempty"`                     //  (Optional)
+		Position       canvasapi.Opt[int64]     `json:"position" url:"position,omitempty"`                 //  (Optional)
 	} `json:"form"`
 }
 
@@ -51,7 +49,7 @@ func (t *UpdateFolder) GetMethod() string {
 
 func (t *UpdateFolder) GetURLPath() string {
 	path := "folders/{id}"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -60,30 +58,34 @@ func (t *UpdateFolder) GetQuery() (string, error) {
 }
 
 func (t *UpdateFolder) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateFolder) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdateFolder) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *UpdateFolder) Do(c *canvasapi.Canvas) (*models.Folder, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateFolder) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Folder, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -98,6 +100,10 @@ func (t *UpdateFolder) Do(c *canvasapi.Canvas) (*models.Folder, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,15 +1,15 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -34,17 +34,17 @@ import (
 //
 type UpdateGlobalNotification struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
-		ID        string `json:"id" url:"id,omitempty"`                 //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		ID        canvasapi.ID `json:"id" url:"id,omitempty"`                 //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		AccountNotification struct {
-			Subject string    `json:"subject" url:"subject,omitempty"`   //  (Optional)
-			Message string    `json:"message" url:"message,omitempty"`   //  (Optional)
-			StartAt time.Time `json:"start_at" url:"start_at,omitempty"` //  (Optional)
-			EndAt   time.Time `json:"end_at" url:"end_at,omitempty"`     //  (Optional)
-			Icon    string    `json:"icon" url:"icon,omitempty"`         //  (Optional) . Must be one of warning, information, question, error, calendar
+			Subject canvasapi.Opt[string]    `json:"subject" url:"subject,omitempty"`   //  (Optional)
+			Message canvasapi.Opt[string]    `json:"message" url:"message,omitempty"`   //  (Optional)
+			StartAt canvasapi.Opt[time.Time] `json:"start_at" url:"start_at,omitempty"` //  (Optional)
+			EndAt   canvasapi.Opt[time.Time] `json:"end_at" url:"end_at,omitempty"`     //  (Optional)
+			Icon    canvasapi.Opt[string]    `json:"icon" url:"icon,omitempty"`         //  (Optional) . Must be one of warning, information, question, error, calendar
 		} `json:"account_notification" url:"account_notification,omitempty"`
 
 		AccountNotificationRoles []string `json:"account_notification_roles" url:"account_notification_roles,omitempty"` //  (Optional)
@@ -57,8 +57,8 @@ func (t *UpdateGlobalNotification) GetMethod() string {
 
 func (t *UpdateGlobalNotification) GetURLPath() string {
 	path := "accounts/{account_id}/account_notifications/{id}"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -67,39 +67,58 @@ func (t *UpdateGlobalNotification) GetQuery() (string, error) {
 }
 
 func (t *UpdateGlobalNotification) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateGlobalNotification) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdateGlobalNotification) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
-	if t.Form.AccountNotification.Icon != "" && !string_utils.Include([]string{"warning", "information", "question", "error", "calendar"}, t.Form.AccountNotification.Icon) {
-		errs = append(errs, "AccountNotification must be one of warning, information, question, error, calendar")
+	if t.Form.AccountNotification.Icon.Value() != "" && !string_utils.Include([]string{"warning", "information", "question", "error", "calendar"}, t.Form.AccountNotification.Icon.Value()) {
+		errs = append(errs, canvasapi.FieldError{Field: "Form.AccountNotification.Icon", Rule: canvasapi.RuleOneOf, Allowed: []string{"warning", "information", "question", "error", "calendar"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *UpdateGlobalNotification) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *UpdateGlobalNotification) Do(c *canvasapi.Canvas) (*models.AccountNotification, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateGlobalNotification) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.AccountNotification, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.AccountNotification{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
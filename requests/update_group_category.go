@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -44,17 +42,17 @@ import (
 //
 type UpdateGroupCategory struct {
 	Path struct {
-		GroupCategoryID string `json:"group_category_id" url:"group_category_id,omitempty"` //  (Required)
+		GroupCategoryID canvasapi.ID `json:"group_category_id" url:"group_category_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		Name               string `json:"name" url:"name,omitempty"`                                   //  (Optional)
-		SelfSignup         string `json:"self_signup" url:"self_signup,omitempty"`                     //  (Optional) . Must be one of enabled, restricted
-		AutoLeader         string `json:"auto_leader" url:"auto_leader,omitempty"`                     //  (Optional) . Must be one of first, random
-		GroupLimit         int64  `json:"group_limit" url:"group_limit,omitempty"`                     //  (Optional)
-		SISGroupCategoryID string `json:"sis_group_category_id" url:"sis_group_category_id,omitempty"` //  (Optional)
-		CreateGroupCount   int64  `json:"create_group_count" url:"create_group_count,omitempty"`       //  (Optional)
-		SplitGroupCount    string `json:"split_group_count" url:"split_group_count,omitempty"`         //  (Optional)
+		Name               canvasapi.Opt[string] `json:"name" url:"name,omitempty"`                                   //  (Optional)
+		SelfSignup         canvasapi.Opt[string] `json:"self_signup" url:"self_signup,omitempty"`                     //  (Optional) . Must be one of enabled, restricted
+		AutoLeader         canvasapi.Opt[string] `json:"auto_leader" url:"auto_leader,omitempty"`                     //  (Optional) . Must be one of first, random
+		GroupLimit         canvasapi.Opt[int64]  `json:"group_limit" url:"group_limit,omitempty"`                     //  (Optional)
+		SISGroupCategoryID canvasapi.Opt[string] `json:"sis_group_category_id" url:"sis_group_category_id,omitempty"` //  (Optional)
+		CreateGroupCount   canvasapi.Opt[int64]  `json:"create_group_count" url:"create_group_count,omitempty"`       //  (Optional)
+		SplitGroupCount    canvasapi.Opt[string] `json:"split_group_count" url:"split_group_count,omitempty"`         //  (Optional)
 	} `json:"form"`
 }
 
@@ -64,7 +62,7 @@ func (t *UpdateGroupCategory) GetMethod() string {
 
 func (t *UpdateGroupCategory) GetURLPath() string {
 	path := "group_categories/{group_category_id}"
-	path = strings.ReplaceAll(path, "{group_category_id}", fmt.Sprintf("%v", t.Path.GroupCategoryID))
+	path = strings.ReplaceAll(path, "{group_category_id}", t.Path.GroupCategoryID.Escaped())
 	return path
 }
 
@@ -73,36 +71,40 @@ func (t *UpdateGroupCategory) GetQuery() (string, error) {
 }
 
 func (t *UpdateGroupCategory) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateGroupCategory) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdateGroupCategory) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.GroupCategoryID == "" {
-		errs = append(errs, "'Path.GroupCategoryID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.GroupCategoryID", Rule: canvasapi.RuleRequired})
 	}
-	if t.Form.SelfSignup != "" && !string_utils.Include([]string{"enabled", "restricted"}, t.Form.SelfSignup) {
-		errs = append(errs, "SelfSignup must be one of enabled, restricted")
+	if t.Form.SelfSignup.Value() != "" && !string_utils.Include([]string{"enabled", "restricted"}, t.Form.SelfSignup.Value()) {
+		errs = append(errs, canvasapi.FieldError{Field: "Form.SelfSignup", Rule: canvasapi.RuleOneOf, Allowed: []string{"enabled", "restricted"}})
 	}
-	if t.Form.AutoLeader != "" && !string_utils.Include([]string{"first", "random"}, t.Form.AutoLeader) {
-		errs = append(errs, "AutoLeader must be one of first, random")
+	if t.Form.AutoLeader.Value() != "" && !string_utils.Include([]string{"first", "random"}, t.Form.AutoLeader.Value()) {
+		errs = append(errs, canvasapi.FieldError{Field: "Form.AutoLeader", Rule: canvasapi.RuleOneOf, Allowed: []string{"first", "random"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *UpdateGroupCategory) Do(c *canvasapi.Canvas) (*models.GroupCategory, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateGroupCategory) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.GroupCategory, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -117,6 +119,10 @@ func (t *UpdateGroupCategory) Do(c *canvasapi.Canvas) (*models.GroupCategory, er
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
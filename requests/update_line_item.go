@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -32,15 +30,15 @@ import (
 //
 type UpdateLineItem struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		ID       string `json:"id" url:"id,omitempty"`               //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		ID       canvasapi.ID `json:"id" url:"id,omitempty"`               //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		ScoreMaximum float64 `json:"score_maximum" url:"score_maximum,omitempty"` //  (Optional)
-		Label        string  `json:"label" url:"label,omitempty"`                 //  (Optional)
-		ResourceID   string  `json:"resource_id" url:"resource_id,omitempty"`     //  (Optional)
-		Tag          string  `json:"tag" url:"tag,omitempty"`                     //  (Optional)
+		ScoreMaximum canvasapi.Opt[float64] `json:"scoreMaximum" url:"scoreMaximum,omitempty"` //  (Optional)
+		Label        canvasapi.Opt[string]  `json:"label" url:"label,omitempty"`               //  (Optional)
+		ResourceID   canvasapi.Opt[string]  `json:"resourceId" url:"resourceId,omitempty"`     //  (Optional)
+		Tag          canvasapi.Opt[string]  `json:"tag" url:"tag,omitempty"`                   //  (Optional)
 	} `json:"form"`
 }
 
@@ -50,8 +48,8 @@ func (t *UpdateLineItem) GetMethod() string {
 
 func (t *UpdateLineItem) GetURLPath() string {
 	path := "/lti/courses/{course_id}/line_items/{id}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -60,33 +58,47 @@ func (t *UpdateLineItem) GetQuery() (string, error) {
 }
 
 func (t *UpdateLineItem) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateLineItem) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
+// PrefersJSON reports that Canvas expects the body of this request as JSON.
+func (t *UpdateLineItem) PrefersJSON() bool {
+	return true
+}
+
+// MediaTypes returns the IMS media types of this LTI Advantage service.
+func (t *UpdateLineItem) MediaTypes() (string, string) {
+	return "application/vnd.ims.lis.v2.lineitem+json", "application/vnd.ims.lis.v2.lineitem+json"
+}
+
 func (t *UpdateLineItem) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *UpdateLineItem) Do(c *canvasapi.Canvas) (*models.LineItem, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateLineItem) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.LineItem, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -101,6 +113,10 @@ func (t *UpdateLineItem) Do(c *canvasapi.Canvas) (*models.LineItem, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
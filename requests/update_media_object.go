@@ -1,14 +1,14 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // UpdateMediaObject
@@ -22,11 +22,11 @@ import (
 //
 type UpdateMediaObject struct {
 	Path struct {
-		MediaObjectID string `json:"media_object_id" url:"media_object_id,omitempty"` //  (Required)
+		MediaObjectID canvasapi.ID `json:"media_object_id" url:"media_object_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		UserEnteredTitle string `json:"user_entered_title" url:"user_entered_title,omitempty"` //  (Optional)
+		UserEnteredTitle canvasapi.Opt[string] `json:"user_entered_title" url:"user_entered_title,omitempty"` //  (Optional)
 	} `json:"form"`
 }
 
@@ -36,7 +36,7 @@ func (t *UpdateMediaObject) GetMethod() string {
 
 func (t *UpdateMediaObject) GetURLPath() string {
 	path := "media_objects/{media_object_id}"
-	path = strings.ReplaceAll(path, "{media_object_id}", fmt.Sprintf("%v", t.Path.MediaObjectID))
+	path = strings.ReplaceAll(path, "{media_object_id}", t.Path.MediaObjectID.Escaped())
 	return path
 }
 
@@ -45,33 +45,52 @@ func (t *UpdateMediaObject) GetQuery() (string, error) {
 }
 
 func (t *UpdateMediaObject) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateMediaObject) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdateMediaObject) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.MediaObjectID == "" {
-		errs = append(errs, "'Path.MediaObjectID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.MediaObjectID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *UpdateMediaObject) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *UpdateMediaObject) Do(c *canvasapi.Canvas) (*models.MediaObject, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateMediaObject) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.MediaObject, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.MediaObject{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -32,7 +30,7 @@ import (
 //
 type UpdateMediaTracks struct {
 	Path struct {
-		MediaObjectID string `json:"media_object_id" url:"media_object_id,omitempty"` //  (Required)
+		MediaObjectID canvasapi.ID `json:"media_object_id" url:"media_object_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -46,7 +44,7 @@ func (t *UpdateMediaTracks) GetMethod() string {
 
 func (t *UpdateMediaTracks) GetURLPath() string {
 	path := "media_objects/{media_object_id}/media_tracks"
-	path = strings.ReplaceAll(path, "{media_object_id}", fmt.Sprintf("%v", t.Path.MediaObjectID))
+	path = strings.ReplaceAll(path, "{media_object_id}", t.Path.MediaObjectID.Escaped())
 	return path
 }
 
@@ -55,35 +53,39 @@ func (t *UpdateMediaTracks) GetQuery() (string, error) {
 }
 
 func (t *UpdateMediaTracks) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateMediaTracks) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdateMediaTracks) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.MediaObjectID == "" {
-		errs = append(errs, "'Path.MediaObjectID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.MediaObjectID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *UpdateMediaTracks) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.MediaTrack, *canvasapi.PagedResource, error) {
+	return t.DoContext(context.Background(), c, next)
+}
+
+func (t *UpdateMediaTracks) DoContext(ctx context.Context, c *canvasapi.Canvas, next *url.URL) ([]*models.MediaTrack, *canvasapi.PagedResource, error) {
 	var err error
 	var response *http.Response
 	if next != nil {
-		response, err = c.Send(next, t.GetMethod(), nil)
+		response, err = c.SendContext(ctx, next, t.GetMethod(), nil)
 	} else {
-		response, err = c.SendRequest(t)
+		response, err = c.SendRequestContext(ctx, t)
 	}
 
 	if err != nil {
@@ -103,6 +105,10 @@ func (t *UpdateMediaTracks) Do(c *canvasapi.Canvas, next *url.URL) ([]*models.Me
 	if err != nil {
 		return nil, nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	pagedResource, err := canvasapi.ExtractPagedResource(response.Header)
 	if err != nil {
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -27,13 +25,13 @@ import (
 //
 type UpdateMembershipUsers struct {
 	Path struct {
-		GroupID string `json:"group_id" url:"group_id,omitempty"` //  (Required)
-		UserID  string `json:"user_id" url:"user_id,omitempty"`   //  (Required)
+		GroupID canvasapi.ID `json:"group_id" url:"group_id,omitempty"` //  (Required)
+		UserID  canvasapi.ID `json:"user_id" url:"user_id,omitempty"`   //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		WorkflowState string `json:"workflow_state" url:"workflow_state,omitempty"` //  (Optional) . Must be one of accepted
-		Moderator     string `json:"moderator" url:"moderator,omitempty"`           //  (Optional)
+		WorkflowState canvasapi.Opt[string] `json:"workflow_state" url:"workflow_state,omitempty"` //  (Optional) . Must be one of accepted
+		Moderator     canvasapi.Opt[string] `json:"moderator" url:"moderator,omitempty"`           //  (Optional)
 	} `json:"form"`
 }
 
@@ -43,8 +41,8 @@ func (t *UpdateMembershipUsers) GetMethod() string {
 
 func (t *UpdateMembershipUsers) GetURLPath() string {
 	path := "groups/{group_id}/users/{user_id}"
-	path = strings.ReplaceAll(path, "{group_id}", fmt.Sprintf("%v", t.Path.GroupID))
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", t.Path.UserID))
+	path = strings.ReplaceAll(path, "{group_id}", t.Path.GroupID.Escaped())
+	path = strings.ReplaceAll(path, "{user_id}", t.Path.UserID.Escaped())
 	return path
 }
 
@@ -53,36 +51,40 @@ func (t *UpdateMembershipUsers) GetQuery() (string, error) {
 }
 
 func (t *UpdateMembershipUsers) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateMembershipUsers) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdateMembershipUsers) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.GroupID == "" {
-		errs = append(errs, "'Path.GroupID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.GroupID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.UserID == "" {
-		errs = append(errs, "'Path.UserID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.UserID", Rule: canvasapi.RuleRequired})
 	}
-	if t.Form.WorkflowState != "" && !string_utils.Include([]string{"accepted"}, t.Form.WorkflowState) {
-		errs = append(errs, "WorkflowState must be one of accepted")
+	if t.Form.WorkflowState.Value() != "" && !string_utils.Include([]string{"accepted"}, t.Form.WorkflowState.Value()) {
+		errs = append(errs, canvasapi.FieldError{Field: "Form.WorkflowState", Rule: canvasapi.RuleOneOf, Allowed: []string{"accepted"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *UpdateMembershipUsers) Do(c *canvasapi.Canvas) (*models.GroupMembership, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateMembershipUsers) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.GroupMembership, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -97,6 +99,10 @@ func (t *UpdateMembershipUsers) Do(c *canvasapi.Canvas) (*models.GroupMembership
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -27,9 +25,9 @@ import (
 //
 type UpdateMigrationIssueAccounts struct {
 	Path struct {
-		AccountID          string `json:"account_id" url:"account_id,omitempty"`                     //  (Required)
-		ContentMigrationID string `json:"content_migration_id" url:"content_migration_id,omitempty"` //  (Required)
-		ID                 string `json:"id" url:"id,omitempty"`                                     //  (Required)
+		AccountID          canvasapi.ID `json:"account_id" url:"account_id,omitempty"`                     //  (Required)
+		ContentMigrationID canvasapi.ID `json:"content_migration_id" url:"content_migration_id,omitempty"` //  (Required)
+		ID                 canvasapi.ID `json:"id" url:"id,omitempty"`                                     //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -43,9 +41,9 @@ func (t *UpdateMigrationIssueAccounts) GetMethod() string {
 
 func (t *UpdateMigrationIssueAccounts) GetURLPath() string {
 	path := "accounts/{account_id}/content_migrations/{content_migration_id}/migration_issues/{id}"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
-	path = strings.ReplaceAll(path, "{content_migration_id}", fmt.Sprintf("%v", t.Path.ContentMigrationID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
+	path = strings.ReplaceAll(path, "{content_migration_id}", t.Path.ContentMigrationID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -54,42 +52,46 @@ func (t *UpdateMigrationIssueAccounts) GetQuery() (string, error) {
 }
 
 func (t *UpdateMigrationIssueAccounts) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateMigrationIssueAccounts) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdateMigrationIssueAccounts) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ContentMigrationID == "" {
-		errs = append(errs, "'Path.ContentMigrationID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ContentMigrationID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.WorkflowState == "" {
-		errs = append(errs, "'Form.WorkflowState' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.WorkflowState", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.WorkflowState != "" && !string_utils.Include([]string{"active", "resolved"}, t.Form.WorkflowState) {
-		errs = append(errs, "WorkflowState must be one of active, resolved")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.WorkflowState", Rule: canvasapi.RuleOneOf, Allowed: []string{"active", "resolved"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *UpdateMigrationIssueAccounts) Do(c *canvasapi.Canvas) (*models.MigrationIssue, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateMigrationIssueAccounts) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.MigrationIssue, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -104,6 +106,10 @@ func (t *UpdateMigrationIssueAccounts) Do(c *canvasapi.Canvas) (*models.Migratio
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -35,19 +33,19 @@ import (
 //
 type UpdateModule struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		ID       string `json:"id" url:"id,omitempty"`               //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		ID       canvasapi.ID `json:"id" url:"id,omitempty"`               //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		Module struct {
-			Name                      string    `json:"name" url:"name,omitempty"`                                               //  (Optional)
-			UnlockAt                  time.Time `json:"unlock_at" url:"unlock_at,omitempty"`                                     //  (Optional)
-			Position                  int64     `json:"position" url:"position,omitempty"`                                       //  (Optional)
-			RequireSequentialProgress bool      `json:"require_sequential_progress" url:"require_sequential_progress,omitempty"` //  (Optional)
-			PrerequisiteModuleIDs     []string  `json:"prerequisite_module_ids" url:"prerequisite_module_ids,omitempty"`         //  (Optional)
-			PublishFinalGrade         bool      `json:"publish_final_grade" url:"publish_final_grade,omitempty"`                 //  (Optional)
-			Published                 bool      `json:"published" url:"published,omitempty"`                                     //  (Optional)
+			Name                      canvasapi.Opt[string]    `json:"name" url:"name,omitempty"`                                               //  (Optional)
+			UnlockAt                  canvasapi.Opt[time.Time] `json:"unlock_at" url:"unlock_at,omitempty"`                                     //  (Optional)
+			Position                  canvasapi.Opt[int64]     `json:"position" url:"position,omitempty"`                                       //  (Optional)
+			RequireSequentialProgress canvasapi.Opt[bool]      `json:"require_sequential_progress" url:"require_sequential_progress,omitempty"` //  (Optional)
+			PrerequisiteModuleIDs     []string                 `json:"prerequisite_module_ids" url:"prerequisite_module_ids,omitempty"`         //  (Optional)
+			PublishFinalGrade         canvasapi.Opt[bool]      `json:"publish_final_grade" url:"publish_final_grade,omitempty"`                 //  (Optional)
+			Published                 canvasapi.Opt[bool]      `json:"published" url:"published,omitempty"`                                     //  (Optional)
 		} `json:"module" url:"module,omitempty"`
 	} `json:"form"`
 }
@@ -58,8 +56,8 @@ func (t *UpdateModule) GetMethod() string {
 
 func (t *UpdateModule) GetURLPath() string {
 	path := "courses/{course_id}/modules/{id}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -68,33 +66,37 @@ func (t *UpdateModule) GetQuery() (string, error) {
 }
 
 func (t *UpdateModule) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateModule) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdateModule) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *UpdateModule) Do(c *canvasapi.Canvas) (*models.Module, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateModule) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Module, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -109,6 +111,10 @@ func (t *UpdateModule) Do(c *canvasapi.Canvas) (*models.Module, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
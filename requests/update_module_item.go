@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -43,25 +41,25 @@ import (
 //
 type UpdateModuleItem struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		ModuleID string `json:"module_id" url:"module_id,omitempty"` //  (Required)
-		ID       string `json:"id" url:"id,omitempty"`               //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		ModuleID canvasapi.ID `json:"module_id" url:"module_id,omitempty"` //  (Required)
+		ID       canvasapi.ID `json:"id" url:"id,omitempty"`               //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		ModuleItem struct {
-			Title                 string `json:"title" url:"title,omitempty"`               //  (Optional)
-			Position              int64  `json:"position" url:"position,omitempty"`         //  (Optional)
-			Indent                int64  `json:"indent" url:"indent,omitempty"`             //  (Optional)
-			ExternalUrl           string `json:"external_url" url:"external_url,omitempty"` //  (Optional)
-			NewTab                bool   `json:"new_tab" url:"new_tab,omitempty"`           //  (Optional)
+			Title                 canvasapi.Opt[string] `json:"title" url:"title,omitempty"`               //  (Optional)
+			Position              canvasapi.Opt[int64]  `json:"position" url:"position,omitempty"`         //  (Optional)
+			Indent                canvasapi.Opt[int64]  `json:"indent" url:"indent,omitempty"`             //  (Optional)
+			ExternalUrl           canvasapi.Opt[string] `json:"external_url" url:"external_url,omitempty"` //  (Optional)
+			NewTab                canvasapi.Opt[bool]   `json:"new_tab" url:"new_tab,omitempty"`           //  (Optional)
 			CompletionRequirement struct {
-				Type     string `json:"type" url:"type,omitempty"`           //  (Optional) . Must be one of must_view, must_contribute, must_submit, must_mark_done
-				MinScore int64  `json:"min_score" url:"min_score,omitempty"` //  (Optional)
+				Type     canvasapi.Opt[string] `json:"type" url:"type,omitempty"`           //  (Optional) . Must be one of must_view, must_contribute, must_submit, must_mark_done
+				MinScore canvasapi.Opt[int64]  `json:"min_score" url:"min_score,omitempty"` //  (Optional)
 			} `json:"completion_requirement" url:"completion_requirement,omitempty"`
 
-			Published bool   `json:"published" url:"published,omitempty"` //  (Optional)
-			ModuleID  string `json:"module_id" url:"module_id,omitempty"` //  (Optional)
+			Published canvasapi.Opt[bool]   `json:"published" url:"published,omitempty"` //  (Optional)
+			ModuleID  canvasapi.Opt[string] `json:"module_id" url:"module_id,omitempty"` //  (Optional)
 		} `json:"module_item" url:"module_item,omitempty"`
 	} `json:"form"`
 }
@@ -72,9 +70,9 @@ func (t *UpdateModuleItem) GetMethod() string {
 
 func (t *UpdateModuleItem) GetURLPath() string {
 	path := "courses/{course_id}/modules/{module_id}/items/{id}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{module_id}", fmt.Sprintf("%v", t.Path.ModuleID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{module_id}", t.Path.ModuleID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -83,39 +81,43 @@ func (t *UpdateModuleItem) GetQuery() (string, error) {
 }
 
 func (t *UpdateModuleItem) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateModuleItem) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdateModuleItem) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ModuleID == "" {
-		errs = append(errs, "'Path.ModuleID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ModuleID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
-	if t.Form.ModuleItem.CompletionRequirement.Type != "" && !string_utils.Include([]string{"must_view", "must_contribute", "must_submit", "must_mark_done"}, t.Form.ModuleItem.CompletionRequirement.Type) {
-		errs = append(errs, "ModuleItem must be one of must_view, must_contribute, must_submit, must_mark_done")
+	if t.Form.ModuleItem.CompletionRequirement.Type.Value() != "" && !string_utils.Include([]string{"must_view", "must_contribute", "must_submit", "must_mark_done"}, t.Form.ModuleItem.CompletionRequirement.Type.Value()) {
+		errs = append(errs, canvasapi.FieldError{Field: "Form.ModuleItem.CompletionRequirement.Type", Rule: canvasapi.RuleOneOf, Allowed: []string{"must_view", "must_contribute", "must_submit", "must_mark_done"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *UpdateModuleItem) Do(c *canvasapi.Canvas) (*models.ModuleItem, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateModuleItem) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.ModuleItem, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -130,6 +132,10 @@ func (t *UpdateModuleItem) Do(c *canvasapi.Canvas) (*models.ModuleItem, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
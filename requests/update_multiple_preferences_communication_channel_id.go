@@ -1,14 +1,14 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // UpdateMultiplePreferencesCommunicationChannelID Change the preferences for multiple notifications for a single communication channel at once
@@ -22,11 +22,11 @@ import (
 //
 type UpdateMultiplePreferencesCommunicationChannelID struct {
 	Path struct {
-		CommunicationChannelID string `json:"communication_channel_id" url:"communication_channel_id,omitempty"` //  (Required)
+		CommunicationChannelID canvasapi.ID `json:"communication_channel_id" url:"communication_channel_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		NotificationPreferences map[string]UpdateMultiplePreferencesCommunicationChannelIDNotificationPreferences
+		NotificationPreferences map[string]UpdateMultiplePreferencesCommunicationChannelIDNotificationPreferences `json:"notification_preferences" url:"notification_preferences,omitempty"` //  (Required)
 	} `json:"form"`
 }
 
@@ -36,7 +36,7 @@ func (t *UpdateMultiplePreferencesCommunicationChannelID) GetMethod() string {
 
 func (t *UpdateMultiplePreferencesCommunicationChannelID) GetURLPath() string {
 	path := "users/self/communication_channels/{communication_channel_id}/notification_preferences"
-	path = strings.ReplaceAll(path, "{communication_channel_id}", fmt.Sprintf("%v", t.Path.CommunicationChannelID))
+	path = strings.ReplaceAll(path, "{communication_channel_id}", t.Path.CommunicationChannelID.Escaped())
 	return path
 }
 
@@ -45,38 +45,57 @@ func (t *UpdateMultiplePreferencesCommunicationChannelID) GetQuery() (string, er
 }
 
 func (t *UpdateMultiplePreferencesCommunicationChannelID) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateMultiplePreferencesCommunicationChannelID) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdateMultiplePreferencesCommunicationChannelID) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CommunicationChannelID == "" {
-		errs = append(errs, "'Path.CommunicationChannelID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CommunicationChannelID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.NotificationPreferences == nil {
-		errs = append(errs, "'Form.NotificationPreferences' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.NotificationPreferences", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *UpdateMultiplePreferencesCommunicationChannelID) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *UpdateMultiplePreferencesCommunicationChannelID) Do(c *canvasapi.Canvas) (*models.NotificationPreferencesResponse, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateMultiplePreferencesCommunicationChannelID) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.NotificationPreferencesResponse, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.NotificationPreferencesResponse{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
 
 type UpdateMultiplePreferencesCommunicationChannelIDNotificationPreferences struct {
@@ -1,14 +1,15 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // UpdateMultiplePreferencesType Change the preferences for multiple notifications for a single communication channel at once
@@ -28,7 +29,7 @@ type UpdateMultiplePreferencesType struct {
 	} `json:"path"`
 
 	Form struct {
-		NotificationPreferences map[string]UpdateMultiplePreferencesTypeNotificationPreferences
+		NotificationPreferences map[string]UpdateMultiplePreferencesTypeNotificationPreferences `json:"notification_preferences" url:"notification_preferences,omitempty"` //  (Required)
 	} `json:"form"`
 }
 
@@ -48,41 +49,60 @@ func (t *UpdateMultiplePreferencesType) GetQuery() (string, error) {
 }
 
 func (t *UpdateMultiplePreferencesType) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateMultiplePreferencesType) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdateMultiplePreferencesType) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.Type == "" {
-		errs = append(errs, "'Path.Type' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Type", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.Address == "" {
-		errs = append(errs, "'Path.Address' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Address", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.NotificationPreferences == nil {
-		errs = append(errs, "'Form.NotificationPreferences' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.NotificationPreferences", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *UpdateMultiplePreferencesType) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *UpdateMultiplePreferencesType) Do(c *canvasapi.Canvas) (*models.NotificationPreferencesResponse, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateMultiplePreferencesType) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.NotificationPreferencesResponse, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.NotificationPreferencesResponse{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
 
 type UpdateMultiplePreferencesTypeNotificationPreferences struct {
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -33,15 +31,15 @@ import (
 //
 type UpdateOutcomeGroupAccounts struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
-		ID        string `json:"id" url:"id,omitempty"`                 //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		ID        canvasapi.ID `json:"id" url:"id,omitempty"`                 //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		Title                string `json:"title" url:"title,omitempty"`                                     //  (Optional)
-		Description          string `json:"description" url:"description,omitempty"`                         //  (Optional)
-		VendorGuid           string `json:"vendor_guid" url:"vendor_guid,omitempty"`                         //  (Optional)
-		ParentOutcomeGroupID int64  `json:"parent_outcome_group_id" url:"parent_outcome_group_id,omitempty"` //  (Optional)
+		Title                canvasapi.Opt[string] `json:"title" url:"title,omitempty"`                                     //  (Optional)
+		Description          canvasapi.Opt[string] `json:"description" url:"description,omitempty"`                         //  (Optional)
+		VendorGuid           canvasapi.Opt[string] `json:"vendor_guid" url:"vendor_guid,omitempty"`                         //  (Optional)
+		ParentOutcomeGroupID canvasapi.Opt[int64]  `json:"parent_outcome_group_id" url:"parent_outcome_group_id,omitempty"` //  (Optional)
 	} `json:"form"`
 }
 
@@ -51,8 +49,8 @@ func (t *UpdateOutcomeGroupAccounts) GetMethod() string {
 
 func (t *UpdateOutcomeGroupAccounts) GetURLPath() string {
 	path := "accounts/{account_id}/outcome_groups/{id}"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -61,33 +59,37 @@ func (t *UpdateOutcomeGroupAccounts) GetQuery() (string, error) {
 }
 
 func (t *UpdateOutcomeGroupAccounts) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateOutcomeGroupAccounts) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdateOutcomeGroupAccounts) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *UpdateOutcomeGroupAccounts) Do(c *canvasapi.Canvas) (*models.OutcomeGroup, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateOutcomeGroupAccounts) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.OutcomeGroup, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -102,6 +104,10 @@ func (t *UpdateOutcomeGroupAccounts) Do(c *canvasapi.Canvas) (*models.OutcomeGro
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
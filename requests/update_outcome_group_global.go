@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -32,14 +30,14 @@ import (
 //
 type UpdateOutcomeGroupGlobal struct {
 	Path struct {
-		ID string `json:"id" url:"id,omitempty"` //  (Required)
+		ID canvasapi.ID `json:"id" url:"id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		Title                string `json:"title" url:"title,omitempty"`                                     //  (Optional)
-		Description          string `json:"description" url:"description,omitempty"`                         //  (Optional)
-		VendorGuid           string `json:"vendor_guid" url:"vendor_guid,omitempty"`                         //  (Optional)
-		ParentOutcomeGroupID int64  `json:"parent_outcome_group_id" url:"parent_outcome_group_id,omitempty"` //  (Optional)
+		Title                canvasapi.Opt[string] `json:"title" url:"title,omitempty"`                                     //  (Optional)
+		Description          canvasapi.Opt[string] `json:"description" url:"description,omitempty"`                         //  (Optional)
+		VendorGuid           canvasapi.Opt[string] `json:"vendor_guid" url:"vendor_guid,omitempty"`                         //  (Optional)
+		ParentOutcomeGroupID canvasapi.Opt[int64]  `json:"parent_outcome_group_id" url:"parent_outcome_group_id,omitempty"` //  (Optional)
 	} `json:"form"`
 }
 
@@ -49,7 +47,7 @@ func (t *UpdateOutcomeGroupGlobal) GetMethod() string {
 
 func (t *UpdateOutcomeGroupGlobal) GetURLPath() string {
 	path := "global/outcome_groups/{id}"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -58,30 +56,34 @@ func (t *UpdateOutcomeGroupGlobal) GetQuery() (string, error) {
 }
 
 func (t *UpdateOutcomeGroupGlobal) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateOutcomeGroupGlobal) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdateOutcomeGroupGlobal) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *UpdateOutcomeGroupGlobal) Do(c *canvasapi.Canvas) (*models.OutcomeGroup, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateOutcomeGroupGlobal) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.OutcomeGroup, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -96,6 +98,10 @@ func (t *UpdateOutcomeGroupGlobal) Do(c *canvasapi.Canvas) (*models.OutcomeGroup
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,15 +1,13 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -31,14 +29,14 @@ import (
 //
 type UpdatePlannerNote struct {
 	Path struct {
-		ID string `json:"id" url:"id,omitempty"` //  (Required)
+		ID canvasapi.ID `json:"id" url:"id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		Title    string    `json:"title" url:"title,omitempty"`         //  (Optional)
-		Details  string    `json:"details" url:"details,omitempty"`     //  (Optional)
-		TodoDate time.Time `json:"todo_date" url:"todo_date,omitempty"` //  (Optional)
-		CourseID int64     `json:"course_id" url:"course_id,omitempty"` //  (Optional)
+		Title    canvasapi.Opt[string]    `json:"title" url:"title,omitempty"`         //  (Optional)
+		Details  canvasapi.Opt[string]    `json:"details" url:"details,omitempty"`     //  (Optional)
+		TodoDate canvasapi.Opt[time.Time] `json:"todo_date" url:"todo_date,omitempty"` //  (Optional)
+		CourseID canvasapi.Opt[int64]     `json:"course_id" url:"course_id,omitempty"` //  (Optional)
 	} `json:"form"`
 }
 
@@ -48,7 +46,7 @@ func (t *UpdatePlannerNote) GetMethod() string {
 
 func (t *UpdatePlannerNote) GetURLPath() string {
 	path := "planner_notes/{id}"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -57,30 +55,34 @@ func (t *UpdatePlannerNote) GetQuery() (string, error) {
 }
 
 func (t *UpdatePlannerNote) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdatePlannerNote) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdatePlannerNote) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *UpdatePlannerNote) Do(c *canvasapi.Canvas) (*models.PlannerNote, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdatePlannerNote) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.PlannerNote, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -95,6 +97,10 @@ func (t *UpdatePlannerNote) Do(c *canvasapi.Canvas) (*models.PlannerNote, error)
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
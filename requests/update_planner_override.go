@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -25,12 +23,12 @@ import (
 //
 type UpdatePlannerOverride struct {
 	Path struct {
-		ID string `json:"id" url:"id,omitempty"` //  (Required)
+		ID canvasapi.ID `json:"id" url:"id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		MarkedComplete string `json:"marked_complete" url:"marked_complete,omitempty"` //  (Optional)
-		Dismissed      string `json:"dismissed" url:"dismissed,omitempty"`             //  (Optional)
+		MarkedComplete canvasapi.Opt[string] `json:"marked_complete" url:"marked_complete,omitempty"` //  (Optional)
+		Dismissed      canvasapi.Opt[string] `json:"dismissed" url:"dismissed,omitempty"`             //  (Optional)
 	} `json:"form"`
 }
 
@@ -40,7 +38,7 @@ func (t *UpdatePlannerOverride) GetMethod() string {
 
 func (t *UpdatePlannerOverride) GetURLPath() string {
 	path := "planner/overrides/{id}"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -49,30 +47,34 @@ func (t *UpdatePlannerOverride) GetQuery() (string, error) {
 }
 
 func (t *UpdatePlannerOverride) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdatePlannerOverride) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdatePlannerOverride) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *UpdatePlannerOverride) Do(c *canvasapi.Canvas) (*models.PlannerOverride, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdatePlannerOverride) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.PlannerOverride, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -87,6 +89,10 @@ func (t *UpdatePlannerOverride) Do(c *canvasapi.Canvas) (*models.PlannerOverride
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,14 +1,15 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // UpdatePreferenceCommunicationChannelID Change the preference for a single notification for a single communication channel
@@ -23,8 +24,8 @@ import (
 //
 type UpdatePreferenceCommunicationChannelID struct {
 	Path struct {
-		CommunicationChannelID string `json:"communication_channel_id" url:"communication_channel_id,omitempty"` //  (Required)
-		Notification           string `json:"notification" url:"notification,omitempty"`                         //  (Required)
+		CommunicationChannelID canvasapi.ID `json:"communication_channel_id" url:"communication_channel_id,omitempty"` //  (Required)
+		Notification           string       `json:"notification" url:"notification,omitempty"`                         //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -40,7 +41,7 @@ func (t *UpdatePreferenceCommunicationChannelID) GetMethod() string {
 
 func (t *UpdatePreferenceCommunicationChannelID) GetURLPath() string {
 	path := "users/self/communication_channels/{communication_channel_id}/notification_preferences/{notification}"
-	path = strings.ReplaceAll(path, "{communication_channel_id}", fmt.Sprintf("%v", t.Path.CommunicationChannelID))
+	path = strings.ReplaceAll(path, "{communication_channel_id}", t.Path.CommunicationChannelID.Escaped())
 	path = strings.ReplaceAll(path, "{notification}", fmt.Sprintf("%v", t.Path.Notification))
 	return path
 }
@@ -50,39 +51,58 @@ func (t *UpdatePreferenceCommunicationChannelID) GetQuery() (string, error) {
 }
 
 func (t *UpdatePreferenceCommunicationChannelID) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdatePreferenceCommunicationChannelID) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdatePreferenceCommunicationChannelID) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CommunicationChannelID == "" {
-		errs = append(errs, "'Path.CommunicationChannelID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CommunicationChannelID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.Notification == "" {
-		errs = append(errs, "'Path.Notification' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Notification", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.NotificationPreferences.Frequency == "" {
-		errs = append(errs, "'Form.NotificationPreferences.Frequency' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.NotificationPreferences.Frequency", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *UpdatePreferenceCommunicationChannelID) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *UpdatePreferenceCommunicationChannelID) Do(c *canvasapi.Canvas) (*models.NotificationPreferencesResponse, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdatePreferenceCommunicationChannelID) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.NotificationPreferencesResponse, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.NotificationPreferencesResponse{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
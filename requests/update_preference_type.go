@@ -1,14 +1,15 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // UpdatePreferenceType Change the preference for a single notification for a single communication channel
@@ -53,42 +54,61 @@ func (t *UpdatePreferenceType) GetQuery() (string, error) {
 }
 
 func (t *UpdatePreferenceType) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdatePreferenceType) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdatePreferenceType) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.Type == "" {
-		errs = append(errs, "'Path.Type' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Type", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.Address == "" {
-		errs = append(errs, "'Path.Address' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Address", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.Notification == "" {
-		errs = append(errs, "'Path.Notification' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Notification", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.NotificationPreferences.Frequency == "" {
-		errs = append(errs, "'Form.NotificationPreferences.Frequency' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.NotificationPreferences.Frequency", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *UpdatePreferenceType) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *UpdatePreferenceType) Do(c *canvasapi.Canvas) (*models.NotificationPreferencesResponse, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdatePreferenceType) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.NotificationPreferencesResponse, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.NotificationPreferencesResponse{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
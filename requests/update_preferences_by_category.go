@@ -1,14 +1,15 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // UpdatePreferencesByCategory Change the preferences for multiple notifications based on the category for a single communication channel
@@ -23,8 +24,8 @@ import (
 //
 type UpdatePreferencesByCategory struct {
 	Path struct {
-		CommunicationChannelID string `json:"communication_channel_id" url:"communication_channel_id,omitempty"` //  (Required)
-		Category               string `json:"category" url:"category,omitempty"`                                 //  (Required)
+		CommunicationChannelID canvasapi.ID `json:"communication_channel_id" url:"communication_channel_id,omitempty"` //  (Required)
+		Category               string       `json:"category" url:"category,omitempty"`                                 //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -40,7 +41,7 @@ func (t *UpdatePreferencesByCategory) GetMethod() string {
 
 func (t *UpdatePreferencesByCategory) GetURLPath() string {
 	path := "users/self/communication_channels/{communication_channel_id}/notification_preference_categories/{category}"
-	path = strings.ReplaceAll(path, "{communication_channel_id}", fmt.Sprintf("%v", t.Path.CommunicationChannelID))
+	path = strings.ReplaceAll(path, "{communication_channel_id}", t.Path.CommunicationChannelID.Escaped())
 	path = strings.ReplaceAll(path, "{category}", fmt.Sprintf("%v", t.Path.Category))
 	return path
 }
@@ -50,39 +51,58 @@ func (t *UpdatePreferencesByCategory) GetQuery() (string, error) {
 }
 
 func (t *UpdatePreferencesByCategory) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdatePreferencesByCategory) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdatePreferencesByCategory) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CommunicationChannelID == "" {
-		errs = append(errs, "'Path.CommunicationChannelID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CommunicationChannelID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.Category == "" {
-		errs = append(errs, "'Path.Category' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.Category", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.NotificationPreferences.Frequency == "" {
-		errs = append(errs, "'Form.NotificationPreferences.Frequency' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.NotificationPreferences.Frequency", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *UpdatePreferencesByCategory) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *UpdatePreferencesByCategory) Do(c *canvasapi.Canvas) (*models.NotificationPreferencesResponse, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdatePreferencesByCategory) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.NotificationPreferencesResponse, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.NotificationPreferencesResponse{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
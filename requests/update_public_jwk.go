@@ -1,13 +1,10 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
-	"strings"
-
-	"github.com/google/go-querystring/query"
 
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
@@ -30,7 +27,7 @@ func (t *UpdatePublicJwk) GetMethod() string {
 }
 
 func (t *UpdatePublicJwk) GetURLPath() string {
-	return ""
+	return "/lti/developer_key/update_public_jwk"
 }
 
 func (t *UpdatePublicJwk) GetQuery() (string, error) {
@@ -38,30 +35,34 @@ func (t *UpdatePublicJwk) GetQuery() (string, error) {
 }
 
 func (t *UpdatePublicJwk) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdatePublicJwk) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdatePublicJwk) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Form.PublicJwk == nil {
-		errs = append(errs, "'Form.PublicJwk' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.PublicJwk", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *UpdatePublicJwk) Do(c *canvasapi.Canvas) (*models.DeveloperKey, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdatePublicJwk) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.DeveloperKey, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -76,6 +77,10 @@ func (t *UpdatePublicJwk) Do(c *canvasapi.Canvas) (*models.DeveloperKey, error)
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -43,13 +41,13 @@ import (
 //
 type UpdateRole struct {
 	Path struct {
-		AccountID string `json:"account_id" url:"account_id,omitempty"` //  (Required)
-		ID        string `json:"id" url:"id,omitempty"`                 //  (Required)
+		AccountID canvasapi.ID `json:"account_id" url:"account_id,omitempty"` //  (Required)
+		ID        canvasapi.ID `json:"id" url:"id,omitempty"`                 //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		Label       string `json:"label" url:"label,omitempty"` //  (Optional)
-		Permissions map[string]UpdateRolePermissions
+		Label       canvasapi.Opt[string]            `json:"label" url:"label,omitempty"`             //  (Optional)
+		Permissions map[string]UpdateRolePermissions `json:"permissions" url:"permissions,omitempty"` //  (Optional)
 	} `json:"form"`
 }
 
@@ -59,8 +57,8 @@ func (t *UpdateRole) GetMethod() string {
 
 func (t *UpdateRole) GetURLPath() string {
 	path := "accounts/{account_id}/roles/{id}"
-	path = strings.ReplaceAll(path, "{account_id}", fmt.Sprintf("%v", t.Path.AccountID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{account_id}", t.Path.AccountID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -69,33 +67,37 @@ func (t *UpdateRole) GetQuery() (string, error) {
 }
 
 func (t *UpdateRole) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateRole) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdateRole) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.AccountID == "" {
-		errs = append(errs, "'Path.AccountID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.AccountID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *UpdateRole) Do(c *canvasapi.Canvas) (*models.Role, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateRole) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Role, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -110,13 +112,17 @@ func (t *UpdateRole) Do(c *canvasapi.Canvas) (*models.Role, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
 
 type UpdateRolePermissions struct {
-	Explicit             bool `json:"explicit" url:"explicit,omitempty"`                             //  (Optional)
-	Enabled              bool `json:"enabled" url:"enabled,omitempty"`                               //  (Optional)
-	AppliesToSelf        bool `json:"applies_to_self" url:"applies_to_self,omitempty"`               //  (Optional)
-	AppliesToDescendants bool `json:"applies_to_descendants" url:"applies_to_descendants,omitempty"` //  (Optional)
+	Explicit             canvasapi.Opt[bool] `json:"explicit" url:"explicit,omitempty"`                             //  (Optional)
+	Enabled              canvasapi.Opt[bool] `json:"enabled" url:"enabled,omitempty"`                               //  (Optional)
+	AppliesToSelf        canvasapi.Opt[bool] `json:"applies_to_self" url:"applies_to_self,omitempty"`               //  (Optional)
+	AppliesToDescendants canvasapi.Opt[bool] `json:"applies_to_descendants" url:"applies_to_descendants,omitempty"` //  (Optional)
 }
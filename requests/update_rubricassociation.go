@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
@@ -35,20 +33,20 @@ import (
 //
 type UpdateRubricassociation struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		ID       int64  `json:"id" url:"id,omitempty"`               //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		ID       canvasapi.ID `json:"id" url:"id,omitempty"`               //  (Required)
 	} `json:"path"`
 
 	Form struct {
 		RubricAssociation struct {
-			RubricID        int64  `json:"rubric_id" url:"rubric_id,omitempty"`               //  (Optional)
-			AssociationID   int64  `json:"association_id" url:"association_id,omitempty"`     //  (Optional)
-			AssociationType string `json:"association_type" url:"association_type,omitempty"` //  (Optional) . Must be one of Assignment, Course, Account
-			Title           string `json:"title" url:"title,omitempty"`                       //  (Optional)
-			UseForGrading   bool   `json:"use_for_grading" url:"use_for_grading,omitempty"`   //  (Optional)
-			HideScoreTotal  bool   `json:"hide_score_total" url:"hide_score_total,omitempty"` //  (Optional)
-			Purpose         string `json:"purpose" url:"purpose,omitempty"`                   //  (Optional) . Must be one of grading, bookmark
-			Bookmarked      bool   `json:"bookmarked" url:"bookmarked,omitempty"`             //  (Optional)
+			RubricID        canvasapi.Opt[int64]  `json:"rubric_id" url:"rubric_id,omitempty"`               //  (Optional)
+			AssociationID   canvasapi.Opt[int64]  `json:"association_id" url:"association_id,omitempty"`     //  (Optional)
+			AssociationType canvasapi.Opt[string] `json:"association_type" url:"association_type,omitempty"` //  (Optional) . Must be one of Assignment, Course, Account
+			Title           canvasapi.Opt[string] `json:"title" url:"title,omitempty"`                       //  (Optional)
+			UseForGrading   canvasapi.Opt[bool]   `json:"use_for_grading" url:"use_for_grading,omitempty"`   //  (Optional)
+			HideScoreTotal  canvasapi.Opt[bool]   `json:"hide_score_total" url:"hide_score_total,omitempty"` //  (Optional)
+			Purpose         canvasapi.Opt[string] `json:"purpose" url:"purpose,omitempty"`                   //  (Optional) . Must be one of grading, bookmark
+			Bookmarked      canvasapi.Opt[bool]   `json:"bookmarked" url:"bookmarked,omitempty"`             //  (Optional)
 		} `json:"rubric_association" url:"rubric_association,omitempty"`
 	} `json:"form"`
 }
@@ -59,8 +57,8 @@ func (t *UpdateRubricassociation) GetMethod() string {
 
 func (t *UpdateRubricassociation) GetURLPath() string {
 	path := "courses/{course_id}/rubric_associations/{id}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -69,36 +67,43 @@ func (t *UpdateRubricassociation) GetQuery() (string, error) {
 }
 
 func (t *UpdateRubricassociation) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateRubricassociation) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdateRubricassociation) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
+	if t.Path.ID == "" {
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
+	}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
-	if t.Form.RubricAssociation.AssociationType != "" && !string_utils.Include([]string{"Assignment", "Course", "Account"}, t.Form.RubricAssociation.AssociationType) {
-		errs = append(errs, "RubricAssociation must be one of Assignment, Course, Account")
+	if t.Form.RubricAssociation.AssociationType.Value() != "" && !string_utils.Include([]string{"Assignment", "Course", "Account"}, t.Form.RubricAssociation.AssociationType.Value()) {
+		errs = append(errs, canvasapi.FieldError{Field: "Form.RubricAssociation.AssociationType", Rule: canvasapi.RuleOneOf, Allowed: []string{"Assignment", "Course", "Account"}})
 	}
-	if t.Form.RubricAssociation.Purpose != "" && !string_utils.Include([]string{"grading", "bookmark"}, t.Form.RubricAssociation.Purpose) {
-		errs = append(errs, "RubricAssociation must be one of grading, bookmark")
+	if t.Form.RubricAssociation.Purpose.Value() != "" && !string_utils.Include([]string{"grading", "bookmark"}, t.Form.RubricAssociation.Purpose.Value()) {
+		errs = append(errs, canvasapi.FieldError{Field: "Form.RubricAssociation.Purpose", Rule: canvasapi.RuleOneOf, Allowed: []string{"grading", "bookmark"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *UpdateRubricassociation) Do(c *canvasapi.Canvas) (*models.RubricAssociation, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateRubricassociation) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.RubricAssociation, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -113,6 +118,10 @@ func (t *UpdateRubricassociation) Do(c *canvasapi.Canvas) (*models.RubricAssocia
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
@@ -1,15 +1,15 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // UpdateSingleGradingPeriod Update an existing grading period.
@@ -26,8 +26,8 @@ import (
 //
 type UpdateSingleGradingPeriod struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		ID       string `json:"id" url:"id,omitempty"`               //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		ID       canvasapi.ID `json:"id" url:"id,omitempty"`               //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -45,8 +45,8 @@ func (t *UpdateSingleGradingPeriod) GetMethod() string {
 
 func (t *UpdateSingleGradingPeriod) GetURLPath() string {
 	path := "courses/{course_id}/grading_periods/{id}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -55,42 +55,61 @@ func (t *UpdateSingleGradingPeriod) GetQuery() (string, error) {
 }
 
 func (t *UpdateSingleGradingPeriod) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateSingleGradingPeriod) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdateSingleGradingPeriod) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.GradingPeriods.StartDate == nil {
-		errs = append(errs, "'Form.GradingPeriods.StartDate' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.GradingPeriods.StartDate", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.GradingPeriods.EndDate == nil {
-		errs = append(errs, "'Form.GradingPeriods.EndDate' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.GradingPeriods.EndDate", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *UpdateSingleGradingPeriod) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *UpdateSingleGradingPeriod) Do(c *canvasapi.Canvas) (*models.GradingPeriodsResponse, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateSingleGradingPeriod) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.GradingPeriodsResponse, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.GradingPeriodsResponse{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,14 +1,14 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 	"github.com/atomicjolt/string_utils"
 )
 
@@ -42,25 +42,25 @@ import (
 //
 type UpdateSingleRubric struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		ID       int64  `json:"id" url:"id,omitempty"`               //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		ID       canvasapi.ID `json:"id" url:"id,omitempty"`               //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		RubricAssociationID int64 `json:"rubric_association_id" url:"rubric_association_id,omitempty"` //  (Optional)
+		RubricAssociationID canvasapi.Opt[int64] `json:"rubric_association_id" url:"rubric_association_id,omitempty"` //  (Optional)
 		Rubric              struct {
-			Title                      string                   `json:"title" url:"title,omitempty"`                                                 //  (Optional)
-			FreeFormCriterionComments  bool                     `json:"free_form_criterion_comments" url:"free_form_criterion_comments,omitempty"`   //  (Optional)
-			SkipUpdatingPointsPossible bool                     `json:"skip_updating_points_possible" url:"skip_updating_points_possible,omitempty"` //  (Optional)
+			Title                      canvasapi.Opt[string]    `json:"title" url:"title,omitempty"`                                                 //  (Optional)
+			FreeFormCriterionComments  canvasapi.Opt[bool]      `json:"free_form_criterion_comments" url:"free_form_criterion_comments,omitempty"`   //  (Optional)
+			SkipUpdatingPointsPossible canvasapi.Opt[bool]      `json:"skip_updating_points_possible" url:"skip_updating_points_possible,omitempty"` //  (Optional)
 			Criteria                   map[string](interface{}) `json:"criteria" url:"criteria,omitempty"`                                           //  (Optional)
 		} `json:"rubric" url:"rubric,omitempty"`
 
 		RubricAssociation struct {
-			AssociationID   int64  `json:"association_id" url:"association_id,omitempty"`     //  (Optional)
-			AssociationType string `json:"association_type" url:"association_type,omitempty"` //  (Optional) . Must be one of Assignment, Course, Account
-			UseForGrading   bool   `json:"use_for_grading" url:"use_for_grading,omitempty"`   //  (Optional)
-			HideScoreTotal  bool   `json:"hide_score_total" url:"hide_score_total,omitempty"` //  (Optional)
-			Purpose         string `json:"purpose" url:"purpose,omitempty"`                   //  (Optional) . Must be one of grading, bookmark
+			AssociationID   canvasapi.Opt[int64]  `json:"association_id" url:"association_id,omitempty"`     //  (Optional)
+			AssociationType canvasapi.Opt[string] `json:"association_type" url:"association_type,omitempty"` //  (Optional) . Must be one of Assignment, Course, Account
+			UseForGrading   canvasapi.Opt[bool]   `json:"use_for_grading" url:"use_for_grading,omitempty"`   //  (Optional)
+			HideScoreTotal  canvasapi.Opt[bool]   `json:"hide_score_total" url:"hide_score_total,omitempty"` //  (Optional)
+			Purpose         canvasapi.Opt[string] `json:"purpose" url:"purpose,omitempty"`                   //  (Optional) . Must be one of grading, bookmark
 		} `json:"rubric_association" url:"rubric_association,omitempty"`
 	} `json:"form"`
 }
@@ -71,8 +71,8 @@ func (t *UpdateSingleRubric) GetMethod() string {
 
 func (t *UpdateSingleRubric) GetURLPath() string {
 	path := "courses/{course_id}/rubrics/{id}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -81,39 +81,61 @@ func (t *UpdateSingleRubric) GetQuery() (string, error) {
 }
 
 func (t *UpdateSingleRubric) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateSingleRubric) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdateSingleRubric) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
+	if t.Path.ID == "" {
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
+	}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
-	if t.Form.RubricAssociation.AssociationType != "" && !string_utils.Include([]string{"Assignment", "Course", "Account"}, t.Form.RubricAssociation.AssociationType) {
-		errs = append(errs, "RubricAssociation must be one of Assignment, Course, Account")
+	if t.Form.RubricAssociation.AssociationType.Value() != "" && !string_utils.Include([]string{"Assignment", "Course", "Account"}, t.Form.RubricAssociation.AssociationType.Value()) {
+		errs = append(errs, canvasapi.FieldError{Field: "Form.RubricAssociation.AssociationType", Rule: canvasapi.RuleOneOf, Allowed: []string{"Assignment", "Course", "Account"}})
 	}
-	if t.Form.RubricAssociation.Purpose != "" && !string_utils.Include([]string{"grading", "bookmark"}, t.Form.RubricAssociation.Purpose) {
-		errs = append(errs, "RubricAssociation must be one of grading, bookmark")
+	if t.Form.RubricAssociation.Purpose.Value() != "" && !string_utils.Include([]string{"grading", "bookmark"}, t.Form.RubricAssociation.Purpose.Value()) {
+		errs = append(errs, canvasapi.FieldError{Field: "Form.RubricAssociation.Purpose", Rule: canvasapi.RuleOneOf, Allowed: []string{"grading", "bookmark"}})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *UpdateSingleRubric) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *UpdateSingleRubric) Do(c *canvasapi.Canvas) (*models.RubricResponse, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateSingleRubric) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.RubricResponse, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.RubricResponse{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,14 +1,14 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
 )
 
 // UpdateSingleRubricAssessment Returns the rubric assessment with the given id.
@@ -39,15 +39,15 @@ import (
 //
 type UpdateSingleRubricAssessment struct {
 	Path struct {
-		ID                  int64 `json:"id" url:"id,omitempty"`                                       //  (Required)
-		CourseID            int64 `json:"course_id" url:"course_id,omitempty"`                         //  (Required)
-		RubricAssociationID int64 `json:"rubric_association_id" url:"rubric_association_id,omitempty"` //  (Required)
+		ID                  canvasapi.ID `json:"id" url:"id,omitempty"`                                       //  (Required)
+		CourseID            canvasapi.ID `json:"course_id" url:"course_id,omitempty"`                         //  (Required)
+		RubricAssociationID canvasapi.ID `json:"rubric_association_id" url:"rubric_association_id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		Provisional       string                   `json:"provisional" url:"provisional,omitempty"`               //  (Optional)
-		Final             string                   `json:"final" url:"final,omitempty"`                           //  (Optional)
-		GradedAnonymously bool                     `json:"graded_anonymously" url:"graded_anonymously,omitempty"` //  (Optional)
+		Provisional       canvasapi.Opt[string]    `json:"provisional" url:"provisional,omitempty"`               //  (Optional)
+		Final             canvasapi.Opt[string]    `json:"final" url:"final,omitempty"`                           //  (Optional)
+		GradedAnonymously canvasapi.Opt[bool]      `json:"graded_anonymously" url:"graded_anonymously,omitempty"` //  (Optional)
 		RubricAssessment  map[string](interface{}) `json:"rubric_assessment" url:"rubric_assessment,omitempty"`   //  (Optional)
 	} `json:"form"`
 }
@@ -58,9 +58,9 @@ func (t *UpdateSingleRubricAssessment) GetMethod() string {
 
 func (t *UpdateSingleRubricAssessment) GetURLPath() string {
 	path := "courses/{course_id}/rubric_associations/{rubric_association_id}/rubric_assessments/{id}"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{rubric_association_id}", fmt.Sprintf("%v", t.Path.RubricAssociationID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{rubric_association_id}", t.Path.RubricAssociationID.Escaped())
 	return path
 }
 
@@ -69,26 +69,63 @@ func (t *UpdateSingleRubricAssessment) GetQuery() (string, error) {
 }
 
 func (t *UpdateSingleRubricAssessment) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateSingleRubricAssessment) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
+// PrefersJSON reports that Canvas expects the body of this request as JSON.
+func (t *UpdateSingleRubricAssessment) PrefersJSON() bool {
+	return true
+}
+
 func (t *UpdateSingleRubricAssessment) HasErrors() error {
+	errs := []canvasapi.FieldError{}
+	if t.Path.ID == "" {
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
+	}
+	if t.Path.CourseID == "" {
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
+	}
+	if t.Path.RubricAssociationID == "" {
+		errs = append(errs, canvasapi.FieldError{Field: "Path.RubricAssociationID", Rule: canvasapi.RuleRequired})
+	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
+	}
 	return nil
 }
 
-func (t *UpdateSingleRubricAssessment) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *UpdateSingleRubricAssessment) Do(c *canvasapi.Canvas) (*models.RubricAssessment, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateSingleRubricAssessment) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.RubricAssessment, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ret := models.RubricAssessment{}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
 }
@@ -1,14 +1,12 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 	"github.com/atomicjolt/canvasapi/models"
 )
@@ -28,13 +26,13 @@ import (
 //
 type UpdateTabForCourse struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		TabID    string `json:"tab_id" url:"tab_id,omitempty"`       //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		TabID    canvasapi.ID `json:"tab_id" url:"tab_id,omitempty"`       //  (Required)
 	} `json:"path"`
 
 	Form struct {
-		Position int64 `json:"position" url:"position,omitempty"` //  (Optional)
-		Hidden   bool  `json:"hidden" url:"hidden,omitempty"`     //  (Optional)
+		Position canvasapi.Opt[int64] `json:"position" url:"position,omitempty"` //  (Optional)
+		Hidden   canvasapi.Opt[bool]  `json:"hidden" url:"hidden,omitempty"`     //  (Optional)
 	} `json:"form"`
 }
 
@@ -44,8 +42,8 @@ func (t *UpdateTabForCourse) GetMethod() string {
 
 func (t *UpdateTabForCourse) GetURLPath() string {
 	path := "courses/{course_id}/tabs/{tab_id}"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{tab_id}", fmt.Sprintf("%v", t.Path.TabID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{tab_id}", t.Path.TabID.Escaped())
 	return path
 }
 
@@ -54,33 +52,37 @@ func (t *UpdateTabForCourse) GetQuery() (string, error) {
 }
 
 func (t *UpdateTabForCourse) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *UpdateTabForCourse) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *UpdateTabForCourse) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.TabID == "" {
-		errs = append(errs, "'Path.TabID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.TabID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *UpdateTabForCourse) Do(c *canvasapi.Canvas) (*models.Tab, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateTabForCourse) DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.Tab, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return nil, err
 	}
@@ -95,6 +97,10 @@ func (t *UpdateTabForCourse) Do(c *canvasapi.Canvas) (*models.Tab, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.ValidateResponse(&ret)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ret, nil
 }
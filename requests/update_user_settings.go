@@ -1,12 +1,12 @@
 package requests
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 )
 
@@ -29,16 +29,16 @@ import (
 //
 type UpdateUserSettings struct {
 	Path struct {
-		ID string `json:"id" url:"id,omitempty"` //  (Required)
+		ID canvasapi.ID `json:"id" url:"id,omitempty"` //  (Required)
 	} `json:"path"`
 
 	Query struct {
-		ManualMarkAsRead                 bool `json:"manual_mark_as_read" url:"manual_mark_as_read,omitempty"`                                 //  (Optional)
-		ReleaseNotesBadgeDisabled        bool `json:"release_notes_badge_disabled" url:"release_notes_badge_disabled,omitempty"`               //  (Optional)
-		CollapseGlobalNav                bool `json:"collapse_global_nav" url:"collapse_global_nav,omitempty"`                                 //  (Optional)
-		HideDashcardColorOverlays        bool `json:"hide_dashcard_color_overlays" url:"hide_dashcard_color_overlays,omitempty"`               //  (Optional)
-		CommentLibrarySuggestionsEnabled bool `json:"comment_library_suggestions_enabled" url:"comment_library_suggestions_enabled,omitempty"` //  (Optional)
-		ElementaryDashboardDisabled      bool `json:"elementary_dashboard_disabled" url:"elementary_dashboard_disabled,omitempty"`             //  (Optional)
+		ManualMarkAsRead                 canvasapi.Opt[bool] `json:"manual_mark_as_read" url:"manual_mark_as_read,omitempty"`                                 //  (Optional)
+		ReleaseNotesBadgeDisabled        canvasapi.Opt[bool] `json:"release_notes_badge_disabled" url:"release_notes_badge_disabled,omitempty"`               //  (Optional)
+		CollapseGlobalNav                canvasapi.Opt[bool] `json:"collapse_global_nav" url:"collapse_global_nav,omitempty"`                                 //  (Optional)
+		HideDashcardColorOverlays        canvasapi.Opt[bool] `json:"hide_dashcard_color_overlays" url:"hide_dashcard_color_overlays,omitempty"`               //  (Optional)
+		CommentLibrarySuggestionsEnabled canvasapi.Opt[bool] `json:"comment_library_suggestions_enabled" url:"comment_library_suggestions_enabled,omitempty"` //  (Optional)
+		ElementaryDashboardDisabled      canvasapi.Opt[bool] `json:"elementary_dashboard_disabled" url:"elementary_dashboard_disabled,omitempty"`             //  (Optional)
 	} `json:"query"`
 }
 
@@ -48,12 +48,12 @@ func (t *UpdateUserSettings) GetMethod() string {
 
 func (t *UpdateUserSettings) GetURLPath() string {
 	path := "users/{id}/settings"
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
 func (t *UpdateUserSettings) GetQuery() (string, error) {
-	v, err := query.Values(t.Query)
+	v, err := canvasapi.EncodeValues(t.Query)
 	if err != nil {
 		return "", err
 	}
@@ -69,21 +69,31 @@ func (t *UpdateUserSettings) GetJSON() ([]byte, error) {
 }
 
 func (t *UpdateUserSettings) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
-func (t *UpdateUserSettings) Do(c *canvasapi.Canvas) error {
-	_, err := c.SendRequest(t)
+func (t *UpdateUserSettings) Do(c *canvasapi.Canvas) (json.RawMessage, error) {
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *UpdateUserSettings) DoContext(ctx context.Context, c *canvasapi.Canvas) (json.RawMessage, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(body), nil
 }
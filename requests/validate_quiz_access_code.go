@@ -1,14 +1,11 @@
 package requests
 
 import (
-	"encoding/json"
-	"fmt"
+	"context"
 	"io/ioutil"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	"github.com/atomicjolt/canvasapi"
 )
 
@@ -24,8 +21,8 @@ import (
 //
 type ValidateQuizAccessCode struct {
 	Path struct {
-		CourseID string `json:"course_id" url:"course_id,omitempty"` //  (Required)
-		ID       string `json:"id" url:"id,omitempty"`               //  (Required)
+		CourseID canvasapi.ID `json:"course_id" url:"course_id,omitempty"` //  (Required)
+		ID       canvasapi.ID `json:"id" url:"id,omitempty"`               //  (Required)
 	} `json:"path"`
 
 	Form struct {
@@ -39,8 +36,8 @@ func (t *ValidateQuizAccessCode) GetMethod() string {
 
 func (t *ValidateQuizAccessCode) GetURLPath() string {
 	path := "courses/{course_id}/quizzes/{id}/validate_access_code"
-	path = strings.ReplaceAll(path, "{course_id}", fmt.Sprintf("%v", t.Path.CourseID))
-	path = strings.ReplaceAll(path, "{id}", fmt.Sprintf("%v", t.Path.ID))
+	path = strings.ReplaceAll(path, "{course_id}", t.Path.CourseID.Escaped())
+	path = strings.ReplaceAll(path, "{id}", t.Path.ID.Escaped())
 	return path
 }
 
@@ -49,36 +46,40 @@ func (t *ValidateQuizAccessCode) GetQuery() (string, error) {
 }
 
 func (t *ValidateQuizAccessCode) GetBody() (url.Values, error) {
-	return query.Values(t.Form)
+	return canvasapi.EncodeValues(t.Form)
 }
 
 func (t *ValidateQuizAccessCode) GetJSON() ([]byte, error) {
-	j, err := json.Marshal(t.Form)
+	j, err := canvasapi.EncodeJSON(t.Form)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return j, nil
 }
 
 func (t *ValidateQuizAccessCode) HasErrors() error {
-	errs := []string{}
+	errs := []canvasapi.FieldError{}
 	if t.Path.CourseID == "" {
-		errs = append(errs, "'Path.CourseID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.CourseID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Path.ID == "" {
-		errs = append(errs, "'Path.ID' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Path.ID", Rule: canvasapi.RuleRequired})
 	}
 	if t.Form.AccessCode == "" {
-		errs = append(errs, "'Form.AccessCode' is required")
+		errs = append(errs, canvasapi.FieldError{Field: "Form.AccessCode", Rule: canvasapi.RuleRequired})
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf(strings.Join(errs, ", "))
+		return &canvasapi.ValidationError{Errors: errs}
 	}
 	return nil
 }
 
 func (t *ValidateQuizAccessCode) Do(c *canvasapi.Canvas) (bool, error) {
-	response, err := c.SendRequest(t)
+	return t.DoContext(context.Background(), c)
+}
+
+func (t *ValidateQuizAccessCode) DoContext(ctx context.Context, c *canvasapi.Canvas) (bool, error) {
+	response, err := c.SendRequestContext(ctx, t)
 	if err != nil {
 		return false, err
 	}
@@ -0,0 +1,135 @@
+package canvasapi
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried. Requests are retried when Canvas
+// responds with one of RetryStatuses, when the rate limit is exceeded or when the
+// connection fails. Only RetryMethods are retried, POST is retried only when RetryPOST is set.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry. It doubles on every retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between attempts.
+	MaxBackoff time.Duration
+	// Jitter randomizes each delay by up to this fraction of it, between 0 and 1.
+	Jitter float64
+	// RetryStatuses lists the HTTP status codes that are retried.
+	RetryStatuses []int
+	// RetryMethods lists the HTTP methods that are retried.
+	RetryMethods []string
+	// RetryPOST allows POST requests to be retried. POST is not idempotent so this is opt in.
+	RetryPOST bool
+	// OnRetry is called before waiting for each retry.
+	OnRetry func(RetryEvent)
+}
+
+// RetryEvent describes a failed attempt that is about to be retried.
+type RetryEvent struct {
+	// Attempt is the number of the attempt that failed, starting at 1.
+	Attempt int
+	Method  string
+	URL     string
+	Err     error
+	// Delay is how long the client waits before the next attempt.
+	Delay time.Duration
+}
+
+// DefaultRetryPolicy returns a RetryPolicy that makes up to 4 attempts of GET, PUT and
+// DELETE requests that were throttled or failed with 429, 502, 503 or 504.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    4,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     30 * time.Second,
+		Jitter:         0.2,
+		RetryStatuses: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryMethods: []string{http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete},
+	}
+}
+
+// WithRetryPolicy enables retries of failed requests.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Canvas) {
+		c.RetryPolicy = &policy
+	}
+}
+
+// retry returns whether the attempt that failed with err should be retried and how long
+// to wait before retrying.
+func (p *RetryPolicy) retry(ctx context.Context, attempt int, method string, err error) (time.Duration, bool) {
+	if p == nil || attempt >= p.MaxAttempts || ctx.Err() != nil {
+		return 0, false
+	}
+	if !p.retryMethod(method) {
+		return 0, false
+	}
+
+	var apiError *APIError
+	if errors.As(err, &apiError) {
+		if !apiError.isRateLimit() && !p.retryStatus(apiError.StatusCode) {
+			return 0, false
+		}
+		if delay, ok := retryAfter(apiError.Header); ok {
+			return delay, true
+		}
+	}
+	return p.backoff(attempt), true
+}
+
+func (p *RetryPolicy) retryMethod(method string) bool {
+	if strings.EqualFold(method, http.MethodPost) {
+		return p.RetryPOST
+	}
+	for _, m := range p.RetryMethods {
+		if strings.EqualFold(m, method) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *RetryPolicy) retryStatus(statusCode int) bool {
+	for _, status := range p.RetryStatuses {
+		if status == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.InitialBackoff
+	for i := 1; i < attempt && (p.MaxBackoff <= 0 || delay < p.MaxBackoff); i++ {
+		delay *= 2
+	}
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	if p.Jitter > 0 {
+		delay += time.Duration(float64(delay) * p.Jitter * (2*rand.Float64() - 1))
+	}
+	return delay
+}
+
+// retryAfter reads a Retry-After header given in seconds.
+func retryAfter(header http.Header) (time.Duration, bool) {
+	seconds, err := strconv.Atoi(header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+	return time.Duration(seconds) * time.Second, true
+}
@@ -0,0 +1,68 @@
+package canvasapi
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	tests := []struct {
+		name      string
+		method    string
+		retryPOST bool
+		attempts  int
+	}{
+		{name: "retries PUT", method: http.MethodPut, attempts: 3},
+		{name: "does not retry POST by default", method: http.MethodPost, attempts: 1},
+		{name: "retries POST when opted in", method: http.MethodPost, retryPOST: true, attempts: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts++
+				body, _ := ioutil.ReadAll(r.Body)
+				if string(body) != "name=test" {
+					t.Errorf("expected the body to be sent on attempt %v, got %q", attempts, body)
+				}
+				if attempts < 3 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			retries := []RetryEvent{}
+			policy := DefaultRetryPolicy()
+			policy.InitialBackoff = time.Millisecond
+			policy.RetryPOST = tt.retryPOST
+			policy.OnRetry = func(event RetryEvent) {
+				retries = append(retries, event)
+			}
+			canvas := New("test", "", WithBaseURL(server.URL), WithRetryPolicy(policy))
+
+			response, err := canvas.SendRequest(&testRequest{
+				method: tt.method,
+				path:   "courses/1",
+				body:   url.Values{"name": []string{"test"}},
+			})
+			if err == nil {
+				response.Body.Close()
+			}
+			if attempts != tt.attempts {
+				t.Errorf("expected %v attempts, got %v", tt.attempts, attempts)
+			}
+			if len(retries) != tt.attempts-1 {
+				t.Errorf("expected OnRetry to be called %v times, got %v", tt.attempts-1, len(retries))
+			}
+			if tt.attempts == 3 && err != nil {
+				t.Errorf("expected the request to succeed after retrying, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package sis
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/atomicjolt/canvasapi"
+)
+
+// Bundle holds the rows of a SIS import. Every file with rows is written to the zip that is
+// uploaded to Canvas, which processes the files in dependency order.
+type Bundle struct {
+	Accounts         []Account
+	Terms            []Term
+	Users            []User
+	Logins           []Login
+	Courses          []Course
+	Sections         []Section
+	Xlists           []Xlist
+	Enrollments      []Enrollment
+	Groups           []Group
+	GroupMemberships []GroupMembership
+}
+
+type bundleFile struct {
+	field string
+	name  string
+	rows  interface{}
+}
+
+func (b *Bundle) files() []bundleFile {
+	return []bundleFile{
+		{"Accounts", "accounts.csv", b.Accounts},
+		{"Terms", "terms.csv", b.Terms},
+		{"Users", "users.csv", b.Users},
+		{"Logins", "logins.csv", b.Logins},
+		{"Courses", "courses.csv", b.Courses},
+		{"Sections", "sections.csv", b.Sections},
+		{"Xlists", "xlists.csv", b.Xlists},
+		{"Enrollments", "enrollments.csv", b.Enrollments},
+		{"Groups", "groups.csv", b.Groups},
+		{"GroupMemberships", "group_membership.csv", b.GroupMemberships},
+	}
+}
+
+// Validate returns a *canvasapi.ValidationError listing every required cell that is empty,
+// such as Users[3].LoginID. When none of the columns of a required group has a value, such as
+// the CourseID and SectionID of an enrollment, the first is listed with the others in Allowed.
+func (b *Bundle) Validate() error {
+	errs := []canvasapi.FieldError{}
+	for _, file := range b.files() {
+		rows := reflect.ValueOf(file.rows)
+		for i := 0; i < rows.Len(); i++ {
+			row := rows.Index(i)
+			groups := []string{}
+			members := map[string][]string{}
+			filled := map[string]bool{}
+			for _, column := range columnsOf(row.Type()) {
+				name := row.Type().Field(column.index).Name
+				value := cell(row.Field(column.index))
+				if column.required && value == "" {
+					field := fmt.Sprintf("%s[%d].%s", file.field, i, name)
+					errs = append(errs, canvasapi.FieldError{Field: field, Rule: canvasapi.RuleRequired})
+				}
+				if column.group != "" {
+					if members[column.group] == nil {
+						groups = append(groups, column.group)
+					}
+					members[column.group] = append(members[column.group], name)
+					filled[column.group] = filled[column.group] || value != ""
+				}
+			}
+			for _, group := range groups {
+				if !filled[group] {
+					field := fmt.Sprintf("%s[%d].%s", file.field, i, members[group][0])
+					errs = append(errs, canvasapi.FieldError{Field: field, Rule: canvasapi.RuleRequired, Allowed: members[group][1:]})
+				}
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+// WriteZip validates the bundle and writes a zip holding a CSV file for every kind of row it
+// has.
+func (b *Bundle) WriteZip(w io.Writer) error {
+	if err := b.Validate(); err != nil {
+		return err
+	}
+	archive := zip.NewWriter(w)
+	empty := true
+	for _, file := range b.files() {
+		rows := reflect.ValueOf(file.rows)
+		if rows.Len() == 0 {
+			continue
+		}
+		empty = false
+		f, err := archive.Create(file.name)
+		if err != nil {
+			return err
+		}
+		if err := writeCSV(f, rows); err != nil {
+			return err
+		}
+	}
+	if empty {
+		return fmt.Errorf("sis: the bundle has no rows")
+	}
+	return archive.Close()
+}
+
+// Zip returns the zip written by WriteZip.
+func (b *Bundle) Zip() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := b.WriteZip(buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+type column struct {
+	index    int
+	name     string
+	required bool
+	// group names the required group of the column, see the csv tags of the rows.
+	group  string
+	always bool
+}
+
+func columnsOf(t reflect.Type) []column {
+	columns := []column{}
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("csv")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		parts := strings.Split(tag, ",")
+		c := column{index: i, name: parts[0]}
+		for _, option := range parts[1:] {
+			switch {
+			case option == "required":
+				c.required = true
+			case strings.HasPrefix(option, "required="):
+				c.group = strings.TrimPrefix(option, "required=")
+			case option == "column":
+				c.always = true
+			}
+		}
+		columns = append(columns, c)
+	}
+	return columns
+}
+
+// writeCSV writes the rows with the required columns and the optional columns any row sets.
+func writeCSV(w io.Writer, rows reflect.Value) error {
+	written := []column{}
+	for _, c := range columnsOf(rows.Type().Elem()) {
+		used := c.required || c.always
+		for i := 0; i < rows.Len() && !used; i++ {
+			used = cell(rows.Index(i).Field(c.index)) != ""
+		}
+		if used {
+			written = append(written, c)
+		}
+	}
+
+	writer := csv.NewWriter(w)
+	record := make([]string, len(written))
+	for i, c := range written {
+		record[i] = c.name
+	}
+	if err := writer.Write(record); err != nil {
+		return err
+	}
+	for i := 0; i < rows.Len(); i++ {
+		for j, c := range written {
+			record[j] = cell(rows.Index(i).Field(c.index))
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+type optional interface {
+	IsSet() bool
+}
+
+func cell(v reflect.Value) string {
+	switch value := v.Interface().(type) {
+	case string:
+		return value
+	case time.Time:
+		if value.IsZero() {
+			return ""
+		}
+		return value.Format(time.RFC3339)
+	case optional:
+		if !value.IsSet() {
+			return ""
+		}
+	}
+	return fmt.Sprint(v.Interface())
+}
@@ -0,0 +1,227 @@
+// Package sis builds, uploads and tracks SIS imports, see
+// https://canvas.instructure.com/doc/api/sis_imports.html
+//
+//	bundle := &sis.Bundle{
+//		Users:       []sis.User{{UserID: "u1", LoginID: "jdoe", FullName: "Jane Doe", Status: sis.StatusActive}},
+//		Enrollments: []sis.Enrollment{{CourseID: "bio101", UserID: "u1", Role: "student", Status: sis.StatusActive}},
+//	}
+//	result, err := sis.Import(ctx, &canvas, canvasapi.Self, bundle, sis.ImportOptions{})
+package sis
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
+	"github.com/atomicjolt/canvasapi/requests"
+	"github.com/atomicjolt/canvasapi/workflow"
+)
+
+// ImportOptions configure Import, ImportFile and Wait.
+type ImportOptions struct {
+	// Request holds the parameters of the import, such as BatchMode and BatchModeTermID. The
+	// account and the attachment are set by Import, and ImportType defaults to instructure_csv.
+	Request requests.ImportSISData
+	// InitialInterval and MaxInterval pace the polls of the import, see workflow.PollOptions.
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+	// OnProgress is called with the import after it is created and after every poll.
+	OnProgress func(*models.SISImport)
+}
+
+// Message is a warning or error Canvas reported for a file of the import.
+type Message struct {
+	File    string
+	Message string
+}
+
+// Result is a finished SIS import.
+type Result struct {
+	Import     *models.SISImport
+	Statistics *models.SISImportStatistics
+	Warnings   []Message
+	Errors     []Message
+	// ErrorRows are the rows of the errors attachment, which lists every row Canvas could not
+	// import. It is nil when the import has no errors attachment.
+	ErrorRows []*models.SISImportError
+}
+
+// ImportError is returned with the Result when an import fails or is aborted.
+type ImportError struct {
+	Result *Result
+}
+
+func (e *ImportError) Error() string {
+	msg := fmt.Sprintf("sis import %d %s", e.Result.Import.ID, e.Result.Import.WorkflowState)
+	if len(e.Result.Errors) > 0 {
+		msg += fmt.Sprintf(": %s: %s", e.Result.Errors[0].File, e.Result.Errors[0].Message)
+	}
+	return msg
+}
+
+// Import zips the bundle, uploads it to the account and waits for Canvas to process it.
+func Import(ctx context.Context, c *canvasapi.Canvas, accountID canvasapi.ID, bundle *Bundle, opts ImportOptions) (*Result, error) {
+	archive, err := bundle.Zip()
+	if err != nil {
+		return nil, err
+	}
+	return ImportFile(ctx, c, accountID, "sis_import.zip", bytes.NewReader(archive), opts)
+}
+
+// ImportFile uploads a zip or CSV file to the account and waits for Canvas to process it.
+func ImportFile(ctx context.Context, c *canvasapi.Canvas, accountID canvasapi.ID, name string, r io.Reader, opts ImportOptions) (*Result, error) {
+	request := opts.Request
+	request.Path.AccountID = accountID
+	if request.Form.ImportType == "" {
+		request.Form.ImportType = "instructure_csv"
+	}
+	if request.Form.Extension == "" {
+		request.Form.Extension = strings.TrimPrefix(filepath.Ext(name), ".")
+	}
+	sisImport, err := workflow.ImportSIS(ctx, c, request, name, r)
+	if err != nil {
+		return nil, err
+	}
+	return Wait(ctx, c, accountID, sisImport, opts)
+}
+
+// Wait polls an import until Canvas has processed it. It returns an *ImportError along with
+// the result when the import failed or was aborted.
+func Wait(ctx context.Context, c *canvasapi.Canvas, accountID canvasapi.ID, sisImport *models.SISImport, opts ImportOptions) (*Result, error) {
+	if sisImport == nil || sisImport.ID == 0 {
+		return nil, fmt.Errorf("a sis import id is required to wait for an import")
+	}
+	var r *Result
+	err := workflow.Poll(ctx, workflow.PollOptions{InitialInterval: opts.InitialInterval, MaxInterval: opts.MaxInterval}, func() (bool, error) {
+		if opts.OnProgress != nil {
+			opts.OnProgress(sisImport)
+		}
+		switch sisImport.WorkflowState {
+		case "imported", "imported_with_messages":
+			var err error
+			r, err = result(ctx, c, sisImport)
+			return true, err
+		case "aborted", "failed", "failed_with_messages":
+			var err error
+			r, err = result(ctx, c, sisImport)
+			if err != nil {
+				return true, err
+			}
+			return true, &ImportError{Result: r}
+		}
+		return false, nil
+	}, func(ctx context.Context) error {
+		status := requests.GetSISImportStatus{}
+		status.Path.AccountID = accountID
+		status.Path.ID = canvasapi.IDFromInt(sisImport.ID)
+		next, err := status.DoContext(ctx, c)
+		if err != nil {
+			return err
+		}
+		sisImport = next
+		return nil
+	})
+	return r, err
+}
+
+func result(ctx context.Context, c *canvasapi.Canvas, sisImport *models.SISImport) (*Result, error) {
+	r := &Result{
+		Import:     sisImport,
+		Statistics: sisImport.Statistics,
+		Warnings:   messages(sisImport.ProcessingWarnings),
+		Errors:     messages(sisImport.ProcessingErrors),
+	}
+	if sisImport.ErrorsAttachment != nil && sisImport.ErrorsAttachment.Url != "" {
+		rows, err := errorRows(ctx, c, sisImport.ErrorsAttachment.Url)
+		if err != nil {
+			return r, err
+		}
+		r.ErrorRows = rows
+	}
+	return r, nil
+}
+
+func messages(pairs [][]string) []Message {
+	msgs := make([]Message, 0, len(pairs))
+	for _, pair := range pairs {
+		msg := Message{}
+		if len(pair) > 0 {
+			msg.File = pair[0]
+		}
+		if len(pair) > 1 {
+			msg.Message = pair[1]
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs
+}
+
+// errorRows downloads the errors attachment and parses its rows.
+func errorRows(ctx context.Context, c *canvasapi.Canvas, location string) ([]*models.SISImportError, error) {
+	u, err := c.ResolveURL(location)
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.SendContext(ctx, u, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	return ParseErrors(response.Body)
+}
+
+// ParseErrors parses the errors attachment of an import. Columns are matched by the names in
+// the header row.
+func ParseErrors(r io.Reader) ([]*models.SISImportError, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err == io.EOF {
+		return []*models.SISImportError{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+	value := func(record []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return record[i]
+		}
+		return ""
+	}
+
+	rows := []*models.SISImportError{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		row := &models.SISImportError{
+			File:    value(record, "file"),
+			Message: value(record, "message"),
+			RowInfo: value(record, "row_info"),
+		}
+		if id, err := strconv.ParseInt(value(record, "sis_import_id"), 10, 64); err == nil {
+			row.SISImportID = id
+		}
+		if line, err := strconv.ParseInt(value(record, "row"), 10, 64); err == nil {
+			row.Row = line
+		}
+		rows = append(rows, row)
+	}
+}
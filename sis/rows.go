@@ -0,0 +1,153 @@
+package sis
+
+import (
+	"time"
+
+	"github.com/atomicjolt/canvasapi"
+)
+
+// The rows of the SIS CSV files, see https://canvas.instructure.com/doc/api/file.sis_csv.html
+//
+// The csv tag of a field is its column. Columns marked required must have a value in every
+// row, and at least one of the columns marked required=<group> must have a value. Columns
+// marked column are always written, and the other columns are only written when a row of the
+// file sets them, so an empty optional field leaves the value in Canvas alone.
+
+// Status values of the rows. Each file only accepts some of them, see the SIS CSV format.
+const (
+	StatusActive    = "active"
+	StatusDeleted   = "deleted"
+	StatusCompleted = "completed"
+	StatusInactive  = "inactive"
+	StatusSuspended = "suspended"
+	StatusPublished = "published"
+)
+
+// User is a row of users.csv.
+type User struct {
+	UserID                     string              `csv:"user_id,required"`
+	IntegrationID              string              `csv:"integration_id"`
+	LoginID                    string              `csv:"login_id,required"`
+	Password                   string              `csv:"password"`
+	SSHAPassword               string              `csv:"ssha_password"`
+	AuthenticationProviderID   string              `csv:"authentication_provider_id"`
+	FirstName                  string              `csv:"first_name"`
+	LastName                   string              `csv:"last_name"`
+	FullName                   string              `csv:"full_name"`
+	SortableName               string              `csv:"sortable_name"`
+	ShortName                  string              `csv:"short_name"`
+	Email                      string              `csv:"email"`
+	Pronouns                   string              `csv:"pronouns"`
+	DeclaredUserType           string              `csv:"declared_user_type"`
+	CanvasPasswordNotification canvasapi.Opt[bool] `csv:"canvas_password_notification"`
+	HomeAccount                canvasapi.Opt[bool] `csv:"home_account"`
+	Status                     string              `csv:"status,required"`
+}
+
+// Account is a row of accounts.csv. An empty ParentAccountID puts the account under the root
+// account.
+type Account struct {
+	AccountID       string `csv:"account_id,required"`
+	ParentAccountID string `csv:"parent_account_id,column"`
+	Name            string `csv:"name,required"`
+	Status          string `csv:"status,required"`
+	IntegrationID   string `csv:"integration_id"`
+}
+
+// Term is a row of terms.csv. A term with DateOverrideEnrollmentType sets the dates of that
+// enrollment type for the term instead of the term itself.
+type Term struct {
+	TermID                     string    `csv:"term_id,required"`
+	Name                       string    `csv:"name,required"`
+	Status                     string    `csv:"status,required"`
+	IntegrationID              string    `csv:"integration_id"`
+	DateOverrideEnrollmentType string    `csv:"date_override_enrollment_type"`
+	StartDate                  time.Time `csv:"start_date"`
+	EndDate                    time.Time `csv:"end_date"`
+}
+
+// Course is a row of courses.csv.
+type Course struct {
+	CourseID             string              `csv:"course_id,required"`
+	ShortName            string              `csv:"short_name,required"`
+	LongName             string              `csv:"long_name,required"`
+	AccountID            string              `csv:"account_id"`
+	TermID               string              `csv:"term_id"`
+	IntegrationID        string              `csv:"integration_id"`
+	Status               string              `csv:"status,required"`
+	StartDate            time.Time           `csv:"start_date"`
+	EndDate              time.Time           `csv:"end_date"`
+	CourseFormat         string              `csv:"course_format"`
+	BlueprintCourseID    string              `csv:"blueprint_course_id"`
+	GradePassbackSetting string              `csv:"grade_passback_setting"`
+	HomeroomCourse       canvasapi.Opt[bool] `csv:"homeroom_course"`
+	FriendlyName         string              `csv:"friendly_name"`
+}
+
+// Section is a row of sections.csv.
+type Section struct {
+	SectionID     string    `csv:"section_id,required"`
+	CourseID      string    `csv:"course_id,required"`
+	Name          string    `csv:"name,required"`
+	Status        string    `csv:"status,required"`
+	IntegrationID string    `csv:"integration_id"`
+	StartDate     time.Time `csv:"start_date"`
+	EndDate       time.Time `csv:"end_date"`
+}
+
+// Enrollment is a row of enrollments.csv. It needs CourseID or SectionID, UserID or
+// UserIntegrationID, and Role or RoleID.
+type Enrollment struct {
+	CourseID               string              `csv:"course_id,required=course"`
+	RootAccount            string              `csv:"root_account"`
+	StartDate              time.Time           `csv:"start_date"`
+	EndDate                time.Time           `csv:"end_date"`
+	UserID                 string              `csv:"user_id,required=user"`
+	UserIntegrationID      string              `csv:"user_integration_id,required=user"`
+	Role                   string              `csv:"role,required=role"`
+	RoleID                 string              `csv:"role_id,required=role"`
+	SectionID              string              `csv:"section_id,required=course"`
+	Status                 string              `csv:"status,required"`
+	AssociatedUserID       string              `csv:"associated_user_id"`
+	LimitSectionPrivileges canvasapi.Opt[bool] `csv:"limit_section_privileges"`
+	Notify                 canvasapi.Opt[bool] `csv:"notify"`
+}
+
+// Group is a row of groups.csv.
+type Group struct {
+	GroupID         string `csv:"group_id,required"`
+	GroupCategoryID string `csv:"group_category_id"`
+	AccountID       string `csv:"account_id"`
+	CourseID        string `csv:"course_id"`
+	Name            string `csv:"name,required"`
+	Status          string `csv:"status,required"`
+}
+
+// GroupMembership is a row of group_membership.csv.
+type GroupMembership struct {
+	GroupID string `csv:"group_id,required"`
+	UserID  string `csv:"user_id,required"`
+	Status  string `csv:"status,required"`
+}
+
+// Xlist is a row of xlists.csv, which cross-lists a section into another course.
+type Xlist struct {
+	XlistCourseID string `csv:"xlist_course_id,required"`
+	SectionID     string `csv:"section_id,required"`
+	Status        string `csv:"status,required"`
+}
+
+// Login is a row of logins.csv, which adds a login to an existing user.
+type Login struct {
+	UserID                   string `csv:"user_id,required"`
+	IntegrationID            string `csv:"integration_id"`
+	LoginID                  string `csv:"login_id,required"`
+	Password                 string `csv:"password"`
+	SSHAPassword             string `csv:"ssha_password"`
+	AuthenticationProviderID string `csv:"authentication_provider_id"`
+	ExistingUserID           string `csv:"existing_user_id"`
+	ExistingIntegrationID    string `csv:"existing_integration_id"`
+	ExistingCanvasUserID     string `csv:"existing_canvas_user_id"`
+	RootAccount              string `csv:"root_account"`
+	Email                    string `csv:"email"`
+}
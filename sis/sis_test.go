@@ -0,0 +1,201 @@
+package sis
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
+)
+
+func readZip(t *testing.T, archive []byte) map[string]string {
+	t.Helper()
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{}
+	for _, f := range reader.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, _ := ioutil.ReadAll(rc)
+		rc.Close()
+		files[f.Name] = string(content)
+	}
+	return files
+}
+
+func TestBundleZip(t *testing.T) {
+	bundle := &Bundle{
+		Accounts: []Account{{AccountID: "science", Name: "Science", Status: StatusActive}},
+		Users: []User{
+			{UserID: "u1", LoginID: "jdoe", FullName: "Jane Doe", Status: StatusActive},
+			{UserID: "u2", LoginID: "rroe", Email: "rroe@example.edu", Status: StatusActive},
+		},
+		Terms: []Term{{TermID: "fall", Name: "Fall", Status: StatusActive, StartDate: time.Date(2026, 8, 24, 0, 0, 0, 0, time.UTC)}},
+		Enrollments: []Enrollment{
+			{CourseID: "bio101", UserID: "u1", Role: "student", Status: StatusActive, Notify: canvasapi.Some(false)},
+		},
+	}
+	archive, err := bundle.Zip()
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := readZip(t, archive)
+	if len(files) != 4 {
+		t.Errorf("expected only the files with rows, got %v", files)
+	}
+	expected := map[string]string{
+		"accounts.csv":    "account_id,parent_account_id,name,status\nscience,,Science,active\n",
+		"users.csv":       "user_id,login_id,full_name,email,status\nu1,jdoe,Jane Doe,,active\nu2,rroe,,rroe@example.edu,active\n",
+		"terms.csv":       "term_id,name,status,start_date\nfall,Fall,active,2026-08-24T00:00:00Z\n",
+		"enrollments.csv": "course_id,user_id,role,status,notify\nbio101,u1,student,active,false\n",
+	}
+	for name, content := range expected {
+		if files[name] != content {
+			t.Errorf("unexpected %s\n got: %q\nwant: %q", name, files[name], content)
+		}
+	}
+}
+
+func TestBundleValidate(t *testing.T) {
+	bundle := &Bundle{Users: []User{{UserID: "u1", LoginID: "jdoe", Status: StatusActive}, {UserID: "u2", Status: StatusActive}}}
+	_, err := bundle.Zip()
+	var validationError *canvasapi.ValidationError
+	if !errors.As(err, &validationError) {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+	if len(validationError.Errors) != 1 || validationError.Field("Users[1].LoginID") == nil {
+		t.Errorf("unexpected errors %v", validationError)
+	}
+
+	if _, err := (&Bundle{}).Zip(); err == nil {
+		t.Error("expected an error for an empty bundle")
+	}
+}
+
+func TestBundleValidateEnrollments(t *testing.T) {
+	bundle := &Bundle{Enrollments: []Enrollment{
+		{CourseID: "bio101", UserID: "u1", Role: "student", Status: StatusActive},
+		{SectionID: "s1", UserIntegrationID: "i1", RoleID: "7", Status: StatusActive},
+		{Status: StatusActive},
+	}}
+	err := bundle.Validate()
+	var validationError *canvasapi.ValidationError
+	if !errors.As(err, &validationError) {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+	if len(validationError.Errors) != 3 {
+		t.Fatalf("expected only the last enrollment to fail, got %v", validationError)
+	}
+	expected := map[string]string{
+		"Enrollments[2].CourseID": "SectionID",
+		"Enrollments[2].UserID":   "UserIntegrationID",
+		"Enrollments[2].Role":     "RoleID",
+	}
+	for field, alternative := range expected {
+		fieldError := validationError.Field(field)
+		if fieldError == nil || fieldError.Rule != canvasapi.RuleRequired || fmt.Sprint(fieldError.Allowed) != "["+alternative+"]" {
+			t.Errorf("unexpected error for %s: %+v", field, fieldError)
+		}
+	}
+	if validationError.Error() != "'Enrollments[2].CourseID' or one of SectionID is required, 'Enrollments[2].UserID' or one of UserIntegrationID is required, 'Enrollments[2].Role' or one of RoleID is required" {
+		t.Errorf("unexpected message %q", validationError.Error())
+	}
+}
+
+func TestImport(t *testing.T) {
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/accounts/self/sis_imports":
+			if err := r.ParseMultipartForm(1 << 20); err != nil {
+				t.Fatal(err)
+			}
+			if r.FormValue("import_type") != "instructure_csv" || r.FormValue("extension") != "zip" || r.FormValue("batch_mode") != "true" {
+				t.Errorf("unexpected form %v", r.MultipartForm.Value)
+			}
+			file, header, err := r.FormFile("attachment")
+			if err != nil {
+				t.Fatal(err)
+			}
+			content, _ := ioutil.ReadAll(file)
+			if header.Filename != "sis_import.zip" || !strings.Contains(readZip(t, content)["users.csv"], "u1,jdoe") {
+				t.Errorf("unexpected attachment %s", header.Filename)
+			}
+			fmt.Fprint(w, `{"id":7,"workflow_state":"created"}`)
+		case r.URL.Path == "/api/v1/accounts/self/sis_imports/7":
+			polls++
+			if polls < 2 {
+				fmt.Fprint(w, `{"id":7,"workflow_state":"importing","progress":40}`)
+				return
+			}
+			fmt.Fprintf(w, `{
+				"id":7,"workflow_state":"imported_with_messages","progress":100,
+				"statistics":{"total_state_changes":2,"pseudonym":{"created":1}},
+				"processing_warnings":[["users.csv","user u2 has no email"]],
+				"processing_errors":[["enrollments.csv","Enrollment with an unknown course"]],
+				"errors_attachment":{"id":3,"url":"http://%s/files/3/download"}
+			}`, r.Host)
+		case r.URL.Path == "/files/3/download":
+			fmt.Fprint(w, "sis_import_id,file,message,row,row_info\n7,enrollments.csv,Enrollment with an unknown course,2,\"bio999,u1,student,active\"\n")
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	canvas := canvasapi.New("token", "", canvasapi.WithBaseURL(server.URL))
+	opts := ImportOptions{InitialInterval: time.Millisecond}
+	opts.Request.Form.BatchMode = canvasapi.Some(true)
+	var states []string
+	opts.OnProgress = func(i *models.SISImport) {
+		states = append(states, i.WorkflowState)
+	}
+	bundle := &Bundle{Users: []User{{UserID: "u1", LoginID: "jdoe", Status: StatusActive}}}
+	result, err := Import(context.Background(), &canvas, canvasapi.Self, bundle, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(states, ",") != "created,importing,imported_with_messages" {
+		t.Errorf("unexpected states %v", states)
+	}
+	if result.Statistics.TotalStateChanges != 2 || result.Statistics.Pseudonym.Created != 1 {
+		t.Errorf("unexpected statistics %+v", result.Statistics)
+	}
+	if len(result.Warnings) != 1 || result.Warnings[0].File != "users.csv" || len(result.Errors) != 1 {
+		t.Errorf("unexpected messages %+v %+v", result.Warnings, result.Errors)
+	}
+	if len(result.ErrorRows) != 1 || result.ErrorRows[0].Row != 2 || result.ErrorRows[0].SISImportID != 7 || result.ErrorRows[0].RowInfo != "bio999,u1,student,active" {
+		t.Errorf("unexpected error rows %+v", result.ErrorRows)
+	}
+}
+
+func TestImportFailed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":8,"workflow_state":"failed_with_messages","processing_errors":[["users.csv","Missing login_id"]]}`)
+	}))
+	defer server.Close()
+
+	canvas := canvasapi.New("token", "", canvasapi.WithBaseURL(server.URL))
+	result, err := ImportFile(context.Background(), &canvas, "1", "users.csv", strings.NewReader("user_id,status\nu1,active\n"), ImportOptions{})
+	var importError *ImportError
+	if !errors.As(err, &importError) {
+		t.Fatalf("expected an import error, got %v", err)
+	}
+	if err.Error() != "sis import 8 failed_with_messages: users.csv: Missing login_id" || result.Import.ID != 8 {
+		t.Errorf("unexpected error %v", err)
+	}
+}
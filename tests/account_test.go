@@ -14,7 +14,7 @@ func TestGetSingleAccount(t *testing.T) {
 	testAccountID := "578"
 
 	getSingleAccount := requests.GetSingleAccount{}
-	getSingleAccount.Path.ID = testAccountID
+	getSingleAccount.Path.ID = canvasapi.ID(testAccountID)
 
 	type fields struct {
 		AccessToken string
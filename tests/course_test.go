@@ -2,7 +2,6 @@ package test
 
 import (
 	"os"
-	"strconv"
 	"testing"
 
 	"github.com/atomicjolt/canvasapi"
@@ -12,7 +11,7 @@ import (
 func TestCreateNewCourse(t *testing.T) {
 	token := os.Getenv("CANVAS_API_TOKEN")
 	canvasURL := "atomicjolt.instructure.com"
-	testAccountID := os.Getenv("CANVAS_ACCOUNT_ID")
+	testAccountID := canvasapi.ID(os.Getenv("CANVAS_ACCOUNT_ID"))
 	canvas := canvasapi.New(token, canvasURL)
 
 	// Create the course
@@ -29,18 +28,18 @@ func TestCreateNewCourse(t *testing.T) {
 		t.Logf("CreateNewCourse returned: %v", course)
 	}
 
-	courseID := strconv.FormatInt(course.ID, 10)
+	courseID := canvasapi.IDFromInt(course.ID)
 
 	// Update the course
 	updateCourse := requests.UpdateCourse{}
 	updateCourse.Path.ID = courseID
-	updateCourse.Form.Course.Name = "canvasapi test course updated"
-	updateCourse.Form.Course.DefaultView = "assignments"
-	uerr := updateCourse.Do(&canvas)
+	updateCourse.Form.Course.Name = canvasapi.Some("canvasapi test course updated")
+	updateCourse.Form.Course.DefaultView = canvasapi.Some("assignments")
+	updatedCourse, uerr := updateCourse.Do(&canvas)
 	if uerr != nil {
 		t.Errorf("UpdateCourse failed: %v", uerr)
 	} else {
-		t.Logf("UpdateCourse returned: %v", course)
+		t.Logf("UpdateCourse returned: %v", updatedCourse)
 	}
 
 	// Create an assignment in the course
@@ -92,7 +91,7 @@ func TestCreateNewCourse(t *testing.T) {
 	} else {
 		t.Logf("CreateQuiz returned: %v", course)
 	}
-	quizID := strconv.FormatInt(quiz.ID, 10)
+	quizID := canvasapi.IDFromInt(quiz.ID)
 
 	// Test validate access code
 	validateCode := requests.ValidateQuizAccessCode{}
@@ -112,10 +111,10 @@ func TestCreateNewCourse(t *testing.T) {
 	deleteCourse := requests.DeleteConcludeCourse{}
 	deleteCourse.Path.ID = courseID
 	deleteCourse.Query.Event = "delete"
-	derr := deleteCourse.Do(&canvas)
+	deleted, derr := deleteCourse.Do(&canvas)
 	if derr != nil {
 		t.Errorf("DeleteConcludeCourse failed: %v", derr)
-	} else {
-		t.Logf("DeleteConcludeCourse returned: %v", course)
+	} else if !deleted["delete"] {
+		t.Errorf("DeleteConcludeCourse returned: %v", deleted)
 	}
 }
@@ -0,0 +1,43 @@
+package canvasapi
+
+import (
+	"context"
+)
+
+// TokenSource supplies the access token sent with every request. Implementations must be
+// safe for concurrent use. The oauth package has token sources for the Canvas OAuth2 flows.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// RefreshingTokenSource is a TokenSource that can replace a token Canvas rejected. When a
+// request fails because the token is invalid or expired, see IsInvalidToken, Refresh is called
+// with the rejected token and the request is sent once more with the token it returns. Other
+// 401 responses, such as a user lacking permission, are returned without a refresh. Refresh
+// should return the current token without refreshing again when rejected has already been
+// replaced by another caller.
+type RefreshingTokenSource interface {
+	TokenSource
+	Refresh(ctx context.Context, rejected string) (string, error)
+}
+
+// WithTokenSource sends the tokens of ts instead of the static AccessToken.
+func WithTokenSource(ts TokenSource) Option {
+	return func(c *Canvas) {
+		c.TokenSource = ts
+	}
+}
+
+func (c *Canvas) token(ctx context.Context) (string, error) {
+	if c.TokenSource == nil {
+		return c.AccessToken, nil
+	}
+	return c.TokenSource.Token(ctx)
+}
+
+// refresher returns the token source when err means its token was rejected and can be
+// refreshed.
+func (c *Canvas) refresher(err error) (RefreshingTokenSource, bool) {
+	refresher, ok := c.TokenSource.(RefreshingTokenSource)
+	return refresher, ok && IsInvalidToken(err)
+}
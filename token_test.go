@@ -0,0 +1,99 @@
+package canvasapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type testTokenSource struct {
+	mu        sync.Mutex
+	token     string
+	refreshes int
+}
+
+func (s *testTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.token, nil
+}
+
+func (s *testTokenSource) Refresh(ctx context.Context, rejected string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token == rejected {
+		s.refreshes++
+		s.token = "fresh"
+	}
+	return s.token, nil
+}
+
+func TestTokenSourceRefreshesOnUnauthorized(t *testing.T) {
+	var authorizations []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		if r.Header.Get("Authorization") != "Bearer fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"errors":[{"message":"Invalid access token."}]}`))
+		}
+	}))
+	defer server.Close()
+
+	source := &testTokenSource{token: "expired"}
+	canvas := New("", "", WithBaseURL(server.URL), WithTokenSource(source))
+	response, err := canvas.SendRequest(&testRequest{method: http.MethodGet, path: "courses"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+
+	if strings.Join(authorizations, ",") != "Bearer expired,Bearer fresh" {
+		t.Errorf("unexpected authorizations %v", authorizations)
+	}
+	if source.refreshes != 1 {
+		t.Errorf("expected one refresh, got %d", source.refreshes)
+	}
+}
+
+func TestTokenSourceRefreshesOnce(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("WWW-Authenticate", `Bearer realm="canvas-lms"`)
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	source := &testTokenSource{token: "expired"}
+	canvas := New("", "", WithBaseURL(server.URL), WithTokenSource(source))
+	_, err := canvas.SendRequest(&testRequest{method: http.MethodGet, path: "courses"})
+	if !hasStatus(err, http.StatusUnauthorized) {
+		t.Fatalf("expected an unauthorized error, got %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("expected the request to be sent twice, got %d", requests)
+	}
+}
+
+func TestTokenSourceDoesNotRefreshOnPermissionFailure(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"status":"unauthorized","errors":[{"message":"user not authorized to perform that action"}]}`))
+	}))
+	defer server.Close()
+
+	source := &testTokenSource{token: "valid"}
+	canvas := New("", "", WithBaseURL(server.URL), WithTokenSource(source))
+	_, err := canvas.SendRequest(&testRequest{method: http.MethodGet, path: "courses/1/users"})
+	if !IsUnauthorized(err) || IsInvalidToken(err) {
+		t.Fatalf("expected a permission failure, got %v", err)
+	}
+	if requests != 1 || source.refreshes != 0 {
+		t.Errorf("expected a single request without a refresh, got %d requests and %d refreshes", requests, source.refreshes)
+	}
+}
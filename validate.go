@@ -0,0 +1,163 @@
+package canvasapi
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Rules of a FieldError.
+const (
+	// RuleRequired is broken by a required field that is not set.
+	RuleRequired = "required"
+	// RuleOneOf is broken by a field whose value is not one of its Allowed values.
+	RuleOneOf = "one_of"
+)
+
+// FieldError is a field of a request or model that failed validation.
+type FieldError struct {
+	// Field is the path of the field, such as Form.Assignment.GradingType
+	Field string
+	Rule  string
+	// Allowed holds the values the field may take for RuleOneOf, and for RuleRequired the
+	// fields that can be set instead of it.
+	Allowed []string
+}
+
+func (e FieldError) Error() string {
+	switch e.Rule {
+	case RuleRequired:
+		if len(e.Allowed) > 0 {
+			return fmt.Sprintf("'%s' or one of %s is required", e.Field, strings.Join(e.Allowed, ", "))
+		}
+		return fmt.Sprintf("'%s' is required", e.Field)
+	case RuleOneOf:
+		return fmt.Sprintf("'%s' must be one of %s", e.Field, strings.Join(e.Allowed, ", "))
+	}
+	return fmt.Sprintf("'%s' is invalid (%s)", e.Field, e.Rule)
+}
+
+// ValidationError is returned by HasErrors, and by SendRequest before anything is sent, when
+// fields fail validation. It holds every failed field.
+//
+//	var validationError *canvasapi.ValidationError
+//	if errors.As(err, &validationError) {
+//		for _, fieldError := range validationError.Errors {
+//			form.Highlight(fieldError.Field, fieldError.Allowed)
+//		}
+//	}
+type ValidationError struct {
+	Errors []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, fieldError := range e.Errors {
+		msgs[i] = fieldError.Error()
+	}
+	return strings.Join(msgs, ", ")
+}
+
+// Field returns the error of a field, or nil when the field didn't fail validation.
+func (e *ValidationError) Field(field string) *FieldError {
+	for i := range e.Errors {
+		if e.Errors[i].Field == field {
+			return &e.Errors[i]
+		}
+	}
+	return nil
+}
+
+// ValidateResponse checks a decoded response when StrictModels is set. Every model in v,
+// including the models nested in it, is checked with its HasErrors method and the errors are
+// returned together in a *ValidationError. It returns nil when StrictModels is not set.
+func (c *Canvas) ValidateResponse(v interface{}) error {
+	if !c.StrictModels {
+		return nil
+	}
+	return ValidateModels(v)
+}
+
+// ValidateModels checks every model in v, which may be a model, a slice or map of models, or
+// any value holding them. The fields of nested models are prefixed with their path in v.
+func ValidateModels(v interface{}) error {
+	validationError := &ValidationError{}
+	if err := validateValue(reflect.ValueOf(v), "", validationError); err != nil {
+		return err
+	}
+	if len(validationError.Errors) > 0 {
+		return validationError
+	}
+	return nil
+}
+
+var canvasModelType = reflect.TypeOf((*CanvasModel)(nil)).Elem()
+
+func validateValue(v reflect.Value, path string, validationError *ValidationError) error {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return validateValue(v.Elem(), path, validationError)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := validateValue(v.Index(i), fmt.Sprintf("%s[%d]", path, i), validationError); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			if err := validateValue(iter.Value(), fmt.Sprintf("%s[%v]", path, iter.Key()), validationError); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		if v.CanAddr() && v.Addr().Type().Implements(canvasModelType) {
+			if err := validateModel(v.Addr().Interface().(CanvasModel), path, validationError); err != nil {
+				return err
+			}
+		}
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			if t.Field(i).PkgPath != "" {
+				continue
+			}
+			if err := validateValue(v.Field(i), joinPath(path, t.Field(i).Name), validationError); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validateModel(model CanvasModel, path string, validationError *ValidationError) error {
+	err := model.HasErrors()
+	if err == nil {
+		return nil
+	}
+	var modelError *ValidationError
+	if !errors.As(err, &modelError) {
+		if path == "" {
+			return err
+		}
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	for _, fieldError := range modelError.Errors {
+		fieldError.Field = joinPath(path, fieldError.Field)
+		validationError.Errors = append(validationError.Errors, fieldError)
+	}
+	return nil
+}
+
+func joinPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+	if strings.HasPrefix(field, "[") {
+		return path + field
+	}
+	return path + "." + field
+}
@@ -0,0 +1,86 @@
+package canvasapi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type testModel struct {
+	State    string
+	Children []*testModel
+	Owner    *testModel
+}
+
+func (t *testModel) HasErrors() error {
+	if t.State != "" && t.State != "active" {
+		return &ValidationError{Errors: []FieldError{{Field: "State", Rule: RuleOneOf, Allowed: []string{"active"}}}}
+	}
+	return nil
+}
+
+func TestValidateResponse(t *testing.T) {
+	models := []*testModel{
+		{State: "active"},
+		{State: "active", Children: []*testModel{{State: "active"}, {State: "archived"}}},
+		{State: "deleted", Owner: &testModel{State: "gone"}},
+	}
+
+	canvas := New("token", "canvas.test")
+	if err := canvas.ValidateResponse(&models); err != nil {
+		t.Errorf("expected no validation without strict models, got %v", err)
+	}
+
+	strict := New("token", "canvas.test", WithStrictModels())
+	err := strict.ValidateResponse(&models)
+	var validationError *ValidationError
+	if !errors.As(err, &validationError) {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+	fields := []string{}
+	for _, fieldError := range validationError.Errors {
+		fields = append(fields, fieldError.Field)
+	}
+	if strings.Join(fields, " ") != "[1].Children[1].State [2].State [2].Owner.State" {
+		t.Errorf("unexpected fields %v", fields)
+	}
+
+	if err := strict.ValidateResponse(&testModel{State: "active"}); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+	if err := strict.ValidateResponse(&testModel{State: "draft"}); err == nil || err.Error() != "'State' must be one of active" {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+type invalidRequest struct {
+	testRequest
+}
+
+func (t *invalidRequest) HasErrors() error {
+	return &ValidationError{Errors: []FieldError{
+		{Field: "Path.CourseID", Rule: RuleRequired},
+		{Field: "Form.Assignment.GradingType", Rule: RuleOneOf, Allowed: []string{"pass_fail", "points"}},
+	}}
+}
+
+func TestSendRequestValidationError(t *testing.T) {
+	canvas := New("token", "canvas.test")
+	_, err := canvas.SendRequest(&invalidRequest{testRequest{method: http.MethodPost, path: "courses"}})
+	var validationError *ValidationError
+	if !errors.As(err, &validationError) {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+	if validationError.Error() != "'Path.CourseID' is required, 'Form.Assignment.GradingType' must be one of pass_fail, points" {
+		t.Errorf("unexpected message %q", validationError.Error())
+	}
+	fieldError := validationError.Field("Form.Assignment.GradingType")
+	if fieldError == nil || fieldError.Rule != RuleOneOf || fmt.Sprint(fieldError.Allowed) != "[pass_fail points]" {
+		t.Errorf("unexpected field error %+v", fieldError)
+	}
+	if validationError.Field("Form.Assignment.Name") != nil {
+		t.Error("expected no error for a valid field")
+	}
+}
@@ -0,0 +1,276 @@
+package workflow
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
+	"github.com/atomicjolt/canvasapi/requests"
+	"github.com/atomicjolt/string_utils"
+)
+
+// Export types, see https://canvas.instructure.com/doc/api/content_exports.html
+const (
+	// ExportCommonCartridge exports the content of a course as a Common Cartridge (.imscc).
+	ExportCommonCartridge = "common_cartridge"
+	// ExportQTI exports the quizzes of a course in the QTI format.
+	ExportQTI = "qti"
+	// ExportZip exports files as a zip file.
+	ExportZip = "zip"
+)
+
+// courseExportTypes are the export types of a course. Groups and users only export their
+// files as a zip.
+var courseExportTypes = []string{ExportCommonCartridge, ExportQTI, ExportZip}
+
+// exportSelectable lists the object types each export type can select.
+var exportSelectable = map[string][]string{
+	ExportCommonCartridge: {
+		"folders", "files", "attachments", "quizzes", "assignments", "announcements",
+		"calendar_events", "discussion_topics", "modules", "module_items", "pages", "rubrics",
+	},
+	ExportQTI: {"quizzes"},
+	ExportZip: {"folders", "files"},
+}
+
+// ExportSelectors limit an export to some of the content, by object type such as files,
+// folders or quizzes. The values are the ids of the objects. Every object is exported when
+// the selectors are empty.
+type ExportSelectors map[string][]string
+
+// ExportOptions configure ExportCourse, ExportGroup and ExportUser.
+type ExportOptions struct {
+	// SkipNotifications doesn't notify the user when the export completes.
+	SkipNotifications bool
+	// InitialInterval and MaxInterval pace the polls of the export, see ProgressOptions.
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+	// OnProgress is called with the progress of the export and every update fetched after it.
+	OnProgress func(*models.Progress)
+}
+
+// ExportResult is a completed export that was written to a writer.
+type ExportResult struct {
+	Export *models.ContentExport
+	// Size is the number of bytes written.
+	Size int64
+	// SHA256 is the hex encoded SHA-256 checksum of the bytes written.
+	SHA256 string
+}
+
+type exportRequest interface {
+	DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.ContentExport, error)
+}
+
+// ExportCourse exports the content of a course, waits for the export to complete and writes
+// the archive to w. The archive is downloaded to a temporary file first and only written to w
+// once its size matches the size Canvas reports, so w gets nothing from a truncated download.
+// The temporary file is created in os.TempDir, which needs room for the whole archive.
+//
+//	f, _ := os.Create("course.imscc")
+//	defer f.Close()
+//	result, err := workflow.ExportCourse(ctx, &canvas, "123", workflow.ExportCommonCartridge, nil, f,
+//		workflow.ExportOptions{})
+//	log.Printf("wrote %d bytes, sha256 %s", result.Size, result.SHA256)
+func ExportCourse(
+	ctx context.Context, c *canvasapi.Canvas, courseID canvasapi.ID,
+	exportType string, selectors ExportSelectors, w io.Writer, opts ExportOptions,
+) (*ExportResult, error) {
+	if err := validateSelectors(exportType, courseExportTypes, selectors); err != nil {
+		return nil, err
+	}
+	start := &requests.ExportContentCourses{}
+	start.Path.CourseID = courseID
+	start.Form.ExportType = exportType
+	start.Form.SkipNotifications = skipNotifications(opts)
+	start.Form.Select = selectParam(selectors)
+	return export(ctx, c, start, func(id canvasapi.ID) exportRequest {
+		r := &requests.ShowContentExportCourses{}
+		r.Path.CourseID = courseID
+		r.Path.ID = id
+		return r
+	}, w, opts)
+}
+
+// ExportGroup exports the files of a group, see ExportCourse. Canvas only exports groups as
+// ExportZip.
+func ExportGroup(
+	ctx context.Context, c *canvasapi.Canvas, groupID canvasapi.ID,
+	exportType string, selectors ExportSelectors, w io.Writer, opts ExportOptions,
+) (*ExportResult, error) {
+	if err := validateSelectors(exportType, []string{ExportZip}, selectors); err != nil {
+		return nil, err
+	}
+	start := &requests.ExportContentGroups{}
+	start.Path.GroupID = groupID
+	start.Form.ExportType = exportType
+	start.Form.SkipNotifications = skipNotifications(opts)
+	start.Form.Select = selectParam(selectors)
+	return export(ctx, c, start, func(id canvasapi.ID) exportRequest {
+		r := &requests.ShowContentExportGroups{}
+		r.Path.GroupID = groupID
+		r.Path.ID = id
+		return r
+	}, w, opts)
+}
+
+// ExportUser exports the personal files of a user, see ExportCourse. Canvas only exports users
+// as ExportZip. Use canvasapi.Self for the current user.
+func ExportUser(
+	ctx context.Context, c *canvasapi.Canvas, userID canvasapi.ID,
+	exportType string, selectors ExportSelectors, w io.Writer, opts ExportOptions,
+) (*ExportResult, error) {
+	if err := validateSelectors(exportType, []string{ExportZip}, selectors); err != nil {
+		return nil, err
+	}
+	start := &requests.ExportContentUsers{}
+	start.Path.UserID = userID
+	start.Form.ExportType = exportType
+	start.Form.SkipNotifications = skipNotifications(opts)
+	start.Form.Select = selectParam(selectors)
+	return export(ctx, c, start, func(id canvasapi.ID) exportRequest {
+		r := &requests.ShowContentExportUsers{}
+		r.Path.UserID = userID
+		r.Path.ID = id
+		return r
+	}, w, opts)
+}
+
+// export starts an export, waits for its progress to complete and downloads the archive.
+func export(
+	ctx context.Context, c *canvasapi.Canvas,
+	start exportRequest, show func(id canvasapi.ID) exportRequest, w io.Writer, opts ExportOptions,
+) (*ExportResult, error) {
+	contentExport, err := start.DoContext(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	if contentExport.ProgressUrl == "" {
+		return nil, fmt.Errorf("content export %d has no progress url", contentExport.ID)
+	}
+	progress, err := getProgress(ctx, c, contentExport.ProgressUrl)
+	if err != nil {
+		return nil, err
+	}
+	_, err = WaitForProgress(ctx, c, progress, ProgressOptions{
+		InitialInterval: opts.InitialInterval,
+		MaxInterval:     opts.MaxInterval,
+		OnProgress:      opts.OnProgress,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	contentExport, err = show(canvasapi.IDFromInt(contentExport.ID)).DoContext(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	if contentExport.WorkflowState != "exported" {
+		return nil, fmt.Errorf("content export %d is %s", contentExport.ID, contentExport.WorkflowState)
+	}
+	if contentExport.Attachment == nil || contentExport.Attachment.Url == "" {
+		return nil, fmt.Errorf("content export %d has no attachment to download", contentExport.ID)
+	}
+	return downloadExport(ctx, c, contentExport, w)
+}
+
+// downloadExport downloads the attachment of an export to a temporary file and checks its size
+// against the size of the attachment, when Canvas reports it, before copying it to w.
+func downloadExport(
+	ctx context.Context, c *canvasapi.Canvas, contentExport *models.ContentExport, w io.Writer,
+) (*ExportResult, error) {
+	u, err := c.ResolveURL(contentExport.Attachment.Url)
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.SendContext(ctx, u, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	spool, err := ioutil.TempFile("", "canvas-export-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(spool.Name())
+	defer spool.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(spool, hash), response.Body)
+	if err != nil {
+		return nil, err
+	}
+	if expected := contentExport.Attachment.Size; expected > 0 && size != expected {
+		return nil, fmt.Errorf("content export %d downloaded %d bytes, expected %d",
+			contentExport.ID, size, expected)
+	}
+	if _, err := spool.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(w, spool); err != nil {
+		return nil, err
+	}
+	return &ExportResult{
+		Export: contentExport,
+		Size:   size,
+		SHA256: hex.EncodeToString(hash.Sum(nil)),
+	}, nil
+}
+
+// validateSelectors checks that the export type is one of exportTypes and supports every
+// selected object type.
+func validateSelectors(exportType string, exportTypes []string, selectors ExportSelectors) error {
+	if !string_utils.Include(exportTypes, exportType) {
+		return &canvasapi.ValidationError{Errors: []canvasapi.FieldError{
+			{Field: "ExportType", Rule: canvasapi.RuleOneOf, Allowed: exportTypes},
+		}}
+	}
+	allowed := exportSelectable[exportType]
+	objectTypes := make([]string, 0, len(selectors))
+	for objectType := range selectors {
+		objectTypes = append(objectTypes, objectType)
+	}
+	sort.Strings(objectTypes)
+	errs := []canvasapi.FieldError{}
+	for _, objectType := range objectTypes {
+		if !string_utils.Include(allowed, objectType) {
+			errs = append(errs, canvasapi.FieldError{
+				Field:   fmt.Sprintf("Selectors[%s]", objectType),
+				Rule:    canvasapi.RuleOneOf,
+				Allowed: allowed,
+			})
+		}
+	}
+	if len(errs) > 0 {
+		return &canvasapi.ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func selectParam(selectors ExportSelectors) map[string]interface{} {
+	if len(selectors) == 0 {
+		return nil
+	}
+	param := make(map[string]interface{}, len(selectors))
+	for objectType, ids := range selectors {
+		param[objectType] = ids
+	}
+	return param
+}
+
+func skipNotifications(opts ExportOptions) canvasapi.Opt[bool] {
+	if !opts.SkipNotifications {
+		return canvasapi.None[bool]()
+	}
+	return canvasapi.Some(true)
+}
@@ -0,0 +1,132 @@
+package workflow
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
+)
+
+func TestExportCourse(t *testing.T) {
+	archive := []byte("PK\x03\x04 course archive")
+	polls := 0
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/courses/1/content_exports":
+			r.ParseForm()
+			if r.Form.Get("export_type") != "zip" || strings.Join(r.Form["select[files][]"], ",") != "10,11" || r.Form.Get("skip_notifications") != "true" {
+				t.Errorf("unexpected export form %v", r.Form)
+			}
+			fmt.Fprintf(w, `{"id":3,"export_type":"zip","workflow_state":"created","progress_url":"%s/api/v1/progress/7"}`, server.URL)
+		case "/api/v1/progress/7":
+			polls++
+			if polls < 2 {
+				fmt.Fprint(w, `{"id":7,"workflow_state":"queued"}`)
+				return
+			}
+			fmt.Fprint(w, `{"id":7,"workflow_state":"completed","completion":100}`)
+		case "/api/v1/courses/1/content_exports/3":
+			fmt.Fprintf(w, `{"id":3,"export_type":"zip","workflow_state":"exported",
+				"attachment":{"id":40,"size":%d,"url":"%s/files/40/download?verifier=v"}}`, len(archive), server.URL)
+		case "/files/40/download":
+			if r.URL.Query().Get("verifier") != "v" {
+				t.Errorf("unexpected download %s", r.URL)
+			}
+			w.Write(archive)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	canvas := canvasapi.New("token", "", canvasapi.WithBaseURL(server.URL))
+	var states []string
+	out := &bytes.Buffer{}
+	result, err := ExportCourse(context.Background(), &canvas, "1", ExportZip, ExportSelectors{"files": {"10", "11"}}, out, ExportOptions{
+		SkipNotifications: true,
+		InitialInterval:   time.Millisecond,
+		OnProgress: func(p *models.Progress) {
+			states = append(states, p.WorkflowState)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256(archive)
+	if !bytes.Equal(out.Bytes(), archive) || result.Size != int64(len(archive)) || result.SHA256 != hex.EncodeToString(sum[:]) {
+		t.Errorf("unexpected download %q %+v", out.Bytes(), result)
+	}
+	if result.Export.ID != 3 || strings.Join(states, ",") != "queued,completed" {
+		t.Errorf("unexpected export %+v %v", result.Export, states)
+	}
+}
+
+func TestExportSizeMismatch(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/users/self/content_exports":
+			fmt.Fprint(w, `{"id":4,"workflow_state":"created","progress_url":"/api/v1/progress/8"}`)
+		case "/api/v1/progress/8":
+			fmt.Fprint(w, `{"id":8,"workflow_state":"completed"}`)
+		case "/api/v1/users/self/content_exports/4":
+			fmt.Fprintf(w, `{"id":4,"workflow_state":"exported","attachment":{"id":41,"size":100,"url":"%s/files/41/download"}}`, server.URL)
+		case "/files/41/download":
+			fmt.Fprint(w, "short")
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	canvas := canvasapi.New("token", "", canvasapi.WithBaseURL(server.URL))
+	out := &bytes.Buffer{}
+	_, err := ExportUser(context.Background(), &canvas, canvasapi.Self, ExportZip, nil, out, ExportOptions{})
+	if err == nil || err.Error() != "content export 4 downloaded 5 bytes, expected 100" {
+		t.Errorf("expected a size mismatch, got %v", err)
+	}
+	if out.Len() != 0 {
+		t.Errorf("expected nothing to be written for a truncated download, got %q", out)
+	}
+}
+
+func TestExportSelectorsValidated(t *testing.T) {
+	canvas := canvasapi.New("token", "canvas.example.com")
+	_, err := ExportCourse(context.Background(), &canvas, "1", ExportQTI, ExportSelectors{"pages": {"1"}, "quizzes": {"2"}}, &bytes.Buffer{}, ExportOptions{})
+	var validationError *canvasapi.ValidationError
+	if !errors.As(err, &validationError) || len(validationError.Errors) != 1 || validationError.Field("Selectors[pages]") == nil {
+		t.Errorf("expected a validation error for pages, got %v", err)
+	}
+
+	_, err = ExportGroup(context.Background(), &canvas, "1", "pdf", nil, &bytes.Buffer{}, ExportOptions{})
+	if !errors.As(err, &validationError) || validationError.Field("ExportType") == nil {
+		t.Errorf("expected a validation error for the export type, got %v", err)
+	}
+
+	for _, export := range []func() (*ExportResult, error){
+		func() (*ExportResult, error) {
+			return ExportGroup(context.Background(), &canvas, "1", ExportCommonCartridge, nil, &bytes.Buffer{}, ExportOptions{})
+		},
+		func() (*ExportResult, error) {
+			return ExportUser(context.Background(), &canvas, canvasapi.Self, ExportQTI, nil, &bytes.Buffer{}, ExportOptions{})
+		},
+	} {
+		_, err = export()
+		if !errors.As(err, &validationError) || validationError.Field("ExportType") == nil || fmt.Sprint(validationError.Field("ExportType").Allowed) != "[zip]" {
+			t.Errorf("expected groups and users to only export zip, got %v", err)
+		}
+	}
+}
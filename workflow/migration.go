@@ -0,0 +1,490 @@
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
+	"github.com/atomicjolt/canvasapi/requests"
+)
+
+// MigrationOptions configure Migrate.
+type MigrationOptions struct {
+	// Request holds the migration type and its settings, such as Settings.SourceCourseID of a
+	// course copy. Only its Form is used, the path is set from the target, so the same request
+	// serves every kind of target.
+	Request requests.CreateContentMigrationCourses
+	// Name is the filename of the uploaded package. Defaults to the base name of the reader
+	// when it is an *os.File.
+	Name string
+	// Select chooses the content of a selective import. Migrate sets SelectiveImport and calls
+	// Select with the content of the package, see SelectiveImportItems, once Canvas has read
+	// it. Select returns the Property of every item to import.
+	Select func(items []*models.SelectiveImportItem) ([]string, error)
+	// InitialInterval and MaxInterval pace the polls of the migration, see ProgressOptions.
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+	// OnProgress is called with every progress update of the migration.
+	OnProgress func(*models.Progress)
+}
+
+// MigrationResult is a finished content migration.
+type MigrationResult struct {
+	Migration *models.ContentMigration
+	// Issues are the problems Canvas found while importing the content.
+	Issues []*models.MigrationIssue
+}
+
+// Warnings returns the issues that did not stop content from being imported, which are the
+// todo and warning issues.
+func (r *MigrationResult) Warnings() []*models.MigrationIssue {
+	warnings := []*models.MigrationIssue{}
+	for _, issue := range r.Issues {
+		if issue.IssueType != "error" {
+			warnings = append(warnings, issue)
+		}
+	}
+	return warnings
+}
+
+// Errors returns the issues of content that could not be imported.
+func (r *MigrationResult) Errors() []*models.MigrationIssue {
+	errs := []*models.MigrationIssue{}
+	for _, issue := range r.Issues {
+		if issue.IssueType == "error" {
+			errs = append(errs, issue)
+		}
+	}
+	return errs
+}
+
+// MigrationError is returned with the MigrationResult when a migration fails.
+type MigrationError struct {
+	Result *MigrationResult
+}
+
+func (e *MigrationError) Error() string {
+	msg := fmt.Sprintf("content migration %d failed", e.Result.Migration.ID)
+	if errs := e.Result.Errors(); len(errs) > 0 {
+		msg += ": " + errs[0].Description
+	}
+	return msg
+}
+
+// MigrationTarget is a course, account, group or user that content is migrated into.
+type MigrationTarget struct {
+	create func(request requests.CreateContentMigrationCourses) migrationRequest
+	get    func(id canvasapi.ID) migrationRequest
+	update func(id canvasapi.ID, selected map[string]interface{}) migrationRequest
+	items  func(id canvasapi.ID, itemType string) selectiveImportRequest
+	issues func(id canvasapi.ID) canvasapi.PagedRequest[*models.MigrationIssue]
+}
+
+type migrationRequest interface {
+	DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.ContentMigration, error)
+}
+
+type selectiveImportRequest interface {
+	DoContext(ctx context.Context, c *canvasapi.Canvas) ([]*models.SelectiveImportItem, error)
+}
+
+// CourseMigrations migrates content into a course.
+func CourseMigrations(courseID canvasapi.ID) MigrationTarget {
+	return MigrationTarget{
+		create: func(request requests.CreateContentMigrationCourses) migrationRequest {
+			request.Path.CourseID = courseID
+			return &request
+		},
+		get: func(id canvasapi.ID) migrationRequest {
+			r := &requests.GetContentMigrationCourses{}
+			r.Path.CourseID = courseID
+			r.Path.ID = id
+			return r
+		},
+		update: func(id canvasapi.ID, selected map[string]interface{}) migrationRequest {
+			r := &requests.UpdateContentMigrationCourses{}
+			r.Path.CourseID = courseID
+			r.Path.ID = id
+			r.Form.Copy = selected
+			return r
+		},
+		items: func(id canvasapi.ID, itemType string) selectiveImportRequest {
+			r := &requests.ListItemsForSelectiveImportCourses{}
+			r.Path.CourseID = courseID
+			r.Path.ID = id
+			r.Query.Type = itemType
+			return r
+		},
+		issues: func(id canvasapi.ID) canvasapi.PagedRequest[*models.MigrationIssue] {
+			r := &requests.ListMigrationIssuesCourses{}
+			r.Path.CourseID = courseID
+			r.Path.ContentMigrationID = id
+			return r
+		},
+	}
+}
+
+// AccountMigrations migrates content into an account, such as question banks.
+func AccountMigrations(accountID canvasapi.ID) MigrationTarget {
+	return MigrationTarget{
+		create: func(request requests.CreateContentMigrationCourses) migrationRequest {
+			r := &requests.CreateContentMigrationAccounts{}
+			r.Path.AccountID = accountID
+			r.Form = request.Form
+			return r
+		},
+		get: func(id canvasapi.ID) migrationRequest {
+			r := &requests.GetContentMigrationAccounts{}
+			r.Path.AccountID = accountID
+			r.Path.ID = id
+			return r
+		},
+		update: func(id canvasapi.ID, selected map[string]interface{}) migrationRequest {
+			r := &requests.UpdateContentMigrationAccounts{}
+			r.Path.AccountID = accountID
+			r.Path.ID = id
+			r.Form.Copy = selected
+			return r
+		},
+		items: func(id canvasapi.ID, itemType string) selectiveImportRequest {
+			r := &requests.ListItemsForSelectiveImportAccounts{}
+			r.Path.AccountID = accountID
+			r.Path.ID = id
+			r.Query.Type = itemType
+			return r
+		},
+		issues: func(id canvasapi.ID) canvasapi.PagedRequest[*models.MigrationIssue] {
+			r := &requests.ListMigrationIssuesAccounts{}
+			r.Path.AccountID = accountID
+			r.Path.ContentMigrationID = id
+			return r
+		},
+	}
+}
+
+// GroupMigrations migrates content into a group.
+func GroupMigrations(groupID canvasapi.ID) MigrationTarget {
+	return MigrationTarget{
+		create: func(request requests.CreateContentMigrationCourses) migrationRequest {
+			r := &requests.CreateContentMigrationGroups{}
+			r.Path.GroupID = groupID
+			r.Form = request.Form
+			return r
+		},
+		get: func(id canvasapi.ID) migrationRequest {
+			r := &requests.GetContentMigrationGroups{}
+			r.Path.GroupID = groupID
+			r.Path.ID = id
+			return r
+		},
+		update: func(id canvasapi.ID, selected map[string]interface{}) migrationRequest {
+			r := &requests.UpdateContentMigrationGroups{}
+			r.Path.GroupID = groupID
+			r.Path.ID = id
+			r.Form.Copy = selected
+			return r
+		},
+		items: func(id canvasapi.ID, itemType string) selectiveImportRequest {
+			r := &requests.ListItemsForSelectiveImportGroups{}
+			r.Path.GroupID = groupID
+			r.Path.ID = id
+			r.Query.Type = itemType
+			return r
+		},
+		issues: func(id canvasapi.ID) canvasapi.PagedRequest[*models.MigrationIssue] {
+			r := &requests.ListMigrationIssuesGroups{}
+			r.Path.GroupID = groupID
+			r.Path.ContentMigrationID = id
+			return r
+		},
+	}
+}
+
+// UserMigrations migrates content into the files of a user. Use canvasapi.Self for the
+// current user.
+func UserMigrations(userID canvasapi.ID) MigrationTarget {
+	return MigrationTarget{
+		create: func(request requests.CreateContentMigrationCourses) migrationRequest {
+			r := &requests.CreateContentMigrationUsers{}
+			r.Path.UserID = userID
+			r.Form = request.Form
+			return r
+		},
+		get: func(id canvasapi.ID) migrationRequest {
+			r := &requests.GetContentMigrationUsers{}
+			r.Path.UserID = userID
+			r.Path.ID = id
+			return r
+		},
+		update: func(id canvasapi.ID, selected map[string]interface{}) migrationRequest {
+			r := &requests.UpdateContentMigrationUsers{}
+			r.Path.UserID = userID
+			r.Path.ID = id
+			r.Form.Copy = selected
+			return r
+		},
+		items: func(id canvasapi.ID, itemType string) selectiveImportRequest {
+			r := &requests.ListItemsForSelectiveImportUsers{}
+			r.Path.UserID = userID
+			r.Path.ID = id
+			r.Query.Type = itemType
+			return r
+		},
+		issues: func(id canvasapi.ID) canvasapi.PagedRequest[*models.MigrationIssue] {
+			r := &requests.ListMigrationIssuesUsers{}
+			r.Path.UserID = userID
+			r.Path.ContentMigrationID = id
+			return r
+		},
+	}
+}
+
+// Migrate runs a content migration into target and waits for it to finish, see
+// https://canvas.instructure.com/doc/api/content_migrations.html
+// Packages such as common cartridges and zip files are streamed from r to the pre_attachment
+// of the migration, with their size when r can seek or reports its length, see Upload. r is
+// nil for migrations without a file, such as a course copy or a package downloaded from
+// Settings.FileUrl. Migrate returns a *MigrationError along with the result when the
+// migration fails.
+//
+//	migration := workflow.MigrationOptions{}
+//	migration.Request.Form.MigrationType = "course_copy_importer"
+//	migration.Request.Form.Settings.SourceCourseID = "123"
+//	result, err := workflow.Migrate(ctx, &canvas, workflow.CourseMigrations("456"), nil, migration)
+func Migrate(ctx context.Context, c *canvasapi.Canvas, target MigrationTarget, r io.Reader, opts MigrationOptions) (*MigrationResult, error) {
+	request := opts.Request
+	if opts.Select != nil {
+		request.Form.SelectiveImport = canvasapi.Some(true)
+	}
+
+	size := int64(-1)
+	if r != nil {
+		name := opts.Name
+		if name == "" {
+			if named, ok := r.(interface{ Name() string }); ok {
+				name = filepath.Base(named.Name())
+			}
+		}
+		if name == "" {
+			return nil, fmt.Errorf("a name is required to upload a migration package")
+		}
+		var err error
+		size, err = readerSize(r)
+		if err != nil {
+			return nil, err
+		}
+		request.Form.PreAttachment.Name = name
+		request.Form.PreAttachment.Size = sizeParam(size)
+		if request.Form.PreAttachment.ContentType == "" {
+			request.Form.PreAttachment.ContentType = mime.TypeByExtension(filepath.Ext(name))
+		}
+	}
+
+	migration, err := target.create(request).DoContext(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	if r != nil {
+		params := migration.PreAttachment
+		if params == nil || params.UploadUrl == "" {
+			msg := "Canvas did not return an upload_url for the migration package"
+			if params != nil && params.Message != "" {
+				msg += ": " + params.Message
+			}
+			return nil, fmt.Errorf("%s", msg)
+		}
+		response, err := postUpload(ctx, c, params, &canvasapi.File{
+			Name:        request.Form.PreAttachment.Name,
+			ContentType: request.Form.PreAttachment.ContentType,
+			Content:     r,
+		}, size)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := confirmUpload(ctx, c, response); err != nil {
+			return nil, err
+		}
+	}
+
+	migration, err = waitForMigration(ctx, c, target, migration, opts, opts.Select != nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if migration.WorkflowState == "waiting_for_select" {
+		if opts.Select == nil {
+			return nil, fmt.Errorf("content migration %d is waiting for content to be selected", migration.ID)
+		}
+		id := canvasapi.IDFromInt(migration.ID)
+		items, err := SelectiveImportItems(ctx, c, target, id)
+		if err != nil {
+			return nil, err
+		}
+		properties, err := opts.Select(items)
+		if err != nil {
+			return nil, err
+		}
+		selected, err := copyParams(properties)
+		if err != nil {
+			return nil, err
+		}
+		migration, err = target.update(id, selected).DoContext(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+		migration, err = waitForMigration(ctx, c, target, migration, opts, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result := &MigrationResult{Migration: migration}
+	issues, err := canvasapi.All[*models.MigrationIssue](ctx, c, target.issues(canvasapi.IDFromInt(migration.ID)))
+	if err != nil {
+		return result, err
+	}
+	result.Issues = issues
+	if migration.WorkflowState == "failed" {
+		return result, &MigrationError{Result: result}
+	}
+	return result, nil
+}
+
+// SelectiveImportItems lists the content a migration waiting for select can import. The
+// top-level items that link to their children are returned with the children as SubItems.
+func SelectiveImportItems(ctx context.Context, c *canvasapi.Canvas, target MigrationTarget, migrationID canvasapi.ID) ([]*models.SelectiveImportItem, error) {
+	items, err := target.items(migrationID, "").DoContext(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if item.SubItemsUrl == "" || len(item.SubItems) > 0 {
+			continue
+		}
+		subItems, err := target.items(migrationID, item.Type).DoContext(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+		item.SubItems = subItems
+	}
+	return items, nil
+}
+
+// waitForMigration waits for the progress of a migration and then for the migration to
+// complete or fail. When selecting it also stops once the migration waits for content to be
+// selected, which its progress doesn't report. The state of the migration decides, because its
+// progress may be left completed by an earlier step.
+func waitForMigration(ctx context.Context, c *canvasapi.Canvas, target MigrationTarget, migration *models.ContentMigration, opts MigrationOptions, selecting bool) (*models.ContentMigration, error) {
+	id := canvasapi.IDFromInt(migration.ID)
+	done := func() (bool, error) {
+		switch migration.WorkflowState {
+		case "completed", "failed":
+			return true, nil
+		case "waiting_for_select":
+			return selecting, nil
+		}
+		return false, nil
+	}
+	if finished, _ := done(); finished {
+		return migration, nil
+	}
+
+	if migration.ProgressUrl != "" && !selecting {
+		progress, err := getProgress(ctx, c, migration.ProgressUrl)
+		if err != nil {
+			return nil, err
+		}
+		_, err = WaitForProgress(ctx, c, progress, ProgressOptions{
+			InitialInterval: opts.InitialInterval,
+			MaxInterval:     opts.MaxInterval,
+			OnProgress:      opts.OnProgress,
+		})
+		// a failed migration is reported by its state and issues
+		var progressError *ProgressError
+		if err != nil && !errors.As(err, &progressError) {
+			return nil, err
+		}
+		if migration, err = target.get(id).DoContext(ctx, c); err != nil {
+			return nil, err
+		}
+	}
+
+	err := Poll(ctx, PollOptions{InitialInterval: opts.InitialInterval, MaxInterval: opts.MaxInterval}, done, func(ctx context.Context) error {
+		if migration.ProgressUrl != "" && opts.OnProgress != nil {
+			progress, err := getProgress(ctx, c, migration.ProgressUrl)
+			if err != nil {
+				return err
+			}
+			opts.OnProgress(progress)
+		}
+		next, err := target.get(id).DoContext(ctx, c)
+		if err != nil {
+			return err
+		}
+		migration = next
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return migration, nil
+}
+
+func getProgress(ctx context.Context, c *canvasapi.Canvas, location string) (*models.Progress, error) {
+	u, err := c.ResolveURL(location)
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.SendContext(ctx, u, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	progress := &models.Progress{}
+	if err := json.Unmarshal(body, progress); err != nil {
+		return nil, err
+	}
+	return progress, nil
+}
+
+// copyParams turns the properties of selective import items, such as
+// copy[assignments][id_i2102a7fa93b29226774949298626719d], into the nested copy parameter of
+// the migration update.
+func copyParams(properties []string) (map[string]interface{}, error) {
+	if len(properties) == 0 {
+		return nil, fmt.Errorf("no content was selected to import")
+	}
+	params := map[string]interface{}{}
+	for _, property := range properties {
+		if !strings.HasPrefix(property, "copy[") || !strings.HasSuffix(property, "]") {
+			return nil, fmt.Errorf("%q is not the property of a selective import item", property)
+		}
+		keys := strings.Split(strings.TrimSuffix(strings.TrimPrefix(property, "copy["), "]"), "][")
+		node := params
+		for _, key := range keys[:len(keys)-1] {
+			child, ok := node[key].(map[string]interface{})
+			if !ok {
+				child = map[string]interface{}{}
+				node[key] = child
+			}
+			node = child
+		}
+		node[keys[len(keys)-1]] = "1"
+	}
+	return params, nil
+}
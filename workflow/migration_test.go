@@ -0,0 +1,219 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
+)
+
+func TestMigrateSelectiveImport(t *testing.T) {
+	updated := false
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/courses/1/content_migrations":
+			r.ParseForm()
+			if r.Form.Get("migration_type") != "common_cartridge_importer" || r.Form.Get("pre_attachment[name]") != "course.imscc" ||
+				r.Form.Get("pre_attachment[size]") != "7" || r.Form.Get("selective_import") != "true" {
+				t.Errorf("unexpected migration form %v", r.Form)
+			}
+			fmt.Fprintf(w, `{"id":4,"workflow_state":"pre_processing","progress_url":"%[1]s/api/v1/progress/8",
+				"pre_attachment":{"upload_url":"%[1]s/storage","upload_params":{"key":"k"},"file_param":"file"}}`, server.URL)
+		case r.URL.Path == "/storage":
+			if err := r.ParseMultipartForm(1024); err != nil {
+				t.Error(err)
+				return
+			}
+			file, header, err := r.FormFile("file")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			data, _ := ioutil.ReadAll(file)
+			if r.FormValue("key") != "k" || header.Filename != "course.imscc" || string(data) != "package" {
+				t.Errorf("unexpected upload key=%q filename=%q data=%q", r.FormValue("key"), header.Filename, data)
+			}
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id":30,"display_name":"course.imscc"}`)
+		case r.URL.Path == "/api/v1/progress/8":
+			state := "running"
+			if updated {
+				state = "completed"
+			}
+			fmt.Fprintf(w, `{"id":8,"workflow_state":"%s"}`, state)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/courses/1/content_migrations/4":
+			state := "waiting_for_select"
+			if updated {
+				state = "completed"
+			}
+			fmt.Fprintf(w, `{"id":4,"workflow_state":"%s","progress_url":"%s/api/v1/progress/8"}`, state, server.URL)
+		case r.URL.Path == "/api/v1/courses/1/content_migrations/4/selective_data":
+			switch r.URL.Query().Get("type") {
+			case "":
+				fmt.Fprintf(w, `[{"type":"course_settings","property":"copy[all_course_settings]","title":"Course Settings"},
+					{"type":"assignments","property":"copy[all_assignments]","title":"Assignments","count":1,
+					"sub_items_url":"%s/api/v1/courses/1/content_migrations/4/selective_data?type=assignments"}]`, server.URL)
+			case "assignments":
+				fmt.Fprint(w, `[{"type":"assignment_groups","title":"Homework","property":"copy[assignment_groups][id_g1]",
+					"sub_items":[{"type":"assignments","title":"Essay","property":"copy[assignments][id_a1]"}]}]`)
+			default:
+				t.Errorf("unexpected type %s", r.URL.Query().Get("type"))
+			}
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v1/courses/1/content_migrations/4":
+			r.ParseForm()
+			if len(r.PostForm) != 2 || r.PostForm.Get("copy[all_course_settings]") != "1" || r.PostForm.Get("copy[assignments][id_a1]") != "1" {
+				t.Errorf("unexpected copy %v", r.PostForm)
+			}
+			updated = true
+			fmt.Fprintf(w, `{"id":4,"workflow_state":"running","progress_url":"%s/api/v1/progress/8"}`, server.URL)
+		case r.URL.Path == "/api/v1/courses/1/content_migrations/4/migration_issues":
+			fmt.Fprint(w, `[{"id":1,"issue_type":"warning","description":"Missing links"},
+				{"id":2,"issue_type":"error","description":"Quiz could not be imported"}]`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	canvas := canvasapi.New("token", "", canvasapi.WithBaseURL(server.URL))
+	opts := MigrationOptions{
+		Name:            "course.imscc",
+		InitialInterval: time.Millisecond,
+		Select: func(items []*models.SelectiveImportItem) ([]string, error) {
+			if len(items) != 2 || len(items[1].SubItems) != 1 || len(items[1].SubItems[0].SubItems) != 1 {
+				t.Errorf("unexpected items %+v", items)
+				return nil, fmt.Errorf("unexpected items")
+			}
+			return []string{items[0].Property, items[1].SubItems[0].SubItems[0].Property}, nil
+		},
+	}
+	opts.Request.Form.MigrationType = "common_cartridge_importer"
+	result, err := Migrate(context.Background(), &canvas, CourseMigrations("1"), strings.NewReader("package"), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !updated || result.Migration.WorkflowState != "completed" {
+		t.Errorf("unexpected migration %+v", result.Migration)
+	}
+	if len(result.Warnings()) != 1 || result.Warnings()[0].Description != "Missing links" || len(result.Errors()) != 1 {
+		t.Errorf("unexpected issues %+v", result.Issues)
+	}
+}
+
+func TestMigrateFailed(t *testing.T) {
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/groups/2/content_migrations":
+			r.ParseForm()
+			if r.Form.Get("settings[file_url]") != "http://example.com/files.zip" {
+				t.Errorf("unexpected migration form %v", r.Form)
+			}
+			fmt.Fprint(w, `{"id":5,"workflow_state":"running","progress_url":"/api/v1/progress/9"}`)
+		case "/api/v1/progress/9":
+			polls++
+			if polls < 2 {
+				fmt.Fprint(w, `{"id":9,"workflow_state":"running"}`)
+				return
+			}
+			fmt.Fprint(w, `{"id":9,"workflow_state":"failed"}`)
+		case "/api/v1/groups/2/content_migrations/5":
+			fmt.Fprint(w, `{"id":5,"workflow_state":"failed"}`)
+		case "/api/v1/groups/2/content_migrations/5/migration_issues":
+			fmt.Fprint(w, `[{"id":3,"issue_type":"error","description":"The file could not be downloaded"}]`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	canvas := canvasapi.New("token", "", canvasapi.WithBaseURL(server.URL))
+	var states []string
+	opts := MigrationOptions{
+		InitialInterval: time.Millisecond,
+		OnProgress: func(p *models.Progress) {
+			states = append(states, p.WorkflowState)
+		},
+	}
+	opts.Request.Form.MigrationType = "zip_file_importer"
+	opts.Request.Form.Settings.FileUrl = "http://example.com/files.zip"
+	result, err := Migrate(context.Background(), &canvas, GroupMigrations("2"), nil, opts)
+	var migrationError *MigrationError
+	if !errors.As(err, &migrationError) || err.Error() != "content migration 5 failed: The file could not be downloaded" {
+		t.Fatalf("expected a migration error, got %v", err)
+	}
+	if result.Migration.ID != 5 || strings.Join(states, ",") != "running,failed" {
+		t.Errorf("unexpected result %+v %v", result.Migration, states)
+	}
+}
+
+func TestMigratePreAttachmentError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":6,"workflow_state":"pre_processing","pre_attachment":{"upload_url":"","message":"file exceeded quota","upload_params":{}}}`)
+	}))
+	defer server.Close()
+
+	canvas := canvasapi.New("token", "", canvasapi.WithBaseURL(server.URL))
+	opts := MigrationOptions{Name: "files.zip"}
+	opts.Request.Form.MigrationType = "zip_file_importer"
+	_, err := Migrate(context.Background(), &canvas, UserMigrations(canvasapi.Self), strings.NewReader("zip"), opts)
+	if err == nil || !strings.HasSuffix(err.Error(), "file exceeded quota") {
+		t.Errorf("expected the pre_attachment message, got %v", err)
+	}
+}
+
+func TestMigrateStreamsUnsizedPackage(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/courses/1/content_migrations":
+			r.ParseForm()
+			if _, ok := r.Form["pre_attachment[size]"]; ok {
+				t.Errorf("expected no size for an unsized reader, got %v", r.Form)
+			}
+			fmt.Fprintf(w, `{"id":7,"workflow_state":"completed","pre_attachment":{"upload_url":"%s/storage","upload_params":{},"file_param":"file"}}`, server.URL)
+		case "/storage":
+			file, _, err := r.FormFile("file")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			data, _ := ioutil.ReadAll(file)
+			if string(data) != "package" {
+				t.Errorf("unexpected package %q", data)
+			}
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id":31}`)
+		case "/api/v1/courses/1/content_migrations/7/migration_issues":
+			fmt.Fprint(w, `[]`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	canvas := canvasapi.New("token", "", canvasapi.WithBaseURL(server.URL))
+	opts := MigrationOptions{Name: "course.imscc"}
+	opts.Request.Form.MigrationType = "common_cartridge_importer"
+	unsized := struct{ io.Reader }{strings.NewReader("package")}
+	result, err := Migrate(context.Background(), &canvas, CourseMigrations("1"), unsized, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Migration.ID != 7 {
+		t.Errorf("unexpected migration %+v", result.Migration)
+	}
+}
@@ -0,0 +1,162 @@
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
+	"github.com/atomicjolt/canvasapi/requests"
+)
+
+// PollOptions configure Poll.
+type PollOptions struct {
+	// InitialInterval is the time before the first poll. Defaults to one second.
+	InitialInterval time.Duration
+	// MaxInterval caps the time between polls, which grows by half after every poll. Defaults
+	// to 30 seconds.
+	MaxInterval time.Duration
+}
+
+// Poll waits for a job by calling done with the state the caller last fetched, and update to
+// fetch the next state, until done reports the job finished or either returns an error. The
+// wait before each update grows as described by PollOptions. Poll returns the error of done
+// or update, or the error of ctx when it is done first.
+//
+//	err := workflow.Poll(ctx, workflow.PollOptions{}, func() (bool, error) {
+//		return job.WorkflowState == "completed", nil
+//	}, func(ctx context.Context) (err error) {
+//		job, err = fetchJob(ctx, job.ID)
+//		return err
+//	})
+func Poll(ctx context.Context, opts PollOptions, done func() (bool, error), update func(ctx context.Context) error) error {
+	interval := opts.InitialInterval
+	if interval <= 0 {
+		interval = time.Second
+	}
+	maxInterval := opts.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = 30 * time.Second
+	}
+
+	for {
+		finished, err := done()
+		if err != nil || finished {
+			return err
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		interval += interval / 2
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+
+		if err := update(ctx); err != nil {
+			return err
+		}
+	}
+}
+
+// ProgressOptions configure WaitForProgress.
+type ProgressOptions struct {
+	// InitialInterval and MaxInterval pace the polls, see PollOptions.
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+	// OnProgress is called with the initial progress and every update fetched after it.
+	OnProgress func(*models.Progress)
+	// Result, when not nil, is decoded from the result the completed job points to. Nothing is
+	// fetched when the progress has no result url.
+	Result interface{}
+}
+
+// ProgressError is returned by WaitForProgress when the job fails.
+type ProgressError struct {
+	Progress *models.Progress
+}
+
+func (e *ProgressError) Error() string {
+	msg := fmt.Sprintf("%s job %d failed", e.Progress.Tag, e.Progress.ID)
+	if e.Progress.Message != "" {
+		msg += ": " + e.Progress.Message
+	}
+	return msg
+}
+
+// WaitForProgress polls progress until its job completes and returns the completed progress.
+// It returns a *ProgressError when the job fails.
+func WaitForProgress(ctx context.Context, c *canvasapi.Canvas, progress *models.Progress, opts ProgressOptions) (*models.Progress, error) {
+	if progress == nil || progress.ID == 0 {
+		return nil, fmt.Errorf("a progress id is required to wait for a job")
+	}
+	err := Poll(ctx, PollOptions{InitialInterval: opts.InitialInterval, MaxInterval: opts.MaxInterval}, func() (bool, error) {
+		if opts.OnProgress != nil {
+			opts.OnProgress(progress)
+		}
+		switch progress.WorkflowState {
+		case "completed":
+			if opts.Result != nil {
+				return true, fetchResult(ctx, c, progress, opts.Result)
+			}
+			return true, nil
+		case "failed":
+			return true, &ProgressError{Progress: progress}
+		}
+		return false, nil
+	}, func(ctx context.Context) error {
+		query := requests.QueryProgress{}
+		query.Path.ID = canvasapi.IDFromInt(progress.ID)
+		next, err := query.DoContext(ctx, c)
+		if err != nil {
+			return err
+		}
+		progress = next
+		return nil
+	})
+	return progress, err
+}
+
+// resultURL returns the url of the result of a completed job. Jobs report it in their
+// results, or in url when that is not the url of the progress itself.
+func resultURL(progress *models.Progress) string {
+	for _, key := range []string{"url", "location"} {
+		if u, ok := progress.Results[key].(string); ok && u != "" {
+			return u
+		}
+	}
+	if progress.Url != "" && !strings.Contains(progress.Url, "/progress/") {
+		return progress.Url
+	}
+	return ""
+}
+
+func fetchResult(ctx context.Context, c *canvasapi.Canvas, progress *models.Progress, result interface{}) error {
+	location := resultURL(progress)
+	if location == "" {
+		return nil
+	}
+	u, err := c.ResolveURL(location)
+	if err != nil {
+		return err
+	}
+	response, err := c.SendContext(ctx, u, http.MethodGet, nil)
+	if err != nil {
+		return err
+	}
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, result)
+}
@@ -0,0 +1,74 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
+)
+
+func TestWaitForProgress(t *testing.T) {
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/progress/3":
+			polls++
+			if polls < 3 {
+				fmt.Fprintf(w, `{"id":3,"workflow_state":"running","completion":%d}`, polls*40)
+				return
+			}
+			fmt.Fprint(w, `{"id":3,"workflow_state":"completed","completion":100,"results":{"url":"/api/v1/courses/1/content_exports/8"}}`)
+		case "/api/v1/courses/1/content_exports/8":
+			fmt.Fprint(w, `{"id":8,"workflow_state":"exported"}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	canvas := canvasapi.New("token", "", canvasapi.WithBaseURL(server.URL))
+	var completions []int64
+	var export models.ContentExport
+	progress, err := WaitForProgress(context.Background(), &canvas, &models.Progress{ID: 3, WorkflowState: "queued"}, ProgressOptions{
+		InitialInterval: 1,
+		OnProgress: func(p *models.Progress) {
+			completions = append(completions, p.Completion)
+		},
+		Result: &export,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if progress.WorkflowState != "completed" {
+		t.Errorf("unexpected progress %+v", progress)
+	}
+	if fmt.Sprint(completions) != "[0 40 80 100]" {
+		t.Errorf("unexpected progress updates %v", completions)
+	}
+	if export.ID != 8 {
+		t.Errorf("expected the result to be fetched, got %+v", export)
+	}
+}
+
+func TestWaitForProgressFailed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":4,"tag":"course_batch_update","workflow_state":"failed","message":"boom"}`)
+	}))
+	defer server.Close()
+
+	canvas := canvasapi.New("token", "", canvasapi.WithBaseURL(server.URL))
+	_, err := WaitForProgress(context.Background(), &canvas, &models.Progress{ID: 4, WorkflowState: "running"}, ProgressOptions{InitialInterval: 1})
+	var progressError *ProgressError
+	if !errors.As(err, &progressError) {
+		t.Fatalf("expected a ProgressError, got %v", err)
+	}
+	if progressError.Error() != "course_batch_update job 4 failed: boom" {
+		t.Errorf("unexpected error %q", progressError.Error())
+	}
+}
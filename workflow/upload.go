@@ -0,0 +1,449 @@
+// Package workflow implements the Canvas operations that take more than one request, such as
+// the three step file upload.
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"time"
+
+	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
+	"github.com/atomicjolt/canvasapi/requests"
+)
+
+// FileUploadOptions describe the file sent by Upload.
+type FileUploadOptions struct {
+	// Name is the filename in Canvas. Defaults to the base name of the reader when it is an
+	// *os.File.
+	Name string
+	// ContentType defaults to the type registered for the extension of Name.
+	ContentType string
+	// Size defaults to the number of bytes left in the reader when it can seek, such as an
+	// *os.File, or reports its length. The size is left out when it is unknown and the file
+	// is streamed to the upload url without reading it into memory. Set it to
+	// canvasapi.Some[int64](0) to upload an empty file from a reader that doesn't tell.
+	Size canvasapi.Opt[int64]
+	// ParentFolderID and ParentFolderPath choose the folder the file is stored in. They only
+	// apply to course, user and group files.
+	ParentFolderID   string
+	ParentFolderPath string
+	// OnDuplicate is either "overwrite" or "rename".
+	OnDuplicate string
+
+	// URL has Canvas download the file from URL instead of uploading the reader, which may be
+	// nil. Upload polls the progress of the download until it finishes.
+	URL string
+	// PollInterval is the time before the first progress check of a URL upload, see
+	// ProgressOptions.InitialInterval.
+	PollInterval time.Duration
+	// OnProgress is called with every progress update of a URL upload.
+	OnProgress func(*models.Progress)
+}
+
+// UploadTarget is a place in Canvas that files can be uploaded to.
+type UploadTarget struct {
+	preflight func(opts FileUploadOptions) uploadRequest
+	// fileOnly is set for endpoints that can't download the file from a URL.
+	fileOnly bool
+}
+
+type uploadRequest interface {
+	DoContext(ctx context.Context, c *canvasapi.Canvas) (*models.UploadParams, error)
+}
+
+// sizeParam leaves the size out when it is unknown, which readerSize reports as -1.
+func sizeParam(size int64) canvasapi.Opt[int64] {
+	if size < 0 {
+		return canvasapi.None[int64]()
+	}
+	return canvasapi.Some(size)
+}
+
+// CourseFiles uploads to the files of a course.
+func CourseFiles(courseID canvasapi.ID) UploadTarget {
+	return UploadTarget{preflight: func(opts FileUploadOptions) uploadRequest {
+		r := &requests.CoursesUploadFile{}
+		r.Path.CourseID = courseID
+		r.Form.Name = opts.Name
+		r.Form.Size = opts.Size
+		r.Form.ContentType = opts.ContentType
+		r.Form.ParentFolderID = opts.ParentFolderID
+		r.Form.ParentFolderPath = opts.ParentFolderPath
+		r.Form.OnDuplicate = opts.OnDuplicate
+		r.Form.Url = opts.URL
+		return r
+	}}
+}
+
+// UserFiles uploads to the personal files of a user. Use canvasapi.Self for the current user.
+func UserFiles(userID canvasapi.ID) UploadTarget {
+	return UploadTarget{preflight: func(opts FileUploadOptions) uploadRequest {
+		r := &requests.UsersUploadFile{}
+		r.Path.UserID = userID
+		r.Form.Name = opts.Name
+		r.Form.Size = opts.Size
+		r.Form.ContentType = opts.ContentType
+		r.Form.ParentFolderID = opts.ParentFolderID
+		r.Form.ParentFolderPath = opts.ParentFolderPath
+		r.Form.OnDuplicate = opts.OnDuplicate
+		r.Form.Url = opts.URL
+		return r
+	}}
+}
+
+// GroupFiles uploads to the files of a group.
+func GroupFiles(groupID canvasapi.ID) UploadTarget {
+	return UploadTarget{preflight: func(opts FileUploadOptions) uploadRequest {
+		r := &requests.GroupsUploadFile{}
+		r.Path.GroupID = groupID
+		r.Form.Name = opts.Name
+		r.Form.Size = opts.Size
+		r.Form.ContentType = opts.ContentType
+		r.Form.ParentFolderID = opts.ParentFolderID
+		r.Form.ParentFolderPath = opts.ParentFolderPath
+		r.Form.OnDuplicate = opts.OnDuplicate
+		r.Form.Url = opts.URL
+		return r
+	}}
+}
+
+// FolderFiles uploads into a folder.
+func FolderFiles(folderID canvasapi.ID) UploadTarget {
+	return UploadTarget{preflight: func(opts FileUploadOptions) uploadRequest {
+		r := &requests.FilesUploadFile{}
+		r.Path.FolderID = folderID
+		r.Form.Name = opts.Name
+		r.Form.Size = opts.Size
+		r.Form.ContentType = opts.ContentType
+		r.Form.OnDuplicate = opts.OnDuplicate
+		r.Form.Url = opts.URL
+		return r
+	}}
+}
+
+// SubmissionFiles uploads a file for a student to submit to an online_upload assignment.
+// Submit the returned file id with SubmitAssignmentCourses.
+func SubmissionFiles(courseID, assignmentID, userID canvasapi.ID) UploadTarget {
+	return UploadTarget{preflight: func(opts FileUploadOptions) uploadRequest {
+		r := &requests.UploadFileCourses{}
+		r.Path.CourseID = courseID
+		r.Path.AssignmentID = assignmentID
+		r.Path.UserID = userID
+		r.Form.Name = opts.Name
+		r.Form.Size = opts.Size
+		r.Form.ContentType = opts.ContentType
+		r.Form.OnDuplicate = opts.OnDuplicate
+		r.Form.Url = opts.URL
+		return r
+	}}
+}
+
+// SectionSubmissionFiles is SubmissionFiles for an assignment reached through a section.
+func SectionSubmissionFiles(sectionID, assignmentID, userID canvasapi.ID) UploadTarget {
+	return UploadTarget{preflight: func(opts FileUploadOptions) uploadRequest {
+		r := &requests.UploadFileSections{}
+		r.Path.SectionID = sectionID
+		r.Path.AssignmentID = assignmentID
+		r.Path.UserID = userID
+		r.Form.Name = opts.Name
+		r.Form.Size = opts.Size
+		r.Form.ContentType = opts.ContentType
+		r.Form.OnDuplicate = opts.OnDuplicate
+		r.Form.Url = opts.URL
+		return r
+	}}
+}
+
+// SubmissionCommentFiles uploads a file to attach to a submission comment. URL uploads are
+// not supported by this endpoint and Upload rejects them.
+func SubmissionCommentFiles(courseID, assignmentID, userID canvasapi.ID) UploadTarget {
+	return UploadTarget{preflight: func(opts FileUploadOptions) uploadRequest {
+		r := &requests.SubmissionCommentsUploadFile{}
+		r.Path.CourseID = courseID
+		r.Path.AssignmentID = assignmentID
+		r.Path.UserID = userID
+		r.Form.Name = opts.Name
+		r.Form.Size = opts.Size
+		r.Form.ContentType = opts.ContentType
+		return r
+	}, fileOnly: true}
+}
+
+// QuizSubmissionFiles uploads a file for the current user's submission to a quiz. URL uploads
+// are not supported by this endpoint and Upload rejects them.
+func QuizSubmissionFiles(courseID, quizID canvasapi.ID) UploadTarget {
+	return UploadTarget{preflight: func(opts FileUploadOptions) uploadRequest {
+		r := &requests.QuizSubmissionFilesUploadFile{}
+		r.Path.CourseID = courseID
+		r.Path.QuizID = quizID
+		r.Form.Name = opts.Name
+		r.Form.Size = opts.Size
+		r.Form.ContentType = opts.ContentType
+		r.Form.OnDuplicate = opts.OnDuplicate
+		return r
+	}, fileOnly: true}
+}
+
+// Upload runs the Canvas file upload workflow, see
+// https://canvas.instructure.com/doc/api/file.file_uploads.html
+// It tells Canvas about the file, posts the file data to the returned upload url and
+// confirms the upload, returning the new file. When opts.URL is set Canvas downloads the
+// file instead and Upload waits for the download to finish.
+func Upload(ctx context.Context, c *canvasapi.Canvas, target UploadTarget, r io.Reader, opts FileUploadOptions) (*models.File, error) {
+	if opts.Name == "" {
+		if named, ok := r.(interface{ Name() string }); ok {
+			opts.Name = filepath.Base(named.Name())
+		}
+	}
+	if opts.ContentType == "" && opts.Name != "" {
+		opts.ContentType = mime.TypeByExtension(filepath.Ext(opts.Name))
+	}
+
+	if opts.URL != "" && target.fileOnly {
+		return nil, fmt.Errorf("this upload target doesn't support URL uploads")
+	}
+	size := int64(-1)
+	if opts.URL == "" {
+		if r == nil {
+			return nil, fmt.Errorf("a reader or a URL is required to upload a file")
+		}
+		if value, ok := opts.Size.Get(); ok {
+			size = value
+		} else {
+			var err error
+			size, err = readerSize(r)
+			if err != nil {
+				return nil, err
+			}
+			opts.Size = sizeParam(size)
+		}
+	}
+
+	params, err := target.preflight(opts).DoContext(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.URL != "" {
+		return uploadFromURL(ctx, c, params, opts)
+	}
+
+	if params.UploadUrl == "" {
+		return nil, fmt.Errorf("Canvas did not return an upload_url")
+	}
+	response, err := postUpload(ctx, c, params, &canvasapi.File{
+		Name:        opts.Name,
+		ContentType: opts.ContentType,
+		Content:     r,
+	}, size)
+	if err != nil {
+		return nil, err
+	}
+	return confirmUpload(ctx, c, response)
+}
+
+// readerSize returns the number of bytes left in r, or -1 when r doesn't tell.
+func readerSize(r io.Reader) (int64, error) {
+	switch sized := r.(type) {
+	case interface{ Len() int }:
+		return int64(sized.Len()), nil
+	case io.Seeker:
+		current, err := sized.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return -1, nil
+		}
+		end, err := sized.Seek(0, io.SeekEnd)
+		if err != nil {
+			return -1, err
+		}
+		if _, err := sized.Seek(current, io.SeekStart); err != nil {
+			return -1, err
+		}
+		return end - current, nil
+	}
+	return -1, nil
+}
+
+// postUpload posts the upload params, followed by the file when there is one, to the upload
+// url. The file is streamed, size is its size or -1 when unknown. The access token is not
+// sent because the upload url usually belongs to a storage service rather than Canvas.
+// Redirects are not followed so that the confirmation can be sent with the token.
+func postUpload(ctx context.Context, c *canvasapi.Canvas, params *models.UploadParams, file *canvasapi.File, size int64) (*http.Response, error) {
+	values := url.Values{}
+	for key, value := range params.UploadParams {
+		values.Set(key, value)
+	}
+	field := params.FileParam
+	if field == "" {
+		field = "file"
+	}
+	var content canvasapi.File
+	if file != nil {
+		content = *file
+	}
+	body, length, contentType, err := canvasapi.MultipartReader(values, field, content, size)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, params.UploadUrl, body)
+	if err != nil {
+		return nil, err
+	}
+	request.ContentLength = length
+	request.Header.Set("Content-Type", contentType)
+	request.Header.Set("User-Agent", c.UserAgent)
+
+	client := *c.Client()
+	client.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode >= 300 && response.StatusCode < 400 {
+		return response, nil
+	}
+	if err := canvasapi.CheckResponse(response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// confirmUpload completes the upload from the response to the file data. A redirect has to
+// be followed with the access token for the file to become available. A 201 Created response
+// may already hold the file, otherwise it is fetched from the Location.
+func confirmUpload(ctx context.Context, c *canvasapi.Canvas, response *http.Response) (*models.File, error) {
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	location := response.Header.Get("Location")
+	if response.StatusCode < 300 {
+		created := struct {
+			models.File
+			Location string `json:"location"`
+		}{}
+		if len(body) > 0 {
+			if err := json.Unmarshal(body, &created); err != nil {
+				return nil, err
+			}
+		}
+		if created.ID != 0 {
+			return &created.File, nil
+		}
+		if created.Location != "" {
+			location = created.Location
+		}
+	}
+	if location == "" {
+		return nil, fmt.Errorf("upload response %s did not include the file or a location", response.Status)
+	}
+
+	confirmURL, err := c.ResolveURL(location)
+	if err != nil {
+		return nil, err
+	}
+	confirmation, err := c.SendContext(ctx, confirmURL, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err = ioutil.ReadAll(confirmation.Body)
+	confirmation.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	file := models.File{}
+	if err := json.Unmarshal(body, &file); err != nil {
+		return nil, err
+	}
+	return &file, nil
+}
+
+// uploadFromURL starts the download of opts.URL, waits for it and fetches the new file.
+func uploadFromURL(ctx context.Context, c *canvasapi.Canvas, params *models.UploadParams, opts FileUploadOptions) (*models.File, error) {
+	progress := params.Progress
+	if params.UploadUrl != "" {
+		response, err := postUpload(ctx, c, params, nil, -1)
+		if err != nil {
+			return nil, err
+		}
+		body, err := ioutil.ReadAll(response.Body)
+		response.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		if progress == nil {
+			progress = &models.Progress{}
+			if err := json.Unmarshal(body, progress); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if progress == nil {
+		return nil, fmt.Errorf("Canvas did not return the progress of the URL upload")
+	}
+
+	progress, err := WaitForProgress(ctx, c, progress, ProgressOptions{
+		InitialInterval: opts.PollInterval,
+		OnProgress:      opts.OnProgress,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	fileID, err := uploadedFileID(ctx, c, progress)
+	if err != nil {
+		return nil, err
+	}
+	getFile := requests.GetFileFiles{}
+	getFile.Path.ID = canvasapi.IDFromInt(fileID.Int64())
+	return getFile.DoContext(ctx, c)
+}
+
+// uploadedFileID reads the id of the file a URL upload created from its completed progress.
+// The progress is fetched once more because Results holds numbers as float64, which can't
+// hold every id, so the id is decoded as a models.ID instead.
+func uploadedFileID(ctx context.Context, c *canvasapi.Canvas, progress *models.Progress) (models.ID, error) {
+	query := requests.QueryProgress{}
+	query.Path.ID = canvasapi.IDFromInt(progress.ID)
+	response, err := c.SendRequestContext(ctx, &query)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	completed := struct {
+		Results struct {
+			ID models.ID `json:"id"`
+		} `json:"results"`
+	}{}
+	if err := json.NewDecoder(response.Body).Decode(&completed); err != nil {
+		return 0, err
+	}
+	if completed.Results.ID == 0 {
+		return 0, fmt.Errorf("URL upload completed without a file id")
+	}
+	return completed.Results.ID, nil
+}
+
+// ImportSIS posts SIS data from r to the account as a multipart attachment named name. The
+// other import settings are taken from request.
+func ImportSIS(ctx context.Context, c *canvasapi.Canvas, request requests.ImportSISData, name string, r io.Reader) (*models.SISImport, error) {
+	request.Form.Attachment = &canvasapi.File{
+		Name:        name,
+		ContentType: mime.TypeByExtension(filepath.Ext(name)),
+		Content:     r,
+	}
+	return request.DoContext(ctx, c)
+}
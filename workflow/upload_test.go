@@ -0,0 +1,208 @@
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/atomicjolt/canvasapi"
+	"github.com/atomicjolt/canvasapi/models"
+)
+
+func TestUpload(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/courses/1/files":
+			r.ParseForm()
+			if r.Form.Get("name") != "notes.txt" || r.Form.Get("size") != "5" || r.Form.Get("parent_folder_path") != "docs" {
+				t.Errorf("unexpected preflight form %v", r.Form)
+			}
+			fmt.Fprintf(w, `{"upload_url":"%s/storage","upload_params":{"key":"abc"},"file_param":"attachment"}`, server.URL)
+		case "/storage":
+			if r.Header.Get("Authorization") != "" {
+				t.Errorf("the access token was sent to the upload url")
+			}
+			if err := r.ParseMultipartForm(1024); err != nil {
+				t.Fatal(err)
+			}
+			file, header, err := r.FormFile("attachment")
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, _ := ioutil.ReadAll(file)
+			if r.FormValue("key") != "abc" || header.Filename != "notes.txt" || string(data) != "hello" {
+				t.Errorf("unexpected upload key=%q filename=%q data=%q", r.FormValue("key"), header.Filename, data)
+			}
+			http.Redirect(w, r, "/api/v1/files/5/create_success?uuid=x", http.StatusFound)
+		case "/api/v1/files/5/create_success":
+			if r.Header.Get("Authorization") != "Bearer token" {
+				t.Errorf("the confirmation was not authenticated")
+			}
+			fmt.Fprint(w, `{"id":5,"display_name":"notes.txt","size":5}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	canvas := canvasapi.New("token", "", canvasapi.WithBaseURL(server.URL))
+	file, err := Upload(context.Background(), &canvas, CourseFiles("1"), strings.NewReader("hello"), FileUploadOptions{
+		Name:             "notes.txt",
+		ParentFolderPath: "docs",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if file.ID != 5 || file.DisplayName != "notes.txt" {
+		t.Errorf("unexpected file %+v", file)
+	}
+}
+
+func TestUploadStreamsUnsizedReader(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/groups/2/files":
+			r.ParseForm()
+			if _, ok := r.Form["size"]; ok {
+				t.Errorf("expected the unknown size to be left out, got %v", r.Form)
+			}
+			fmt.Fprintf(w, `{"upload_url":"%s/storage","upload_params":{}}`, server.URL)
+		case "/storage":
+			if r.ContentLength != -1 {
+				t.Errorf("expected a streamed body, got a length of %d", r.ContentLength)
+			}
+			file, _, err := r.FormFile("file")
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, _ := ioutil.ReadAll(file)
+			if string(data) != "hello world" {
+				t.Errorf("unexpected upload %q", data)
+			}
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id":6,"display_name":"notes.txt"}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	canvas := canvasapi.New("token", "", canvasapi.WithBaseURL(server.URL))
+	r := io.MultiReader(strings.NewReader("hello "), strings.NewReader("world"))
+	file, err := Upload(context.Background(), &canvas, GroupFiles("2"), r, FileUploadOptions{Name: "notes.txt"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if file.ID != 6 {
+		t.Errorf("unexpected file %+v", file)
+	}
+}
+
+func TestUploadEmptyFile(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/users/self/files":
+			r.ParseForm()
+			if r.Form.Get("size") != "0" {
+				t.Errorf("expected an explicit size of 0, got %v", r.Form)
+			}
+			fmt.Fprintf(w, `{"upload_url":"%s/storage","upload_params":{}}`, server.URL)
+		case "/storage":
+			if r.ContentLength == -1 {
+				t.Errorf("expected the length of an empty file to be known")
+			}
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id":7,"display_name":"empty.txt","size":0}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	canvas := canvasapi.New("token", "", canvasapi.WithBaseURL(server.URL))
+	r := io.MultiReader()
+	file, err := Upload(context.Background(), &canvas, UserFiles(canvasapi.Self), r, FileUploadOptions{
+		Name: "empty.txt",
+		Size: canvasapi.Some[int64](0),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if file.ID != 7 {
+		t.Errorf("unexpected file %+v", file)
+	}
+}
+
+func TestUploadFromURLUnsupported(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL)
+	}))
+	defer server.Close()
+
+	canvas := canvasapi.New("token", "", canvasapi.WithBaseURL(server.URL))
+	_, err := Upload(context.Background(), &canvas, QuizSubmissionFiles("1", "2"), nil, FileUploadOptions{
+		Name: "notes.txt",
+		URL:  "https://example.com/notes.txt",
+	})
+	if err == nil {
+		t.Errorf("expected URL uploads to quiz submissions to fail")
+	}
+}
+
+func TestUploadFromURL(t *testing.T) {
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/users/self/files":
+			r.ParseForm()
+			if r.Form.Get("url") != "http://example.com/a.pdf" {
+				t.Errorf("unexpected preflight form %v", r.Form)
+			}
+			fmt.Fprint(w, `{"progress":{"id":9,"workflow_state":"queued"}}`)
+		case "/api/v1/progress/9":
+			polls++
+			if polls < 2 {
+				fmt.Fprint(w, `{"id":9,"workflow_state":"running","completion":50}`)
+				return
+			}
+			fmt.Fprint(w, `{"id":9,"workflow_state":"completed","completion":100,"results":{"id":9007199254740993}}`)
+		case "/api/v1/files/9007199254740993":
+			fmt.Fprint(w, `{"id":9007199254740993,"display_name":"a.pdf"}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	canvas := canvasapi.New("token", "", canvasapi.WithBaseURL(server.URL))
+	var completions []int64
+	file, err := Upload(context.Background(), &canvas, UserFiles("self"), nil, FileUploadOptions{
+		Name:         "a.pdf",
+		URL:          "http://example.com/a.pdf",
+		PollInterval: 1,
+		OnProgress: func(p *models.Progress) {
+			completions = append(completions, p.Completion)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if file.ID != 9007199254740993 {
+		t.Errorf("unexpected file %+v", file)
+	}
+	if fmt.Sprint(completions) != "[0 50 100]" {
+		t.Errorf("unexpected progress updates %v", completions)
+	}
+}